@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	entityv1 "github.com/history-quiz/historyquiz/proto/entity/v1"
+	questionv1 "github.com/history-quiz/historyquiz/proto/question/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// questionctl は作問チーム向けの管理 CLI。
+// バックエンドの gRPC に直接つなぎ、userId は metadata（x-user-id）で渡す。
+// NOTE: バックエンドは userId を検証しない前提（Remix が認証済み）のため、内部ネットワークからのみ使う。
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = runImport(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	case "import-entities":
+		err = runImportEntities(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: questionctl <command> [flags]
+
+commands:
+  import   CSV/JSON から問題を一括作成する（-dry-run で検証のみ）
+  export   自分の問題をすべて CSV/JSON/Anki 形式で書き出す
+  import-entities
+           CSV/JSON-LD から人物/出来事/場所/王朝と関係を取り込む（管理者のみ。-dry-run で検証のみ）`)
+}
+
+// runImport は import サブコマンド。1行でも不正があれば何も作成せず、行ごとのエラーを表示して終了コード 1 を返す。
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	addr := fs.String("addr", envOr("BACKEND_GRPC_ADDR", "127.0.0.1:50051"), "バックエンドの gRPC アドレス")
+	userID := fs.String("user-id", os.Getenv("QUESTIONCTL_USER_ID"), "作成者の userId（OIDC sub）")
+	format := fs.String("format", "", "csv または json（省略時は拡張子から判定）")
+	dryRun := fs.Bool("dry-run", false, "検証のみ行い、作成しない")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("取り込むファイルを1つ指定してください")
+	}
+	if *userID == "" {
+		return fmt.Errorf("-user-id（または QUESTIONCTL_USER_ID）が必要です")
+	}
+	path := fs.Arg(0)
+
+	fileFormat, err := resolveFileFormat(*format, path)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("接続に失敗しました: %w", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", *userID)
+
+	resp, err := questionv1.NewQuestionServiceClient(conn).ImportQuestions(ctx, &questionv1.ImportQuestionsRequest{
+		Format:  fileFormat,
+		Content: content,
+		DryRun:  *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.GetRowErrors()) > 0 {
+		for _, rowErr := range resp.GetRowErrors() {
+			for _, v := range rowErr.GetFieldViolations() {
+				fmt.Printf("%s:%d: %s: %s\n", path, rowErr.GetRow(), v.GetField(), v.GetDescription())
+			}
+		}
+		return fmt.Errorf("%d/%d 行が不正です（何も作成していません）", len(resp.GetRowErrors()), resp.GetTotalRows())
+	}
+	if *dryRun {
+		fmt.Printf("%d 問すべて取り込み可能です（dry-run）\n", resp.GetTotalRows())
+		return nil
+	}
+	for _, q := range resp.GetQuestions() {
+		fmt.Printf("%s\t%s\n", q.GetId(), q.GetPrompt())
+	}
+	fmt.Printf("%d 問を作成しました（状態: 下書き）\n", len(resp.GetQuestions()))
+	return nil
+}
+
+// runImportEntities は import-entities サブコマンド。key で照合して作成/更新する（同じファイルを何度流してもよい）。
+// 1件でも不正があれば何も変更せず、行ごとのエラーを表示して終了コード 1 を返す。
+func runImportEntities(args []string) error {
+	fs := flag.NewFlagSet("import-entities", flag.ExitOnError)
+	addr := fs.String("addr", envOr("BACKEND_GRPC_ADDR", "127.0.0.1:50051"), "バックエンドの gRPC アドレス")
+	userID := fs.String("user-id", os.Getenv("QUESTIONCTL_USER_ID"), "管理者の userId（OIDC sub）")
+	format := fs.String("format", "", "csv または jsonld（省略時は拡張子から判定、.json も jsonld）")
+	dryRun := fs.Bool("dry-run", false, "検証のみ行い、変更しない")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("取り込むファイルを1つ指定してください")
+	}
+	if *userID == "" {
+		return fmt.Errorf("-user-id（または QUESTIONCTL_USER_ID）が必要です")
+	}
+	path := fs.Arg(0)
+
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	var fileFormat entityv1.EntityFileFormat
+	switch *format {
+	case "csv":
+		fileFormat = entityv1.EntityFileFormat_ENTITY_FILE_FORMAT_CSV
+	case "jsonld", "json":
+		fileFormat = entityv1.EntityFileFormat_ENTITY_FILE_FORMAT_JSON_LD
+	default:
+		return fmt.Errorf("形式を判定できません（-format に csv または jsonld を指定してください）: %q", *format)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("接続に失敗しました: %w", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", *userID)
+
+	resp, err := entityv1.NewEntityServiceClient(conn).ImportEntities(ctx, &entityv1.ImportEntitiesRequest{
+		Format:  fileFormat,
+		Content: content,
+		DryRun:  *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.GetRowErrors()) > 0 {
+		for _, rowErr := range resp.GetRowErrors() {
+			for _, v := range rowErr.GetFieldViolations() {
+				fmt.Printf("%s:%d: %s: %s\n", path, rowErr.GetRow(), v.GetField(), v.GetDescription())
+			}
+		}
+		return fmt.Errorf("%d/%d 件が不正です（何も変更していません）", len(resp.GetRowErrors()), resp.GetTotalRows())
+	}
+	if *dryRun {
+		fmt.Printf("%d 件すべて取り込み可能です（dry-run）\n", resp.GetTotalRows())
+		return nil
+	}
+	fmt.Printf("作成 %d 件、更新 %d 件、関係の追加 %d 件\n", resp.GetCreatedCount(), resp.GetUpdatedCount(), resp.GetRelationsAddedCount())
+	return nil
+}
+
+// runExport は export サブコマンド。-o を省略した場合は標準出力に書き出す。
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	addr := fs.String("addr", envOr("BACKEND_GRPC_ADDR", "127.0.0.1:50051"), "バックエンドの gRPC アドレス")
+	userID := fs.String("user-id", os.Getenv("QUESTIONCTL_USER_ID"), "書き出す問題の作者の userId（OIDC sub）")
+	format := fs.String("format", "", "csv / json / anki（省略時は -o の拡張子から判定、.tsv は anki）")
+	output := fs.String("o", "", "出力先ファイル（省略時は標準出力）")
+	_ = fs.Parse(args)
+
+	if *userID == "" {
+		return fmt.Errorf("-user-id（または QUESTIONCTL_USER_ID）が必要です")
+	}
+	if *format == "" && *output == "" {
+		return fmt.Errorf("標準出力に書き出す場合は -format を指定してください")
+	}
+	fileFormat, err := resolveFileFormat(*format, *output)
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("接続に失敗しました: %w", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", *userID)
+
+	stream, err := questionv1.NewQuestionServiceClient(conn).ExportMyQuestions(ctx, &questionv1.ExportMyQuestionsRequest{
+		Format: fileFormat,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 途中で失敗した場合に中途半端なファイルを残さないよう、一時ファイルに書いてから置き換える。
+	out := os.Stdout
+	tmpPath := ""
+	if *output != "" {
+		f, err := os.CreateTemp(filepath.Dir(*output), ".questionctl-export-*")
+		if err != nil {
+			return fmt.Errorf("出力ファイルの作成に失敗しました: %w", err)
+		}
+		defer os.Remove(f.Name()) // rename 成功後は no-op
+		defer f.Close()
+		out = f
+		tmpPath = f.Name()
+	}
+
+	var count int32
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(resp.GetChunk()); err != nil {
+			return fmt.Errorf("書き込みに失敗しました: %w", err)
+		}
+		if resp.GetQuestionCount() > 0 {
+			count = resp.GetQuestionCount()
+		}
+	}
+
+	if tmpPath != "" {
+		if err := out.Close(); err != nil {
+			return fmt.Errorf("書き込みに失敗しました: %w", err)
+		}
+		if err := os.Rename(tmpPath, *output); err != nil {
+			return fmt.Errorf("出力ファイルの保存に失敗しました: %w", err)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%d 問を書き出しました\n", count)
+	return nil
+}
+
+// resolveFileFormat は -format の値、またはファイルの拡張子から形式を決める。
+func resolveFileFormat(format string, path string) (questionv1.QuestionFileFormat, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	switch strings.ToLower(format) {
+	case "csv":
+		return questionv1.QuestionFileFormat_QUESTION_FILE_FORMAT_CSV, nil
+	case "json":
+		return questionv1.QuestionFileFormat_QUESTION_FILE_FORMAT_JSON, nil
+	case "anki", "tsv":
+		return questionv1.QuestionFileFormat_QUESTION_FILE_FORMAT_ANKI_TSV, nil
+	default:
+		return questionv1.QuestionFileFormat_QUESTION_FILE_FORMAT_UNSPECIFIED, fmt.Errorf("形式を判定できません（-format csv|json|anki を指定してください）")
+	}
+}
+
+func envOr(name string, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
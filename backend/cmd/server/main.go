@@ -2,17 +2,32 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
 	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 	"time"
 
+	"github.com/history-quiz/historyquiz/internal/app/authz"
+	"github.com/history-quiz/historyquiz/internal/app/jobrunner"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/infrastructure/blobstore"
 	"github.com/history-quiz/historyquiz/internal/infrastructure/observability"
 	"github.com/history-quiz/historyquiz/internal/infrastructure/postgres"
+	"github.com/history-quiz/historyquiz/internal/repository"
 	grpcserver "github.com/history-quiz/historyquiz/internal/transport/grpc"
+	attachmentusecase "github.com/history-quiz/historyquiz/internal/usecase/attachment"
+	deckusecase "github.com/history-quiz/historyquiz/internal/usecase/deck"
+	entityusecase "github.com/history-quiz/historyquiz/internal/usecase/entity"
+	moderationusecase "github.com/history-quiz/historyquiz/internal/usecase/moderation"
 	questionusecase "github.com/history-quiz/historyquiz/internal/usecase/question"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/draftrule"
 	quizusecase "github.com/history-quiz/historyquiz/internal/usecase/quiz"
+	searchusecase "github.com/history-quiz/historyquiz/internal/usecase/search"
+	timelineusecase "github.com/history-quiz/historyquiz/internal/usecase/timeline"
 	userusecase "github.com/history-quiz/historyquiz/internal/usecase/user"
 )
 
@@ -29,7 +44,9 @@ func main() {
 		log.Fatalf("listen failed: %v", err)
 	}
 
-	ctx := context.Background()
+	// SIGTERM/SIGINT で ctx を取り消し、定期ジョブと gRPC サーバーを一緒に止める。
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	// DB への接続は server 起動の必須要件とする。
 	// NOTE: ローカル開発では Supabase/Neon 等の接続文字列を DATABASE_URL に設定する。
@@ -42,31 +59,91 @@ func main() {
 	userRepo := postgres.NewUserRepository(pool)
 	questionRepo := postgres.NewQuestionRepository(pool)
 	attemptRepo := postgres.NewAttemptRepository(pool)
+	moderationRepo := postgres.NewModerationRepository(pool)
+	searchRepo := postgres.NewQuestionSearchRepository(pool)
+	attachmentRepo := postgres.NewAttachmentRepository(pool)
+	deckRepo := postgres.NewDeckRepository(pool)
+	entityRepo := postgres.NewEntityRepository(pool)
+	admins := authz.ParseAdminSet(os.Getenv("BACKEND_ADMIN_USER_IDS"))
+
+	blobStore, err := newBlobStore()
+	if err != nil {
+		log.Fatalf("blob store init failed: %v", err)
+	}
+	pageTokens, err := newPageTokenCodec()
+	if err != nil {
+		log.Fatalf("page token init failed: %v", err)
+	}
+	draftRules, err := newDraftRules()
+	if err != nil {
+		log.Fatalf("draft rules init failed: %v", err)
+	}
 
 	quizUC := quizusecase.NewUsecase(questionRepo, attemptRepo, userRepo)
-	questionUC := questionusecase.NewUsecase(questionRepo, userRepo)
-	userUC := userusecase.NewUsecase(attemptRepo)
+	questionUC := questionusecase.NewUsecase(questionRepo, userRepo, pageTokens, draftRules)
+	passageUC := questionusecase.NewPassageUsecase(questionRepo, userRepo, draftRules)
+	userUC := userusecase.NewUsecase(attemptRepo, pageTokens)
+	moderationUC := moderationusecase.NewUsecase(
+		moderationRepo,
+		questionRepo,
+		userRepo,
+		admins,
+		resolveReportHideThreshold(),
+		draftRules,
+	)
+	searchUC := searchusecase.NewUsecase(searchRepo, admins, pageTokens)
+	attachmentUC := attachmentusecase.NewUsecase(attachmentRepo, blobStore, userRepo)
+	deckUC := deckusecase.NewUsecase(deckRepo, userRepo)
+	entityUC := entityusecase.NewUsecase(entityRepo, admins, pageTokens)
+	timelineUC := timelineusecase.NewUsecase(entityRepo)
+
+	// NOTE: 実行中のジョブが終わるまで待ってから pool を閉じる（jobsDone）。
+	jobsDone := make(chan struct{})
+	go func() {
+		defer close(jobsDone)
+		jobrunner.Run(
+			ctx,
+			log.Default(),
+			jobrunner.Job{Name: "attachment-purge", Interval: time.Hour, Run: attachmentUC.PurgeUnusedAttachments},
+			jobrunner.Job{Name: "question-schedule", Interval: resolveScheduleInterval(), Run: questionUC.ApplyDueSchedules},
+		)
+	}()
 
 	collector := observability.NewCollector(512)
 	unaryObserver := observability.NewUnaryObserver(log.Default(), collector)
 	go observability.StartSnapshotReporter(
-		context.Background(),
+		ctx,
 		log.Default(),
 		collector,
 		resolveMetricsReportInterval(),
 	)
 
 	s := grpcserver.NewServer(grpcserver.Dependencies{
-		QuizUsecase:                   quizUC,
-		QuestionUsecase:               questionUC,
-		UserUsecase:                   userUC,
-		ObservabilityUnaryInterceptor: unaryObserver.Interceptor(),
+		QuizUsecase:                    quizUC,
+		QuestionUsecase:                questionUC,
+		PassageUsecase:                 passageUC,
+		UserUsecase:                    userUC,
+		ModerationUsecase:              moderationUC,
+		SearchUsecase:                  searchUC,
+		AttachmentUsecase:              attachmentUC,
+		DeckUsecase:                    deckUC,
+		EntityUsecase:                  entityUC,
+		TimelineUsecase:                timelineUC,
+		ObservabilityUnaryInterceptor:  unaryObserver.Interceptor(),
+		ObservabilityStreamInterceptor: unaryObserver.StreamInterceptor(),
 	})
 
+	go func() {
+		<-ctx.Done()
+		log.Printf("shutting down gRPC server")
+		s.GracefulStop()
+	}()
+
 	log.Printf("gRPC server listening on :%s", port)
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("serve failed: %v", err)
 	}
+	<-jobsDone
 }
 
 // resolveMetricsReportInterval はメトリクス定期出力間隔を秒単位で解決する。
@@ -85,3 +162,85 @@ func resolveMetricsReportInterval() time.Duration {
 	}
 	return time.Duration(seconds) * time.Second
 }
+
+// resolveScheduleInterval は公開/公開終了の予約を適用する間隔を秒単位で解決する。
+// NOTE: 予約の時刻から実際に公開されるまでの遅れは最大でこの間隔になる（公開終了は時刻で出題候補から外れるため遅れない）。
+func resolveScheduleInterval() time.Duration {
+	return time.Duration(resolvePositiveInt("BACKEND_SCHEDULE_INTERVAL_SECONDS", 60)) * time.Second
+}
+
+// resolveReportHideThreshold は問題を自動非表示にする未対応報告数を解決する。
+func resolveReportHideThreshold() int {
+	const envName = "BACKEND_REPORT_HIDE_THRESHOLD"
+
+	raw := os.Getenv(envName)
+	if raw == "" {
+		return moderationusecase.DefaultHideThreshold
+	}
+
+	threshold, err := strconv.Atoi(raw)
+	if err != nil || threshold <= 0 {
+		return moderationusecase.DefaultHideThreshold
+	}
+	return threshold
+}
+
+// newDraftRules は作問入力の内容の検証ルールを環境変数から作る。
+// 文字数の上限が未設定/不正な場合はデフォルト値を使う。無効にするルール名の誤りは起動時のエラーにする。
+func newDraftRules() (*draftrule.Pipeline, error) {
+	cfg := draftrule.DefaultConfig()
+	cfg.MaxPromptRunes = resolvePositiveInt("BACKEND_DRAFT_MAX_PROMPT_RUNES", cfg.MaxPromptRunes)
+	cfg.MaxChoiceRunes = resolvePositiveInt("BACKEND_DRAFT_MAX_CHOICE_RUNES", cfg.MaxChoiceRunes)
+	cfg.MaxExplanationRunes = resolvePositiveInt("BACKEND_DRAFT_MAX_EXPLANATION_RUNES", cfg.MaxExplanationRunes)
+	cfg.BannedWords = draftrule.ParseList(os.Getenv("BACKEND_DRAFT_BANNED_WORDS"))
+	cfg.Disabled = draftrule.ParseList(os.Getenv("BACKEND_DRAFT_DISABLED_RULES"))
+	return draftrule.New(cfg)
+}
+
+// resolvePositiveInt は正の整数の環境変数を解決する（未設定/不正な場合は defaultValue）。
+func resolvePositiveInt(envName string, defaultValue int) int {
+	raw := os.Getenv(envName)
+	if raw == "" {
+		return defaultValue
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil || v <= 0 {
+		return defaultValue
+	}
+	return v
+}
+
+// newBlobStore は添付の保存先を環境変数から作る。
+// BACKEND_BLOB_STORE=s3 の場合は S3 互換ストレージ、それ以外（既定）はローカルのディレクトリに保存する。
+func newBlobStore() (repository.BlobStore, error) {
+	switch os.Getenv("BACKEND_BLOB_STORE") {
+	case "", "fs":
+		dir := os.Getenv("BACKEND_BLOB_FS_DIR")
+		if dir == "" {
+			dir = "./data/blobs"
+		}
+		return blobstore.NewFSStore(dir)
+	case "s3":
+		return blobstore.NewS3Store(blobstore.S3Config{
+			Endpoint:           os.Getenv("BACKEND_S3_ENDPOINT"),
+			Region:             os.Getenv("BACKEND_S3_REGION"),
+			Bucket:             os.Getenv("BACKEND_S3_BUCKET"),
+			AccessKeyID:        os.Getenv("BACKEND_S3_ACCESS_KEY_ID"),
+			SecretAccessKey:    os.Getenv("BACKEND_S3_SECRET_ACCESS_KEY"),
+			VirtualHostedStyle: os.Getenv("BACKEND_S3_VIRTUAL_HOSTED_STYLE") == "true",
+		}, nil)
+	default:
+		return nil, fmt.Errorf("BACKEND_BLOB_STORE は fs または s3 を指定してください: %q", os.Getenv("BACKEND_BLOB_STORE"))
+	}
+}
+
+// newPageTokenCodec は一覧の page_token の署名鍵を BACKEND_PAGE_TOKEN_SECRET から作る。
+// 未設定の場合は起動ごとの乱数を鍵にする（再起動すると、それまでの page_token は使えなくなる）。
+func newPageTokenCodec() (pagetoken.Codec, error) {
+	secret := os.Getenv("BACKEND_PAGE_TOKEN_SECRET")
+	if secret == "" {
+		log.Printf("BACKEND_PAGE_TOKEN_SECRET is not set: page tokens are signed with a per-process random key")
+		return pagetoken.NewRandomCodec()
+	}
+	return pagetoken.NewCodec([]byte(secret)), nil
+}
@@ -0,0 +1,28 @@
+-- 記述式回答（タイプ入力）対応
+-- NOTE: 正解の選択肢ラベルは answer_keys から導出できるため、ここでは「別表記」だけを保持する。
+
+-- question_answer_aliases: 記述式で正解として扱う別表記
+CREATE TABLE IF NOT EXISTS question_answer_aliases (
+  question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+  ordinal INT NOT NULL CHECK (ordinal >= 0),
+  alias TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (question_id, ordinal)
+);
+
+-- attempts: 記述式の回答は選択肢を持たないため、selected_choice_id を任意にして入力文字列を保持する。
+-- 混同しやすい点: 複合FK（selected_choice_id, question_id）は NULL を含む行を検査しないため、そのまま維持できる。
+ALTER TABLE attempts
+  ALTER COLUMN selected_choice_id DROP NOT NULL;
+
+ALTER TABLE attempts
+  ADD COLUMN IF NOT EXISTS answer_text TEXT;
+
+ALTER TABLE attempts
+  ADD CONSTRAINT attempts_choice_or_text_present
+  CHECK (selected_choice_id IS NOT NULL OR answer_text IS NOT NULL);
+
+-- 既定問題セットの別表記（Q3: インド航路）
+INSERT INTO question_answer_aliases (question_id, ordinal, alias) VALUES
+  ('00000000-0000-0000-0000-000000000003', 0, 'Vasco da Gama')
+ON CONFLICT (question_id, ordinal) DO NOTHING;
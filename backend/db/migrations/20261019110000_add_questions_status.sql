@@ -0,0 +1,26 @@
+-- questions の公開状態（draft → published → unlisted/archived）を追加
+-- NOTE: これまでの問題は作成と同時に出題されていたため、既存行は published として扱う（挙動を変えない）。
+--       新規作成分のみ draft から始まるよう、backfill 後にデフォルト値を切り替える。
+
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'published';
+
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+
+UPDATE questions
+SET published_at = created_at
+WHERE status = 'published'
+  AND published_at IS NULL;
+
+ALTER TABLE questions
+  ALTER COLUMN status SET DEFAULT 'draft';
+
+ALTER TABLE questions
+  ADD CONSTRAINT questions_status_valid
+  CHECK (status IN ('draft', 'published', 'unlisted', 'archived'));
+
+-- 出題候補の抽出は「公開中かつ未削除」に限られるため、部分インデックスを用意する
+CREATE INDEX IF NOT EXISTS questions_published_created_at_idx
+  ON questions(created_at DESC)
+  WHERE deleted_at IS NULL AND status = 'published';
@@ -0,0 +1,36 @@
+-- 問題の報告（プレイヤー）とモデレーション（管理者）
+-- NOTE: 報告が一定数を超えた問題は hidden_at を立てて出題から外す（閾値はアプリ設定）。
+
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMPTZ;
+
+-- question_reports: 問題の報告
+CREATE TABLE IF NOT EXISTS question_reports (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+  reporter_user_id TEXT NOT NULL REFERENCES users(id),
+  reason TEXT NOT NULL CHECK (reason IN ('wrong_answer', 'ambiguous', 'typo', 'inappropriate', 'other')),
+  detail TEXT,
+  status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'dismissed', 'resolved')),
+  resolution TEXT CHECK (resolution IN ('dismiss', 'hide', 'edit')),
+  resolved_by_user_id TEXT REFERENCES users(id),
+  resolved_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 同じユーザーが同じ問題を未対応のまま重複報告できないようにする（閾値の水増し対策）
+CREATE UNIQUE INDEX IF NOT EXISTS question_reports_open_reporter_unique
+  ON question_reports(question_id, reporter_user_id)
+  WHERE status = 'open';
+
+-- 管理画面の「未対応一覧（古い順）」向け
+CREATE INDEX IF NOT EXISTS question_reports_open_created_at_idx
+  ON question_reports(created_at ASC)
+  WHERE status = 'open';
+
+-- 出題候補の部分インデックスを、非表示を除外する条件に張り替える
+DROP INDEX IF EXISTS questions_published_created_at_idx;
+
+CREATE INDEX IF NOT EXISTS questions_published_created_at_idx
+  ON questions(created_at DESC)
+  WHERE deleted_at IS NULL AND status = 'published' AND hidden_at IS NULL;
@@ -0,0 +1,19 @@
+-- 問題のタグ（作者が付ける分類）
+-- NOTE: 書き出し/取り込み（CSV/JSON/Anki）で往復できるよう、入力順を ordinal で保持する。
+
+CREATE TABLE IF NOT EXISTS question_tags (
+  question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+  ordinal INT NOT NULL CHECK (ordinal >= 0),
+  tag TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (question_id, ordinal),
+  CONSTRAINT question_tags_unique_tag UNIQUE (question_id, tag)
+);
+
+-- タグでの絞り込み用
+CREATE INDEX IF NOT EXISTS question_tags_tag_idx ON question_tags (tag);
+
+-- 書き出しのページング（作成順の keyset）用
+CREATE INDEX IF NOT EXISTS questions_author_created_at_id_idx
+  ON questions (author_user_id, created_at, id)
+  WHERE deleted_at IS NULL;
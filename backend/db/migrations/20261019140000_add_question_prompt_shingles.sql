@@ -0,0 +1,37 @@
+-- 問題文の近似重複判定用に、正規化した問題文の文字 bigram（shingle）を保持する
+-- NOTE: 値はアプリ（internal/domain/similarity.Shingles）が作成/更新時に計算して保存する。
+--       既存行だけは SQL で同じ正規化を近似して backfill する（次回の更新でアプリの値に置き換わる）。
+
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS prompt_shingles TEXT[] NOT NULL DEFAULT '{}';
+
+-- 正規化: NFKC → 小文字化 → カタカナをひらがなへ → 文字と数字以外を除去
+WITH normalized AS (
+  SELECT
+    id,
+    regexp_replace(
+      translate(
+        lower(normalize(prompt, NFKC)),
+        'ァアィイゥウェエォオカガキギクグケゲコゴサザシジスズセゼソゾタダチヂッツヅテデトドナニヌネノハバパヒビピフブプヘベペホボポマミムメモャヤュユョヨラリルレロヮワヰヱヲンヴヵヶ',
+        'ぁあぃいぅうぇえぉおかがきぎくぐけげこごさざしじすずせぜそぞただちぢっつづてでとどなにぬねのはばぱひびぴふぶぷへべぺほぼぽまみむめもゃやゅゆょよらりるれろゎわゐゑをんゔゕゖ'
+      ),
+      '[^[:alnum:]ー]', '', 'g'
+    ) AS s
+  FROM questions
+)
+UPDATE questions q
+SET prompt_shingles = COALESCE(
+  (
+    SELECT array_agg(DISTINCT substr(n.s, i, 2))
+    FROM generate_series(1, GREATEST(char_length(n.s) - 1, 1)) AS i
+    WHERE n.s <> ''
+  ),
+  '{}'
+)
+FROM normalized n
+WHERE q.id = n.id;
+
+-- 類似候補の絞り込み（prompt_shingles && $1）に使う
+CREATE INDEX IF NOT EXISTS questions_prompt_shingles_idx
+  ON questions USING GIN (prompt_shingles)
+  WHERE deleted_at IS NULL;
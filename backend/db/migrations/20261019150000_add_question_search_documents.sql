@@ -0,0 +1,59 @@
+-- 問題の全文検索（問題文/選択肢/解説）用の文書
+-- NOTE: 日本語は単語に区切れないため、正規化した本文の文字 bigram で候補を絞り、部分一致で確定する。
+--       値はアプリ（internal/domain/searchtext.NewDocument）が作成/更新時に保存する。
+--       既存行だけは SQL で同じ正規化を近似して backfill する（次回の更新でアプリの値に置き換わる）。
+
+CREATE TABLE IF NOT EXISTS question_search_documents (
+  question_id UUID PRIMARY KEY REFERENCES questions(id) ON DELETE CASCADE,
+  prompt_text TEXT NOT NULL,
+  choices_text TEXT NOT NULL,       -- 正規化した選択肢を改行で連結（選択肢をまたいで一致させない）
+  explanation_text TEXT NOT NULL,
+  bigrams TEXT[] NOT NULL DEFAULT '{}',
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER question_search_documents_set_updated_at
+BEFORE UPDATE ON question_search_documents
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- 検索語の bigram をすべて含む文書（bigrams @> $1）の絞り込みに使う
+CREATE INDEX IF NOT EXISTS question_search_documents_bigrams_idx
+  ON question_search_documents USING GIN (bigrams);
+
+-- backfill 用の正規化（NFKC → 小文字化 → カタカナをひらがなへ → 文字と数字以外を除去）
+CREATE OR REPLACE FUNCTION question_search_backfill_normalize(s TEXT)
+RETURNS TEXT AS $$
+  SELECT regexp_replace(
+    translate(
+      lower(normalize(s, NFKC)),
+      'ァアィイゥウェエォオカガキギクグケゲコゴサザシジスズセゼソゾタダチヂッツヅテデトドナニヌネノハバパヒビピフブプヘベペホボポマミムメモャヤュユョヨラリルレロヮワヰヱヲンヴヵヶ',
+      'ぁあぃいぅうぇえぉおかがきぎくぐけげこごさざしじすずせぜそぞただちぢっつづてでとどなにぬねのはばぱひびぴふぶぷへべぺほぼぽまみむめもゃやゅゆょよらりるれろゎわゐゑをんゔゕゖ'
+    ),
+    '[^[:alnum:]ー]', '', 'g'
+  )
+$$ LANGUAGE sql IMMUTABLE;
+
+INSERT INTO question_search_documents (question_id, prompt_text, choices_text, explanation_text)
+SELECT
+  q.id,
+  question_search_backfill_normalize(q.prompt),
+  COALESCE(
+    (SELECT string_agg(question_search_backfill_normalize(c.label), E'\n' ORDER BY c.ordinal) FROM choices c WHERE c.question_id = q.id),
+    ''
+  ),
+  question_search_backfill_normalize(COALESCE(q.explanation, ''))
+FROM questions q
+ON CONFLICT (question_id) DO NOTHING;
+
+UPDATE question_search_documents d
+SET bigrams = COALESCE(
+  (
+    SELECT array_agg(DISTINCT substr(part, i, 2))
+    FROM unnest(string_to_array(d.prompt_text || E'\n' || d.choices_text || E'\n' || d.explanation_text, E'\n')) AS part,
+         generate_series(1, char_length(part) - 1) AS i
+  ),
+  '{}'
+);
+
+DROP FUNCTION question_search_backfill_normalize(TEXT);
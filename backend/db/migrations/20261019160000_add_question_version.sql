@@ -0,0 +1,6 @@
+-- 問題の編集競合（後勝ちの上書き）を防ぐための版番号
+-- NOTE: UpdateQuestion のたびに 1 増やす。既存行は 1 から始める。
+--       公開状態の変更や非表示化は本文の編集ではないため、版は増やさない。
+
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,31 @@
+-- 問題の添付（肖像画/地図/史料の写真など）
+-- NOTE: 本体のバイト列は BlobStore（ローカルはファイル、本番は S3 互換ストレージ）に置き、ここにはメタデータだけを持つ。
+--       BlobStore のキーは attachments/<id> で、id から決まる。
+
+CREATE TABLE IF NOT EXISTS attachments (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  owner_user_id TEXT NOT NULL REFERENCES users(id),
+  kind TEXT NOT NULL CHECK (kind IN ('image', 'map')),
+  content_type TEXT NOT NULL,
+  size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+  sha256 TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  -- 参照していた問題が論理削除されたときに立てる（保持期間の経過後に本体ごと削除する）
+  deleted_at TIMESTAMPTZ
+);
+
+-- 削除対象の掃除（未使用/論理削除済み）用
+CREATE INDEX IF NOT EXISTS attachments_created_at_idx ON attachments (created_at);
+
+-- question_attachments: 問題と添付の対応（表示順を ordinal で保持する）
+CREATE TABLE IF NOT EXISTS question_attachments (
+  question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+  ordinal INT NOT NULL CHECK (ordinal >= 0),
+  attachment_id UUID NOT NULL REFERENCES attachments(id) ON DELETE CASCADE,
+  alt_text TEXT NOT NULL DEFAULT '',
+  PRIMARY KEY (question_id, ordinal),
+  CONSTRAINT question_attachments_unique_attachment UNIQUE (question_id, attachment_id)
+);
+
+-- 添付から参照元の問題を引く（公開判定/掃除）用
+CREATE INDEX IF NOT EXISTS question_attachments_attachment_id_idx ON question_attachments (attachment_id);
@@ -0,0 +1,13 @@
+-- 問題の出典（書籍/Web/史料）
+-- NOTE: 表示順を ordinal で保持する。問題の更新時は全件を入れ替える（question_tags と同じ扱い）。
+
+CREATE TABLE IF NOT EXISTS question_citations (
+  question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+  ordinal INT NOT NULL CHECK (ordinal >= 0),
+  kind TEXT NOT NULL CHECK (kind IN ('book', 'web', 'primary_source')),
+  title TEXT NOT NULL CHECK (title <> ''),
+  author TEXT NOT NULL DEFAULT '',
+  locator TEXT NOT NULL DEFAULT '',
+  url TEXT NOT NULL DEFAULT '',
+  PRIMARY KEY (question_id, ordinal)
+);
@@ -0,0 +1,15 @@
+-- 問題の翻訳（問題文/選択肢/解説）
+-- NOTE: 正解（answer_keys）と別表記は原文の問題と共有する。選択肢は choices.ordinal と同じ順の配列で持つ
+--       （原文の更新で choices の行は作り直されるため、choice_id ではなく並び順で対応付ける）。
+--       source_version は翻訳を保存した時点の questions.version。原文の方が新しい翻訳は出題に使わない。
+
+CREATE TABLE IF NOT EXISTS question_translations (
+  question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+  locale TEXT NOT NULL CHECK (locale <> '' AND locale <> 'ja'),
+  prompt TEXT NOT NULL,
+  choices TEXT[] NOT NULL,
+  explanation TEXT,
+  source_version BIGINT NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (question_id, locale)
+);
@@ -0,0 +1,27 @@
+-- デッキ（ユーザーが作る問題集）
+-- NOTE: share_slug は共有用の推測されにくい文字列（アプリ側で生成する）。
+--       論理削除（deleted_at）したデッキは、共有リンクからも出題からも見えなくする。
+
+CREATE TABLE IF NOT EXISTS decks (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  owner_user_id TEXT NOT NULL REFERENCES users(id),
+  title TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  share_slug TEXT NOT NULL UNIQUE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  deleted_at TIMESTAMPTZ
+);
+
+-- 自分のデッキ一覧用
+CREATE INDEX IF NOT EXISTS decks_owner_user_id_idx ON decks (owner_user_id, created_at DESC) WHERE deleted_at IS NULL;
+
+-- deck_questions: デッキと問題の対応（出題順を ordinal で保持する）
+-- 混同しやすい点: 問題の論理削除/非公開化では行を消さない（出題時に公開中のものだけを使う）。
+CREATE TABLE IF NOT EXISTS deck_questions (
+  deck_id UUID NOT NULL REFERENCES decks(id) ON DELETE CASCADE,
+  ordinal INT NOT NULL CHECK (ordinal >= 0),
+  question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+  PRIMARY KEY (deck_id, ordinal),
+  CONSTRAINT deck_questions_unique_question UNIQUE (deck_id, question_id)
+);
@@ -0,0 +1,5 @@
+-- 作者向けの問題ごとの回答統計（GetQuestionStats）用
+-- NOTE: 既存の attempts_user_answered_at_idx はユーザー単位の集計（マイページ）用で、問題単位の集計には使えない。
+
+CREATE INDEX IF NOT EXISTS attempts_question_answered_at_idx
+ON attempts(question_id, answered_at);
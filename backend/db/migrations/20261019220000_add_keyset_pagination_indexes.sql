@@ -0,0 +1,12 @@
+-- 自分の問題一覧/解答履歴の keyset ページング（page_token）用
+-- NOTE: 同時刻の行があっても位置が一意に決まるよう、id まで含めた順序で索引を張る。
+
+CREATE INDEX IF NOT EXISTS questions_author_updated_at_id_active_idx
+  ON questions (author_user_id, updated_at DESC, id DESC)
+  WHERE deleted_at IS NULL;
+
+-- attempts_user_answered_at_idx は下の索引の先頭部分と同じため置き換える。
+CREATE INDEX IF NOT EXISTS attempts_user_answered_at_id_idx
+  ON attempts (user_id, answered_at DESC, id DESC);
+
+DROP INDEX IF EXISTS attempts_user_answered_at_idx;
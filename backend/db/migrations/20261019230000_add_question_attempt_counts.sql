@@ -0,0 +1,40 @@
+-- 自分の問題一覧の正答率/回答数での並び替え（ListMyQuestions）用
+-- NOTE: 一覧のたびに attempts を集計すると回答の多い作者ほど遅くなるため、問題ごとの回答数を別表に持つ。
+-- questions に列を足すと回答のたびに updated_at（set_updated_at トリガー）が変わってしまうため、別表にしている。
+
+CREATE TABLE IF NOT EXISTS question_attempt_counts (
+  question_id UUID PRIMARY KEY REFERENCES questions(id) ON DELETE CASCADE,
+  attempt_count BIGINT NOT NULL DEFAULT 0,
+  correct_count BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO question_attempt_counts (question_id, attempt_count, correct_count)
+SELECT question_id, COUNT(*), COUNT(*) FILTER (WHERE is_correct)
+FROM attempts
+GROUP BY question_id
+ON CONFLICT (question_id) DO NOTHING;
+
+-- 回答の保存（選択式/記述式）と同じトランザクションで数を進める。
+-- NOTE: attempts は問題の物理削除（CASCADE）以外で消えないため、減らす処理は持たない。
+CREATE OR REPLACE FUNCTION count_question_attempt()
+RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO question_attempt_counts (question_id, attempt_count, correct_count)
+  VALUES (NEW.question_id, 1, CASE WHEN NEW.is_correct THEN 1 ELSE 0 END)
+  ON CONFLICT (question_id) DO UPDATE
+  SET attempt_count = question_attempt_counts.attempt_count + 1,
+      correct_count = question_attempt_counts.correct_count + EXCLUDED.correct_count;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS attempts_count_question_attempt ON attempts;
+CREATE TRIGGER attempts_count_question_attempt
+AFTER INSERT ON attempts
+FOR EACH ROW
+EXECUTE FUNCTION count_question_attempt();
+
+-- 公開状態での絞り込み用（作成日の範囲は questions_author_created_at_id_idx、更新順は questions_author_updated_at_id_active_idx を使う）
+CREATE INDEX IF NOT EXISTS questions_author_status_active_idx
+  ON questions (author_user_id, status)
+  WHERE deleted_at IS NULL;
@@ -0,0 +1,11 @@
+-- 他人の公開中の問題の複製（ForkQuestion）用
+-- NOTE: 元の問題は論理削除されても行が残るため、複製からの参照（出典表示）はそのまま保てる。
+-- 物理削除された場合に複製まで消えないよう、ON DELETE SET NULL にする。
+
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS origin_question_id UUID REFERENCES questions(id) ON DELETE SET NULL;
+
+-- 元の問題ごとの複製数の集計用
+CREATE INDEX IF NOT EXISTS questions_origin_question_id_idx
+  ON questions (origin_question_id)
+  WHERE origin_question_id IS NOT NULL;
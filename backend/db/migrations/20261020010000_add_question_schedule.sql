@@ -0,0 +1,35 @@
+-- 公開/公開終了の予約と、公開状態の変更の記録（監査用）
+-- NOTE: 予約はサーバーの定期処理が時刻になったら公開状態を変更し、使った予約は NULL に戻す。
+--       公開中（published）の問題の publish_at、アーカイブ済みの問題の予約は常に NULL になる。
+
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMPTZ;
+
+ALTER TABLE questions
+  ADD CONSTRAINT questions_schedule_order
+  CHECK (publish_at IS NULL OR unpublish_at IS NULL OR publish_at < unpublish_at);
+
+-- 定期処理の「時刻が来た予約」の検索用
+CREATE INDEX IF NOT EXISTS questions_publish_at_idx
+  ON questions (publish_at)
+  WHERE publish_at IS NOT NULL AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS questions_unpublish_at_idx
+  ON questions (unpublish_at)
+  WHERE unpublish_at IS NOT NULL AND deleted_at IS NULL;
+
+-- question_status_changes: 公開状態の変更の記録
+-- actor_user_id が NULL の行は、予約によるサーバーの定期処理での変更。
+CREATE TABLE IF NOT EXISTS question_status_changes (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+  actor_user_id TEXT REFERENCES users(id),
+  reason TEXT NOT NULL CHECK (reason IN ('manual', 'scheduled_publish', 'scheduled_unpublish')),
+  from_status TEXT NOT NULL,
+  to_status TEXT NOT NULL,
+  changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS question_status_changes_question_id_idx
+  ON question_status_changes (question_id, changed_at DESC);
@@ -0,0 +1,45 @@
+-- 歴史上の人物/出来事/場所/王朝（エンティティ）と、その関係、問題との対応
+-- NOTE: key は取り込みファイル（CSV/JSON-LD）で使う外部キー（例: "person:vasco-da-gama"、JSON-LD の @id）。
+--       取り込みは key で照合して作成/更新する。kind は作成後に変えない（関係の種類の組み合わせが崩れないように）。
+--       start_year/end_year は年（紀元前は負の数）。0 は不明（紀元0年は存在しない）。
+
+CREATE TABLE IF NOT EXISTS entities (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  key TEXT NOT NULL UNIQUE CHECK (key <> ''),
+  kind TEXT NOT NULL CHECK (kind IN ('person', 'event', 'place', 'dynasty')),
+  name TEXT NOT NULL CHECK (name <> ''),
+  description TEXT NOT NULL DEFAULT '',
+  start_year INT NOT NULL DEFAULT 0,
+  end_year INT NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT entities_year_order CHECK (start_year = 0 OR end_year = 0 OR start_year <= end_year)
+);
+
+-- 一覧（種類で絞り込み、名前順）用
+CREATE INDEX IF NOT EXISTS entities_kind_name_idx ON entities (kind, name, id);
+CREATE INDEX IF NOT EXISTS entities_name_idx ON entities (name, id);
+
+-- entity_relations: 種類付きの関係（主語 → 目的語。例: 人物 participated_in 出来事、出来事 located_in 場所）
+-- 混同しやすい点: 主語/目的語の種類の組み合わせはアプリ側で検証する（kind を変えないため、保存後に崩れない）。
+CREATE TABLE IF NOT EXISTS entity_relations (
+  subject_entity_id UUID NOT NULL REFERENCES entities(id) ON DELETE CASCADE,
+  kind TEXT NOT NULL CHECK (kind IN ('participated_in', 'located_in', 'member_of')),
+  object_entity_id UUID NOT NULL REFERENCES entities(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (subject_entity_id, kind, object_entity_id),
+  CONSTRAINT entity_relations_not_self CHECK (subject_entity_id <> object_entity_id)
+);
+
+-- 目的語側からたどる（例: 出来事の参加者）
+CREATE INDEX IF NOT EXISTS entity_relations_object_idx ON entity_relations (object_entity_id);
+
+-- question_entities: 問題が扱うエンティティ（作者が付ける）
+CREATE TABLE IF NOT EXISTS question_entities (
+  question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+  entity_id UUID NOT NULL REFERENCES entities(id) ON DELETE CASCADE,
+  PRIMARY KEY (question_id, entity_id)
+);
+
+-- 「エンティティ X の問題」の出題用
+CREATE INDEX IF NOT EXISTS question_entities_entity_idx ON question_entities (entity_id);
@@ -0,0 +1,4 @@
+-- 誤答の候補の提案で、正解と同じ表記の選択肢を持つ問題を引く（lower(btrim(label)) = $1）ための索引
+
+CREATE INDEX IF NOT EXISTS choices_label_lower_idx
+  ON choices (lower(btrim(label)));
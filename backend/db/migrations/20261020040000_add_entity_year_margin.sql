@@ -0,0 +1,8 @@
+-- entities.year_margin: 年の誤差（±年）。0 は正確な年（例: "前500年頃" は start_year = -500, year_margin = 25）。
+-- NOTE: 「どちらが先か」の出題（タイムライン）で、誤差の範囲が重なる出来事どうしは比べない。
+
+ALTER TABLE entities
+  ADD COLUMN IF NOT EXISTS year_margin INT NOT NULL DEFAULT 0 CHECK (year_margin BETWEEN 0 AND 500);
+
+-- タイムラインの出題候補（年が分かる出来事）用
+CREATE INDEX IF NOT EXISTS entities_dated_events_idx ON entities (start_year) WHERE kind = 'event' AND start_year <> 0;
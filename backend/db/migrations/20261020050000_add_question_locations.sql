@@ -0,0 +1,29 @@
+-- 地図で答える問題（位置の正解と許容半径）
+-- NOTE: 位置は4択の問題に付ける「もう一つの答え方」（記述式の別表記と同じ扱い）。位置が無い問題は地図では答えられない。
+
+-- question_locations: 問題ごとの正解の地点（1問に1地点）
+CREATE TABLE IF NOT EXISTS question_locations (
+  question_id UUID PRIMARY KEY REFERENCES questions(id) ON DELETE CASCADE,
+  latitude DOUBLE PRECISION NOT NULL CHECK (latitude BETWEEN -90 AND 90),
+  longitude DOUBLE PRECISION NOT NULL CHECK (longitude BETWEEN -180 AND 180),
+  radius_km DOUBLE PRECISION NOT NULL CHECK (radius_km BETWEEN 1 AND 2000),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- attempts: 地図で答えた地点と、正解の地点からの距離を保持する。
+ALTER TABLE attempts
+  ADD COLUMN IF NOT EXISTS answer_latitude DOUBLE PRECISION,
+  ADD COLUMN IF NOT EXISTS answer_longitude DOUBLE PRECISION,
+  ADD COLUMN IF NOT EXISTS distance_km DOUBLE PRECISION;
+
+-- 混同しやすい点: 地図の回答は選択肢も入力文字列も持たないため、「選択肢か文字列」の制約を広げる。
+ALTER TABLE attempts
+  DROP CONSTRAINT IF EXISTS attempts_choice_or_text_present;
+
+ALTER TABLE attempts
+  ADD CONSTRAINT attempts_answer_present
+  CHECK (
+    selected_choice_id IS NOT NULL
+    OR answer_text IS NOT NULL
+    OR (answer_latitude IS NOT NULL AND answer_longitude IS NOT NULL AND distance_km IS NOT NULL)
+  );
@@ -0,0 +1,39 @@
+-- 史料を共有する問題（ケーススタディ）
+-- NOTE: 史料と子の問題は1トランザクションでまとめて作成/更新/削除する。子の問題は通常の問題と同じ行（questions）で持つ。
+
+-- passages: 複数の問題が共有する史料の抜粋
+CREATE TABLE IF NOT EXISTS passages (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  author_user_id TEXT NOT NULL REFERENCES users(id),
+  body TEXT NOT NULL CHECK (body <> ''),
+  source_kind TEXT NOT NULL CHECK (source_kind IN ('book', 'web', 'primary_source')),
+  source_title TEXT NOT NULL CHECK (source_title <> ''),
+  source_author TEXT NOT NULL DEFAULT '',
+  source_locator TEXT NOT NULL DEFAULT '',
+  source_url TEXT NOT NULL DEFAULT '',
+  -- NOTE: 論理削除済みの史料の添付は片付け（DeleteUnusedAttachment）で消えるため、参照は NULL に戻す。
+  attachment_id UUID REFERENCES attachments(id) ON DELETE SET NULL,
+  attachment_alt_text TEXT NOT NULL DEFAULT '',
+  version BIGINT NOT NULL DEFAULT 1,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS passages_author_user_id_idx
+  ON passages (author_user_id)
+  WHERE deleted_at IS NULL;
+
+-- questions: 史料を共有する問題は史料と、その中での出題順を持つ。
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS passage_id UUID REFERENCES passages(id),
+  ADD COLUMN IF NOT EXISTS passage_ordinal INT CHECK (passage_ordinal >= 0);
+
+-- 混同しやすい点: 史料に属さない問題は両方 NULL、属する問題は両方を持つ。
+ALTER TABLE questions
+  ADD CONSTRAINT questions_passage_ordinal_present
+  CHECK ((passage_id IS NULL) = (passage_ordinal IS NULL));
+
+CREATE INDEX IF NOT EXISTS questions_passage_id_ordinal_idx
+  ON questions (passage_id, passage_ordinal)
+  WHERE passage_id IS NOT NULL;
@@ -0,0 +1,24 @@
+-- 問題を非表示にした理由（報告数による自動 / 管理者の判断）
+-- NOTE: 報告の却下（DISMISS）で表示に戻すのは自動の非表示だけにする。管理者が隠した問題は、後の報告を却下しても隠したままにする。
+
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS hidden_reason TEXT CHECK (hidden_reason IN ('auto', 'admin'));
+
+-- 既に非表示の問題は、HIDE で解決した報告があれば管理者、無ければ自動で隠したものとみなす。
+UPDATE questions q
+SET hidden_reason = CASE
+  WHEN EXISTS (
+    SELECT 1
+    FROM question_reports r
+    WHERE r.question_id = q.id
+      AND r.resolution = 'hide'
+  ) THEN 'admin'
+  ELSE 'auto'
+END
+WHERE q.hidden_at IS NOT NULL
+  AND q.hidden_reason IS NULL;
+
+-- 混同しやすい点: 表示中の問題は理由を持たず、非表示の問題は必ず理由を持つ。
+ALTER TABLE questions
+  ADD CONSTRAINT questions_hidden_reason_present
+  CHECK ((hidden_at IS NULL) = (hidden_reason IS NULL));
@@ -0,0 +1,6 @@
+-- 問題の種類（4択 / 地図だけで答える問題）
+-- NOTE: 地図だけで答える問題（location）は選択肢・正解の選択肢・別表記を持たず、question_locations の地点が必須。
+-- 既存の問題はすべて4択（choice）とする。
+
+ALTER TABLE questions
+  ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'choice' CHECK (kind IN ('choice', 'location'));
@@ -0,0 +1,22 @@
+-- 地図だけで答える問題（kind = 'location'）は選択肢を持たない
+-- NOTE: 4択の問題を地図だけで答える問題に変える更新では、同じトランザクションで choices を全件消す。
+-- 選択肢の件数はトランザクション終端で、問題の種類（更新後の kind）に応じて確かめる。
+
+CREATE OR REPLACE FUNCTION enforce_four_choices_per_question()
+RETURNS TRIGGER AS $$
+DECLARE
+  qid UUID;
+  cnt INT;
+  expected INT;
+BEGIN
+  qid := COALESCE(NEW.question_id, OLD.question_id);
+  SELECT COUNT(*) INTO cnt FROM choices WHERE question_id = qid;
+  SELECT CASE WHEN kind = 'location' THEN 0 ELSE 4 END INTO expected FROM questions WHERE id = qid;
+  -- 混同しやすい点: 問題の行が無い場合（同じトランザクションで削除済み）は従来どおり 4件を求める。
+  expected := COALESCE(expected, 4);
+  IF cnt <> expected THEN
+    RAISE EXCEPTION 'choices must be exactly % per question (question_id=%, count=%)', expected, qid, cnt;
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
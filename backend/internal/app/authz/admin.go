@@ -0,0 +1,29 @@
+package authz
+
+import "strings"
+
+// AdminSet は管理者権限を持つ userId（OIDC sub）の集合。
+// NOTE: Phase2 ではロール管理を持たないため、デプロイ設定（環境変数）で管理者を列挙する。
+type AdminSet map[string]struct{}
+
+// ParseAdminSet はカンマ区切りの userId 一覧から AdminSet を作る（空要素は無視する）。
+func ParseAdminSet(raw string) AdminSet {
+	admins := AdminSet{}
+	for _, id := range strings.Split(raw, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		admins[id] = struct{}{}
+	}
+	return admins
+}
+
+// IsAdmin は userID が管理者かどうかを返す（空の userID は常に false）。
+func (s AdminSet) IsAdmin(userID string) bool {
+	if userID == "" {
+		return false
+	}
+	_, ok := s[userID]
+	return ok
+}
@@ -9,6 +9,8 @@ type contextKey string
 const (
 	requestIDKey contextKey = "request_id"
 	userIDKey    contextKey = "user_id"
+	// acceptLanguageKey は出題の言語の希望（HTTP の Accept-Language と同じ形式）。
+	acceptLanguageKey contextKey = "accept_language"
 )
 
 // WithRequestID は context に requestId を格納する。
@@ -33,3 +35,13 @@ func UserID(ctx context.Context) (string, bool) {
 	return v, ok && v != ""
 }
 
+// WithAcceptLanguage は context に Accept-Language を格納する。
+func WithAcceptLanguage(ctx context.Context, acceptLanguage string) context.Context {
+	return context.WithValue(ctx, acceptLanguageKey, acceptLanguage)
+}
+
+// AcceptLanguage は context から Accept-Language を取得する。
+func AcceptLanguage(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(acceptLanguageKey).(string)
+	return v, ok && v != ""
+}
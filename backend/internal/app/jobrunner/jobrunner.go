@@ -0,0 +1,67 @@
+package jobrunner
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job はサーバーの中で定期的に実行する処理（添付の掃除、公開の予約の適用など）。
+type Job struct {
+	// Name はログに出す名前。
+	Name string
+	// Interval は実行の間隔（0 以下の Job は実行しない）。
+	Interval time.Duration
+	// Run は now 時点の処理を行い、処理した件数を返す。
+	// 失敗しても Job は止めず、次の間隔で再試行する（途中まで処理した件数も返してよい）。
+	Run func(ctx context.Context, now time.Time) (int, error)
+}
+
+// Run は jobs をそれぞれの間隔で ctx が終わるまで実行する（すべての Job が止まるまで戻らない）。
+// 混同しやすい点: 起動直後に1回実行してから間隔ごとに実行する（停止中に時刻を過ぎた予約などをすぐに適用するため）。
+// 同じ Job の実行は重ならない（前回が間隔より長くかかった場合、間の実行は飛ばす）。
+func Run(ctx context.Context, logger *log.Logger, jobs ...Job) {
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		if job.Interval <= 0 || job.Run == nil {
+			logger.Printf("job %s is disabled", job.Name)
+			continue
+		}
+		wg.Add(1)
+		go func(job Job) {
+			defer wg.Done()
+			runLoop(ctx, logger, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+func runLoop(ctx context.Context, logger *log.Logger, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	runOnce(ctx, logger, job, time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			runOnce(ctx, logger, job, now)
+		}
+	}
+}
+
+func runOnce(ctx context.Context, logger *log.Logger, job Job, now time.Time) {
+	if ctx.Err() != nil {
+		return
+	}
+	processed, err := job.Run(ctx, now)
+	if err != nil {
+		logger.Printf("job %s failed: processed=%d err=%v", job.Name, processed, err)
+		return
+	}
+	if processed > 0 {
+		logger.Printf("job %s: processed=%d", job.Name, processed)
+	}
+}
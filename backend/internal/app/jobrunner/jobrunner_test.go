@@ -0,0 +1,118 @@
+package jobrunner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuffer はログを複数の goroutine から書いても壊れないようにする。
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestRun_RunsAtStartAndEveryInterval(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	calls := 0
+	job := Job{
+		Name:     "count",
+		Interval: 5 * time.Millisecond,
+		Run: func(context.Context, time.Time) (int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			if calls == 3 {
+				cancel()
+			}
+			return 1, nil
+		},
+	}
+
+	var logs syncBuffer
+	done := make(chan struct{})
+	go func() {
+		Run(ctx, log.New(&logs, "", 0), job)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after ctx was canceled")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if calls < 3 {
+		t.Fatalf("calls = %d, want >= 3", calls)
+	}
+	if !strings.Contains(logs.String(), "job count: processed=1") {
+		t.Fatalf("logs = %q, want processed count", logs.String())
+	}
+}
+
+func TestRun_ContinuesAfterFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	calls := 0
+	job := Job{
+		Name:     "flaky",
+		Interval: 5 * time.Millisecond,
+		Run: func(context.Context, time.Time) (int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			if calls == 1 {
+				return 2, errors.New("boom")
+			}
+			cancel()
+			return 0, nil
+		},
+	}
+
+	var logs syncBuffer
+	Run(ctx, log.New(&logs, "", 0), job)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls < 2 {
+		t.Fatalf("calls = %d, want >= 2 (retry after failure)", calls)
+	}
+	if !strings.Contains(logs.String(), "job flaky failed: processed=2 err=boom") {
+		t.Fatalf("logs = %q, want failure log", logs.String())
+	}
+}
+
+func TestRun_SkipsDisabledJobs(t *testing.T) {
+	var logs syncBuffer
+	// 有効な Job が無い場合はすぐに戻る。
+	Run(context.Background(), log.New(&logs, "", 0), Job{Name: "off", Interval: 0, Run: func(context.Context, time.Time) (int, error) {
+		t.Fatal("disabled job must not run")
+		return 0, nil
+	}})
+	if !strings.Contains(logs.String(), "job off is disabled") {
+		t.Fatalf("logs = %q, want disabled log", logs.String())
+	}
+}
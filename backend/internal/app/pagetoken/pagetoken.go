@@ -0,0 +1,81 @@
+package pagetoken
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// version はトークンの形式のバージョン（形式を変えた場合に古いトークンを弾く）。
+const version = "p1"
+
+// ErrInvalid はトークンが壊れている/改ざんされている/別の一覧のものである場合のエラー。
+var ErrInvalid = errors.New("invalid page token")
+
+// Codec は一覧の続きの位置（keyset の値）を、署名付きの不透明な文字列にする。
+// NOTE: クライアントは中身を解釈せず、next_page_token をそのまま送り返す前提。
+//
+// 混同しやすい点: 位置の値は base64 で読めるが、HMAC-SHA256 の署名で書き換えを検出する。
+// 一覧の問い合わせは常に userID で絞るため、書き換えられても他人の行は見えないが、
+// 任意の位置を指定させない（＝サーバが返した位置からしか続きを読ませない）ために署名している。
+type Codec struct {
+	key []byte
+}
+
+// NewCodec は secret を署名鍵にした Codec を作る。
+// NOTE: 複数台で動かす場合は全台で同じ secret を設定する（別の鍵で作ったトークンは ErrInvalid になる）。
+func NewCodec(secret []byte) Codec {
+	return Codec{key: append([]byte(nil), secret...)}
+}
+
+// NewRandomCodec は起動ごとの乱数を署名鍵にした Codec を作る（鍵を設定しない開発環境用）。
+// 再起動すると、それまでに返したトークンは ErrInvalid になる。
+func NewRandomCodec() (Codec, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return Codec{}, err
+	}
+	return Codec{key: key}, nil
+}
+
+// Encode は kind（どの一覧のトークンか）と位置の値からトークンを作る。
+// fields に ":" を含めてはいけない（時刻は UnixMicro 等の数値にする）。
+func (c Codec) Encode(kind string, fields ...string) string {
+	payload := strings.Join(append([]string{version, kind}, fields...), ":")
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload))
+}
+
+// Decode はトークンを検証して位置の値を返す。
+// 署名が合わない、kind が違う、値の数が n でない場合は ErrInvalid を返す。
+func (c Codec) Decode(kind string, token string, n int) ([]string, error) {
+	encodedPayload, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	if !hmac.Equal(mac, c.sign(string(payload))) {
+		return nil, ErrInvalid
+	}
+
+	parts := strings.Split(string(payload), ":")
+	if len(parts) != n+2 || parts[0] != version || parts[1] != kind {
+		return nil, ErrInvalid
+	}
+	return parts[2:], nil
+}
+
+func (c Codec) sign(payload string) []byte {
+	h := hmac.New(sha256.New, c.key)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
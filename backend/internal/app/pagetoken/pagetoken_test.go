@@ -0,0 +1,55 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCodec_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	c := NewCodec([]byte("secret"))
+	token := c.Encode("questions", "1700000000000000", "5f0c2b7e-4d7a-4a39-9d1e-1f1b2c3d4e5f")
+
+	got, err := c.Decode("questions", token, 2)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(got) != 2 || got[0] != "1700000000000000" || got[1] != "5f0c2b7e-4d7a-4a39-9d1e-1f1b2c3d4e5f" {
+		t.Fatalf("復元した値が期待と異なります: %v", got)
+	}
+}
+
+func TestCodec_RejectsInvalid(t *testing.T) {
+	t.Parallel()
+
+	c := NewCodec([]byte("secret"))
+	token := c.Encode("questions", "1700000000000000", "id")
+	_, mac, _ := strings.Cut(token, ".")
+	tampered := base64.RawURLEncoding.EncodeToString([]byte("p1:questions:1800000000000000:id")) + "." + mac
+
+	tests := []struct {
+		name  string
+		codec Codec
+		kind  string
+		token string
+		n     int
+	}{
+		{name: "署名が無い", codec: c, kind: "questions", token: "abc", n: 2},
+		{name: "base64 でない", codec: c, kind: "questions", token: "!!!.!!!", n: 2},
+		{name: "位置を書き換えた", codec: c, kind: "questions", token: tampered, n: 2},
+		{name: "別の鍵で作った", codec: NewCodec([]byte("other")), kind: "questions", token: token, n: 2},
+		{name: "別の一覧のトークン", codec: c, kind: "attempts", token: token, n: 2},
+		{name: "値の数が違う", codec: c, kind: "questions", token: token, n: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := tt.codec.Decode(tt.kind, tt.token, tt.n); !errors.Is(err, ErrInvalid) {
+				t.Fatalf("ErrInvalid を期待しました: err=%v", err)
+			}
+		})
+	}
+}
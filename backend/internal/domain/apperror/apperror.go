@@ -13,6 +13,12 @@ const (
 	CodeNotFound Code = "NOT_FOUND"
 	// CodePermissionDenied は所有者チェック等の認可違反を表す。
 	CodePermissionDenied Code = "PERMISSION_DENIED"
+	// CodeFailedPrecondition は現在の状態では実行できない操作（不正な状態遷移など）を表す。
+	CodeFailedPrecondition Code = "FAILED_PRECONDITION"
+	// CodeAlreadyExists は同じ（または実質的に同じ）リソースが既に存在することを表す。
+	CodeAlreadyExists Code = "ALREADY_EXISTS"
+	// CodeAborted は楽観ロックの不一致など、並行する更新との競合で操作を中断したことを表す。
+	CodeAborted Code = "ABORTED"
 	// CodeUnauthenticated は未認証を表す。
 	CodeUnauthenticated Code = "UNAUTHENTICATED"
 	// CodeInternal は想定外のサーバ内部エラーを表す。
@@ -34,6 +40,9 @@ type Error struct {
 	Message        string
 	FieldViolations []FieldViolation
 	Cause          error
+	// Current は CodeAborted のときのサーバ側の最新値（例: domain.QuestionDetail）。
+	// transport で status の details に載せ、クライアントが差分を確認して再編集できるようにする。
+	Current        any
 }
 
 func (e *Error) Error() string {
@@ -71,6 +80,21 @@ func PermissionDenied(message string) *Error {
 	return &Error{Code: CodePermissionDenied, Message: message}
 }
 
+// FailedPrecondition は現在の状態では実行できない操作を表す Error を作る。
+func FailedPrecondition(message string) *Error {
+	return &Error{Code: CodeFailedPrecondition, Message: message}
+}
+
+// AlreadyExists は既に同じリソースが存在することを表す Error を作る。
+func AlreadyExists(message string) *Error {
+	return &Error{Code: CodeAlreadyExists, Message: message}
+}
+
+// Aborted は並行する更新との競合を表す Error を作る。current にはサーバ側の最新値を渡す。
+func Aborted(message string, current any) *Error {
+	return &Error{Code: CodeAborted, Message: message, Current: current}
+}
+
 // Unauthenticated は未認証を表す Error を作る。
 func Unauthenticated(message string) *Error {
 	return &Error{Code: CodeUnauthenticated, Message: message}
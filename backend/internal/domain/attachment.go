@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// AttachmentKind は添付の種類（表示の仕方を変えるために使う。中身はどちらも画像）。
+type AttachmentKind string
+
+const (
+	// AttachmentKindImage は肖像画や史料の写真など。
+	AttachmentKindImage AttachmentKind = "image"
+	// AttachmentKindMap は地図。
+	AttachmentKindMap AttachmentKind = "map"
+)
+
+// Attachment はアップロード済みの添付のメタデータ（本体は BlobStore に置く）。
+type Attachment struct {
+	ID          string
+	OwnerUserID string
+	Kind        AttachmentKind
+	ContentType string
+	SizeBytes   int64
+	// SHA256 は本体の SHA-256（16進）。
+	SHA256    string
+	CreatedAt time.Time
+	// Deleted は参照していた問題が論理削除され、添付も削除扱いになっていることを表す。
+	Deleted bool
+	// Public は公開中（非表示でない、公開終了前）の問題、または公開中の問題が共有する史料から参照されていることを表す（未ログインでも取得できる）。
+	Public bool
+}
+
+// AttachmentRef は作問入力での添付の指定（アップロード済みの添付を表示順に並べる）。
+type AttachmentRef struct {
+	AttachmentID string
+	// AltText は画像を表示できない場合の代替テキスト（任意）。
+	AltText string
+}
+
+// QuestionAttachment は問題に付いた添付（表示順）。
+type QuestionAttachment struct {
+	AttachmentID string
+	Kind         AttachmentKind
+	ContentType  string
+	SizeBytes    int64
+	AltText      string
+}
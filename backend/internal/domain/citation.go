@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// CitationKind は出典の種類。
+type CitationKind string
+
+const (
+	// CitationKindBook は書籍（著者・ページを添えることが多い）。
+	CitationKindBook CitationKind = "book"
+	// CitationKindWeb は Web ページ（URL 必須）。
+	CitationKindWeb CitationKind = "web"
+	// CitationKindPrimarySource は史料（古文書・条約文・日記など）。
+	CitationKindPrimarySource CitationKind = "primary_source"
+)
+
+// Citation は問題の出典（表示順）。
+type Citation struct {
+	Kind  CitationKind
+	Title string
+	// Author は著者/編者/発行元（任意）。
+	Author string
+	// Locator はページや巻・章など、出典の中の位置（任意、例: "p.123"）。
+	Locator string
+	// URL は http/https の絶対 URL（web では必須、それ以外は任意）。
+	URL string
+}
+
+// UncitedQuestion は出典が1件もない公開中の問題（管理者向けの一覧）。
+type UncitedQuestion struct {
+	QuestionID   string
+	AuthorUserID string
+	Prompt       string
+	CreatedAt    time.Time
+}
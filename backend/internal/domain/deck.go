@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// Deck はユーザーが作る問題集（テーマごとに問題を並べたもの。例: 「幕末10問」）。
+type Deck struct {
+	ID          string
+	OwnerUserID string
+	Title       string
+	Description string
+	// ShareSlug は共有用の短い文字列。知っている人は未ログインでもデッキを開いて遊べる。
+	ShareSlug string
+	// QuestionIDs は出題順に並べた問題（自分の問題、または他人の公開中の問題）。
+	QuestionIDs []string
+	// PlayableCount は QuestionIDs のうち、今出題できる（公開中で非表示でない）問題の数。
+	PlayableCount int32
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// DeckDraft はデッキの作成/更新の入力（問題の並びは丸ごと置き換える）。
+type DeckDraft struct {
+	Title       string
+	Description string
+	QuestionIDs []string
+}
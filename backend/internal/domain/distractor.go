@@ -0,0 +1,37 @@
+package domain
+
+// DistractorQuery は誤答の候補（既存の問題の選択肢）を集める条件。
+// 次のいずれかに当てはまる問題を「関連する問題」とし、その選択肢を候補にする。
+//   - 選択肢に正解（AnswerLabel）がある
+//   - Tags のいずれかが付いている
+//   - 問題文が似ている（PromptShingles の Jaccard 係数が MinPromptSimilarity 以上）
+type DistractorQuery struct {
+	// UserID の下書き等も対象にする（他人の問題は公開中で非表示でないものだけ）。
+	UserID string
+	// AnswerLabel は正解の表記（前後の空白を除いたもの）。大文字小文字と前後の空白を無視して選択肢と照合する。
+	AnswerLabel         string
+	Tags                []string
+	PromptShingles      []string
+	MinPromptSimilarity float64
+	// ExcludeQuestionID は編集中の問題自身（空の場合は除外しない）。
+	ExcludeQuestionID string
+	// MaxQuestions は関連する問題の上限（関連の強い順に選ぶ）。
+	MaxQuestions int32
+}
+
+// DistractorOccurrence は関連する問題に出てきた選択肢1つ分。
+type DistractorOccurrence struct {
+	QuestionID string
+	Label      string
+	// IsCorrect はこの選択肢がその問題の正解であること。
+	IsCorrect bool
+	// HasAnswer はその問題の選択肢に、入力の正解があること（正解と同じ問題に並んだ選択肢）。
+	HasAnswer bool
+	// SharedTags はその問題と入力で重なるタグの数。
+	SharedTags int32
+	// PromptSimilarity はその問題と入力の問題文の Jaccard 係数（0..1）。
+	PromptSimilarity float64
+	// PickCount はこの選択肢が選ばれた回数、AttemptCount はその問題の選択式の回答数。
+	PickCount    int64
+	AttemptCount int64
+}
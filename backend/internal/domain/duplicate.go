@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// SimilarQuestion は作問時に見つかった、問題文がよく似た既存の問題。
+// NOTE: 他人の問題は公開中のものだけを返す（下書きの問題文を漏らさないため）。
+type SimilarQuestion struct {
+	QuestionID string
+	Prompt     string
+	Status     QuestionStatus
+	// Mine は作問者自身の問題であることを表す。
+	Mine bool
+	// Similarity は問題文の類似度（0..1、1 は正規化後の文字 bigram が完全に一致）。
+	Similarity float64
+}
+
+// DuplicateQuestion は重複候補として管理者に見せる問題。
+type DuplicateQuestion struct {
+	QuestionID   string
+	AuthorUserID string
+	Prompt       string
+	Status       QuestionStatus
+	CreatedAt    time.Time
+}
+
+// SimilarQuestionPair は問題文がよく似た2問の組。
+type SimilarQuestionPair struct {
+	A          DuplicateQuestion
+	B          DuplicateQuestion
+	Similarity float64
+}
+
+// DuplicateCluster は類似度が閾値以上の組を連結した問題のまとまり（古い順）。
+// 混同しやすい点: A≒B かつ B≒C なら A と C が閾値未満でも同じまとまりになる。
+type DuplicateCluster struct {
+	Questions     []DuplicateQuestion
+	MaxSimilarity float64
+}
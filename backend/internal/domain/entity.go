@@ -0,0 +1,134 @@
+package domain
+
+import "time"
+
+// EntityKind はエンティティ（歴史上の人物/出来事/場所/王朝）の種類。
+type EntityKind string
+
+const (
+	// EntityKindPerson は人物（例: ヴァスコ・ダ・ガマ）。
+	EntityKindPerson EntityKind = "person"
+	// EntityKindEvent は出来事（例: インド航路の開拓）。
+	EntityKindEvent EntityKind = "event"
+	// EntityKindPlace は場所（都市/地域/国。例: カリカット）。
+	EntityKindPlace EntityKind = "place"
+	// EntityKindDynasty は王朝/政権（例: アヴィス朝）。
+	EntityKindDynasty EntityKind = "dynasty"
+)
+
+// Entity は問題に紐づける歴史上の人物/出来事/場所/王朝。
+type Entity struct {
+	ID string
+	// Key は取り込みファイル（CSV/JSON-LD）で使う外部キー。取り込みはこれで照合して作成/更新する。
+	Key         string
+	Kind        EntityKind
+	Name        string
+	Description string
+	// StartYear/EndYear は年（紀元前は負の数）。0 は不明（紀元0年は存在しない）。
+	// 人物は生没年、出来事は開始/終了年、王朝は存続期間。
+	StartYear int32
+	EndYear   int32
+	// YearMargin は年の誤差（±年）。0 は正確な年（例: "前500年頃" は StartYear = -500, YearMargin = 25）。
+	YearMargin int32
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// EntityDraft はエンティティの作成/更新の入力。
+// 混同しやすい点: Kind は作成後に変えられない（関係の種類の組み合わせが崩れないように）。
+type EntityDraft struct {
+	Key         string
+	Kind        EntityKind
+	Name        string
+	Description string
+	StartYear   int32
+	EndYear     int32
+	YearMargin  int32
+}
+
+// EntityRef は一覧や関係の相手として返すエンティティの要約。
+type EntityRef struct {
+	ID   string
+	Kind EntityKind
+	Name string
+}
+
+// EntityRelationKind は関係の種類（主語 → 目的語の向き）。
+type EntityRelationKind string
+
+const (
+	// EntityRelationParticipatedIn は人物が出来事に関わった（人物 → 出来事）。
+	EntityRelationParticipatedIn EntityRelationKind = "participated_in"
+	// EntityRelationLocatedIn は出来事が起きた場所、または場所を含む地域（出来事/場所 → 場所）。
+	EntityRelationLocatedIn EntityRelationKind = "located_in"
+	// EntityRelationMemberOf は人物が属した王朝（人物 → 王朝）。
+	EntityRelationMemberOf EntityRelationKind = "member_of"
+)
+
+// Allows は subject → object の種類の組み合わせがこの関係で使えるかを返す。
+func (k EntityRelationKind) Allows(subject EntityKind, object EntityKind) bool {
+	switch k {
+	case EntityRelationParticipatedIn:
+		return subject == EntityKindPerson && object == EntityKindEvent
+	case EntityRelationLocatedIn:
+		return (subject == EntityKindEvent || subject == EntityKindPlace) && object == EntityKindPlace
+	case EntityRelationMemberOf:
+		return subject == EntityKindPerson && object == EntityKindDynasty
+	default:
+		return false
+	}
+}
+
+// EntityRelation はエンティティ間の関係。
+type EntityRelation struct {
+	SubjectID string
+	Kind      EntityRelationKind
+	ObjectID  string
+}
+
+// EntityRelationView はあるエンティティから見た関係（相手と向き付き）。
+type EntityRelationView struct {
+	Kind EntityRelationKind
+	// Outgoing はこのエンティティが主語（例: 人物から見た participated_in）。false は目的語（例: 出来事から見た参加者）。
+	Outgoing bool
+	Other    EntityRef
+}
+
+// EntityDetail はエンティティと、その関係/出題できる問題の数。
+type EntityDetail struct {
+	Entity
+	Relations []EntityRelationView
+	// PlayableQuestionCount はこのエンティティが付いた問題のうち、今出題できる（公開中で非表示でない）問題の数。
+	PlayableQuestionCount int32
+}
+
+// EntityListQuery はエンティティ一覧の条件。
+type EntityListQuery struct {
+	// Kind が空の場合はすべての種類。
+	Kind EntityKind
+	// NameQuery は名前の部分一致（空の場合は絞り込まない）。
+	NameQuery string
+	// After は名前順のページング位置（nil = 先頭から）。
+	After *EntityListCursor
+	Limit int32
+}
+
+// EntityListCursor は一覧の最後の要素（名前, ID）。
+type EntityListCursor struct {
+	Name     string
+	EntityID string
+}
+
+// EntityImportRelation は取り込みの関係（エンティティを key で指す）。
+type EntityImportRelation struct {
+	SubjectKey string
+	Kind       EntityRelationKind
+	ObjectKey  string
+}
+
+// EntityImportResult は取り込みで作成/更新した件数。
+type EntityImportResult struct {
+	Created        int
+	Updated        int
+	RelationsAdded int
+}
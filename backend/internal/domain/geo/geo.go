@@ -0,0 +1,89 @@
+// Package geo は地図で答える問題（位置の正解）のための地球上の距離計算と、距離による採点を提供する。
+// NOTE: 外部のライブラリや地図サービスには頼らず、球面上の大円距離（haversine）で計算する。
+package geo
+
+import "math"
+
+// EarthRadiusKm は地球の平均半径（IUGG の平均半径 R1）。
+// 混同しやすい点: 地球は楕円体のため、球として計算すると最大で 0.5% 程度ずれる。採点の帯（半径の倍数）に比べて十分小さい。
+const EarthRadiusKm = 6371.0088
+
+// 作者が指定できる許容半径の範囲（km）。
+const (
+	MinRadiusKm = 1
+	MaxRadiusKm = 2000
+)
+
+// Point は緯度/経度（度）。緯度は北緯が正（-90..90）、経度は東経が正（-180..180）。
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Valid は緯度/経度が範囲内の有限の数であること。
+func (p Point) Valid() bool {
+	return !math.IsNaN(p.Lat) && !math.IsNaN(p.Lng) &&
+		p.Lat >= -90 && p.Lat <= 90 &&
+		p.Lng >= -180 && p.Lng <= 180
+}
+
+// DistanceKm は a と b の大円距離（km）を haversine の式で返す。
+// 混同しやすい点: 経度の差は日付変更線（±180度）をまたいでも正しく扱える（sin² が周期的なため）。
+func DistanceKm(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+
+	h := sin2(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*sin2(dLng/2)
+	// 丸め誤差で 1 をわずかに超えると Asin が NaN になるため、0..1 に収める。
+	h = math.Min(1, math.Max(0, h))
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func sin2(x float64) float64 {
+	s := math.Sin(x)
+	return s * s
+}
+
+// Band は正解の地点からの距離による採点の帯。
+type Band string
+
+const (
+	// BandWithin は許容半径以内（正解）。
+	BandWithin Band = "within"
+	// BandNear は許容半径の 2 倍以内。
+	BandNear Band = "near"
+	// BandRegion は許容半径の 5 倍以内。
+	BandRegion Band = "region"
+	// BandFar は許容半径の 10 倍以内。
+	BandFar Band = "far"
+	// BandMiss はそれより遠い。
+	BandMiss Band = "miss"
+)
+
+// bands は帯の境界（許容半径の倍数）と得点。近い順に並べる。
+var bands = []struct {
+	band   Band
+	factor float64
+	score  int32
+}{
+	{band: BandWithin, factor: 1, score: 100},
+	{band: BandNear, factor: 2, score: 70},
+	{band: BandRegion, factor: 5, score: 40},
+	{band: BandFar, factor: 10, score: 10},
+}
+
+// Score は距離（km）と許容半径（km）から帯と得点（0..100）を返す。
+// 帯を半径の倍数で決めるのは、「都市」（数十 km）と「地域」（数百 km）の問題で同じ基準を使うため。
+func Score(distanceKm float64, radiusKm float64) (Band, int32) {
+	for _, b := range bands {
+		if distanceKm <= radiusKm*b.factor {
+			return b.band, b.score
+		}
+	}
+	return BandMiss, 0
+}
@@ -0,0 +1,88 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistanceKm(t *testing.T) {
+	t.Parallel()
+
+	tokyo := Point{Lat: 35.6812, Lng: 139.7671}
+	osaka := Point{Lat: 34.7025, Lng: 135.4959}
+	carthage := Point{Lat: 36.8529, Lng: 10.3233}
+	rome := Point{Lat: 41.8925, Lng: 12.4853}
+
+	tests := []struct {
+		name string
+		a, b Point
+		want float64
+		tol  float64
+	}{
+		{name: "同じ地点", a: tokyo, b: tokyo, want: 0, tol: 1e-9},
+		{name: "東京駅と大阪駅", a: tokyo, b: osaka, want: 403.1, tol: 1},
+		{name: "カルタゴとローマ", a: carthage, b: rome, want: 590.3, tol: 1},
+		{name: "赤道上の経度1度", a: Point{Lat: 0, Lng: 0}, b: Point{Lat: 0, Lng: 1}, want: EarthRadiusKm * math.Pi / 180, tol: 1e-6},
+		{name: "対蹠点", a: Point{Lat: 0, Lng: 0}, b: Point{Lat: 0, Lng: 180}, want: EarthRadiusKm * math.Pi, tol: 1e-6},
+		{name: "北極と南極", a: Point{Lat: 90, Lng: 0}, b: Point{Lat: -90, Lng: 0}, want: EarthRadiusKm * math.Pi, tol: 1e-6},
+		{name: "北極では経度によらない", a: Point{Lat: 90, Lng: -120}, b: Point{Lat: 90, Lng: 45}, want: 0, tol: 1e-6},
+		{name: "日付変更線をまたぐ", a: Point{Lat: 0, Lng: 179.5}, b: Point{Lat: 0, Lng: -179.5}, want: EarthRadiusKm * math.Pi / 180, tol: 1e-6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := DistanceKm(tt.a, tt.b)
+			if math.Abs(got-tt.want) > tt.tol {
+				t.Fatalf("DistanceKm = %.4f, want %.4f (±%g)", got, tt.want, tt.tol)
+			}
+			if back := DistanceKm(tt.b, tt.a); math.Abs(back-got) > 1e-9 {
+				t.Fatalf("向きによらない想定です: %.6f != %.6f", back, got)
+			}
+		})
+	}
+}
+
+func TestPoint_Valid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		p    Point
+		want bool
+	}{
+		{p: Point{Lat: 36.85, Lng: 10.32}, want: true},
+		{p: Point{Lat: 90, Lng: -180}, want: true},
+		{p: Point{Lat: 90.1, Lng: 0}, want: false},
+		{p: Point{Lat: 0, Lng: 180.1}, want: false},
+		{p: Point{Lat: math.NaN(), Lng: 0}, want: false},
+		{p: Point{Lat: 0, Lng: math.Inf(1)}, want: false},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Valid(); got != tt.want {
+			t.Errorf("Valid(%+v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		distance float64
+		band     Band
+		score    int32
+	}{
+		{distance: 0, band: BandWithin, score: 100},
+		{distance: 50, band: BandWithin, score: 100},
+		{distance: 50.1, band: BandNear, score: 70},
+		{distance: 100, band: BandNear, score: 70},
+		{distance: 250, band: BandRegion, score: 40},
+		{distance: 500, band: BandFar, score: 10},
+		{distance: 500.1, band: BandMiss, score: 0},
+	}
+	for _, tt := range tests {
+		band, score := Score(tt.distance, 50)
+		if band != tt.band || score != tt.score {
+			t.Errorf("Score(%g, 50) = (%s, %d), want (%s, %d)", tt.distance, band, score, tt.band, tt.score)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Package locale は翻訳の言語タグの正規化と、Accept-Language による言語の選択を提供する。
+// NOTE: 翻訳の保存時（usecase/question）と出題時（usecase/quiz）で同じ規則を使うため domain に置く。
+package locale
+
+import (
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"golang.org/x/text/language"
+)
+
+// Normalize は BCP 47 の言語タグを正規の表記にそろえる（例: "EN-us" → "en-US"）。
+// 解釈できない、または言語が特定できない（"und"）場合は false を返す。
+func Normalize(raw string) (string, bool) {
+	tag, err := language.Parse(strings.TrimSpace(raw))
+	if err != nil || tag == language.Und {
+		return "", false
+	}
+	return tag.String(), true
+}
+
+// IsCanonical は tag が原文と同じ言語（地域違いを含む。例: "ja-JP"）かを返す。
+func IsCanonical(tag string) bool {
+	base, _ := language.Make(tag).Base()
+	canonical, _ := language.Make(domain.CanonicalLocale).Base()
+	return base == canonical
+}
+
+// Negotiate は Accept-Language の希望に最も合う言語を available（翻訳のある言語）から選ぶ。
+// 合うものが無い場合や acceptLanguage が空/不正な場合は domain.CanonicalLocale を返す。
+// 混同しやすい点: 原文の言語も候補に含めて比べるため、"ja, en;q=0.5" のように日本語を優先する希望では翻訳があっても原文を返す。
+func Negotiate(acceptLanguage string, available []string) string {
+	if strings.TrimSpace(acceptLanguage) == "" || len(available) == 0 {
+		return domain.CanonicalLocale
+	}
+	desired, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(desired) == 0 {
+		return domain.CanonicalLocale
+	}
+
+	supported := make([]language.Tag, 0, len(available)+1)
+	supported = append(supported, language.Make(domain.CanonicalLocale))
+	for _, a := range available {
+		supported = append(supported, language.Make(a))
+	}
+	_, index, confidence := language.NewMatcher(supported).Match(desired...)
+	if confidence == language.No || index == 0 {
+		return domain.CanonicalLocale
+	}
+	return available[index-1]
+}
@@ -0,0 +1,65 @@
+package locale
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw    string
+		want   string
+		wantOK bool
+	}{
+		{raw: "en", want: "en", wantOK: true},
+		{raw: " EN-us ", want: "en-US", wantOK: true},
+		{raw: "zh-hant", want: "zh-Hant", wantOK: true},
+		{raw: "", wantOK: false},
+		{raw: "und", wantOK: false},
+		{raw: "english", wantOK: false},
+	}
+	for _, tt := range tests {
+		got, ok := Normalize(tt.raw)
+		if ok != tt.wantOK || got != tt.want {
+			t.Fatalf("Normalize(%q) = %q, %v; want %q, %v", tt.raw, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestIsCanonical(t *testing.T) {
+	t.Parallel()
+
+	for tag, want := range map[string]bool{"ja": true, "ja-JP": true, "en": false, "zh-Hant": false} {
+		if got := IsCanonical(tag); got != want {
+			t.Fatalf("IsCanonical(%q) = %v; want %v", tag, got, want)
+		}
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		available      []string
+		want           string
+	}{
+		{name: "指定なしは原文", acceptLanguage: "", available: []string{"en"}, want: "ja"},
+		{name: "翻訳なしは原文", acceptLanguage: "en", available: nil, want: "ja"},
+		{name: "完全一致", acceptLanguage: "en", available: []string{"en", "ko"}, want: "en"},
+		{name: "地域違いも一致", acceptLanguage: "en-US,en;q=0.9", available: []string{"en"}, want: "en"},
+		{name: "優先度の高い方", acceptLanguage: "ko;q=0.9, en;q=0.5", available: []string{"en", "ko"}, want: "ko"},
+		{name: "日本語を優先する希望は原文", acceptLanguage: "ja, en;q=0.5", available: []string{"en"}, want: "ja"},
+		{name: "合う翻訳が無ければ原文", acceptLanguage: "fr", available: []string{"en"}, want: "ja"},
+		{name: "不正な値は原文", acceptLanguage: ";;;", available: []string{"en"}, want: "ja"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Negotiate(tt.acceptLanguage, tt.available); got != tt.want {
+				t.Fatalf("Negotiate(%q, %v) = %q; want %q", tt.acceptLanguage, tt.available, got, tt.want)
+			}
+		})
+	}
+}
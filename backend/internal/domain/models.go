@@ -15,14 +15,75 @@ type Question struct {
 	Prompt      string
 	Choices     []Choice
 	Explanation string
+	Attachments []QuestionAttachment
+	// Locale は Prompt/Choices/Explanation の言語（翻訳で出題した場合はその言語、それ以外は CanonicalLocale）。
+	Locale string
+	// AcceptsLocation は地図で答えられる（正解の地点がある）こと。地点そのものは回答後にだけ返す。
+	AcceptsLocation bool
+	// Kind は問題の種類（QuestionKindLocation の場合は Choices が空で、地図でだけ答えられる）。
+	Kind QuestionKind
+	// Passage は史料を共有する問題の場合の史料と位置（それ以外は nil）。
+	Passage *QuizPassage
 }
 
 // QuestionDraft は作問入力（作成/更新で共通）。
 type QuestionDraft struct {
+	// Kind は問題の種類（空は QuestionKindChoice として扱う）。
+	Kind           QuestionKind
 	Prompt         string
 	Choices        []string
 	CorrectOrdinal int32
 	Explanation    string
+	// AcceptedAnswers は記述式回答で正解として扱う別表記（正解ラベル自体は含めない）。
+	AcceptedAnswers []string
+	// Tags は作者が付ける分類（例: "大航海時代"）。並び順は入力順を保持する。
+	Tags []string
+	// Attachments は問題に付ける画像/地図（アップロード済みの自分の添付を表示順に指定する）。
+	Attachments []AttachmentRef
+	// Citations は出典（表示順）。
+	Citations []Citation
+	// Location は地図で答える場合の正解の地点（nil の場合は地図では答えられない）。
+	Location *QuestionLocation
+}
+
+// QuestionStatus は問題の公開状態。
+// 出題候補になるのは QuestionStatusPublished のみ。
+type QuestionStatus string
+
+const (
+	// QuestionStatusDraft は作成直後の下書き（作者のみ閲覧/編集できる）。
+	QuestionStatusDraft QuestionStatus = "draft"
+	// QuestionStatusPublished は公開中（出題候補に含まれる）。
+	QuestionStatusPublished QuestionStatus = "published"
+	// QuestionStatusUnlisted は限定公開（出題候補から外すが、再公開できる）。
+	QuestionStatusUnlisted QuestionStatus = "unlisted"
+	// QuestionStatusArchived はアーカイブ済み（再公開しない）。
+	QuestionStatusArchived QuestionStatus = "archived"
+)
+
+// QuestionKind は問題の答え方の種類。
+type QuestionKind string
+
+const (
+	// QuestionKindChoice は4択の問題（正解の地点があれば地図でも答えられる）。
+	QuestionKindChoice QuestionKind = "choice"
+	// QuestionKindLocation は地図でだけ答える問題（選択肢/別表記を持たず、正解の地点が必須）。
+	QuestionKindLocation QuestionKind = "location"
+)
+
+// CanTransitionTo は from → to の状態遷移が許可されているかを返す。
+// 混同しやすい点: archived は終端とし、再公開したい場合は新しい問題として作り直す。
+func (from QuestionStatus) CanTransitionTo(to QuestionStatus) bool {
+	switch from {
+	case QuestionStatusDraft:
+		return to == QuestionStatusPublished
+	case QuestionStatusPublished:
+		return to == QuestionStatusUnlisted || to == QuestionStatusArchived
+	case QuestionStatusUnlisted:
+		return to == QuestionStatusPublished || to == QuestionStatusArchived
+	default:
+		return false
+	}
 }
 
 // QuestionSummary は一覧表示向けの最小情報。
@@ -30,6 +91,12 @@ type QuestionSummary struct {
 	ID        string
 	Prompt    string
 	UpdatedAt time.Time
+	Status    QuestionStatus
+	// 以下は自分の問題一覧（ListMyQuestions）でのみ設定する。
+	CreatedAt       time.Time
+	AttemptCount    int64
+	CorrectAttempts int64
+	Accuracy        float64
 }
 
 // QuestionDetail は編集画面向けの詳細。
@@ -40,6 +107,24 @@ type QuestionDetail struct {
 	CorrectChoiceID  string
 	Explanation      string
 	UpdatedAt        time.Time
+	AcceptedAnswers  []string
+	Status           QuestionStatus
+	// Hidden は報告によりモデレーションで非表示になっていることを表す（出題候補から外れる）。
+	Hidden           bool
+	Tags             []string
+	// Version は楽観ロック用の版番号（本文の更新のたびに 1 増える）。
+	Version          int64
+	Attachments      []QuestionAttachment
+	Citations        []Citation
+	// OriginQuestionID は複製（ForkQuestion）で作った場合の元の問題（出典表示用。元が削除されても残す）。
+	OriginQuestionID string
+	// Schedule は公開/公開終了の予約（予約が無い場合はゼロ値）。
+	Schedule         QuestionSchedule
+	Location         *QuestionLocation
+	// PassageID は史料を共有する問題の場合の史料（それ以外は空）。
+	PassageID string
+	// Kind は問題の種類（QuestionKindLocation の場合は Choices/CorrectChoiceID が空）。
+	Kind QuestionKind
 }
 
 // Attempt は解答履歴。
@@ -48,10 +133,18 @@ type Attempt struct {
 	QuestionID       string
 	QuestionPrompt   string
 	SelectedChoiceID string
+	// AnswerText は記述式で回答した場合の入力文字列（選択式の場合は空）。
+	AnswerText       string
 	IsCorrect        bool
 	AnsweredAt       time.Time
 }
 
+// AttemptListCursor は解答履歴のページングの位置（answered_at 降順 → id 降順）。
+type AttemptListCursor struct {
+	AnsweredAt time.Time
+	AttemptID  string
+}
+
 // Stats はマイページ向けの統計。
 type Stats struct {
 	TotalAttempts   int64
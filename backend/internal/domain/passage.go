@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+// Passage は複数の問題が共有する史料の抜粋（例: 「『魏志倭人伝』の一節を読んで答える3問」）。
+// NOTE: 問題は作者が決めた順（PassageQuestionDraft の並び）で続けて出題する。
+type Passage struct {
+	ID   string
+	Body string
+	// Source は抜粋の出典（必須。問題の出典と同じ形式）。
+	Source Citation
+	// Attachment は史料の画像（任意、1件まで）。
+	Attachment *QuestionAttachment
+}
+
+// PassageDraft は史料と、それを共有する問題をまとめた作問入力（作成/更新で共通）。
+type PassageDraft struct {
+	Body       string
+	Source     Citation
+	Attachment *AttachmentRef
+	// Questions は出題順に並べた子の問題。
+	Questions []PassageQuestionDraft
+}
+
+// PassageQuestionDraft は史料を共有する問題の1件。
+// 混同しやすい点: 更新では QuestionID が空の問題を新しく作り、並びから外れた既存の問題は論理削除する。
+type PassageQuestionDraft struct {
+	QuestionID string
+	Draft      QuestionDraft
+}
+
+// PassageDetail は作者向けの史料の詳細（子の問題を出題順に含む）。
+type PassageDetail struct {
+	Passage
+	Questions []QuestionDetail
+	// Version は楽観ロック用の版番号（史料または子の問題の更新のたびに 1 増える）。
+	Version   int64
+	UpdatedAt time.Time
+}
+
+// QuizPassage は出題中の問題が共有する史料と、その中での位置（1始まり）。
+// Total は今出題できる子の問題の数（非公開/非表示の問題は数えない）。
+type QuizPassage struct {
+	Passage
+	Position int32
+	Total    int32
+}
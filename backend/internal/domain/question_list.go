@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// QuestionSortKey は自分の問題一覧の並び替えの基準。
+type QuestionSortKey string
+
+const (
+	QuestionSortUpdatedAt    QuestionSortKey = "updated_at"
+	QuestionSortCreatedAt    QuestionSortKey = "created_at"
+	QuestionSortAccuracy     QuestionSortKey = "accuracy"
+	QuestionSortAttemptCount QuestionSortKey = "attempt_count"
+)
+
+// MyQuestionsQuery は自分の問題一覧の条件（値は usecase で検証/正規化済み）。
+type MyQuestionsQuery struct {
+	// Statuses が空の場合はすべての公開状態を対象にする。
+	Statuses []QuestionStatus
+	// Tag が空でない場合は、そのタグが付いた問題に限る。
+	Tag string
+	// CreatedFrom 以降、CreatedTo より前に作成した問題に限る（ゼロ値はその側を制限しない）。
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// HasExplanation が nil でない場合は、解説の有無で絞り込む。
+	HasExplanation *bool
+	// KeywordTerms は正規化したキーワード（すべてを問題文/選択肢/解説のいずれかに含む問題に限る）。
+	KeywordTerms []string
+	// KeywordBigrams は KeywordTerms の bigram（index での絞り込み用）。
+	KeywordBigrams []string
+	Sort           QuestionSortKey
+	Ascending      bool
+	// After はページングの位置（前ページの最後の問題）。nil の場合は先頭から。
+	After *QuestionListCursor
+	Limit int32
+}
+
+// QuestionListCursor は自分の問題一覧のページングの位置（並び替えの値 → id）。
+// 並び替えの値は Sort に応じて At / Value / Count のいずれかを使う。
+type QuestionListCursor struct {
+	// At は created_at / updated_at で並べる場合の値。
+	At time.Time
+	// Value は accuracy で並べる場合の値（AccuracySortValue）。
+	Value float64
+	// Count は attempt_count で並べる場合の値。
+	Count      int64
+	QuestionID string
+}
+
+// AccuracySortValue は正答率で並べる場合の値を返す。
+// 混同しやすい点: 回答の無い問題は正答率 0 ではない。昇順/降順のどちらでも最後になるよう、0..1 の範囲外の値にする。
+func AccuracySortValue(attempts int64, correct int64, ascending bool) float64 {
+	if attempts == 0 {
+		if ascending {
+			return 2
+		}
+		return -1
+	}
+	return float64(correct) / float64(attempts)
+}
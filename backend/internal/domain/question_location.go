@@ -0,0 +1,10 @@
+package domain
+
+import "github.com/history-quiz/historyquiz/internal/domain/geo"
+
+// QuestionLocation は地図で答える問題の正解の地点（例: 「カルタゴはどこ？」）。
+// 正解の地点から RadiusKm 以内を正解とし、それより遠い回答は距離の帯で部分点にする（geo.Score）。
+type QuestionLocation struct {
+	Point    geo.Point
+	RadiusKm float64
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// QuestionSchedule は公開/公開終了の予約。ゼロ値の時刻は予約なしを表す。
+// 混同しやすい点: 予約は時刻になったときに公開状態を変えるだけで、状態の遷移ルール（CanTransitionTo）はそのまま適用される。
+//   - PublishAt: 下書き/限定公開の問題を公開する（公開中の問題には設定できない）。
+//   - UnpublishAt: 公開中の問題を限定公開に戻す。時刻を過ぎた問題は、状態の変更を待たずに出題候補から外す。
+type QuestionSchedule struct {
+	PublishAt   time.Time
+	UnpublishAt time.Time
+}
+
+// IsZero は予約が1つも無いかを返す。
+func (s QuestionSchedule) IsZero() bool {
+	return s.PublishAt.IsZero() && s.UnpublishAt.IsZero()
+}
+
+// QuestionStatusChangeReason は公開状態を変更した理由（監査用）。
+type QuestionStatusChangeReason string
+
+const (
+	// QuestionStatusChangeManual は作者の操作（PublishQuestion/UnpublishQuestion）による変更。
+	QuestionStatusChangeManual QuestionStatusChangeReason = "manual"
+	// QuestionStatusChangeScheduledPublish は公開の予約による変更。
+	QuestionStatusChangeScheduledPublish QuestionStatusChangeReason = "scheduled_publish"
+	// QuestionStatusChangeScheduledUnpublish は公開終了の予約による変更。
+	QuestionStatusChangeScheduledUnpublish QuestionStatusChangeReason = "scheduled_unpublish"
+)
+
+// QuestionStatusChange は公開状態の変更の記録（question_status_changes の1行）。
+type QuestionStatusChange struct {
+	QuestionID string
+	// ActorUserID は変更したユーザー。予約による変更（サーバーの定期処理）の場合は空。
+	ActorUserID string
+	Reason      QuestionStatusChangeReason
+	From        QuestionStatus
+	To          QuestionStatus
+	ChangedAt   time.Time
+}
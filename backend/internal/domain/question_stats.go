@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+// QuestionStats は作者向けの問題ごとの回答統計（attempts から集計する）。
+type QuestionStats struct {
+	TotalAttempts   int64
+	CorrectAttempts int64
+	Accuracy        float64
+	// TextAttempts は記述式で回答された数（選択肢の選択率の分母には含めない）。
+	TextAttempts int64
+	// LocationAttempts は地図で回答された数（TextAttempts と同じく選択率の分母には含めない）。
+	LocationAttempts int64
+	// Choices は選択肢ごとの選ばれた数（ordinal 順。選ばれていない選択肢も含む）。
+	Choices []ChoiceStats
+	// Trend は週ごとの回答数と正答率（古い順。回答の無い週も 0 件で含む）。
+	Trend []StatsBucket
+	// StrongPlayerAttempts/StrongPlayerCorrect は、よくできるプレイヤー（StrongPlayerCriteria）による回答の数。
+	StrongPlayerAttempts int64
+	StrongPlayerCorrect  int64
+	StrongPlayerAccuracy float64
+	// Flags は問題の質について自動で検出した注意点。
+	Flags []QuestionQualityFlag
+	// ForkCount は他のユーザーがこの問題を複製した数（論理削除された複製は数えない）。
+	ForkCount int64
+}
+
+// ChoiceStats は選択肢ごとの集計。
+type ChoiceStats struct {
+	ChoiceID  string
+	Label     string
+	Ordinal   int32
+	IsCorrect bool
+	Picks     int64
+	// PickRate は選択式の回答に占める割合。
+	PickRate float64
+}
+
+// StatsBucket は期間ごとの集計。
+type StatsBucket struct {
+	// Start は期間の開始（UTC の月曜 0 時）。
+	Start           time.Time
+	Attempts        int64
+	CorrectAttempts int64
+	Accuracy        float64
+}
+
+// StrongPlayerCriteria は「よくできるプレイヤー」の条件。
+// 混同しやすい点: 対象の問題への回答は含めずに判定する（問題の出来で判定が変わらないように）。
+type StrongPlayerCriteria struct {
+	MinAttempts int64
+	MinAccuracy float64
+}
+
+// QuestionQualityFlagKind は問題の質についての注意点の種類。
+type QuestionQualityFlagKind string
+
+const (
+	// QualityFlagUnpickedDistractor はほとんど選ばれない誤答の選択肢（選択肢として機能していない）。
+	QualityFlagUnpickedDistractor QuestionQualityFlagKind = "unpicked_distractor"
+	// QualityFlagStrongPlayersMiss はよくできるプレイヤーの多くが間違える（正解の設定が誤っている可能性がある）。
+	QualityFlagStrongPlayersMiss QuestionQualityFlagKind = "strong_players_miss"
+)
+
+// QuestionQualityFlag は問題の質についての注意点。
+type QuestionQualityFlag struct {
+	Kind QuestionQualityFlagKind
+	// ChoiceID は対象の選択肢（QualityFlagUnpickedDistractor の場合のみ）。
+	ChoiceID string
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// ReportReason は問題の報告理由。
+type ReportReason string
+
+const (
+	ReportReasonWrongAnswer   ReportReason = "wrong_answer"
+	ReportReasonAmbiguous     ReportReason = "ambiguous"
+	ReportReasonTypo          ReportReason = "typo"
+	ReportReasonInappropriate ReportReason = "inappropriate"
+	ReportReasonOther         ReportReason = "other"
+)
+
+// ReportStatus は報告の対応状況。
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"
+	ReportStatusDismissed ReportStatus = "dismissed"
+	ReportStatusResolved  ReportStatus = "resolved"
+)
+
+// ResolutionAction は報告の解決方法。
+type ResolutionAction string
+
+const (
+	// ResolutionDismiss は「問題に誤りなし」として却下する（自動非表示も解除する）。
+	ResolutionDismiss ResolutionAction = "dismiss"
+	// ResolutionHide は問題を非表示にする。
+	ResolutionHide ResolutionAction = "hide"
+	// ResolutionEdit は問題を修正して再表示する。
+	ResolutionEdit ResolutionAction = "edit"
+)
+
+// QuestionReport はプレイヤーからの問題の報告。
+type QuestionReport struct {
+	ID             string
+	QuestionID     string
+	QuestionPrompt string
+	ReporterUserID string
+	Reason         ReportReason
+	Detail         string
+	Status         ReportStatus
+	CreatedAt      time.Time
+}
+
+// ReportedQuestion は管理者がモデレーション判断に使う情報（正解情報と未対応の報告を含む）。
+type ReportedQuestion struct {
+	Question     QuestionDetail
+	AuthorUserID string
+	Hidden       bool
+	OpenReports  []QuestionReport
+}
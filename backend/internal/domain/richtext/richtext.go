@@ -0,0 +1,325 @@
+// Package richtext は問題文/解説の書式（ルビ・強調・改行・リンク）の構文解析を提供する。
+// NOTE: 保存時の検証（usecase）と応答の構文木の作成（transport）で同じ解析を使うため domain に置く。
+//
+// 書式:
+//   - ルビ: 北条時宗《ほうじょうときむね》（直前の漢字の並びが対象）、｜源氏《げんじ》物語（｜から《までが対象）
+//   - 強調: **強調**
+//   - 改行: 改行文字
+//   - リンク: [表示する文字](https://example.com/)（http/https のみ）
+//   - エスケープ: \* \[ \] \《 \》 \｜ \\ は記号そのものを表す
+//
+// 混同しやすい点: HTML は解釈しない（"<b>" はただの文字列）。クライアントは構文木を描画し、文字列を HTML として扱わない。
+package richtext
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind は構文木のノードの種類。
+type Kind string
+
+const (
+	KindText      Kind = "text"
+	KindRuby      Kind = "ruby"
+	KindEmphasis  Kind = "emphasis"
+	KindLineBreak Kind = "line_break"
+	KindLink      Kind = "link"
+)
+
+// ルビの上限（長すぎるルビは表示が崩れるため）。
+const (
+	MaxRubyBaseRunes    = 20
+	MaxRubyReadingRunes = 40
+)
+
+// Node は構文木のノード。
+type Node struct {
+	Kind Kind
+	// Text は KindText の文字列、KindRuby の親文字。
+	Text string
+	// Reading は KindRuby の読み。
+	Reading string
+	// URL は KindLink のリンク先（http/https の絶対 URL）。
+	URL string
+	// Children は KindEmphasis / KindLink の中身。
+	Children []Node
+}
+
+// Problem は書式の誤り。Offset は誤りの位置（1 始まりの文字数）。
+type Problem struct {
+	Offset      int
+	Description string
+}
+
+// String は "N文字目: 説明" の形式で返す（FieldViolation の説明に使う）。
+func (p Problem) String() string {
+	return strconv.Itoa(p.Offset) + "文字目: " + p.Description
+}
+
+// Parse は書式付きの文字列を構文木に変換する。
+// 誤りがあっても構文木は常に返す（誤りの箇所は文字列として扱う）。保存済みの文字列の表示にはそのまま使える。
+// 混同しやすい点: 書式として閉じていない《》や[]は誤りではなく文字列として扱う（括弧として使われることがあるため）。
+// 誤りにするのは、閉じていない強調、http/https 以外のリンク、空/長すぎるルビ、空のリンク文字列。
+func Parse(markup string) ([]Node, []Problem) {
+	p := &parser{runes: []rune(markup)}
+	nodes, _ := p.parseSeq(false, false)
+	// 最上位では "]" で止まらないため、常に末尾まで読み終わっている。
+	return nodes, p.problems
+}
+
+// PlainText は構文木から書式を除いた文字列を返す（ルビは親文字だけ、改行は "\n"）。
+func PlainText(nodes []Node) string {
+	var b strings.Builder
+	writePlain(&b, nodes)
+	return b.String()
+}
+
+func writePlain(b *strings.Builder, nodes []Node) {
+	for _, n := range nodes {
+		switch n.Kind {
+		case KindText, KindRuby:
+			b.WriteString(n.Text)
+		case KindLineBreak:
+			b.WriteByte('\n')
+		case KindEmphasis, KindLink:
+			writePlain(b, n.Children)
+		}
+	}
+}
+
+type parser struct {
+	runes    []rune
+	pos      int
+	problems []Problem
+}
+
+func (p *parser) peek(offset int) rune {
+	if p.pos+offset >= len(p.runes) {
+		return 0
+	}
+	return p.runes[p.pos+offset]
+}
+
+func (p *parser) problem(pos int, description string) {
+	p.problems = append(p.problems, Problem{Offset: pos + 1, Description: description})
+}
+
+// parseSeq は終端（末尾、強調の中の "**"、リンクの中の "]"）まで読む。
+// 強調の中で "**" を読んだ場合は closed = true（"**" は読み進める）。リンクの中の "]" は読み進めない。
+func (p *parser) parseSeq(inEmphasis bool, inLink bool) (nodes []Node, closed bool) {
+	var buf []rune
+	flush := func() {
+		if len(buf) > 0 {
+			nodes = appendText(nodes, string(buf))
+			buf = nil
+		}
+	}
+
+	for p.pos < len(p.runes) {
+		r := p.runes[p.pos]
+		switch {
+		case r == '\\':
+			if next := p.peek(1); isEscapable(next) {
+				buf = append(buf, next)
+				p.pos += 2
+				continue
+			}
+			buf = append(buf, r)
+			p.pos++
+
+		case r == '\n':
+			flush()
+			nodes = append(nodes, Node{Kind: KindLineBreak})
+			p.pos++
+
+		case r == '*' && p.peek(1) == '*':
+			if inEmphasis {
+				flush()
+				p.pos += 2
+				return nodes, true
+			}
+			flush()
+			nodes = append(nodes, p.parseEmphasis(inLink)...)
+
+		case r == '[' && !inLink:
+			if link, ok := p.parseLink(); ok {
+				flush()
+				nodes = append(nodes, link...)
+				continue
+			}
+			buf = append(buf, r)
+			p.pos++
+
+		case r == ']' && inLink:
+			flush()
+			return nodes, false
+
+		case r == '｜':
+			if base, reading, end, ok := p.scanExplicitRuby(); ok {
+				flush()
+				nodes = append(nodes, p.rubyNode(p.pos, base, reading))
+				p.pos = end
+				continue
+			}
+			buf = append(buf, r)
+			p.pos++
+
+		case r == '《':
+			baseStart := len(buf)
+			for baseStart > 0 && isRubyBase(buf[baseStart-1]) {
+				baseStart--
+			}
+			reading, end, ok := p.scanReading(p.pos)
+			if baseStart == len(buf) || !ok {
+				// 親文字が無い/閉じていない《》は括弧として扱う。
+				buf = append(buf, r)
+				p.pos++
+				continue
+			}
+			base := string(buf[baseStart:])
+			buf = buf[:baseStart]
+			flush()
+			nodes = append(nodes, p.rubyNode(p.pos, base, reading))
+			p.pos = end
+
+		default:
+			buf = append(buf, r)
+			p.pos++
+		}
+	}
+	flush()
+	return nodes, false
+}
+
+// parseEmphasis は "**" から始まる強調を読む。閉じていない場合は誤りとし、"**" を文字列として中身と並べる。
+func (p *parser) parseEmphasis(inLink bool) []Node {
+	start := p.pos
+	p.pos += 2
+	children, closed := p.parseSeq(true, inLink)
+	if !closed {
+		p.problem(start, "強調（**）が閉じられていません")
+		return append(appendText(nil, "**"), children...)
+	}
+	if len(children) == 0 {
+		// 中身の無い "****" は文字列として残す（入力を黙って消さない）。
+		return appendText(nil, "****")
+	}
+	return []Node{{Kind: KindEmphasis, Children: children}}
+}
+
+// parseLink は "[" から始まるリンクを読む。"[文字列](URL)" の形でない場合は ok = false で位置を戻す。
+func (p *parser) parseLink() ([]Node, bool) {
+	start := p.pos
+	problemCount := len(p.problems)
+	p.pos++
+
+	children, _ := p.parseSeq(false, true)
+	if p.peek(0) != ']' || p.peek(1) != '(' {
+		p.pos = start
+		p.problems = p.problems[:problemCount]
+		return nil, false
+	}
+	urlStart := p.pos + 2
+	urlEnd := urlStart
+	for urlEnd < len(p.runes) && p.runes[urlEnd] != ')' && !unicode.IsSpace(p.runes[urlEnd]) {
+		urlEnd++
+	}
+	if urlEnd >= len(p.runes) || p.runes[urlEnd] != ')' {
+		p.pos = start
+		p.problems = p.problems[:problemCount]
+		return nil, false
+	}
+	rawURL := string(p.runes[urlStart:urlEnd])
+	p.pos = urlEnd + 1
+
+	if !isHTTPURL(rawURL) {
+		p.problem(urlStart, "リンク先は http:// または https:// で始まる URL を指定してください")
+		return children, true
+	}
+	if strings.TrimSpace(PlainText(children)) == "" {
+		p.problem(start, "リンクの文字列が空です")
+		return appendText(nil, rawURL), true
+	}
+	return []Node{{Kind: KindLink, URL: rawURL, Children: children}}, true
+}
+
+// scanExplicitRuby は "｜親文字《読み》" を読む（位置は進めない）。end は "》" の次の位置。
+func (p *parser) scanExplicitRuby() (base string, reading string, end int, ok bool) {
+	i := p.pos + 1
+	for i < len(p.runes) && p.runes[i] != '《' {
+		if r := p.runes[i]; r == '\n' || r == '｜' || r == '》' {
+			return "", "", 0, false
+		}
+		i++
+	}
+	if i >= len(p.runes) || i == p.pos+1 {
+		return "", "", 0, false
+	}
+	reading, end, ok = p.scanReading(i)
+	if !ok {
+		return "", "", 0, false
+	}
+	return string(p.runes[p.pos+1 : i]), reading, end, true
+}
+
+// scanReading は open（"《" の位置）から "》" までの読みを読む（位置は進めない）。end は "》" の次の位置。
+func (p *parser) scanReading(open int) (reading string, end int, ok bool) {
+	for i := open + 1; i < len(p.runes); i++ {
+		switch p.runes[i] {
+		case '》':
+			return string(p.runes[open+1 : i]), i + 1, true
+		case '\n', '《':
+			return "", 0, false
+		}
+	}
+	return "", 0, false
+}
+
+// rubyNode はルビのノードを作り、空/長すぎるルビを誤りとして記録する。
+func (p *parser) rubyNode(pos int, base string, reading string) Node {
+	switch {
+	case strings.TrimSpace(reading) == "":
+		p.problem(pos, "ルビ（《》）の読みが空です")
+	case utf8.RuneCountInString(reading) > MaxRubyReadingRunes:
+		p.problem(pos, "ルビの読みは"+strconv.Itoa(MaxRubyReadingRunes)+"文字以内で指定してください")
+	}
+	if utf8.RuneCountInString(base) > MaxRubyBaseRunes {
+		p.problem(pos, "ルビを振る文字は"+strconv.Itoa(MaxRubyBaseRunes)+"文字以内で指定してください")
+	}
+	return Node{Kind: KindRuby, Text: base, Reading: reading}
+}
+
+// appendText は文字列のノードを追加する（直前も文字列なら連結する）。
+func appendText(nodes []Node, text string) []Node {
+	if n := len(nodes); n > 0 && nodes[n-1].Kind == KindText {
+		nodes[n-1].Text += text
+		return nodes
+	}
+	return append(nodes, Node{Kind: KindText, Text: text})
+}
+
+func isEscapable(r rune) bool {
+	switch r {
+	case '\\', '*', '[', ']', '《', '》', '｜':
+		return true
+	}
+	return false
+}
+
+// isRubyBase は《》の直前からさかのぼってルビの親文字とみなす文字（漢字と繰り返し記号）かを返す。
+func isRubyBase(r rune) bool {
+	return unicode.Is(unicode.Han, r) || r == '々' || r == '〆' || r == 'ヶ'
+}
+
+// isHTTPURL は s がホスト付きの http/https の絶対 URL かを返す（javascript: などは受け付けない）。
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && u.User == nil
+}
@@ -0,0 +1,107 @@
+package richtext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		markup string
+		want   []Node
+	}{
+		{name: "書式なし", markup: "鎌倉幕府を開いたのは？", want: []Node{{Kind: KindText, Text: "鎌倉幕府を開いたのは？"}}},
+		{
+			name:   "直前の漢字にルビ",
+			markup: "元寇の時の執権は北条時宗《ほうじょうときむね》です",
+			want: []Node{
+				{Kind: KindText, Text: "元寇の時の執権は"},
+				{Kind: KindRuby, Text: "北条時宗", Reading: "ほうじょうときむね"},
+				{Kind: KindText, Text: "です"},
+			},
+		},
+		{
+			name:   "｜で親文字を指定",
+			markup: "｜源氏物語《げんじものがたり》の作者",
+			want: []Node{
+				{Kind: KindRuby, Text: "源氏物語", Reading: "げんじものがたり"},
+				{Kind: KindText, Text: "の作者"},
+			},
+		},
+		{name: "親文字の無い《》は括弧", markup: "《吾妻鏡》によると", want: []Node{{Kind: KindText, Text: "《吾妻鏡》によると"}}},
+		{name: "閉じていない《は文字列", markup: "北条《ほう", want: []Node{{Kind: KindText, Text: "北条《ほう"}}},
+		{
+			name:   "強調と改行",
+			markup: "**御成敗式目**を定めた\n人物は？",
+			want: []Node{
+				{Kind: KindEmphasis, Children: []Node{{Kind: KindText, Text: "御成敗式目"}}},
+				{Kind: KindText, Text: "を定めた"},
+				{Kind: KindLineBreak},
+				{Kind: KindText, Text: "人物は？"},
+			},
+		},
+		{
+			name:   "リンク",
+			markup: "[国立国会図書館](https://www.ndl.go.jp/)を参照",
+			want: []Node{
+				{Kind: KindLink, URL: "https://www.ndl.go.jp/", Children: []Node{{Kind: KindText, Text: "国立国会図書館"}}},
+				{Kind: KindText, Text: "を参照"},
+			},
+		},
+		{name: "リンクの形でない[]は文字列", markup: "[注1] 参照", want: []Node{{Kind: KindText, Text: "[注1] 参照"}}},
+		{name: "HTML は文字列", markup: "<b>太字</b><script>", want: []Node{{Kind: KindText, Text: "<b>太字</b><script>"}}},
+		{name: "エスケープ", markup: `\*\*北条\《ほう\》`, want: []Node{{Kind: KindText, Text: "**北条《ほう》"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, problems := Parse(tt.markup)
+			if len(problems) != 0 {
+				t.Fatalf("誤りは無い想定です: %+v", problems)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Parse(%q) = %+v, want %+v", tt.markup, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Problems(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		markup     string
+		wantOffset int
+		wantPlain  string
+	}{
+		{name: "閉じていない強調", markup: "あ**い", wantOffset: 2, wantPlain: "あ**い"},
+		{name: "javascript のリンク", markup: "[押す](javascript:alert(1))", wantOffset: 6, wantPlain: "押す)"},
+		{name: "空のリンク文字列", markup: "[](https://example.com/)", wantOffset: 1, wantPlain: "https://example.com/"},
+		{name: "空のルビ", markup: "時宗《》", wantOffset: 3, wantPlain: "時宗"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			nodes, problems := Parse(tt.markup)
+			if len(problems) != 1 || problems[0].Offset != tt.wantOffset {
+				t.Fatalf("%d文字目の誤りを期待しました: %+v", tt.wantOffset, problems)
+			}
+			if got := PlainText(nodes); got != tt.wantPlain {
+				t.Fatalf("誤りがあっても構文木を返す想定です: PlainText = %q, want %q", got, tt.wantPlain)
+			}
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	t.Parallel()
+
+	nodes, _ := Parse("**北条時宗《ほうじょうときむね》**は\n[元寇](https://example.com/)で")
+	if got := PlainText(nodes); got != "北条時宗は\n元寇で" {
+		t.Fatalf("PlainText = %q", got)
+	}
+}
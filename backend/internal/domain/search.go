@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// QuestionSearchQuery は問題の全文検索の条件（検索語は正規化済み）。
+type QuestionSearchQuery struct {
+	// Terms は正規化した検索語（すべてを含む問題に一致する）。
+	Terms []string
+	// Bigrams は検索語の bigram（index での絞り込み用）。
+	Bigrams []string
+	// AuthorUserID が空の場合は全作者を対象にする（管理者のみ）。
+	AuthorUserID string
+	// Tag が空でない場合は、そのタグが付いた問題に限る。
+	Tag string
+	// Statuses が空の場合はすべての公開状態を対象にする。
+	Statuses []QuestionStatus
+	// After はページングの位置（前ページの最後の結果）。nil の場合は先頭から。
+	After *QuestionSearchCursor
+	Limit int32
+}
+
+// QuestionSearchCursor は検索結果のページングの位置（score 降順 → question_id 昇順）。
+type QuestionSearchCursor struct {
+	Score      int32
+	QuestionID string
+}
+
+// QuestionSearchHit は検索に一致した問題。
+type QuestionSearchHit struct {
+	QuestionID   string
+	AuthorUserID string
+	Prompt       string
+	Choices      []string
+	Explanation  string
+	Status       QuestionStatus
+	UpdatedAt    time.Time
+	Tags         []string
+	// Score は一致したフィールドの重みの合計（問題文 3 / 選択肢 2 / 解説 1 を検索語ごとに加算）。
+	Score int32
+}
@@ -0,0 +1,196 @@
+// Package searchtext は問題の全文検索向けの正規化・bigram 化・スニペット作成を提供する。
+// NOTE: 保存時（infrastructure）と検索時（usecase）で同じ正規化を使うため domain に置く。
+// 正規化そのものは近似重複判定（similarity）と共通にする。
+package searchtext
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/domain/similarity"
+)
+
+// 検索語の上限。
+const (
+	MaxTerms     = 5
+	MaxTermRunes = 50
+)
+
+// choiceSeparator は正規化した選択肢を連結する区切り。
+// 正規化後の文字列は文字と数字のみになるため、検索語が選択肢をまたいで一致することはない。
+const choiceSeparator = "\n"
+
+// Document は検索用に正規化した問題の本文。
+type Document struct {
+	Prompt      string
+	Choices     string
+	Explanation string
+	// Bigrams は全フィールドの文字 bigram（重複なし、昇順）。GIN index での絞り込みに使う。
+	Bigrams []string
+}
+
+// NewDocument は問題の本文から検索用の文書を作る。
+func NewDocument(prompt string, choices []string, explanation string) Document {
+	normalizedChoices := make([]string, 0, len(choices))
+	for _, c := range choices {
+		normalizedChoices = append(normalizedChoices, similarity.Normalize(c))
+	}
+
+	doc := Document{
+		Prompt:      similarity.Normalize(prompt),
+		Choices:     strings.Join(normalizedChoices, choiceSeparator),
+		Explanation: similarity.Normalize(explanation),
+	}
+	doc.Bigrams = bigramsOf(append([]string{doc.Prompt, doc.Explanation}, normalizedChoices...)...)
+	return doc
+}
+
+// ParseQuery は検索文字列を空白で区切り、正規化した検索語にする（空や重複は除く、先頭 MaxTerms 件まで）。
+// 例: "ヴァスコ・ダ・ガマ　インド" → ["ゔぁすこだがま", "いんど"]
+func ParseQuery(query string) []string {
+	var terms []string
+	seen := map[string]struct{}{}
+	for _, field := range strings.Fields(query) {
+		term := similarity.Normalize(field)
+		if term == "" {
+			continue
+		}
+		if _, dup := seen[term]; dup {
+			continue
+		}
+		seen[term] = struct{}{}
+		terms = append(terms, term)
+		if len(terms) == MaxTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// QueryBigrams は検索語の bigram を返す（文書の Bigrams がすべて含んでいれば候補になる）。
+// 混同しやすい点: 1文字の検索語には bigram が無いため、ここには含まれない（部分一致の確認だけで判定する）。
+func QueryBigrams(terms []string) []string {
+	return bigramsOf(terms...)
+}
+
+func bigramsOf(texts ...string) []string {
+	seen := map[string]struct{}{}
+	var bigrams []string
+	for _, text := range texts {
+		runes := []rune(text)
+		for i := 0; i+1 < len(runes); i++ {
+			b := string(runes[i : i+2])
+			if _, dup := seen[b]; dup {
+				continue
+			}
+			seen[b] = struct{}{}
+			bigrams = append(bigrams, b)
+		}
+	}
+	sort.Strings(bigrams)
+	return bigrams
+}
+
+// Segment はスニペットの一部分。Highlighted は検索語に一致した部分を表す。
+type Segment struct {
+	Text        string
+	Highlighted bool
+}
+
+// snippetLeadRunes は最初の一致箇所より前に残す文字数。
+const snippetLeadRunes = 20
+
+// Snippet は元の文字列から、検索語に一致した箇所を含む最大 maxRunes 文字の抜粋を作る。
+// 一致しない場合は nil を返す。省略した前後には "…" を付ける。
+// NOTE: 照合は1文字ずつ正規化した文字列で行い、一致箇所を元の文字に戻してハイライトする。
+func Snippet(text string, terms []string, maxRunes int) []Segment {
+	original := []rune(text)
+
+	// 正規化後の1文字ごとに、元の文字の位置を記録する（NFKC で1文字が複数文字になる場合がある）。
+	var normalized []rune
+	var origin []int
+	for i, r := range original {
+		for _, n := range similarity.Normalize(string(r)) {
+			normalized = append(normalized, n)
+			origin = append(origin, i)
+		}
+	}
+
+	highlighted := make([]bool, len(original))
+	first := -1
+	for _, term := range terms {
+		t := []rune(term)
+		if len(t) == 0 {
+			continue
+		}
+		for start := 0; start+len(t) <= len(normalized); start++ {
+			if !equalRunes(normalized[start:start+len(t)], t) {
+				continue
+			}
+			from, to := origin[start], origin[start+len(t)-1]
+			for i := from; i <= to; i++ {
+				highlighted[i] = true
+			}
+			if first == -1 || from < first {
+				first = from
+			}
+		}
+	}
+	if first == -1 {
+		return nil
+	}
+
+	start := first - snippetLeadRunes
+	if start < 0 {
+		start = 0
+	}
+	end := start + maxRunes
+	if end > len(original) {
+		end = len(original)
+		if start = end - maxRunes; start < 0 {
+			start = 0
+		}
+	}
+
+	var segments []Segment
+	if start > 0 {
+		segments = append(segments, Segment{Text: "…"})
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && highlighted[j] == highlighted[i] {
+			j++
+		}
+		segments = append(segments, Segment{Text: string(original[i:j]), Highlighted: highlighted[i]})
+		i = j
+	}
+	if end < len(original) {
+		segments = append(segments, Segment{Text: "…"})
+	}
+	return mergeSegments(segments)
+}
+
+// mergeSegments は隣り合うハイライトなしの部分（"…" を含む）を1つにまとめる。
+func mergeSegments(segments []Segment) []Segment {
+	merged := make([]Segment, 0, len(segments))
+	for _, s := range segments {
+		if n := len(merged); n > 0 && merged[n-1].Highlighted == s.Highlighted {
+			merged[n-1].Text += s.Text
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+func equalRunes(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
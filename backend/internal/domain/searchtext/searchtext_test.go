@@ -0,0 +1,101 @@
+package searchtext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+
+	got := ParseQuery("  ヴァスコ・ダ・ガマ　インド  いんど ？ ")
+	want := []string{"ゔぁすこだがま", "いんど"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseQuery = %q, want %q", got, want)
+	}
+
+	if got := ParseQuery("a b c d e f g"); len(got) != MaxTerms {
+		t.Fatalf("検索語は %d 件までの想定です: %q", MaxTerms, got)
+	}
+}
+
+func TestNewDocument_ChoicesDoNotJoin(t *testing.T) {
+	t.Parallel()
+
+	doc := NewDocument("Q", []string{"ロー", "マ"}, "")
+	if doc.Choices != "ろー\nま" {
+		t.Fatalf("選択肢は区切って保存する想定です: %q", doc.Choices)
+	}
+	for _, b := range doc.Bigrams {
+		if b == "ーま" {
+			t.Fatalf("選択肢をまたぐ bigram は作らない想定です: %q", doc.Bigrams)
+		}
+	}
+}
+
+func TestQueryBigrams_SkipsSingleRuneTerms(t *testing.T) {
+	t.Parallel()
+
+	if got := QueryBigrams([]string{"蒙", "ろーま"}); !reflect.DeepEqual(got, []string{"ろー", "ーま"}) {
+		t.Fatalf("1文字の検索語は bigram に含めない想定です: %q", got)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		maxRunes int
+		want     []Segment
+	}{
+		{
+			name:     "表記揺れでも元の表記のままハイライトする",
+			text:     "古代ﾛｰﾏの首都はどこ？",
+			terms:    []string{"ろーま"},
+			maxRunes: 100,
+			want: []Segment{
+				{Text: "古代"},
+				{Text: "ﾛｰﾏ", Highlighted: true},
+				{Text: "の首都はどこ？"},
+			},
+		},
+		{
+			name:     "長い文は一致箇所の周辺を切り出す",
+			text:     "あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほ首都まみむめも",
+			terms:    []string{"首都"},
+			maxRunes: 25,
+			want: []Segment{
+				{Text: "…さしすせそたちつてとなにぬねのはひふへほ"},
+				{Text: "首都", Highlighted: true},
+				{Text: "まみむ…"},
+			},
+		},
+		{
+			name:     "区切り文字をまたいで一致する",
+			text:     "ヴァスコ・ダ・ガマ",
+			terms:    []string{"ゔぁすこだがま"},
+			maxRunes: 100,
+			want:     []Segment{{Text: "ヴァスコ・ダ・ガマ", Highlighted: true}},
+		},
+		{
+			name:     "一致しない場合は nil",
+			text:     "ローマ",
+			terms:    []string{"あてね"},
+			maxRunes: 100,
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Snippet(tt.text, tt.terms, tt.maxRunes); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Snippet = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Package similarity は問題文の近似重複判定（文字 bigram の Jaccard 係数）を提供する。
+// NOTE: 保存時（infrastructure）と判定時（usecase）で同じ正規化を使うため domain に置く。
+package similarity
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize は問題文を比較用に正規化する。
+// 1) NFKC で全角/半角を統一 2) 小文字化 3) カタカナをひらがなへ寄せる 4) 文字と数字以外（空白/句読点/記号）を除去する。
+// 混同しやすい点: 日本語は単語の区切りが無いため、単語ではなく文字単位で比較する。
+func Normalize(prompt string) string {
+	prompt = norm.NFKC.String(prompt)
+
+	var b strings.Builder
+	b.Grow(len(prompt))
+	for _, r := range prompt {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			continue
+		}
+		b.WriteRune(KatakanaToHiragana(unicode.ToLower(r)))
+	}
+	return b.String()
+}
+
+// Shingles は問題文の文字 bigram の集合を返す（重複なし、昇順）。
+// 正規化後に1文字しか無い場合はその1文字を、空の場合は nil を返す。
+func Shingles(prompt string) []string {
+	runes := []rune(Normalize(prompt))
+	switch len(runes) {
+	case 0:
+		return nil
+	case 1:
+		return []string{string(runes)}
+	}
+
+	seen := make(map[string]struct{}, len(runes)-1)
+	shingles := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		s := string(runes[i : i+2])
+		if _, dup := seen[s]; dup {
+			continue
+		}
+		seen[s] = struct{}{}
+		shingles = append(shingles, s)
+	}
+	sort.Strings(shingles)
+	return shingles
+}
+
+// Jaccard は2つの shingle 集合の Jaccard 係数（共通部分 / 和集合、0..1）を返す。
+// どちらかが空の場合は 0 を返す（空同士を重複扱いにしない）。
+func Jaccard(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[string]struct{}, len(a))
+	for _, s := range a {
+		set[s] = struct{}{}
+	}
+	union := len(set)
+	intersection := 0
+	counted := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		if _, dup := counted[s]; dup {
+			continue
+		}
+		counted[s] = struct{}{}
+		if _, ok := set[s]; ok {
+			intersection++
+		} else {
+			union++
+		}
+	}
+	return float64(intersection) / float64(union)
+}
+
+// KatakanaToHiragana はカタカナ1文字をひらがなへ変換する（対応が無い文字はそのまま返す）。
+// 混同しやすい点: 記述式回答の正規化（answermatch.Normalize）もこの関数を使う。二つの正規化でカタカナの扱いがずれないようにするため。
+// NOTE: U+30A1（ァ）..U+30F6（ヶ）はひらがな U+3041..U+3096 と同じ並びなので、差分で変換できる。
+func KatakanaToHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - ('ァ' - 'ぁ')
+	}
+	return r
+}
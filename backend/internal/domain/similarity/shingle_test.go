@@ -0,0 +1,73 @@
+package similarity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "古代ローマの首都はどこ？", want: "古代ろーまの首都はどこ"},
+		{in: "古代ﾛｰﾏの首都は、どこ?", want: "古代ろーまの首都はどこ"},
+		{in: "「十字軍」の目的地は？", want: "十字軍の目的地は"},
+		{in: "Vasco da Gama", want: "vascodagama"},
+		{in: "1498年", want: "1498年"},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestShingles(t *testing.T) {
+	t.Parallel()
+
+	if got := Shingles("ローマ？"); !reflect.DeepEqual(got, []string{"ろー", "ーま"}) {
+		t.Fatalf("昇順の bigram を期待しました: %q", got)
+	}
+	if got := Shingles("あああ"); !reflect.DeepEqual(got, []string{"ああ"}) {
+		t.Fatalf("重複は除く想定です: %q", got)
+	}
+	if got := Shingles("謎"); !reflect.DeepEqual(got, []string{"謎"}) {
+		t.Fatalf("1文字はそのまま返す想定です: %q", got)
+	}
+	if got := Shingles(" ？ "); got != nil {
+		t.Fatalf("空の場合は nil を期待しました: %q", got)
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		min  float64
+		max  float64
+	}{
+		{name: "表記揺れだけの違いは完全一致", a: "古代ローマの首都はどこ？", b: "古代ﾛｰﾏの首都は、どこ?", min: 1, max: 1},
+		{name: "語尾だけの違いは高い", a: "古代ローマの首都はどこ？", b: "古代ローマの首都はどこですか？", min: 0.7, max: 0.9},
+		{name: "別の問題は低い", a: "古代ローマの首都はどこ？", b: "大航海時代にインド航路を開拓した人物は？", min: 0, max: 0.1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Jaccard(Shingles(tt.a), Shingles(tt.b))
+			if got < tt.min || got > tt.max {
+				t.Fatalf("類似度が期待範囲外です: got=%v want=[%v, %v]", got, tt.min, tt.max)
+			}
+		})
+	}
+
+	if got := Jaccard(nil, nil); got != 0 {
+		t.Fatalf("空同士は 0 を期待しました: %v", got)
+	}
+}
@@ -0,0 +1,115 @@
+package domain
+
+// HistoricalYear は年と、その誤差（±年）。
+// Year は紀元前が負の数（-490 = 紀元前490年）で、0 は不明（紀元0年は存在しない）。
+// Margin が 0 でない場合は「頃」の年で、Year-Margin..Year+Margin のどこかとみなす。
+type HistoricalYear struct {
+	Year   int32
+	Margin int32
+}
+
+// Known は年が分かっていること。
+func (y HistoricalYear) Known() bool {
+	return y.Year != 0
+}
+
+// Approximate は「頃」の年であること。
+func (y HistoricalYear) Approximate() bool {
+	return y.Margin > 0
+}
+
+// axis は年を、紀元前1年を 0 とする通し番号にする（紀元前1年と紀元1年の差を 1 年にするため）。
+func (y HistoricalYear) axis() int32 {
+	if y.Year < 0 {
+		return y.Year + 1
+	}
+	return y.Year
+}
+
+// YearsBefore は y が other より確実に前（誤差の範囲が重ならない）の場合に、その差（近い端どうしの年数）を返す。
+// 同じ年、誤差の範囲が重なる、y の方が後、どちらかの年が不明のいずれかの場合は 0。
+// 例: 紀元前1年と紀元1年は 1、紀元前500年頃（±25）と紀元前490年は 0（重なる）。
+func (y HistoricalYear) YearsBefore(other HistoricalYear) int32 {
+	if !y.Known() || !other.Known() {
+		return 0
+	}
+	gap := (other.axis() - other.Margin) - (y.axis() + y.Margin)
+	if gap <= 0 {
+		return 0
+	}
+	return gap
+}
+
+// YearsBetween は y と other の年の差（誤差を考えない）を返す。どちらかの年が不明の場合は 0。
+func (y HistoricalYear) YearsBetween(other HistoricalYear) int32 {
+	if !y.Known() || !other.Known() {
+		return 0
+	}
+	d := other.axis() - y.axis()
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// TimelineEvent は「どちらが先か」の出題に使う出来事（年が分かる出来事のエンティティ）。
+type TimelineEvent struct {
+	EntityID string
+	Name     string
+	// Date は出来事の開始年（entities.start_year と year_margin）。
+	Date HistoricalYear
+}
+
+// TimelineDifficulty は「どちらが先か」の難しさ。最も早い出来事と2番目の出来事の年の差で決める。
+type TimelineDifficulty string
+
+const (
+	// TimelineDifficultyAny は難しさを指定しない（年の前後が確実な組なら何でもよい）。
+	TimelineDifficultyAny TimelineDifficulty = ""
+	// TimelineDifficultyEasy は 150 年を超えて離れた組。
+	TimelineDifficultyEasy TimelineDifficulty = "easy"
+	// TimelineDifficultyNormal は 26..150 年離れた組。
+	TimelineDifficultyNormal TimelineDifficulty = "normal"
+	// TimelineDifficultyHard は 1..25 年しか離れていない組。
+	TimelineDifficultyHard TimelineDifficulty = "hard"
+)
+
+// GapRange は難しさに対応する年の差（YearsBefore）の範囲を返す（max = 0 は上限なし）。
+func (d TimelineDifficulty) GapRange() (min int32, max int32) {
+	switch d {
+	case TimelineDifficultyEasy:
+		return 151, 0
+	case TimelineDifficultyNormal:
+		return 26, 150
+	case TimelineDifficultyHard:
+		return 1, 25
+	default:
+		return 1, 0
+	}
+}
+
+// DifficultyOfGap は年の差（YearsBefore）から難しさを返す。
+func DifficultyOfGap(gap int32) TimelineDifficulty {
+	for _, d := range []TimelineDifficulty{TimelineDifficultyHard, TimelineDifficultyNormal, TimelineDifficultyEasy} {
+		if lo, hi := d.GapRange(); gap >= lo && (hi == 0 || gap <= hi) {
+			return d
+		}
+	}
+	return TimelineDifficultyAny
+}
+
+// TimelineDuel は「どちらが先か」の出題（出来事は表示順。年は回答後にだけ返す）。
+type TimelineDuel struct {
+	Events     []TimelineEvent
+	Difficulty TimelineDifficulty
+}
+
+// TimelineDuelResult は「どちらが先か」の回答の結果。
+type TimelineDuelResult struct {
+	IsCorrect       bool
+	EarliestEventID string
+	// Events は出題した出来事を年の古い順に並べたもの。
+	Events []TimelineEvent
+	// YearGap は最も早い出来事と2番目の出来事の年の差（誤差を考えない）。
+	YearGap int32
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// CanonicalLocale は問題の原文（questions/choices に保存している内容）の言語。
+// 翻訳が無い、または希望の言語に合う翻訳が無い場合はこの言語で出題する。
+const CanonicalLocale = "ja"
+
+// QuestionTranslation は問題の翻訳（問題文/選択肢/解説）。
+// 混同しやすい点: 正解や別表記は原文の問題と共有し、翻訳では持たない（選択肢は ordinal で原文と対応付ける）。
+type QuestionTranslation struct {
+	// Locale は BCP 47 の言語タグ（例: "en", "zh-Hant"）。
+	Locale      string
+	Prompt      string
+	Choices     []string
+	Explanation string
+	// SourceVersion は翻訳を保存した時点の原文の版（QuestionDetail.Version）。
+	SourceVersion int64
+	UpdatedAt     time.Time
+	// Stale は翻訳の保存後に原文が更新されたことを表す（出題には使わない）。
+	Stale bool
+}
@@ -0,0 +1,94 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// FSStore はローカルのディレクトリに本体を保存する BlobStore 実装（ローカル開発/テスト用）。
+// NOTE: content type は保存しない（メタデータは attachments テーブルが持つ）。
+type FSStore struct {
+	root string
+}
+
+// NewFSStore は root 以下に保存する FSStore を作る（root が無ければ作成する）。
+func NewFSStore(root string) (*FSStore, error) {
+	if root == "" {
+		return nil, fmt.Errorf("保存先ディレクトリが未設定です")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("保存先ディレクトリの作成に失敗しました: %w", err)
+	}
+	return &FSStore{root: root}, nil
+}
+
+func (s *FSStore) Put(_ context.Context, key string, _ string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return apperror.Internal("添付の保存に失敗しました", fmt.Errorf("mkdir blob dir: %w", err))
+	}
+
+	// 書き込み途中のファイルを読まれないよう、一時ファイルに書いてから置き換える。
+	f, err := os.CreateTemp(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return apperror.Internal("添付の保存に失敗しました", fmt.Errorf("create temp blob: %w", err))
+	}
+	defer os.Remove(f.Name()) // rename 成功後は no-op
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return apperror.Internal("添付の保存に失敗しました", fmt.Errorf("write blob: %w", err))
+	}
+	if err := f.Close(); err != nil {
+		return apperror.Internal("添付の保存に失敗しました", fmt.Errorf("close blob: %w", err))
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return apperror.Internal("添付の保存に失敗しました", fmt.Errorf("rename blob: %w", err))
+	}
+	return nil
+}
+
+func (s *FSStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, apperror.NotFound("添付が見つかりません")
+	}
+	if err != nil {
+		return nil, apperror.Internal("添付の読み込みに失敗しました", fmt.Errorf("open blob: %w", err))
+	}
+	return f, nil
+}
+
+func (s *FSStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return apperror.Internal("添付の削除に失敗しました", fmt.Errorf("remove blob: %w", err))
+	}
+	return nil
+}
+
+// path は key を root 以下のパスに変換する。
+// 混同しやすい点: key は呼び出し側が作るが、".." などで root の外を指さないことをここでも保証する。
+func (s *FSStore) path(key string) (string, error) {
+	local := filepath.FromSlash(key)
+	if !filepath.IsLocal(local) {
+		return "", apperror.InvalidArgument("添付のキーが不正です")
+	}
+	return filepath.Join(s.root, local), nil
+}
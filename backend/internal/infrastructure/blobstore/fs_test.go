@@ -0,0 +1,56 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestFSStore_PutGetDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store, err := NewFSStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFSStore: %v", err)
+	}
+
+	if err := store.Put(ctx, "attachments/a1", "image/png", []byte("png-bytes")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	body, err := store.Get(ctx, "attachments/a1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	got, _ := io.ReadAll(body)
+	body.Close()
+	if string(got) != "png-bytes" {
+		t.Fatalf("保存した内容を返す想定です: %q", got)
+	}
+
+	if err := store.Delete(ctx, "attachments/a1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := store.Delete(ctx, "attachments/a1"); err != nil {
+		t.Fatalf("存在しないキーの削除も成功として扱う想定です: %v", err)
+	}
+	if _, err := store.Get(ctx, "attachments/a1"); !apperror.IsCode(err, apperror.CodeNotFound) {
+		t.Fatalf("削除後は NOT_FOUND を期待しました: err=%v", err)
+	}
+}
+
+func TestFSStore_RejectsKeyOutsideRoot(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewFSStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFSStore: %v", err)
+	}
+	for _, key := range []string{"../escape", "/etc/passwd", ""} {
+		if err := store.Put(context.Background(), key, "image/png", []byte("x")); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+			t.Fatalf("root の外を指すキーは INVALID_ARGUMENT を期待しました: key=%q err=%v", key, err)
+		}
+	}
+}
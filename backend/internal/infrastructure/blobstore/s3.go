@@ -0,0 +1,222 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// S3Config は S3 互換ストレージ（AWS S3 / MinIO / Cloudflare R2 など）への接続設定。
+type S3Config struct {
+	// Endpoint はスキーム付きのエンドポイント（例: "https://s3.ap-northeast-1.amazonaws.com", "http://127.0.0.1:9000"）。
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// VirtualHostedStyle が true の場合は <bucket>.<host>/<key>、false の場合は <host>/<bucket>/<key> に送る。
+	// NOTE: MinIO 等は path-style（false）が扱いやすいため、既定は path-style にしている。
+	VirtualHostedStyle bool
+}
+
+// S3Store は S3 互換ストレージに本体を保存する BlobStore 実装（本番用）。
+// 混同しやすい点: SDK を依存に増やさないよう、必要な PUT/GET/DELETE だけを署名付き（SigV4）の HTTP で送る。
+type S3Store struct {
+	cfg      S3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3Store は S3Store を作る。client が nil の場合は http.DefaultClient を使う。
+func NewS3Store(cfg S3Config, client *http.Client) (*S3Store, error) {
+	if cfg.Endpoint == "" || cfg.Region == "" || cfg.Bucket == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("S3 の設定が不足しています（endpoint/region/bucket/access key/secret key）")
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("S3 の endpoint が不正です: %q", cfg.Endpoint)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &S3Store{cfg: cfg, endpoint: endpoint, client: client, now: time.Now}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.sign(req, data)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return apperror.Internal("添付の保存に失敗しました", fmt.Errorf("s3 put: %w", err))
+	}
+	defer drainAndClose(resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return apperror.Internal("添付の保存に失敗しました", fmt.Errorf("s3 put: unexpected status %d", resp.StatusCode))
+	}
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, nil)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, apperror.Internal("添付の読み込みに失敗しました", fmt.Errorf("s3 get: %w", err))
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		drainAndClose(resp.Body)
+		return nil, apperror.NotFound("添付が見つかりません")
+	case resp.StatusCode/100 != 2:
+		drainAndClose(resp.Body)
+		return nil, apperror.Internal("添付の読み込みに失敗しました", fmt.Errorf("s3 get: unexpected status %d", resp.StatusCode))
+	}
+	return resp.Body, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	s.sign(req, nil)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return apperror.Internal("添付の削除に失敗しました", fmt.Errorf("s3 delete: %w", err))
+	}
+	defer drainAndClose(resp.Body)
+	// S3 は存在しないキーの削除にも 204 を返すが、互換実装の 404 も成功として扱う。
+	if resp.StatusCode/100 != 2 && resp.StatusCode != http.StatusNotFound {
+		return apperror.Internal("添付の削除に失敗しました", fmt.Errorf("s3 delete: unexpected status %d", resp.StatusCode))
+	}
+	return nil
+}
+
+func (s *S3Store) newRequest(ctx context.Context, method string, key string, body []byte) (*http.Request, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return nil, apperror.InvalidArgument("添付のキーが不正です")
+	}
+
+	u := *s.endpoint
+	if s.cfg.VirtualHostedStyle {
+		u.Host = s.cfg.Bucket + "." + u.Host
+		u.Path = "/" + key
+	} else {
+		u.Path = "/" + s.cfg.Bucket + "/" + key
+	}
+	u.RawPath = escapePath(u.Path)
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, apperror.Internal("ストレージへの要求の作成に失敗しました", fmt.Errorf("new s3 request: %w", err))
+	}
+	return req, nil
+}
+
+// sign は AWS Signature Version 4 で req に署名する（payload は SHA-256 を計算して署名に含める）。
+func (s *S3Store) sign(req *http.Request, payload []byte) {
+	signV4(req, payload, s.cfg.AccessKeyID, s.cfg.SecretAccessKey, s.cfg.Region, s.now().UTC())
+}
+
+// signV4 は req の Host と、その時点で設定済みのすべてのヘッダを署名対象にして Authorization を付ける。
+// NOTE: 署名後にヘッダを追加しないこと（署名対象から漏れ、サーバ側の検証と一致しなくなる）。
+func signV4(req *http.Request, payload []byte, accessKeyID string, secretAccessKey string, region string, now time.Time) {
+	const service = "s3"
+
+	payloadSum := sha256.Sum256(payload)
+	payloadHash := hex.EncodeToString(payloadSum[:])
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	requestSum := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestSum[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKeyID+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// escapePath は S3 の canonical URI の規則（RFC 3986 の非予約文字と "/" 以外をエンコード）でパスをエンコードする。
+func escapePath(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, 64*1024))
+	_ = body.Close()
+}
@@ -58,6 +58,33 @@ func (o *UnaryObserver) Interceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamInterceptor は server-streaming RPC の観測ログ出力とメトリクス収集を行う interceptor を返す。
+// NOTE: レイテンシはストリーム全体（最初の受信から最後の送信まで）の時間として記録する。
+func (o *UnaryObserver) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		startedAt := time.Now()
+		err := handler(srv, ss)
+		elapsed := time.Since(startedAt)
+
+		fullMethod := "unknown"
+		if info != nil && info.FullMethod != "" {
+			fullMethod = info.FullMethod
+		}
+		grpcCode := status.Code(err)
+		latencyMs := float64(elapsed.Microseconds()) / 1000.0
+
+		o.collector.RecordRPC(fullMethod, grpcCode, elapsed)
+		o.logAccess(ss.Context(), fullMethod, grpcCode.String(), latencyMs)
+
+		return err
+	}
+}
+
 // Collector は観測中のメトリクス Collector を返す。
 func (o *UnaryObserver) Collector() *Collector {
 	if o == nil {
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AttachmentRepository は Postgres 実装の attachments リポジトリ。
+type AttachmentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAttachmentRepository は AttachmentRepository を生成する。
+func NewAttachmentRepository(pool *pgxpool.Pool) *AttachmentRepository {
+	return &AttachmentRepository{pool: pool}
+}
+
+var _ repository.AttachmentRepository = (*AttachmentRepository)(nil)
+
+func (r *AttachmentRepository) CreateAttachment(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error) {
+	err := r.pool.QueryRow(
+		ctx,
+		`INSERT INTO attachments (owner_user_id, kind, content_type, size_bytes, sha256)
+		 VALUES ($1, $2, $3, $4, $5)
+		 RETURNING id::text, created_at`,
+		attachment.OwnerUserID,
+		string(attachment.Kind),
+		attachment.ContentType,
+		attachment.SizeBytes,
+		attachment.SHA256,
+	).Scan(&attachment.ID, &attachment.CreatedAt)
+	if err != nil {
+		return domain.Attachment{}, apperror.Internal("添付の保存に失敗しました", fmt.Errorf("insert attachment: %w", err))
+	}
+	return attachment, nil
+}
+
+func (r *AttachmentRepository) GetAttachment(ctx context.Context, attachmentID string) (domain.Attachment, error) {
+	var a domain.Attachment
+	var kind string
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT a.id::text, a.owner_user_id, a.kind, a.content_type, a.size_bytes, a.sha256, a.created_at,
+		        a.deleted_at IS NOT NULL,
+		        EXISTS (
+		          SELECT 1
+		          FROM question_attachments qa
+		          JOIN questions q ON q.id = qa.question_id
+		          WHERE qa.attachment_id = a.id`+quizServableFilter+`
+		        ) OR EXISTS (
+		          SELECT 1
+		          FROM passages p
+		          JOIN questions q ON q.passage_id = p.id
+		          WHERE p.attachment_id = a.id
+		            AND p.deleted_at IS NULL`+quizServableFilter+`
+		        )
+		 FROM attachments a
+		 WHERE a.id = $1::uuid`,
+		attachmentID,
+	).Scan(&a.ID, &a.OwnerUserID, &kind, &a.ContentType, &a.SizeBytes, &a.SHA256, &a.CreatedAt, &a.Deleted, &a.Public)
+	if err == pgx.ErrNoRows {
+		return domain.Attachment{}, apperror.NotFound("添付が見つかりません")
+	}
+	if err != nil {
+		return domain.Attachment{}, apperror.Internal("添付の取得に失敗しました", fmt.Errorf("select attachment: %w", err))
+	}
+	a.Kind = domain.AttachmentKind(kind)
+	return a, nil
+}
+
+func (r *AttachmentRepository) ListPurgeableAttachments(ctx context.Context, unusedBefore time.Time, deletedBefore time.Time, limit int32) ([]domain.Attachment, error) {
+	// 混同しやすい点: 論理削除済みの問題/史料からの参照は「使用中」に数えない（問題と一緒に片付けるため）。
+	// 論理削除された添付は deletedBefore まで残し、誤削除の問題を DB 上で戻せる猶予にする。
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT a.id::text, a.owner_user_id, a.kind, a.content_type, a.size_bytes, a.sha256, a.created_at, a.deleted_at IS NOT NULL
+		 FROM attachments a
+		 WHERE NOT EXISTS (
+		         SELECT 1
+		         FROM question_attachments qa
+		         JOIN questions q ON q.id = qa.question_id
+		         WHERE qa.attachment_id = a.id
+		           AND q.deleted_at IS NULL
+		       )
+		   AND NOT EXISTS (
+		         SELECT 1
+		         FROM passages p
+		         WHERE p.attachment_id = a.id
+		           AND p.deleted_at IS NULL
+		       )
+		   AND (
+		         (a.deleted_at IS NULL AND a.created_at < $1)
+		         OR a.deleted_at < $2
+		       )
+		 ORDER BY a.created_at ASC
+		 LIMIT $3`,
+		unusedBefore,
+		deletedBefore,
+		limit,
+	)
+	if err != nil {
+		return nil, apperror.Internal("削除対象の添付の取得に失敗しました", fmt.Errorf("select purgeable attachments: %w", err))
+	}
+	defer rows.Close()
+
+	var attachments []domain.Attachment
+	for rows.Next() {
+		var a domain.Attachment
+		var kind string
+		if err := rows.Scan(&a.ID, &a.OwnerUserID, &kind, &a.ContentType, &a.SizeBytes, &a.SHA256, &a.CreatedAt, &a.Deleted); err != nil {
+			return nil, apperror.Internal("削除対象の添付の読み取りに失敗しました", fmt.Errorf("scan purgeable attachments: %w", err))
+		}
+		a.Kind = domain.AttachmentKind(kind)
+		attachments = append(attachments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("削除対象の添付の取得に失敗しました", fmt.Errorf("purgeable attachment rows: %w", err))
+	}
+	return attachments, nil
+}
+
+func (r *AttachmentRepository) DeleteUnusedAttachment(ctx context.Context, attachmentID string) (bool, error) {
+	// NOTE: 論理削除済みの問題からの参照（question_attachments）は ON DELETE CASCADE で一緒に消える。
+	tag, err := r.pool.Exec(
+		ctx,
+		`DELETE FROM attachments a
+		 WHERE a.id = $1::uuid
+		   AND NOT EXISTS (
+		         SELECT 1
+		         FROM question_attachments qa
+		         JOIN questions q ON q.id = qa.question_id
+		         WHERE qa.attachment_id = a.id
+		           AND q.deleted_at IS NULL
+		       )
+		   AND NOT EXISTS (
+		         SELECT 1
+		         FROM passages p
+		         WHERE p.attachment_id = a.id
+		           AND p.deleted_at IS NULL
+		       )`,
+		attachmentID,
+	)
+	if err != nil {
+		return false, apperror.Internal("添付の削除に失敗しました", fmt.Errorf("delete attachment: %w", err))
+	}
+	return tag.RowsAffected() > 0, nil
+}
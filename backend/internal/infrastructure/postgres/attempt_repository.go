@@ -7,6 +7,7 @@ import (
 
 	"github.com/history-quiz/historyquiz/internal/domain"
 	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
 	"github.com/history-quiz/historyquiz/internal/repository"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -53,26 +54,90 @@ func (r *AttemptRepository) CreateAttempt(ctx context.Context, userID string, qu
 	return attemptID, nil
 }
 
-func (r *AttemptRepository) ListMyAttempts(ctx context.Context, userID string, limit int32) ([]domain.Attempt, error) {
+func (r *AttemptRepository) CreateTextAttempt(ctx context.Context, userID string, questionID string, answerText string, isCorrect bool) (string, error) {
+	if userID == "" {
+		return "", apperror.InvalidArgument("userId が空です", apperror.FieldViolation{Field: "user_id", Description: "必須です"})
+	}
+	if questionID == "" {
+		return "", apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if answerText == "" {
+		return "", apperror.InvalidArgument("answer_text が空です", apperror.FieldViolation{Field: "answer_text", Description: "必須です"})
+	}
+
+	var attemptID string
+	err := r.pool.QueryRow(
+		ctx,
+		`INSERT INTO attempts (user_id, question_id, answer_text, is_correct)
+		 VALUES ($1, $2::uuid, $3, $4)
+		 RETURNING id::text`,
+		userID,
+		questionID,
+		answerText,
+		isCorrect,
+	).Scan(&attemptID)
+	if err != nil {
+		return "", apperror.InvalidArgument("解答履歴の保存に失敗しました（入力が不正です）")
+	}
+	return attemptID, nil
+}
+
+func (r *AttemptRepository) CreateLocationAttempt(ctx context.Context, userID string, questionID string, answer geo.Point, distanceKm float64, isCorrect bool) (string, error) {
+	if userID == "" {
+		return "", apperror.InvalidArgument("userId が空です", apperror.FieldViolation{Field: "user_id", Description: "必須です"})
+	}
+	if questionID == "" {
+		return "", apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+
+	var attemptID string
+	err := r.pool.QueryRow(
+		ctx,
+		`INSERT INTO attempts (user_id, question_id, answer_latitude, answer_longitude, distance_km, is_correct)
+		 VALUES ($1, $2::uuid, $3, $4, $5, $6)
+		 RETURNING id::text`,
+		userID,
+		questionID,
+		answer.Lat,
+		answer.Lng,
+		distanceKm,
+		isCorrect,
+	).Scan(&attemptID)
+	if err != nil {
+		return "", apperror.InvalidArgument("解答履歴の保存に失敗しました（入力が不正です）")
+	}
+	return attemptID, nil
+}
+
+func (r *AttemptRepository) ListMyAttempts(ctx context.Context, userID string, after *domain.AttemptListCursor, limit int32) ([]domain.Attempt, error) {
 	if userID == "" {
 		return nil, apperror.Unauthenticated("認証が必要です")
 	}
 
+	// 混同しやすい点: answered_at は同時刻があり得るため、id を加えた (answered_at, id) の keyset で進める。
+	var afterAnsweredAt, afterID any
+	if after != nil {
+		afterAnsweredAt, afterID = after.AnsweredAt, after.AttemptID
+	}
 	rows, err := r.pool.Query(
 		ctx,
 		`SELECT
 		   a.id::text,
 		   a.question_id::text,
 		   q.prompt,
-		   a.selected_choice_id::text,
+		   COALESCE(a.selected_choice_id::text, ''),
+		   COALESCE(a.answer_text, ''),
 		   a.is_correct,
 		   a.answered_at
 		 FROM attempts a
 		 JOIN questions q ON q.id = a.question_id
 		 WHERE a.user_id = $1
-		 ORDER BY a.answered_at DESC
-		 LIMIT $2`,
+		   AND ($2::timestamptz IS NULL OR (a.answered_at, a.id) < ($2::timestamptz, $3::uuid))
+		 ORDER BY a.answered_at DESC, a.id DESC
+		 LIMIT $4`,
 		userID,
+		afterAnsweredAt,
+		afterID,
 		limit,
 	)
 	if err != nil {
@@ -84,7 +149,7 @@ func (r *AttemptRepository) ListMyAttempts(ctx context.Context, userID string, l
 	for rows.Next() {
 		var a domain.Attempt
 		var answeredAt time.Time
-		if err := rows.Scan(&a.ID, &a.QuestionID, &a.QuestionPrompt, &a.SelectedChoiceID, &a.IsCorrect, &answeredAt); err != nil {
+		if err := rows.Scan(&a.ID, &a.QuestionID, &a.QuestionPrompt, &a.SelectedChoiceID, &a.AnswerText, &a.IsCorrect, &answeredAt); err != nil {
 			return nil, apperror.Internal("解答履歴の読み取りに失敗しました", fmt.Errorf("scan attempts: %w", err))
 		}
 		a.AnsweredAt = answeredAt
@@ -0,0 +1,282 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DeckRepository は Postgres 実装の decks リポジトリ。
+type DeckRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDeckRepository は DeckRepository を生成する。
+func NewDeckRepository(pool *pgxpool.Pool) *DeckRepository {
+	return &DeckRepository{pool: pool}
+}
+
+var _ repository.DeckRepository = (*DeckRepository)(nil)
+
+// deckColumns は decks を domain.Deck に読むときの列（scanDeck と対応させる）。
+// PlayableCount は出題候補と同じ条件（quizServableFilter。公開終了の予約時刻を過ぎた問題は除く）で数える。
+const deckColumns = `d.id::text, d.owner_user_id, d.title, d.description, d.share_slug, d.created_at, d.updated_at,
+	(SELECT COUNT(*)
+	 FROM deck_questions dq
+	 JOIN questions q ON q.id = dq.question_id
+	 WHERE dq.deck_id = d.id` + quizServableFilter + `)`
+
+func scanDeck(row pgx.Row) (domain.Deck, error) {
+	var d domain.Deck
+	err := row.Scan(&d.ID, &d.OwnerUserID, &d.Title, &d.Description, &d.ShareSlug, &d.CreatedAt, &d.UpdatedAt, &d.PlayableCount)
+	return d, err
+}
+
+func (r *DeckRepository) CreateDeck(ctx context.Context, ownerUserID string, shareSlug string, draft domain.DeckDraft) (domain.Deck, error) {
+	var deckID string
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(
+			ctx,
+			`INSERT INTO decks (owner_user_id, title, description, share_slug)
+			 VALUES ($1, $2, $3, $4)
+			 RETURNING id::text`,
+			ownerUserID,
+			draft.Title,
+			draft.Description,
+			shareSlug,
+		).Scan(&deckID); err != nil {
+			return apperror.Internal("デッキの保存に失敗しました", fmt.Errorf("insert deck: %w", err))
+		}
+		return replaceDeckQuestions(ctx, tx, deckID, draft.QuestionIDs)
+	})
+	if err != nil {
+		return domain.Deck{}, err
+	}
+	return r.GetDeck(ctx, deckID)
+}
+
+func (r *DeckRepository) UpdateDeck(ctx context.Context, deckID string, draft domain.DeckDraft) (domain.Deck, error) {
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			`UPDATE decks
+			 SET title = $2, description = $3, updated_at = NOW()
+			 WHERE id = $1::uuid
+			   AND deleted_at IS NULL`,
+			deckID,
+			draft.Title,
+			draft.Description,
+		)
+		if err != nil {
+			return apperror.InvalidArgument("deck_id が不正です")
+		}
+		if tag.RowsAffected() == 0 {
+			return apperror.NotFound("デッキが見つかりません")
+		}
+		return replaceDeckQuestions(ctx, tx, deckID, draft.QuestionIDs)
+	})
+	if err != nil {
+		return domain.Deck{}, err
+	}
+	return r.GetDeck(ctx, deckID)
+}
+
+// replaceDeckQuestions はデッキの問題の並びを丸ごと置き換える。
+func replaceDeckQuestions(ctx context.Context, tx pgx.Tx, deckID string, questionIDs []string) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM deck_questions WHERE deck_id = $1::uuid`, deckID); err != nil {
+		return apperror.Internal("デッキの問題の更新に失敗しました", fmt.Errorf("delete deck questions: %w", err))
+	}
+	for i, questionID := range questionIDs {
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO deck_questions (deck_id, ordinal, question_id)
+			 VALUES ($1::uuid, $2, $3::uuid)`,
+			deckID,
+			int32(i),
+			questionID,
+		); err != nil {
+			return apperror.InvalidArgument("デッキの問題の保存に失敗しました（入力が不正です）")
+		}
+	}
+	return nil
+}
+
+func (r *DeckRepository) SoftDeleteDeck(ctx context.Context, deckID string) error {
+	tag, err := r.pool.Exec(
+		ctx,
+		`UPDATE decks
+		 SET deleted_at = NOW()
+		 WHERE id = $1::uuid
+		   AND deleted_at IS NULL`,
+		deckID,
+	)
+	if err != nil {
+		return apperror.InvalidArgument("deck_id が不正です")
+	}
+	if tag.RowsAffected() == 0 {
+		return apperror.NotFound("デッキが見つかりません")
+	}
+	return nil
+}
+
+func (r *DeckRepository) GetDeck(ctx context.Context, deckID string) (domain.Deck, error) {
+	d, err := scanDeck(r.pool.QueryRow(
+		ctx,
+		`SELECT `+deckColumns+`
+		 FROM decks d
+		 WHERE d.id = $1::uuid
+		   AND d.deleted_at IS NULL`,
+		deckID,
+	))
+	if err == pgx.ErrNoRows {
+		return domain.Deck{}, apperror.NotFound("デッキが見つかりません")
+	}
+	if err != nil {
+		return domain.Deck{}, apperror.InvalidArgument("deck_id が不正です")
+	}
+
+	questionIDs, err := r.listDeckQuestionIDs(ctx, d.ID)
+	if err != nil {
+		return domain.Deck{}, err
+	}
+	d.QuestionIDs = questionIDs
+	return d, nil
+}
+
+func (r *DeckRepository) GetDeckBySlug(ctx context.Context, shareSlug string) (domain.Deck, error) {
+	var deckID string
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT id::text
+		 FROM decks
+		 WHERE share_slug = $1
+		   AND deleted_at IS NULL`,
+		shareSlug,
+	).Scan(&deckID)
+	if err == pgx.ErrNoRows {
+		return domain.Deck{}, apperror.NotFound("デッキが見つかりません")
+	}
+	if err != nil {
+		return domain.Deck{}, apperror.Internal("デッキの取得に失敗しました", fmt.Errorf("select deck by slug: %w", err))
+	}
+	return r.GetDeck(ctx, deckID)
+}
+
+func (r *DeckRepository) ListMyDecks(ctx context.Context, ownerUserID string, limit int32) ([]domain.Deck, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT `+deckColumns+`
+		 FROM decks d
+		 WHERE d.owner_user_id = $1
+		   AND d.deleted_at IS NULL
+		 ORDER BY d.created_at DESC, d.id DESC
+		 LIMIT $2`,
+		ownerUserID,
+		limit,
+	)
+	if err != nil {
+		return nil, apperror.Internal("デッキ一覧の取得に失敗しました", fmt.Errorf("select decks: %w", err))
+	}
+	defer rows.Close()
+
+	var decks []domain.Deck
+	for rows.Next() {
+		d, err := scanDeck(rows)
+		if err != nil {
+			return nil, apperror.Internal("デッキ一覧の読み取りに失敗しました", fmt.Errorf("scan decks: %w", err))
+		}
+		decks = append(decks, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("デッキ一覧の取得に失敗しました", fmt.Errorf("deck rows: %w", err))
+	}
+
+	// 一覧では問題の並びは返さない（件数は PlayableCount で足りる）。
+	return decks, nil
+}
+
+func (r *DeckRepository) ListAddableQuestionIDs(ctx context.Context, userID string, questionIDs []string) ([]string, error) {
+	if len(questionIDs) == 0 {
+		return nil, nil
+	}
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT id::text
+		 FROM questions
+		 WHERE id::text = ANY($2::text[])
+		   AND deleted_at IS NULL
+		   AND (author_user_id = $1 OR (status = 'published' AND hidden_at IS NULL))`,
+		userID,
+		questionIDs,
+	)
+	if err != nil {
+		return nil, apperror.Internal("問題の確認に失敗しました", fmt.Errorf("select addable questions: %w", err))
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, apperror.Internal("問題の確認に失敗しました", fmt.Errorf("scan addable questions: %w", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("問題の確認に失敗しました", fmt.Errorf("addable question rows: %w", err))
+	}
+	return ids, nil
+}
+
+func (r *DeckRepository) GetDeckOwner(ctx context.Context, deckID string) (string, bool, error) {
+	var ownerUserID string
+	var deletedAt *time.Time
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT owner_user_id, deleted_at
+		 FROM decks
+		 WHERE id = $1::uuid`,
+		deckID,
+	).Scan(&ownerUserID, &deletedAt)
+	if err == pgx.ErrNoRows {
+		return "", false, apperror.NotFound("デッキが見つかりません")
+	}
+	if err != nil {
+		return "", false, apperror.InvalidArgument("deck_id が不正です")
+	}
+	return ownerUserID, deletedAt != nil, nil
+}
+
+func (r *DeckRepository) listDeckQuestionIDs(ctx context.Context, deckID string) ([]string, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT question_id::text
+		 FROM deck_questions
+		 WHERE deck_id = $1::uuid
+		 ORDER BY ordinal ASC`,
+		deckID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("デッキの問題の取得に失敗しました", fmt.Errorf("select deck questions: %w", err))
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, apperror.Internal("デッキの問題の読み取りに失敗しました", fmt.Errorf("scan deck questions: %w", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("デッキの問題の取得に失敗しました", fmt.Errorf("deck question rows: %w", err))
+	}
+	return ids, nil
+}
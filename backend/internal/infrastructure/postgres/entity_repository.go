@@ -0,0 +1,349 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EntityRepository は Postgres 実装の entities リポジトリ。
+type EntityRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewEntityRepository は EntityRepository を生成する。
+func NewEntityRepository(pool *pgxpool.Pool) *EntityRepository {
+	return &EntityRepository{pool: pool}
+}
+
+var _ repository.EntityRepository = (*EntityRepository)(nil)
+
+// entityColumns は entities を domain.Entity に読むときの列（scanEntity と対応させる）。
+const entityColumns = `e.id::text, e.key, e.kind, e.name, e.description, e.start_year, e.end_year, e.year_margin, e.created_at, e.updated_at`
+
+func scanEntity(row pgx.Row) (domain.Entity, error) {
+	var e domain.Entity
+	var kind string
+	err := row.Scan(&e.ID, &e.Key, &kind, &e.Name, &e.Description, &e.StartYear, &e.EndYear, &e.YearMargin, &e.CreatedAt, &e.UpdatedAt)
+	e.Kind = domain.EntityKind(kind)
+	return e, err
+}
+
+func (r *EntityRepository) CreateEntity(ctx context.Context, draft domain.EntityDraft) (domain.Entity, error) {
+	e, err := scanEntity(r.pool.QueryRow(
+		ctx,
+		`INSERT INTO entities AS e (key, kind, name, description, start_year, end_year, year_margin)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 RETURNING `+entityColumns,
+		draft.Key,
+		string(draft.Kind),
+		draft.Name,
+		draft.Description,
+		draft.StartYear,
+		draft.EndYear,
+		draft.YearMargin,
+	))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.Entity{}, apperror.AlreadyExists("同じ key のエンティティが既にあります")
+		}
+		return domain.Entity{}, apperror.InvalidArgument("エンティティの保存に失敗しました（入力が不正です）")
+	}
+	return e, nil
+}
+
+func (r *EntityRepository) UpdateEntity(ctx context.Context, entityID string, draft domain.EntityDraft) (domain.Entity, error) {
+	e, err := scanEntity(r.pool.QueryRow(
+		ctx,
+		`UPDATE entities AS e
+		 SET key = $2, name = $3, description = $4, start_year = $5, end_year = $6, year_margin = $7, updated_at = NOW()
+		 WHERE e.id = $1::uuid
+		 RETURNING `+entityColumns,
+		entityID,
+		draft.Key,
+		draft.Name,
+		draft.Description,
+		draft.StartYear,
+		draft.EndYear,
+		draft.YearMargin,
+	))
+	if err == pgx.ErrNoRows {
+		return domain.Entity{}, apperror.NotFound("エンティティが見つかりません")
+	}
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.Entity{}, apperror.AlreadyExists("同じ key のエンティティが既にあります")
+		}
+		return domain.Entity{}, apperror.InvalidArgument("エンティティの保存に失敗しました（入力が不正です）")
+	}
+	return e, nil
+}
+
+func (r *EntityRepository) DeleteEntity(ctx context.Context, entityID string) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM entities WHERE id = $1::uuid`, entityID)
+	if err != nil {
+		return apperror.InvalidArgument("entity_id が不正です")
+	}
+	if tag.RowsAffected() == 0 {
+		return apperror.NotFound("エンティティが見つかりません")
+	}
+	return nil
+}
+
+func (r *EntityRepository) GetEntity(ctx context.Context, entityID string) (domain.EntityDetail, error) {
+	var d domain.EntityDetail
+	var kind string
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT `+entityColumns+`,
+		   (SELECT COUNT(*)
+		    FROM question_entities qe
+		    JOIN questions q ON q.id = qe.question_id
+		    WHERE qe.entity_id = e.id
+		      AND q.deleted_at IS NULL
+		      AND q.status = 'published'
+		      AND q.hidden_at IS NULL
+		      AND (q.unpublish_at IS NULL OR q.unpublish_at > NOW()))
+		 FROM entities e
+		 WHERE e.id = $1::uuid`,
+		entityID,
+	).Scan(&d.ID, &d.Key, &kind, &d.Name, &d.Description, &d.StartYear, &d.EndYear, &d.YearMargin, &d.CreatedAt, &d.UpdatedAt, &d.PlayableQuestionCount)
+	if err == pgx.ErrNoRows {
+		return domain.EntityDetail{}, apperror.NotFound("エンティティが見つかりません")
+	}
+	if err != nil {
+		return domain.EntityDetail{}, apperror.Internal("エンティティの取得に失敗しました", fmt.Errorf("select entity: %w", err))
+	}
+	d.Kind = domain.EntityKind(kind)
+
+	// 主語として持つ関係（outgoing）と、目的語として持つ関係を合わせて、種類 → 相手の名前の順に返す。
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT rel.kind, TRUE, o.id::text, o.kind, o.name
+		 FROM entity_relations rel
+		 JOIN entities o ON o.id = rel.object_entity_id
+		 WHERE rel.subject_entity_id = $1::uuid
+		 UNION ALL
+		 SELECT rel.kind, FALSE, s.id::text, s.kind, s.name
+		 FROM entity_relations rel
+		 JOIN entities s ON s.id = rel.subject_entity_id
+		 WHERE rel.object_entity_id = $1::uuid
+		 ORDER BY 1, 2 DESC, 5, 3`,
+		entityID,
+	)
+	if err != nil {
+		return domain.EntityDetail{}, apperror.Internal("エンティティの関係の取得に失敗しました", fmt.Errorf("select entity relations: %w", err))
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var v domain.EntityRelationView
+		var relKind, otherKind string
+		if err := rows.Scan(&relKind, &v.Outgoing, &v.Other.ID, &otherKind, &v.Other.Name); err != nil {
+			return domain.EntityDetail{}, apperror.Internal("エンティティの関係の読み取りに失敗しました", fmt.Errorf("scan entity relations: %w", err))
+		}
+		v.Kind = domain.EntityRelationKind(relKind)
+		v.Other.Kind = domain.EntityKind(otherKind)
+		d.Relations = append(d.Relations, v)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.EntityDetail{}, apperror.Internal("エンティティの関係の取得に失敗しました", fmt.Errorf("entity relation rows: %w", err))
+	}
+	return d, nil
+}
+
+func (r *EntityRepository) ListEntities(ctx context.Context, query domain.EntityListQuery) ([]domain.Entity, error) {
+	afterName, afterID := "", ""
+	if query.After != nil {
+		afterName, afterID = query.After.Name, query.After.EntityID
+	}
+	// NOTE: 部分一致は LIKE のエスケープを避けるため strpos で行う（大文字小文字は区別しない）。
+	// ページング位置の ID は文字列で比べる（小文字の UUID の文字列順は uuid 型の順と同じ）。
+	return r.queryEntities(
+		ctx,
+		`SELECT `+entityColumns+`
+		 FROM entities e
+		 WHERE ($1 = '' OR e.kind = $1)
+		   AND ($2 = '' OR strpos(lower(e.name), lower($2)) > 0)
+		   AND ($4 = '' OR (e.name, e.id::text) > ($3, $4))
+		 ORDER BY e.name ASC, e.id ASC
+		 LIMIT $5`,
+		string(query.Kind),
+		query.NameQuery,
+		afterName,
+		afterID,
+		query.Limit,
+	)
+}
+
+func (r *EntityRepository) ListEntitiesByIDs(ctx context.Context, entityIDs []string) ([]domain.Entity, error) {
+	return r.queryEntities(ctx, `SELECT `+entityColumns+` FROM entities e WHERE e.id = ANY($1::uuid[])`, entityIDs)
+}
+
+func (r *EntityRepository) ListEntitiesByKeys(ctx context.Context, keys []string) ([]domain.Entity, error) {
+	return r.queryEntities(ctx, `SELECT `+entityColumns+` FROM entities e WHERE e.key = ANY($1::text[])`, keys)
+}
+
+func (r *EntityRepository) queryEntities(ctx context.Context, sql string, args ...any) ([]domain.Entity, error) {
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, apperror.Internal("エンティティの取得に失敗しました", fmt.Errorf("select entities: %w", err))
+	}
+	defer rows.Close()
+
+	var entities []domain.Entity
+	for rows.Next() {
+		e, err := scanEntity(rows)
+		if err != nil {
+			return nil, apperror.Internal("エンティティの読み取りに失敗しました", fmt.Errorf("scan entities: %w", err))
+		}
+		entities = append(entities, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("エンティティの取得に失敗しました", fmt.Errorf("entity rows: %w", err))
+	}
+	return entities, nil
+}
+
+func (r *EntityRepository) AddEntityRelation(ctx context.Context, relation domain.EntityRelation) error {
+	_, err := r.pool.Exec(
+		ctx,
+		`INSERT INTO entity_relations (subject_entity_id, kind, object_entity_id)
+		 VALUES ($1::uuid, $2, $3::uuid)`,
+		relation.SubjectID,
+		string(relation.Kind),
+		relation.ObjectID,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperror.AlreadyExists("同じ関係が既にあります")
+		}
+		return apperror.InvalidArgument("関係の保存に失敗しました（入力が不正です）")
+	}
+	return nil
+}
+
+func (r *EntityRepository) RemoveEntityRelation(ctx context.Context, relation domain.EntityRelation) error {
+	tag, err := r.pool.Exec(
+		ctx,
+		`DELETE FROM entity_relations
+		 WHERE subject_entity_id = $1::uuid
+		   AND kind = $2
+		   AND object_entity_id = $3::uuid`,
+		relation.SubjectID,
+		string(relation.Kind),
+		relation.ObjectID,
+	)
+	if err != nil {
+		return apperror.InvalidArgument("関係の指定が不正です")
+	}
+	if tag.RowsAffected() == 0 {
+		return apperror.NotFound("関係が見つかりません")
+	}
+	return nil
+}
+
+func (r *EntityRepository) ImportEntities(ctx context.Context, entities []domain.EntityDraft, relations []domain.EntityImportRelation) (domain.EntityImportResult, error) {
+	var result domain.EntityImportResult
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		for _, e := range entities {
+			// 混同しやすい点: kind は更新しない（呼び出し側で、既存のエンティティと kind が同じことを検証する）。
+			// xmax = 0 は、この文で挿入した行（更新した行ではない）であることを表す。
+			var inserted bool
+			if err := tx.QueryRow(
+				ctx,
+				`INSERT INTO entities (key, kind, name, description, start_year, end_year, year_margin)
+				 VALUES ($1, $2, $3, $4, $5, $6, $7)
+				 ON CONFLICT (key) DO UPDATE
+				 SET name = EXCLUDED.name,
+				     description = EXCLUDED.description,
+				     start_year = EXCLUDED.start_year,
+				     end_year = EXCLUDED.end_year,
+				     year_margin = EXCLUDED.year_margin,
+				     updated_at = NOW()
+				 RETURNING (xmax = 0)`,
+				e.Key,
+				string(e.Kind),
+				e.Name,
+				e.Description,
+				e.StartYear,
+				e.EndYear,
+				e.YearMargin,
+			).Scan(&inserted); err != nil {
+				return apperror.Internal("エンティティの取り込みに失敗しました", fmt.Errorf("upsert entity %q: %w", e.Key, err))
+			}
+			if inserted {
+				result.Created++
+			} else {
+				result.Updated++
+			}
+		}
+
+		for _, rel := range relations {
+			tag, err := tx.Exec(
+				ctx,
+				`INSERT INTO entity_relations (subject_entity_id, kind, object_entity_id)
+				 SELECT s.id, $2, o.id
+				 FROM entities s, entities o
+				 WHERE s.key = $1
+				   AND o.key = $3
+				 ON CONFLICT DO NOTHING`,
+				rel.SubjectKey,
+				string(rel.Kind),
+				rel.ObjectKey,
+			)
+			if err != nil {
+				return apperror.Internal("関係の取り込みに失敗しました", fmt.Errorf("insert relation %q %s %q: %w", rel.SubjectKey, rel.Kind, rel.ObjectKey, err))
+			}
+			result.RelationsAdded += int(tag.RowsAffected())
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.EntityImportResult{}, err
+	}
+	return result, nil
+}
+
+func (r *EntityRepository) SetQuestionEntities(ctx context.Context, questionID string, entityIDs []string) error {
+	return withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM question_entities WHERE question_id = $1::uuid`, questionID); err != nil {
+			return apperror.Internal("問題のエンティティの更新に失敗しました", fmt.Errorf("delete question entities: %w", err))
+		}
+		if len(entityIDs) == 0 {
+			return nil
+		}
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO question_entities (question_id, entity_id)
+			 SELECT $1::uuid, unnest($2::uuid[])`,
+			questionID,
+			entityIDs,
+		); err != nil {
+			return apperror.InvalidArgument("問題のエンティティの保存に失敗しました（入力が不正です）")
+		}
+		return nil
+	})
+}
+
+// ListQuestionEntities と GetQuestionAuthor は問題側のリポジトリと同じ問い合わせを使う。
+
+func (r *EntityRepository) ListQuestionEntities(ctx context.Context, questionID string) ([]domain.EntityRef, error) {
+	return (&QuestionRepository{pool: r.pool}).ListQuestionEntities(ctx, questionID)
+}
+
+func (r *EntityRepository) GetQuestionAuthor(ctx context.Context, questionID string) (string, bool, error) {
+	return (&QuestionRepository{pool: r.pool}).GetQuestionAuthor(ctx, questionID)
+}
+
+// isUniqueViolation は一意制約違反のエラーかを返す。
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
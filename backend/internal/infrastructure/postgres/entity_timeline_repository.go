@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+)
+
+var _ repository.TimelineRepository = (*EntityRepository)(nil)
+
+// timelineEventWhere は「どちらが先か」の出題に使える出来事の条件（年が分かる出来事）。
+const timelineEventWhere = `e.kind = 'event' AND e.start_year <> 0`
+
+// ListTimelineEvents は seed と ID のハッシュの順で候補を選ぶ。
+// NOTE: 年の順で先頭から読むと、出来事が limit 件を超えたときに新しい出来事が出題されなくなるため。
+func (r *EntityRepository) ListTimelineEvents(ctx context.Context, seed string, limit int32) ([]domain.TimelineEvent, error) {
+	return r.queryTimelineEvents(
+		ctx,
+		`SELECT e.id::text, e.name, e.start_year, e.year_margin
+		 FROM entities e
+		 WHERE `+timelineEventWhere+`
+		 ORDER BY md5($2 || e.id::text) ASC, e.id ASC
+		 LIMIT $1`,
+		limit,
+		seed,
+	)
+}
+
+func (r *EntityRepository) ListTimelineEventsByIDs(ctx context.Context, entityIDs []string) ([]domain.TimelineEvent, error) {
+	return r.queryTimelineEvents(
+		ctx,
+		`SELECT e.id::text, e.name, e.start_year, e.year_margin
+		 FROM entities e
+		 WHERE e.id = ANY($1::uuid[]) AND `+timelineEventWhere,
+		entityIDs,
+	)
+}
+
+func (r *EntityRepository) queryTimelineEvents(ctx context.Context, sql string, args ...any) ([]domain.TimelineEvent, error) {
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, apperror.Internal("出来事の取得に失敗しました", fmt.Errorf("select timeline events: %w", err))
+	}
+	defer rows.Close()
+
+	var events []domain.TimelineEvent
+	for rows.Next() {
+		var e domain.TimelineEvent
+		if err := rows.Scan(&e.EntityID, &e.Name, &e.Date.Year, &e.Date.Margin); err != nil {
+			return nil, apperror.Internal("出来事の読み取りに失敗しました", fmt.Errorf("scan timeline events: %w", err))
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("出来事の取得に失敗しました", fmt.Errorf("timeline event rows: %w", err))
+	}
+	return events, nil
+}
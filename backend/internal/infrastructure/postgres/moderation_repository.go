@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ModerationRepository は Postgres 実装の question_reports リポジトリ。
+type ModerationRepository struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.ModerationRepository = (*ModerationRepository)(nil)
+
+// NewModerationRepository は ModerationRepository を生成する。
+func NewModerationRepository(pool *pgxpool.Pool) *ModerationRepository {
+	return &ModerationRepository{pool: pool}
+}
+
+// uniqueViolation は Postgres の一意制約違反の SQLSTATE。
+const uniqueViolation = "23505"
+
+// reportColumns は QuestionReport の読み取りに使う列（scanReport と順序を揃える）。
+const reportColumns = `r.id::text, r.question_id::text, q.prompt, r.reporter_user_id, r.reason, COALESCE(r.detail, ''), r.status, r.created_at`
+
+// CreateReport は出題できる問題（quizServableFilter）への報告を保存する。
+// 混同しやすい点: 下書き/非表示/公開終了後の問題は NOT_FOUND にする。他人の非公開の問題の存在を漏らさず、
+// 報告による自動の非表示で公開前の問題が隠れたままになるのを防ぐため。
+func (r *ModerationRepository) CreateReport(ctx context.Context, reporterUserID string, questionID string, reason domain.ReportReason, detail string) (domain.QuestionReport, error) {
+	var reportID string
+	err := r.pool.QueryRow(
+		ctx,
+		`INSERT INTO question_reports (question_id, reporter_user_id, reason, detail)
+		 SELECT q.id, $2, $3, $4
+		 FROM questions q
+		 WHERE q.id = $1::uuid`+quizServableFilter+`
+		 RETURNING id::text`,
+		questionID,
+		reporterUserID,
+		string(reason),
+		nullIfEmpty(detail),
+	).Scan(&reportID)
+	if err == pgx.ErrNoRows {
+		return domain.QuestionReport{}, apperror.NotFound("問題が見つかりません")
+	}
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return domain.QuestionReport{}, apperror.FailedPrecondition("この問題はすでに報告済みです")
+		}
+		return domain.QuestionReport{}, apperror.InvalidArgument("報告の保存に失敗しました（入力が不正です）")
+	}
+	return r.GetReport(ctx, reportID)
+}
+
+func (r *ModerationRepository) CountOpenReports(ctx context.Context, questionID string) (int64, error) {
+	var count int64
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT COUNT(*)::bigint
+		 FROM question_reports
+		 WHERE question_id = $1::uuid
+		   AND status = 'open'`,
+		questionID,
+	).Scan(&count)
+	if err != nil {
+		return 0, apperror.Internal("報告件数の取得に失敗しました", fmt.Errorf("count open reports: %w", err))
+	}
+	return count, nil
+}
+
+func (r *ModerationRepository) ListOpenReports(ctx context.Context, limit int32) ([]domain.QuestionReport, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT `+reportColumns+`
+		 FROM question_reports r
+		 JOIN questions q ON q.id = r.question_id
+		 WHERE r.status = 'open'
+		 ORDER BY r.created_at ASC
+		 LIMIT $1`,
+		limit,
+	)
+	if err != nil {
+		return nil, apperror.Internal("報告一覧の取得に失敗しました", fmt.Errorf("select open reports: %w", err))
+	}
+	return collectReports(rows)
+}
+
+func (r *ModerationRepository) ListOpenReportsForQuestion(ctx context.Context, questionID string) ([]domain.QuestionReport, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT `+reportColumns+`
+		 FROM question_reports r
+		 JOIN questions q ON q.id = r.question_id
+		 WHERE r.question_id = $1::uuid
+		   AND r.status = 'open'
+		 ORDER BY r.created_at ASC`,
+		questionID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("報告一覧の取得に失敗しました", fmt.Errorf("select question reports: %w", err))
+	}
+	return collectReports(rows)
+}
+
+func (r *ModerationRepository) GetReport(ctx context.Context, reportID string) (domain.QuestionReport, error) {
+	row := r.pool.QueryRow(
+		ctx,
+		`SELECT `+reportColumns+`
+		 FROM question_reports r
+		 JOIN questions q ON q.id = r.question_id
+		 WHERE r.id = $1::uuid`,
+		reportID,
+	)
+	report, err := scanReport(row)
+	if err == pgx.ErrNoRows {
+		return domain.QuestionReport{}, apperror.NotFound("報告が見つかりません")
+	}
+	if err != nil {
+		return domain.QuestionReport{}, apperror.InvalidArgument("report_id が不正です")
+	}
+	return report, nil
+}
+
+func (r *ModerationRepository) ResolveOpenReports(ctx context.Context, questionID string, resolution domain.ResolutionAction, resolvedByUserID string, edit *domain.QuestionDraft) (int64, error) {
+	status := domain.ReportStatusResolved
+	if resolution == domain.ResolutionDismiss {
+		status = domain.ReportStatusDismissed
+	}
+
+	var resolved int64
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		// 混同しやすい点: 作者の編集と競合しないよう、問題の行ロックを取ってから書き換える。
+		var authorUserID string
+		var currentStatus string
+		err := tx.QueryRow(
+			ctx,
+			`SELECT author_user_id, status
+			 FROM questions
+			 WHERE id = $1::uuid
+			   AND deleted_at IS NULL
+			 FOR UPDATE`,
+			questionID,
+		).Scan(&authorUserID, &currentStatus)
+		if err == pgx.ErrNoRows {
+			return apperror.NotFound("問題が見つかりません")
+		}
+		if err != nil {
+			return apperror.Internal("問題の取得に失敗しました", fmt.Errorf("lock question: %w", err))
+		}
+
+		switch resolution {
+		case domain.ResolutionDismiss:
+			// 管理者が隠した問題は、後の報告を却下しても隠したままにする。
+			err = updateQuestionHidden(ctx, tx, questionID, `hidden_at = NULL, hidden_reason = NULL`, `AND hidden_reason = 'auto'`)
+		case domain.ResolutionHide:
+			err = updateQuestionHidden(ctx, tx, questionID, `hidden_at = COALESCE(hidden_at, NOW()), hidden_reason = 'admin'`, "")
+		case domain.ResolutionEdit:
+			if edit == nil {
+				return apperror.Internal("報告の解決に失敗しました", fmt.Errorf("edit resolution without draft"))
+			}
+			// 管理者の修正も作者の問題として保存する（所有者と公開/公開終了の予約は変えない）。
+			if _, err := rewriteQuestion(ctx, tx, authorUserID, questionID, domain.QuestionStatus(currentStatus), *edit, nil); err != nil {
+				return err
+			}
+			err = updateQuestionHidden(ctx, tx, questionID, `hidden_at = NULL, hidden_reason = NULL`, "")
+		}
+		if err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(
+			ctx,
+			`UPDATE question_reports
+			 SET status = $2,
+			     resolution = $3,
+			     resolved_by_user_id = $4,
+			     resolved_at = NOW()
+			 WHERE question_id = $1::uuid
+			   AND status = 'open'`,
+			questionID,
+			string(status),
+			string(resolution),
+			resolvedByUserID,
+		)
+		if err != nil {
+			return apperror.Internal("報告の解決に失敗しました", fmt.Errorf("resolve reports: %w", err))
+		}
+		resolved = tag.RowsAffected()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resolved, nil
+}
+
+func (r *ModerationRepository) HideQuestionByReports(ctx context.Context, questionID string) error {
+	// 混同しやすい点: 既に非表示の問題を再度隠しても hidden_at（最初に隠した時刻）と理由（管理者が隠した場合は admin）は変えない。
+	_, err := r.pool.Exec(
+		ctx,
+		`UPDATE questions
+		 SET hidden_at = COALESCE(hidden_at, NOW()),
+		     hidden_reason = COALESCE(hidden_reason, 'auto')
+		 WHERE id = $1::uuid`,
+		questionID,
+	)
+	if err != nil {
+		return apperror.Internal("問題の表示状態の更新に失敗しました", fmt.Errorf("update hidden_at: %w", err))
+	}
+	return nil
+}
+
+// updateQuestionHidden は問題の表示状態（hidden_at/hidden_reason）を set のとおりに更新する。cond は追加の WHERE 条件。
+func updateQuestionHidden(ctx context.Context, tx pgx.Tx, questionID string, set string, cond string) error {
+	_, err := tx.Exec(
+		ctx,
+		`UPDATE questions
+		 SET `+set+`
+		 WHERE id = $1::uuid `+cond,
+		questionID,
+	)
+	if err != nil {
+		return apperror.Internal("問題の表示状態の更新に失敗しました", fmt.Errorf("update hidden_at: %w", err))
+	}
+	return nil
+}
+
+// scanReport は reportColumns の順序で QuestionReport を読み取る。
+func scanReport(row pgx.Row) (domain.QuestionReport, error) {
+	var report domain.QuestionReport
+	var reason string
+	var status string
+	err := row.Scan(
+		&report.ID,
+		&report.QuestionID,
+		&report.QuestionPrompt,
+		&report.ReporterUserID,
+		&reason,
+		&report.Detail,
+		&status,
+		&report.CreatedAt,
+	)
+	report.Reason = domain.ReportReason(reason)
+	report.Status = domain.ReportStatus(status)
+	return report, err
+}
+
+func collectReports(rows pgx.Rows) ([]domain.QuestionReport, error) {
+	defer rows.Close()
+
+	var reports []domain.QuestionReport
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, apperror.Internal("報告の読み取りに失敗しました", fmt.Errorf("scan reports: %w", err))
+		}
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("報告一覧の取得に失敗しました", fmt.Errorf("report rows: %w", err))
+	}
+	return reports, nil
+}
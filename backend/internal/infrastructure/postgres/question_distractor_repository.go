@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func (r *QuestionRepository) ListDistractorOccurrences(ctx context.Context, query domain.DistractorQuery) ([]domain.DistractorOccurrence, error) {
+	tags := query.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	shingles := query.PromptShingles
+	if shingles == nil {
+		shingles = []string{}
+	}
+
+	// 混同しやすい点: 関連する問題を先に MaxQuestions 件に絞ってから、その選択肢と回答数を集計する
+	// （attempts は related の問題だけを attempts_question_answered_at_idx で引く）。
+	rows, err := r.pool.Query(
+		ctx,
+		`WITH a AS (
+		   SELECT $3::text[] AS prompt_shingles
+		 ),
+		 related AS (
+		   SELECT id, has_answer, shared_tags, similarity
+		   FROM (
+		     SELECT b.id,
+		            EXISTS (
+		              SELECT 1 FROM choices c
+		              WHERE c.question_id = b.id AND lower(btrim(c.label)) = lower($2)
+		            ) AS has_answer,
+		            (SELECT count(*) FROM question_tags t WHERE t.question_id = b.id AND t.tag = ANY($4::text[]))::int AS shared_tags,
+		            COALESCE(`+jaccardSQL+`, 0) AS similarity
+		     FROM a
+		     CROSS JOIN questions b
+		     WHERE b.deleted_at IS NULL
+		       AND (b.author_user_id = $1 OR (b.status = 'published' AND b.hidden_at IS NULL))
+		       AND ($5 = '' OR b.id <> NULLIF($5, '')::uuid)
+		       AND (
+		         b.id IN (SELECT c.question_id FROM choices c WHERE lower(btrim(c.label)) = lower($2))
+		         OR b.id IN (SELECT t.question_id FROM question_tags t WHERE t.tag = ANY($4::text[]))
+		         OR b.prompt_shingles && a.prompt_shingles
+		       )
+		   ) candidates
+		   WHERE has_answer OR shared_tags > 0 OR similarity >= $6
+		   ORDER BY has_answer DESC, shared_tags DESC, similarity DESC, id ASC
+		   LIMIT $7
+		 ),
+		 picks AS (
+		   SELECT at.question_id, at.selected_choice_id, count(*) AS picks
+		   FROM attempts at
+		   JOIN related rq ON rq.id = at.question_id
+		   WHERE at.selected_choice_id IS NOT NULL
+		   GROUP BY at.question_id, at.selected_choice_id
+		 ),
+		 totals AS (
+		   SELECT question_id, sum(picks)::bigint AS attempts
+		   FROM picks
+		   GROUP BY question_id
+		 )
+		 SELECT rq.id::text,
+		        c.label,
+		        COALESCE(ak.correct_choice_id = c.id, false),
+		        rq.has_answer,
+		        rq.shared_tags,
+		        rq.similarity,
+		        COALESCE(p.picks, 0),
+		        COALESCE(tt.attempts, 0)
+		 FROM related rq
+		 JOIN choices c ON c.question_id = rq.id
+		 LEFT JOIN answer_keys ak ON ak.question_id = rq.id
+		 LEFT JOIN picks p ON p.question_id = rq.id AND p.selected_choice_id = c.id
+		 LEFT JOIN totals tt ON tt.question_id = rq.id
+		 ORDER BY rq.id ASC, c.ordinal ASC`,
+		query.UserID,
+		query.AnswerLabel,
+		shingles,
+		tags,
+		query.ExcludeQuestionID,
+		query.MinPromptSimilarity,
+		query.MaxQuestions,
+	)
+	if err != nil {
+		return nil, apperror.Internal("誤答の候補の取得に失敗しました", fmt.Errorf("select distractor occurrences: %w", err))
+	}
+	defer rows.Close()
+
+	var occurrences []domain.DistractorOccurrence
+	for rows.Next() {
+		var o domain.DistractorOccurrence
+		if err := rows.Scan(&o.QuestionID, &o.Label, &o.IsCorrect, &o.HasAnswer, &o.SharedTags, &o.PromptSimilarity, &o.PickCount, &o.AttemptCount); err != nil {
+			return nil, apperror.Internal("誤答の候補の読み取りに失敗しました", fmt.Errorf("scan distractor occurrences: %w", err))
+		}
+		occurrences = append(occurrences, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("誤答の候補の取得に失敗しました", fmt.Errorf("distractor occurrence rows: %w", err))
+	}
+	return occurrences, nil
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func (r *QuestionRepository) ListQuizCandidateEntityQuestionIDs(ctx context.Context, entityID string, previousQuestionID string) ([]string, error) {
+	var exists bool
+	if err := r.pool.QueryRow(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM entities WHERE id = $1::uuid)`,
+		entityID,
+	).Scan(&exists); err != nil {
+		return nil, apperror.InvalidArgument("entity_id が不正です")
+	}
+	if !exists {
+		return nil, apperror.NotFound("エンティティが見つかりません")
+	}
+
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT q.id::text
+		 FROM question_entities qe
+		 JOIN questions q ON q.id = qe.question_id
+		 WHERE qe.entity_id = $1::uuid
+		   AND q.deleted_at IS NULL
+		   AND q.status = 'published'
+		   AND q.hidden_at IS NULL
+		   AND (q.unpublish_at IS NULL OR q.unpublish_at > NOW())
+		   AND ($2 = '' OR q.id::text <> $2)
+		 ORDER BY q.created_at DESC`,
+		entityID,
+		previousQuestionID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("出題候補の取得に失敗しました", fmt.Errorf("select entity candidates: %w", err))
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, apperror.Internal("出題候補の読み取りに失敗しました", fmt.Errorf("scan entity candidates: %w", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("出題候補の取得に失敗しました", fmt.Errorf("entity candidate rows: %w", err))
+	}
+	return ids, nil
+}
+
+func (r *QuestionRepository) ListQuestionEntities(ctx context.Context, questionID string) ([]domain.EntityRef, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT e.id::text, e.kind, e.name
+		 FROM question_entities qe
+		 JOIN entities e ON e.id = qe.entity_id
+		 WHERE qe.question_id = $1::uuid
+		 ORDER BY e.name ASC, e.id ASC`,
+		questionID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("問題のエンティティの取得に失敗しました", fmt.Errorf("select question entities: %w", err))
+	}
+	defer rows.Close()
+
+	var refs []domain.EntityRef
+	for rows.Next() {
+		var ref domain.EntityRef
+		var kind string
+		if err := rows.Scan(&ref.ID, &kind, &ref.Name); err != nil {
+			return nil, apperror.Internal("問題のエンティティの読み取りに失敗しました", fmt.Errorf("scan question entities: %w", err))
+		}
+		ref.Kind = domain.EntityKind(kind)
+		refs = append(refs, ref)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("問題のエンティティの取得に失敗しました", fmt.Errorf("question entity rows: %w", err))
+	}
+	return refs, nil
+}
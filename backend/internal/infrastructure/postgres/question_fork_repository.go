@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// ForkQuestion は公開中の問題を userID の下書きとして複製する。
+// 混同しやすい点: 添付（画像/地図）は元の作者のアップロードのため複製しない（翻訳も複製しない）。
+// NOTE: 元の問題を FOR SHARE でロックし、複製中に元の問題が更新/削除されて中身が混ざらないようにする。
+// 元の問題の選択肢/別表記/タグ/出典/地点も同じトランザクション（tx）で読む（pool から別の接続を取らない）。
+func (r *QuestionRepository) ForkQuestion(ctx context.Context, userID string, sourceQuestionID string) (domain.QuestionDetail, error) {
+	if userID == "" {
+		return domain.QuestionDetail{}, apperror.Unauthenticated("認証が必要です")
+	}
+
+	var detail domain.QuestionDetail
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		var draft domain.QuestionDraft
+		var kind string
+		// 地図でだけ答える問題は answer_keys を持たないため LEFT JOIN にする（正解の ordinal は 0 のまま）。
+		err := tx.QueryRow(
+			ctx,
+			`SELECT q.prompt, COALESCE(q.explanation, ''), COALESCE(c.ordinal, 0), q.kind
+			 FROM questions q
+			 LEFT JOIN answer_keys ak ON ak.question_id = q.id
+			 LEFT JOIN choices c ON c.id = ak.correct_choice_id
+			 WHERE q.id = $1::uuid
+			   AND q.status = 'published'
+			   AND q.hidden_at IS NULL
+			   AND q.deleted_at IS NULL
+			   AND (q.kind = 'location' OR ak.question_id IS NOT NULL)
+			 FOR SHARE OF q`,
+			sourceQuestionID,
+		).Scan(&draft.Prompt, &draft.Explanation, &draft.CorrectOrdinal, &kind)
+		if err == pgx.ErrNoRows {
+			// 下書きや非表示の問題の存在を漏らさないよう、公開中でない場合も NOT_FOUND にそろえる。
+			return apperror.NotFound("複製できる問題が見つかりません")
+		}
+		if err != nil {
+			return apperror.Internal("複製元の問題の取得に失敗しました", fmt.Errorf("select fork source: %w", err))
+		}
+		draft.Kind = domain.QuestionKind(kind)
+
+		choices, err := listChoices(ctx, tx, sourceQuestionID)
+		if err != nil {
+			return err
+		}
+		for _, c := range choices {
+			draft.Choices = append(draft.Choices, c.Label)
+		}
+		if draft.AcceptedAnswers, err = listAnswerAliases(ctx, tx, sourceQuestionID); err != nil {
+			return err
+		}
+		if draft.Tags, err = listTags(ctx, tx, sourceQuestionID); err != nil {
+			return err
+		}
+		if draft.Citations, err = listCitations(ctx, tx, sourceQuestionID); err != nil {
+			return err
+		}
+		if draft.Location, err = getQuestionLocation(ctx, tx, sourceQuestionID); err != nil {
+			return err
+		}
+
+		created, err := insertQuestion(ctx, tx, userID, sourceQuestionID, draft)
+		if err != nil {
+			return err
+		}
+		detail = created
+		return nil
+	})
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	return detail, nil
+}
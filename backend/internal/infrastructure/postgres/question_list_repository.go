@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func (r *QuestionRepository) ListMyQuestions(ctx context.Context, userID string, query domain.MyQuestionsQuery) ([]domain.QuestionSummary, error) {
+	sql, args := buildMyQuestionsQuery(userID, query)
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, apperror.Internal("作成済み問題の一覧取得に失敗しました", fmt.Errorf("select my questions: %w", err))
+	}
+	defer rows.Close()
+
+	questions := make([]domain.QuestionSummary, 0, query.Limit)
+	for rows.Next() {
+		var q domain.QuestionSummary
+		var status string
+		if err := rows.Scan(&q.ID, &q.Prompt, &q.CreatedAt, &q.UpdatedAt, &status, &q.AttemptCount, &q.CorrectAttempts); err != nil {
+			return nil, apperror.Internal("作成済み問題の読み取りに失敗しました", fmt.Errorf("scan my questions: %w", err))
+		}
+		q.Status = domain.QuestionStatus(status)
+		if q.AttemptCount > 0 {
+			q.Accuracy = float64(q.CorrectAttempts) / float64(q.AttemptCount)
+		}
+		questions = append(questions, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("作成済み問題の一覧取得に失敗しました", fmt.Errorf("my questions rows: %w", err))
+	}
+	return questions, nil
+}
+
+// myQuestionsArgs は組み立て中の SQL のバインド変数。
+type myQuestionsArgs []any
+
+// add は値をバインド変数に加え、その参照（$n）を返す。
+func (a *myQuestionsArgs) add(v any) string {
+	*a = append(*a, v)
+	return "$" + strconv.Itoa(len(*a))
+}
+
+// buildMyQuestionsQuery は自分の問題一覧の SQL を条件に応じて組み立てる。
+// 混同しやすい点: SQL に埋め込むのはこの関数内の固定の断片だけで、利用者の入力は必ずバインド変数で渡す。
+// 並び替えの式も Sort の値から固定の式を選ぶ（入力の文字列を式として使わない）。
+func buildMyQuestionsQuery(userID string, query domain.MyQuestionsQuery) (string, []any) {
+	var args myQuestionsArgs
+	var b strings.Builder
+	b.WriteString(`SELECT q.id::text, q.prompt, q.created_at, q.updated_at, q.status,
+		        COALESCE(c.attempt_count, 0), COALESCE(c.correct_count, 0)
+		 FROM questions q
+		 LEFT JOIN question_attempt_counts c ON c.question_id = q.id
+		 WHERE q.author_user_id = ` + args.add(userID) + `
+		   AND q.deleted_at IS NULL`)
+
+	if len(query.Statuses) > 0 {
+		statuses := make([]string, 0, len(query.Statuses))
+		for _, s := range query.Statuses {
+			statuses = append(statuses, string(s))
+		}
+		b.WriteString(`
+		   AND q.status = ANY(` + args.add(statuses) + `::text[])`)
+	}
+	if query.Tag != "" {
+		b.WriteString(`
+		   AND EXISTS (SELECT 1 FROM question_tags t WHERE t.question_id = q.id AND t.tag = ` + args.add(query.Tag) + `)`)
+	}
+	if !query.CreatedFrom.IsZero() {
+		b.WriteString(`
+		   AND q.created_at >= ` + args.add(query.CreatedFrom))
+	}
+	if !query.CreatedTo.IsZero() {
+		b.WriteString(`
+		   AND q.created_at < ` + args.add(query.CreatedTo))
+	}
+	if query.HasExplanation != nil {
+		if *query.HasExplanation {
+			b.WriteString(`
+		   AND btrim(COALESCE(q.explanation, '')) <> ''`)
+		} else {
+			b.WriteString(`
+		   AND btrim(COALESCE(q.explanation, '')) = ''`)
+		}
+	}
+	if len(query.KeywordTerms) > 0 {
+		bigrams := query.KeywordBigrams
+		if bigrams == nil {
+			bigrams = []string{}
+		}
+		// 検索（SearchQuestions）と同じく、bigram で候補を絞ってから strpos で部分一致を確定する。
+		terms := args.add(query.KeywordTerms)
+		b.WriteString(`
+		   AND EXISTS (
+		     SELECT 1 FROM question_search_documents d
+		     WHERE d.question_id = q.id
+		       AND d.bigrams @> ` + args.add(bigrams) + `::text[]
+		       AND NOT EXISTS (
+		         SELECT 1 FROM unnest(` + terms + `::text[]) AS term
+		         WHERE strpos(d.prompt_text, term) = 0
+		           AND strpos(d.choices_text, term) = 0
+		           AND strpos(d.explanation_text, term) = 0
+		       )
+		   )`)
+	}
+
+	var sortExpr string
+	var after any
+	switch query.Sort {
+	case domain.QuestionSortCreatedAt:
+		sortExpr = "q.created_at"
+		if query.After != nil {
+			after = query.After.At
+		}
+	case domain.QuestionSortAccuracy:
+		sortExpr = "COALESCE(c.correct_count::float8 / NULLIF(c.attempt_count, 0), " + args.add(domain.AccuracySortValue(0, 0, query.Ascending)) + "::float8)"
+		if query.After != nil {
+			after = query.After.Value
+		}
+	case domain.QuestionSortAttemptCount:
+		sortExpr = "COALESCE(c.attempt_count, 0)"
+		if query.After != nil {
+			after = query.After.Count
+		}
+	default:
+		sortExpr = "q.updated_at"
+		if query.After != nil {
+			after = query.After.At
+		}
+	}
+	direction, compare := "DESC", "<"
+	if query.Ascending {
+		direction, compare = "ASC", ">"
+	}
+
+	// 同じ値の行があっても位置が一意に決まるよう、id まで含めた keyset で進める。
+	if query.After != nil {
+		b.WriteString(`
+		   AND (` + sortExpr + `, q.id) ` + compare + ` (` + args.add(after) + `, ` + args.add(query.After.QuestionID) + `::uuid)`)
+	}
+	b.WriteString(`
+		 ORDER BY ` + sortExpr + ` ` + direction + `, q.id ` + direction + `
+		 LIMIT ` + args.add(query.Limit))
+
+	return b.String(), args
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/jackc/pgx/v5"
+)
+
+// replaceQuestionLocation は正解の地点を入れ替える（作成/更新で共通。nil の場合は地点を外す）。
+func replaceQuestionLocation(ctx context.Context, tx pgx.Tx, questionID string, location *domain.QuestionLocation) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM question_locations WHERE question_id = $1::uuid`, questionID); err != nil {
+		return apperror.Internal("正解の地点の更新に失敗しました", fmt.Errorf("delete question location: %w", err))
+	}
+	if location == nil {
+		return nil
+	}
+	if _, err := tx.Exec(
+		ctx,
+		`INSERT INTO question_locations (question_id, latitude, longitude, radius_km)
+		 VALUES ($1::uuid, $2, $3, $4)`,
+		questionID,
+		location.Point.Lat,
+		location.Point.Lng,
+		location.RadiusKm,
+	); err != nil {
+		return apperror.InvalidArgument("正解の地点の保存に失敗しました（入力が不正です）")
+	}
+	return nil
+}
+
+func (r *QuestionRepository) GetQuestionLocation(ctx context.Context, questionID string) (*domain.QuestionLocation, error) {
+	return getQuestionLocation(ctx, r.pool, questionID)
+}
+
+func getQuestionLocation(ctx context.Context, q querier, questionID string) (*domain.QuestionLocation, error) {
+	// 混同しやすい点: 「問題が無い」（NotFound）と「地点が無い」（nil）を区別するため、questions から LEFT JOIN する。
+	var lat, lng, radius *float64
+	err := q.QueryRow(
+		ctx,
+		`SELECT l.latitude, l.longitude, l.radius_km
+		 FROM questions q
+		 LEFT JOIN question_locations l ON l.question_id = q.id
+		 WHERE q.id = $1::uuid
+		   AND q.deleted_at IS NULL`,
+		questionID,
+	).Scan(&lat, &lng, &radius)
+	if err == pgx.ErrNoRows {
+		return nil, apperror.NotFound("問題が見つかりません")
+	}
+	if err != nil {
+		return nil, apperror.InvalidArgument("question_id が不正です")
+	}
+	if lat == nil || lng == nil || radius == nil {
+		return nil, nil
+	}
+	location := domain.QuestionLocation{RadiusKm: *radius}
+	location.Point.Lat, location.Point.Lng = *lat, *lng
+	return &location, nil
+}
+
+func (r *QuestionRepository) listQuestionLocationsByQuestionIDs(ctx context.Context, questionIDs []string) (map[string]*domain.QuestionLocation, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT question_id::text, latitude, longitude, radius_km
+		 FROM question_locations
+		 WHERE question_id = ANY($1::uuid[])`,
+		questionIDs,
+	)
+	if err != nil {
+		return nil, apperror.Internal("正解の地点の取得に失敗しました", fmt.Errorf("select question locations: %w", err))
+	}
+	defer rows.Close()
+
+	byQuestion := make(map[string]*domain.QuestionLocation, len(questionIDs))
+	for rows.Next() {
+		var questionID string
+		var l domain.QuestionLocation
+		if err := rows.Scan(&questionID, &l.Point.Lat, &l.Point.Lng, &l.RadiusKm); err != nil {
+			return nil, apperror.Internal("正解の地点の読み取りに失敗しました", fmt.Errorf("scan question locations: %w", err))
+		}
+		byQuestion[questionID] = &l
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("正解の地点の取得に失敗しました", fmt.Errorf("question location rows: %w", err))
+	}
+	return byQuestion, nil
+}
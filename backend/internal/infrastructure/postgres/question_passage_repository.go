@@ -0,0 +1,476 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ repository.PassageRepository = (*QuestionRepository)(nil)
+
+// errPassageVersionConflict は UpdatePassage のトランザクション内で版の不一致を検出したことを表す。
+var errPassageVersionConflict = errors.New("passage version conflict")
+
+// passageColumns は史料の列（添付は LEFT JOIN した attachments a から読む）。
+const passageColumns = `p.id::text, p.body, p.source_kind, p.source_title, p.source_author, p.source_locator, p.source_url,
+		        COALESCE(p.attachment_id::text, ''), p.attachment_alt_text, a.kind, a.content_type, a.size_bytes`
+
+// passageRow は passageColumns を読むための一時的な値（添付は無い場合に NULL になる）。
+type passageRow struct {
+	passage        domain.Passage
+	sourceKind     string
+	attachmentID   string
+	attachmentAlt  string
+	attachmentKind *string
+	contentType    *string
+	sizeBytes      *int64
+}
+
+func (p *passageRow) dest() []any {
+	return []any{
+		&p.passage.ID, &p.passage.Body, &p.sourceKind, &p.passage.Source.Title, &p.passage.Source.Author, &p.passage.Source.Locator, &p.passage.Source.URL,
+		&p.attachmentID, &p.attachmentAlt, &p.attachmentKind, &p.contentType, &p.sizeBytes,
+	}
+}
+
+func (p *passageRow) toDomain() domain.Passage {
+	passage := p.passage
+	passage.Source.Kind = domain.CitationKind(p.sourceKind)
+	if p.attachmentID != "" && p.attachmentKind != nil && p.contentType != nil && p.sizeBytes != nil {
+		passage.Attachment = &domain.QuestionAttachment{
+			AttachmentID: p.attachmentID,
+			Kind:         domain.AttachmentKind(*p.attachmentKind),
+			ContentType:  *p.contentType,
+			SizeBytes:    *p.sizeBytes,
+			AltText:      p.attachmentAlt,
+		}
+	}
+	return passage
+}
+
+func (r *QuestionRepository) CreatePassage(ctx context.Context, authorUserID string, draft domain.PassageDraft) (domain.PassageDetail, error) {
+	if authorUserID == "" {
+		return domain.PassageDetail{}, apperror.Unauthenticated("認証が必要です")
+	}
+
+	var passageID string
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockPassageAttachment(ctx, tx, authorUserID, draft.Attachment); err != nil {
+			return err
+		}
+		attachmentID, altText := passageAttachmentValues(draft.Attachment)
+		err := tx.QueryRow(
+			ctx,
+			`INSERT INTO passages (author_user_id, body, source_kind, source_title, source_author, source_locator, source_url, attachment_id, attachment_alt_text)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8::uuid, $9)
+			 RETURNING id::text`,
+			authorUserID,
+			draft.Body,
+			string(draft.Source.Kind),
+			draft.Source.Title,
+			draft.Source.Author,
+			draft.Source.Locator,
+			draft.Source.URL,
+			attachmentID,
+			altText,
+		).Scan(&passageID)
+		if err != nil {
+			return apperror.InvalidArgument("史料の作成に失敗しました（入力が不正です）")
+		}
+
+		for i, q := range draft.Questions {
+			created, err := insertQuestion(ctx, tx, authorUserID, "", q.Draft)
+			if err != nil {
+				return err
+			}
+			if err := setPassageOrdinal(ctx, tx, created.ID, passageID, i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.PassageDetail{}, err
+	}
+	return r.GetMyPassage(ctx, authorUserID, passageID)
+}
+
+func (r *QuestionRepository) UpdatePassage(ctx context.Context, userID string, passageID string, expectedVersion int64, draft domain.PassageDraft) (domain.PassageDetail, error) {
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		// 混同しやすい点: 子の問題の入れ替えと版の確認の間に別の更新が割り込まないよう、史料の行ロックを先に取る。
+		var currentVersion int64
+		err := tx.QueryRow(
+			ctx,
+			`SELECT version
+			 FROM passages
+			 WHERE id = $1::uuid
+			   AND author_user_id = $2
+			   AND deleted_at IS NULL
+			 FOR UPDATE`,
+			passageID,
+			userID,
+		).Scan(&currentVersion)
+		if err == pgx.ErrNoRows {
+			return apperror.NotFound("史料が見つかりません")
+		}
+		if err != nil {
+			return apperror.InvalidArgument("passage_id が不正です")
+		}
+		if expectedVersion != currentVersion {
+			return errPassageVersionConflict
+		}
+
+		if err := lockPassageAttachment(ctx, tx, userID, draft.Attachment); err != nil {
+			return err
+		}
+		attachmentID, altText := passageAttachmentValues(draft.Attachment)
+		if _, err := tx.Exec(
+			ctx,
+			`UPDATE passages
+			 SET body = $2,
+			     source_kind = $3,
+			     source_title = $4,
+			     source_author = $5,
+			     source_locator = $6,
+			     source_url = $7,
+			     attachment_id = $8::uuid,
+			     attachment_alt_text = $9,
+			     version = version + 1,
+			     updated_at = NOW()
+			 WHERE id = $1::uuid`,
+			passageID,
+			draft.Body,
+			string(draft.Source.Kind),
+			draft.Source.Title,
+			draft.Source.Author,
+			draft.Source.Locator,
+			draft.Source.URL,
+			attachmentID,
+			altText,
+		); err != nil {
+			return apperror.InvalidArgument("史料の更新に失敗しました（入力が不正です）")
+		}
+
+		current, err := lockPassageQuestions(ctx, tx, passageID)
+		if err != nil {
+			return err
+		}
+		for i, q := range draft.Questions {
+			questionID := q.QuestionID
+			if questionID == "" {
+				created, err := insertQuestion(ctx, tx, userID, "", q.Draft)
+				if err != nil {
+					return err
+				}
+				questionID = created.ID
+			} else {
+				status, ok := current[questionID]
+				if !ok {
+					return apperror.InvalidArgument("史料の問題の指定が不正です", apperror.FieldViolation{
+						Field:       "draft.questions[" + strconv.Itoa(i) + "].question_id",
+						Description: "この史料の問題を1回ずつ指定してください（新しい問題は空にします）",
+					})
+				}
+				delete(current, questionID)
+				if _, err := rewriteQuestion(ctx, tx, userID, questionID, status, q.Draft, nil); err != nil {
+					return err
+				}
+			}
+			if err := setPassageOrdinal(ctx, tx, questionID, passageID, i); err != nil {
+				return err
+			}
+		}
+
+		// 並びから外れた子の問題は論理削除する（回答の記録は残る）。
+		removed := make([]string, 0, len(current))
+		for questionID := range current {
+			removed = append(removed, questionID)
+		}
+		if len(removed) == 0 {
+			return nil
+		}
+		if _, err := tx.Exec(
+			ctx,
+			`UPDATE questions SET deleted_at = NOW() WHERE id = ANY($1::uuid[])`,
+			removed,
+		); err != nil {
+			return apperror.Internal("史料の問題の削除に失敗しました", fmt.Errorf("soft delete passage questions: %w", err))
+		}
+		return softDeleteOrphanedAttachments(ctx, tx, removed, "")
+	})
+	if errors.Is(err, errPassageVersionConflict) {
+		current, getErr := r.GetMyPassage(ctx, userID, passageID)
+		if getErr != nil {
+			return domain.PassageDetail{}, getErr
+		}
+		return domain.PassageDetail{}, apperror.Aborted("他の編集で史料が更新されています。最新の内容を確認してください", current)
+	}
+	if err != nil {
+		return domain.PassageDetail{}, err
+	}
+	return r.GetMyPassage(ctx, userID, passageID)
+}
+
+func (r *QuestionRepository) GetMyPassage(ctx context.Context, userID string, passageID string) (domain.PassageDetail, error) {
+	var row passageRow
+	var detail domain.PassageDetail
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT `+passageColumns+`, p.version, p.updated_at
+		 FROM passages p
+		 LEFT JOIN attachments a ON a.id = p.attachment_id
+		 WHERE p.id = $1::uuid
+		   AND p.author_user_id = $2
+		   AND p.deleted_at IS NULL`,
+		passageID,
+		userID,
+	).Scan(append(row.dest(), &detail.Version, &detail.UpdatedAt)...)
+	if err == pgx.ErrNoRows {
+		return domain.PassageDetail{}, apperror.NotFound("史料が見つかりません")
+	}
+	if err != nil {
+		return domain.PassageDetail{}, apperror.InvalidArgument("passage_id が不正です")
+	}
+	detail.Passage = row.toDomain()
+
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT id::text
+		 FROM questions
+		 WHERE passage_id = $1::uuid
+		   AND deleted_at IS NULL
+		 ORDER BY passage_ordinal ASC`,
+		passageID,
+	)
+	if err != nil {
+		return domain.PassageDetail{}, apperror.Internal("史料の問題の取得に失敗しました", fmt.Errorf("select passage questions: %w", err))
+	}
+	var questionIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return domain.PassageDetail{}, apperror.Internal("史料の問題の読み取りに失敗しました", fmt.Errorf("scan passage questions: %w", err))
+		}
+		questionIDs = append(questionIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return domain.PassageDetail{}, apperror.Internal("史料の問題の取得に失敗しました", fmt.Errorf("passage question rows: %w", err))
+	}
+
+	detail.Questions = make([]domain.QuestionDetail, 0, len(questionIDs))
+	for _, id := range questionIDs {
+		q, err := r.GetMyQuestion(ctx, userID, id)
+		if err != nil {
+			return domain.PassageDetail{}, err
+		}
+		detail.Questions = append(detail.Questions, q)
+	}
+	return detail, nil
+}
+
+func (r *QuestionRepository) SoftDeletePassage(ctx context.Context, userID string, passageID string) error {
+	return withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			`UPDATE passages
+			 SET deleted_at = NOW()
+			 WHERE id = $1::uuid
+			   AND author_user_id = $2
+			   AND deleted_at IS NULL`,
+			passageID,
+			userID,
+		)
+		if err != nil {
+			return apperror.InvalidArgument("passage_id が不正です")
+		}
+		if tag.RowsAffected() == 0 {
+			return apperror.NotFound("史料が見つかりません")
+		}
+
+		rows, err := tx.Query(
+			ctx,
+			`UPDATE questions
+			 SET deleted_at = NOW()
+			 WHERE passage_id = $1::uuid
+			   AND deleted_at IS NULL
+			 RETURNING id::text`,
+			passageID,
+		)
+		if err != nil {
+			return apperror.Internal("史料の問題の削除に失敗しました", fmt.Errorf("soft delete passage questions: %w", err))
+		}
+		var questionIDs []string
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return apperror.Internal("史料の問題の削除に失敗しました", fmt.Errorf("scan deleted passage questions: %w", err))
+			}
+			questionIDs = append(questionIDs, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return apperror.Internal("史料の問題の削除に失敗しました", fmt.Errorf("deleted passage question rows: %w", err))
+		}
+		return softDeleteOrphanedAttachments(ctx, tx, questionIDs, passageID)
+	})
+}
+
+func (r *QuestionRepository) ListPassageQuestionIDs(ctx context.Context, questionID string) ([]string, error) {
+	// 混同しやすい点: questionID 自身は出題できなくなっていても含める（呼び出し側がその次を探せるように）。
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT s.id::text
+		 FROM questions q
+		 JOIN questions s ON s.passage_id = q.passage_id
+		 WHERE q.id = $1::uuid
+		   AND s.deleted_at IS NULL
+		   AND (s.id = q.id OR (
+		         s.status = 'published'
+		         AND s.hidden_at IS NULL
+		         AND (s.unpublish_at IS NULL OR s.unpublish_at > NOW())
+		       ))
+		 ORDER BY s.passage_ordinal ASC`,
+		questionID,
+	)
+	if err != nil {
+		return nil, apperror.InvalidArgument("question_id が不正です")
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, apperror.Internal("史料の問題の読み取りに失敗しました", fmt.Errorf("scan passage question ids: %w", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("史料の問題の取得に失敗しました", fmt.Errorf("passage question id rows: %w", err))
+	}
+	return ids, nil
+}
+
+// getQuizPassage は出題する問題が共有する史料と、出題できる子の中での位置を返す（史料が無い場合は nil）。
+func (r *QuestionRepository) getQuizPassage(ctx context.Context, questionID string) (*domain.QuizPassage, error) {
+	var row passageRow
+	var position, total int32
+	err := r.pool.QueryRow(
+		ctx,
+		`WITH siblings AS (
+		   SELECT s.passage_ordinal
+		   FROM questions q
+		   JOIN questions s ON s.passage_id = q.passage_id
+		   WHERE q.id = $1::uuid
+		     AND s.deleted_at IS NULL
+		     AND (s.id = q.id OR (
+		           s.status = 'published'
+		           AND s.hidden_at IS NULL
+		           AND (s.unpublish_at IS NULL OR s.unpublish_at > NOW())
+		         ))
+		 )
+		 SELECT `+passageColumns+`,
+		        (SELECT COUNT(*) FROM siblings WHERE passage_ordinal <= q.passage_ordinal),
+		        (SELECT COUNT(*) FROM siblings)
+		 FROM questions q
+		 JOIN passages p ON p.id = q.passage_id AND p.deleted_at IS NULL
+		 LEFT JOIN attachments a ON a.id = p.attachment_id
+		 WHERE q.id = $1::uuid`,
+		questionID,
+	).Scan(append(row.dest(), &position, &total)...)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, apperror.Internal("史料の取得に失敗しました", fmt.Errorf("select quiz passage: %w", err))
+	}
+	return &domain.QuizPassage{Passage: row.toDomain(), Position: position, Total: total}, nil
+}
+
+// lockPassageAttachment は史料の添付が自分のアップロード済みの添付であることを確認し、削除されないよう共有ロックを取る。
+func lockPassageAttachment(ctx context.Context, tx pgx.Tx, authorUserID string, ref *domain.AttachmentRef) error {
+	if ref == nil {
+		return nil
+	}
+	var exists bool
+	err := tx.QueryRow(
+		ctx,
+		`SELECT TRUE
+		 FROM attachments
+		 WHERE id = $1::uuid
+		   AND owner_user_id = $2
+		   AND deleted_at IS NULL
+		 FOR SHARE`,
+		ref.AttachmentID,
+		authorUserID,
+	).Scan(&exists)
+	if err == pgx.ErrNoRows {
+		return apperror.InvalidArgument("添付が見つかりません", apperror.FieldViolation{Field: "draft.attachment.attachment_id", Description: "アップロード済みの自分の添付を指定してください"})
+	}
+	if err != nil {
+		return apperror.InvalidArgument("添付の指定が不正です", apperror.FieldViolation{Field: "draft.attachment.attachment_id", Description: "UUID 形式で指定してください"})
+	}
+	return nil
+}
+
+func passageAttachmentValues(ref *domain.AttachmentRef) (attachmentID *string, altText string) {
+	if ref == nil {
+		return nil, ""
+	}
+	return &ref.AttachmentID, ref.AltText
+}
+
+// lockPassageQuestions は史料の子の問題（論理削除を除く）を行ロックし、公開状態を返す。
+func lockPassageQuestions(ctx context.Context, tx pgx.Tx, passageID string) (map[string]domain.QuestionStatus, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT id::text, status
+		 FROM questions
+		 WHERE passage_id = $1::uuid
+		   AND deleted_at IS NULL
+		 FOR UPDATE`,
+		passageID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("史料の問題の取得に失敗しました", fmt.Errorf("lock passage questions: %w", err))
+	}
+	defer rows.Close()
+
+	statuses := make(map[string]domain.QuestionStatus)
+	for rows.Next() {
+		var id, status string
+		if err := rows.Scan(&id, &status); err != nil {
+			return nil, apperror.Internal("史料の問題の読み取りに失敗しました", fmt.Errorf("scan passage questions: %w", err))
+		}
+		statuses[id] = domain.QuestionStatus(status)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("史料の問題の取得に失敗しました", fmt.Errorf("passage question rows: %w", err))
+	}
+	return statuses, nil
+}
+
+func setPassageOrdinal(ctx context.Context, tx pgx.Tx, questionID string, passageID string, ordinal int) error {
+	if _, err := tx.Exec(
+		ctx,
+		`UPDATE questions
+		 SET passage_id = $2::uuid,
+		     passage_ordinal = $3
+		 WHERE id = $1::uuid`,
+		questionID,
+		passageID,
+		int32(ordinal),
+	); err != nil {
+		return apperror.Internal("史料の問題の並びの更新に失敗しました", fmt.Errorf("set passage ordinal: %w", err))
+	}
+	return nil
+}
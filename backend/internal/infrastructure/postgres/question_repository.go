@@ -2,11 +2,14 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/history-quiz/historyquiz/internal/domain"
 	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/similarity"
 	"github.com/history-quiz/historyquiz/internal/repository"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -36,6 +39,25 @@ func (r *QuestionRepository) ListQuizCandidateNonSystemQuestionIDs(ctx context.C
 	return r.listQuizCandidates(ctx, previousQuestionID, "non-system")
 }
 
+// quizPassageEntryFilter は史料を共有する問題のうち、出題できる子の先頭だけを候補に残し、previous と同じ史料の問題を除く。
+// 混同しやすい点: 史料の2問目以降は抽選せず、GetQuestion が previous の次の子を続けて出題する（ListPassageQuestionIDs）。
+const quizPassageEntryFilter = `
+			     AND (passage_id IS NULL OR NOT EXISTS (
+			           SELECT 1
+			           FROM questions s
+			           WHERE s.passage_id = questions.passage_id
+			             AND s.passage_ordinal < questions.passage_ordinal
+			             AND s.deleted_at IS NULL
+			             AND s.status = 'published'
+			             AND s.hidden_at IS NULL
+			             AND (s.unpublish_at IS NULL OR s.unpublish_at > NOW())
+			         ))
+			     AND ($1 = '' OR passage_id IS NULL OR passage_id <> ALL (
+			           SELECT p.passage_id FROM questions p WHERE p.id::text = $1 AND p.passage_id IS NOT NULL
+			         ))`
+
+// listQuizCandidates は出題できる問題（公開中、非表示でない、公開終了の予約の時刻を過ぎていない）を返す。
+// 混同しやすい点: 公開終了は定期処理で限定公開に変わるが、それまでの間も出題しないよう時刻でも除外する。
 func (r *QuestionRepository) listQuizCandidates(ctx context.Context, previousQuestionID string, mode string) ([]string, error) {
 	var sql string
 	switch mode {
@@ -43,21 +65,30 @@ func (r *QuestionRepository) listQuizCandidates(ctx context.Context, previousQue
 		sql = `SELECT id::text
 			   FROM questions
 			   WHERE deleted_at IS NULL
-			     AND ($1 = '' OR id::text <> $1)
+			     AND status = 'published'
+			     AND hidden_at IS NULL
+			     AND (unpublish_at IS NULL OR unpublish_at > NOW())
+			     AND ($1 = '' OR id::text <> $1)` + quizPassageEntryFilter + `
 			   ORDER BY created_at DESC`
 	case "system":
 		sql = `SELECT id::text
 			   FROM questions
 			   WHERE deleted_at IS NULL
+			     AND status = 'published'
+			     AND hidden_at IS NULL
+			     AND (unpublish_at IS NULL OR unpublish_at > NOW())
 			     AND author_user_id = 'system'
-			     AND ($1 = '' OR id::text <> $1)
+			     AND ($1 = '' OR id::text <> $1)` + quizPassageEntryFilter + `
 			   ORDER BY created_at DESC`
 	case "non-system":
 		sql = `SELECT id::text
 			   FROM questions
 			   WHERE deleted_at IS NULL
+			     AND status = 'published'
+			     AND hidden_at IS NULL
+			     AND (unpublish_at IS NULL OR unpublish_at > NOW())
 			     AND author_user_id <> 'system'
-			     AND ($1 = '' OR id::text <> $1)
+			     AND ($1 = '' OR id::text <> $1)` + quizPassageEntryFilter + `
 			   ORDER BY created_at DESC`
 	default:
 		return nil, apperror.Internal("出題候補の取得に失敗しました", fmt.Errorf("unknown mode: %s", mode))
@@ -83,16 +114,71 @@ func (r *QuestionRepository) listQuizCandidates(ctx context.Context, previousQue
 	return ids, nil
 }
 
+func (r *QuestionRepository) ListQuizCandidateDeckQuestionIDs(ctx context.Context, deckID string) ([]string, error) {
+	var exists bool
+	if err := r.pool.QueryRow(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM decks WHERE id = $1::uuid AND deleted_at IS NULL)`,
+		deckID,
+	).Scan(&exists); err != nil {
+		return nil, apperror.InvalidArgument("deck_id が不正です")
+	}
+	if !exists {
+		return nil, apperror.NotFound("デッキが見つかりません")
+	}
+
+	// 混同しやすい点: デッキに入っていても、後から非公開/非表示/論理削除/公開終了になった問題は出題しない。
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT q.id::text
+		 FROM deck_questions dq
+		 JOIN questions q ON q.id = dq.question_id
+		 WHERE dq.deck_id = $1::uuid
+		   AND q.deleted_at IS NULL
+		   AND q.status = 'published'
+		   AND q.hidden_at IS NULL
+		   AND (q.unpublish_at IS NULL OR q.unpublish_at > NOW())
+		 ORDER BY dq.ordinal ASC`,
+		deckID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("出題候補の取得に失敗しました", fmt.Errorf("select deck candidates: %w", err))
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, apperror.Internal("出題候補の読み取りに失敗しました", fmt.Errorf("scan deck candidates: %w", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("出題候補の取得に失敗しました", fmt.Errorf("deck candidate rows: %w", err))
+	}
+	return ids, nil
+}
+
+// quizServableFilter は出題できる問題（listQuizCandidates と同じ条件）に絞る WHERE 句の断片（questions の別名は q）。
+// 混同しやすい点: 出題/回答の判定は問題 ID を直接受け取るため、下書きや非表示の問題の正解を返さないよう候補と同じ条件で絞る。
+const quizServableFilter = `
+		   AND q.deleted_at IS NULL
+		   AND q.status = 'published'
+		   AND q.hidden_at IS NULL
+		   AND (q.unpublish_at IS NULL OR q.unpublish_at > NOW())`
+
 func (r *QuestionRepository) GetQuizQuestion(ctx context.Context, questionID string) (domain.Question, error) {
 	var q domain.Question
+	var kind string
 	err := r.pool.QueryRow(
 		ctx,
-		`SELECT id::text, prompt, COALESCE(explanation, '')
-		 FROM questions
-		 WHERE id = $1::uuid
-		   AND deleted_at IS NULL`,
+		`SELECT q.id::text, q.prompt, COALESCE(q.explanation, ''),
+		        EXISTS (SELECT 1 FROM question_locations l WHERE l.question_id = q.id), q.kind
+		 FROM questions q
+		 WHERE q.id = $1::uuid`+quizServableFilter,
 		questionID,
-	).Scan(&q.ID, &q.Prompt, &q.Explanation)
+	).Scan(&q.ID, &q.Prompt, &q.Explanation, &q.AcceptsLocation, &kind)
 	if err == pgx.ErrNoRows {
 		return domain.Question{}, apperror.NotFound("問題が見つかりません")
 	}
@@ -100,16 +186,51 @@ func (r *QuestionRepository) GetQuizQuestion(ctx context.Context, questionID str
 		// uuid パース失敗などが含まれるため INVALID_ARGUMENT として扱う。
 		return domain.Question{}, apperror.InvalidArgument("question_id が不正です")
 	}
+	q.Kind = domain.QuestionKind(kind)
 
-	choices, err := r.listChoices(ctx, questionID)
+	choices, err := listChoices(ctx, r.pool, questionID)
 	if err != nil {
 		return domain.Question{}, err
 	}
 	q.Choices = choices
+
+	attachments, err := r.listQuestionAttachments(ctx, questionID)
+	if err != nil {
+		return domain.Question{}, err
+	}
+	q.Attachments = attachments
+
+	passage, err := r.getQuizPassage(ctx, questionID)
+	if err != nil {
+		return domain.Question{}, err
+	}
+	q.Passage = passage
 	return q, nil
 }
 
 func (r *QuestionRepository) GetCorrectChoiceID(ctx context.Context, questionID string) (string, error) {
+	// 混同しやすい点: 地図でだけ答える問題は answer_keys を持たないため LEFT JOIN にし、空文字を返す。
+	var correctChoiceID string
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT COALESCE(ak.correct_choice_id::text, '')
+		 FROM questions q
+		 LEFT JOIN answer_keys ak ON ak.question_id = q.id
+		 WHERE q.id = $1::uuid
+		   AND (q.kind = 'location' OR ak.question_id IS NOT NULL)`+quizServableFilter,
+		questionID,
+	).Scan(&correctChoiceID)
+	if err == pgx.ErrNoRows {
+		return "", apperror.NotFound("正解情報が見つかりません")
+	}
+	if err != nil {
+		return "", apperror.InvalidArgument("question_id が不正です")
+	}
+	return correctChoiceID, nil
+}
+
+// getAnswerKey は公開状態に関わらず正解の choice_id を返す（作者向けの GetMyQuestion 用。地図でだけ答える問題では呼ばない）。
+func (r *QuestionRepository) getAnswerKey(ctx context.Context, questionID string) (string, error) {
 	var correctChoiceID string
 	err := r.pool.QueryRow(
 		ctx,
@@ -146,6 +267,36 @@ func (r *QuestionRepository) ChoiceBelongsToQuestion(ctx context.Context, questi
 	return ok, nil
 }
 
+func (r *QuestionRepository) ListAcceptedAnswers(ctx context.Context, questionID string) ([]string, error) {
+	var correctLabel *string
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT c.label
+		 FROM questions q
+		 LEFT JOIN answer_keys ak ON ak.question_id = q.id
+		 LEFT JOIN choices c ON c.id = ak.correct_choice_id
+		 WHERE q.id = $1::uuid
+		   AND (q.kind = 'location' OR ak.question_id IS NOT NULL)`+quizServableFilter,
+		questionID,
+	).Scan(&correctLabel)
+	if err == pgx.ErrNoRows {
+		return nil, apperror.NotFound("正解情報が見つかりません")
+	}
+	if err != nil {
+		return nil, apperror.InvalidArgument("question_id が不正です")
+	}
+	// 地図でだけ答える問題は記述式の正解を持たない。
+	if correctLabel == nil {
+		return []string{}, nil
+	}
+
+	aliases, err := listAnswerAliases(ctx, r.pool, questionID)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{*correctLabel}, aliases...), nil
+}
+
 func (r *QuestionRepository) CreateQuestion(ctx context.Context, authorUserID string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
 	if authorUserID == "" {
 		return domain.QuestionDetail{}, apperror.Unauthenticated("認証が必要です")
@@ -153,107 +304,270 @@ func (r *QuestionRepository) CreateQuestion(ctx context.Context, authorUserID st
 
 	var detail domain.QuestionDetail
 	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
-		var questionID string
-		var updatedAt time.Time
-		err := tx.QueryRow(
-			ctx,
-			`INSERT INTO questions (author_user_id, prompt, explanation)
-			 VALUES ($1, $2, $3)
-			 RETURNING id::text, updated_at`,
-			authorUserID,
-			draft.Prompt,
-			nullIfEmpty(draft.Explanation),
-		).Scan(&questionID, &updatedAt)
-		if err != nil {
-			return apperror.InvalidArgument("問題の作成に失敗しました（入力が不正です）")
-		}
-
-		choices, correctChoiceID, err := insertChoicesAndAnswerKey(ctx, tx, questionID, draft)
+		created, err := insertQuestion(ctx, tx, authorUserID, "", draft)
 		if err != nil {
 			return err
 		}
+		detail = created
+		return nil
+	})
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	return detail, nil
+}
+
+func (r *QuestionRepository) CreateQuestions(ctx context.Context, authorUserID string, drafts []domain.QuestionDraft) ([]domain.QuestionDetail, error) {
+	if authorUserID == "" {
+		return nil, apperror.Unauthenticated("認証が必要です")
+	}
 
-		detail = domain.QuestionDetail{
-			ID:             questionID,
-			Prompt:          draft.Prompt,
-			Choices:         choices,
-			CorrectChoiceID: correctChoiceID,
-			Explanation:     draft.Explanation,
-			UpdatedAt:       updatedAt,
+	details := make([]domain.QuestionDetail, 0, len(drafts))
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		for _, draft := range drafts {
+			created, err := insertQuestion(ctx, tx, authorUserID, "", draft)
+			if err != nil {
+				return err
+			}
+			details = append(details, created)
 		}
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// insertQuestion は1問分（questions/choices/answer_keys/別表記）をトランザクション内で作成する。originQuestionID は複製の場合の元の問題（通常の作成では空）。
+func insertQuestion(ctx context.Context, tx pgx.Tx, authorUserID string, originQuestionID string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
+	var questionID string
+	var updatedAt time.Time
+	var status string
+	var version int64
+	err := tx.QueryRow(
+		ctx,
+		`INSERT INTO questions (author_user_id, prompt, explanation, prompt_shingles, origin_question_id, kind)
+		 VALUES ($1, $2, $3, $4, $5::uuid, $6)
+		 RETURNING id::text, updated_at, status, version`,
+		authorUserID,
+		draft.Prompt,
+		nullIfEmpty(draft.Explanation),
+		promptShingles(draft.Prompt),
+		nullIfEmpty(originQuestionID),
+		string(questionKindOf(draft)),
+	).Scan(&questionID, &updatedAt, &status, &version)
+	if err != nil {
+		return domain.QuestionDetail{}, apperror.InvalidArgument("問題の作成に失敗しました（入力が不正です）")
+	}
+
+	choices, correctChoiceID, err := insertChoicesAndAnswerKey(ctx, tx, questionID, draft)
 	if err != nil {
 		return domain.QuestionDetail{}, err
 	}
-	return detail, nil
+	if err := replaceAnswerAliases(ctx, tx, questionID, draft.AcceptedAnswers); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if err := replaceTags(ctx, tx, questionID, draft.Tags); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	attachments, err := replaceQuestionAttachments(ctx, tx, authorUserID, questionID, draft.Attachments)
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if err := replaceCitations(ctx, tx, questionID, draft.Citations); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if err := replaceQuestionLocation(ctx, tx, questionID, draft.Location); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if err := upsertSearchDocument(ctx, tx, questionID, draft); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+
+	return domain.QuestionDetail{
+		ID:              questionID,
+		Prompt:          draft.Prompt,
+		Choices:         choices,
+		CorrectChoiceID: correctChoiceID,
+		Explanation:     draft.Explanation,
+		UpdatedAt:       updatedAt,
+		AcceptedAnswers: draft.AcceptedAnswers,
+		Status:          domain.QuestionStatus(status),
+		Tags:            draft.Tags,
+		Version:         version,
+		Attachments:     attachments,
+		Citations:       draft.Citations,
+		OriginQuestionID: originQuestionID,
+		Location:         draft.Location,
+		Kind:             questionKindOf(draft),
+	}, nil
 }
 
-func (r *QuestionRepository) UpdateQuestion(ctx context.Context, userID string, questionID string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
+// errQuestionVersionConflict は UpdateQuestion のトランザクション内で版の不一致を検出したことを表す。
+// NOTE: 最新の問題はロールバック後に読み直して apperror.Aborted に載せる。
+var errQuestionVersionConflict = errors.New("question version conflict")
+
+func (r *QuestionRepository) UpdateQuestion(ctx context.Context, userID string, questionID string, expectedVersion int64, draft domain.QuestionDraft, schedule *domain.QuestionSchedule) (domain.QuestionDetail, error) {
 	var detail domain.QuestionDetail
 	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
-		var updatedAt time.Time
+		// 混同しやすい点: 版の確認と更新の間に別の更新が割り込まないよう、FOR UPDATE で行ロックを取ってから比較する。
+		var currentVersion int64
+		var currentStatus string
 		err := tx.QueryRow(
 			ctx,
-			`UPDATE questions
-			 SET prompt = $1,
-			     explanation = $2
-			 WHERE id = $3::uuid
-			   AND author_user_id = $4
+			`SELECT version, status
+			 FROM questions
+			 WHERE id = $1::uuid
+			   AND author_user_id = $2
 			   AND deleted_at IS NULL
-			 RETURNING updated_at`,
-			draft.Prompt,
-			nullIfEmpty(draft.Explanation),
+			 FOR UPDATE`,
 			questionID,
 			userID,
-		).Scan(&updatedAt)
+		).Scan(&currentVersion, &currentStatus)
 		if err == pgx.ErrNoRows {
 			return apperror.NotFound("問題が見つかりません")
 		}
 		if err != nil {
 			return apperror.InvalidArgument("question_id が不正です")
 		}
-
-		if _, err := tx.Exec(ctx, `DELETE FROM choices WHERE question_id = $1::uuid`, questionID); err != nil {
-			return apperror.Internal("選択肢の更新に失敗しました", fmt.Errorf("delete choices: %w", err))
+		if expectedVersion != 0 && expectedVersion != currentVersion {
+			return errQuestionVersionConflict
 		}
-
-		choices, correctChoiceID, err := insertChoicesAndAnswerKey(ctx, tx, questionID, draft)
+		updated, err := rewriteQuestion(ctx, tx, userID, questionID, domain.QuestionStatus(currentStatus), draft, schedule)
 		if err != nil {
 			return err
 		}
-
-		detail = domain.QuestionDetail{
-			ID:             questionID,
-			Prompt:          draft.Prompt,
-			Choices:         choices,
-			CorrectChoiceID: correctChoiceID,
-			Explanation:     draft.Explanation,
-			UpdatedAt:       updatedAt,
-		}
+		detail = updated
 		return nil
 	})
+	if errors.Is(err, errQuestionVersionConflict) {
+		current, getErr := r.GetMyQuestion(ctx, userID, questionID)
+		if getErr != nil {
+			return domain.QuestionDetail{}, getErr
+		}
+		return domain.QuestionDetail{}, apperror.Aborted("他の編集で問題が更新されています。最新の内容を確認してください", current)
+	}
 	if err != nil {
 		return domain.QuestionDetail{}, err
 	}
 	return detail, nil
 }
 
+// rewriteQuestion は行ロック済みの問題の本文（選択肢/正解/別表記/タグ/添付/出典/地点）をトランザクション内で置き換え、版を 1 増やす。
+// 混同しやすい点: 版の確認と所有者の確認は呼び出し側（UpdateQuestion/UpdatePassage）で行う。
+func rewriteQuestion(ctx context.Context, tx pgx.Tx, userID string, questionID string, currentStatus domain.QuestionStatus, draft domain.QuestionDraft, schedule *domain.QuestionSchedule) (domain.QuestionDetail, error) {
+	if err := checkScheduleAllowed(currentStatus, schedule); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+
+	// schedule が nil の場合は予約を変えない（$5 = false）。
+	var newPublishAt, newUnpublishAt *time.Time
+	if schedule != nil {
+		newPublishAt = nullIfZeroTime(schedule.PublishAt)
+		newUnpublishAt = nullIfZeroTime(schedule.UnpublishAt)
+	}
+	var updatedAt time.Time
+	var status string
+	var version int64
+	var publishAt, unpublishAt *time.Time
+	var passageID string
+	err := tx.QueryRow(
+		ctx,
+		`UPDATE questions
+		 SET prompt = $1,
+		     explanation = $2,
+		     prompt_shingles = $4,
+		     kind = $8,
+		     version = version + 1,
+		     publish_at = CASE WHEN $5::boolean THEN $6::timestamptz ELSE publish_at END,
+		     unpublish_at = CASE WHEN $5::boolean THEN $7::timestamptz ELSE unpublish_at END
+		 WHERE id = $3::uuid
+		 RETURNING updated_at, status, version, publish_at, unpublish_at, COALESCE(passage_id::text, '')`,
+		draft.Prompt,
+		nullIfEmpty(draft.Explanation),
+		questionID,
+		promptShingles(draft.Prompt),
+		schedule != nil,
+		newPublishAt,
+		newUnpublishAt,
+		string(questionKindOf(draft)),
+	).Scan(&updatedAt, &status, &version, &publishAt, &unpublishAt, &passageID)
+	if err != nil {
+		return domain.QuestionDetail{}, apperror.Internal("問題の更新に失敗しました", fmt.Errorf("update question: %w", err))
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM choices WHERE question_id = $1::uuid`, questionID); err != nil {
+		return domain.QuestionDetail{}, apperror.Internal("選択肢の更新に失敗しました", fmt.Errorf("delete choices: %w", err))
+	}
+
+	choices, correctChoiceID, err := insertChoicesAndAnswerKey(ctx, tx, questionID, draft)
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if err := replaceAnswerAliases(ctx, tx, questionID, draft.AcceptedAnswers); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if err := replaceTags(ctx, tx, questionID, draft.Tags); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	attachments, err := replaceQuestionAttachments(ctx, tx, userID, questionID, draft.Attachments)
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if err := replaceCitations(ctx, tx, questionID, draft.Citations); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if err := replaceQuestionLocation(ctx, tx, questionID, draft.Location); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if err := upsertSearchDocument(ctx, tx, questionID, draft); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+
+	return domain.QuestionDetail{
+		ID:             questionID,
+		Prompt:          draft.Prompt,
+		Choices:         choices,
+		CorrectChoiceID: correctChoiceID,
+		Explanation:     draft.Explanation,
+		UpdatedAt:       updatedAt,
+		AcceptedAnswers: draft.AcceptedAnswers,
+		Status:          domain.QuestionStatus(status),
+		Tags:            draft.Tags,
+		Version:         version,
+		Attachments:     attachments,
+		Citations:       draft.Citations,
+		Schedule:        toQuestionSchedule(publishAt, unpublishAt),
+		Location:        draft.Location,
+		PassageID:       passageID,
+		Kind:            questionKindOf(draft),
+	}, nil
+}
+
 func (r *QuestionRepository) GetMyQuestion(ctx context.Context, userID string, questionID string) (domain.QuestionDetail, error) {
 	var prompt string
 	var explanation string
 	var updatedAt time.Time
+	var status string
+	var hidden bool
+	var version int64
+	var originQuestionID string
+	var publishAt, unpublishAt *time.Time
+	var passageID string
+	var kind string
 
 	err := r.pool.QueryRow(
 		ctx,
-		`SELECT prompt, COALESCE(explanation, ''), updated_at
+		`SELECT prompt, COALESCE(explanation, ''), updated_at, status, hidden_at IS NOT NULL, version, COALESCE(origin_question_id::text, ''),
+		        publish_at, unpublish_at, COALESCE(passage_id::text, ''), kind
 		 FROM questions
 		 WHERE id = $1::uuid
 		   AND author_user_id = $2
 		   AND deleted_at IS NULL`,
 		questionID,
 		userID,
-	).Scan(&prompt, &explanation, &updatedAt)
+	).Scan(&prompt, &explanation, &updatedAt, &status, &hidden, &version, &originQuestionID, &publishAt, &unpublishAt, &passageID, &kind)
 	if err == pgx.ErrNoRows {
 		return domain.QuestionDetail{}, apperror.NotFound("問題が見つかりません")
 	}
@@ -261,12 +575,41 @@ func (r *QuestionRepository) GetMyQuestion(ctx context.Context, userID string, q
 		return domain.QuestionDetail{}, apperror.InvalidArgument("question_id が不正です")
 	}
 
-	choices, err := r.listChoices(ctx, questionID)
+	choices, err := listChoices(ctx, r.pool, questionID)
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+
+	// 地図でだけ答える問題は正解の選択肢を持たない。
+	var correctChoiceID string
+	if domain.QuestionKind(kind) != domain.QuestionKindLocation {
+		correctChoiceID, err = r.getAnswerKey(ctx, questionID)
+		if err != nil {
+			return domain.QuestionDetail{}, err
+		}
+	}
+
+	aliases, err := listAnswerAliases(ctx, r.pool, questionID)
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+
+	tags, err := listTags(ctx, r.pool, questionID)
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+
+	attachments, err := r.listQuestionAttachments(ctx, questionID)
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+
+	citations, err := r.ListCitations(ctx, questionID)
 	if err != nil {
 		return domain.QuestionDetail{}, err
 	}
 
-	correctChoiceID, err := r.GetCorrectChoiceID(ctx, questionID)
+	location, err := r.GetQuestionLocation(ctx, questionID)
 	if err != nil {
 		return domain.QuestionDetail{}, err
 	}
@@ -278,41 +621,114 @@ func (r *QuestionRepository) GetMyQuestion(ctx context.Context, userID string, q
 		CorrectChoiceID: correctChoiceID,
 		Explanation:     explanation,
 		UpdatedAt:       updatedAt,
+		AcceptedAnswers: aliases,
+		Status:          domain.QuestionStatus(status),
+		Hidden:          hidden,
+		Tags:            tags,
+		Version:         version,
+		Attachments:     attachments,
+		Citations:       citations,
+		OriginQuestionID: originQuestionID,
+		Schedule:         toQuestionSchedule(publishAt, unpublishAt),
+		Location:         location,
+		PassageID:        passageID,
+		Kind:             domain.QuestionKind(kind),
 	}, nil
 }
 
-func (r *QuestionRepository) ListMyQuestions(ctx context.Context, userID string, limit int32) ([]domain.QuestionSummary, error) {
-	rows, err := r.pool.Query(
-		ctx,
-		`SELECT id::text, prompt, updated_at
-		 FROM questions
-		 WHERE author_user_id = $1
-		   AND deleted_at IS NULL
-		 ORDER BY updated_at DESC
-		 LIMIT $2`,
-		userID,
-		limit,
-	)
+func (r *QuestionRepository) UpdateQuestionStatus(ctx context.Context, userID string, questionID string, from domain.QuestionStatus, to domain.QuestionStatus) (domain.QuestionDetail, error) {
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		// 混同しやすい点: 状態確認と更新の間に別リクエストが割り込んでも壊れないよう、
+		// WHERE status = from で「期待した状態からの遷移」だけを許可する。
+		// 公開した時点で公開の予約は使い終わり、アーカイブは終端のため予約をすべて取り消す。
+		tag, err := tx.Exec(
+			ctx,
+			`UPDATE questions
+			 SET status = $1,
+			     published_at = CASE WHEN $1 = 'published' THEN COALESCE(published_at, NOW()) ELSE published_at END,
+			     publish_at = CASE WHEN $1 IN ('published', 'archived') THEN NULL ELSE publish_at END,
+			     unpublish_at = CASE WHEN $1 = 'archived' THEN NULL ELSE unpublish_at END
+			 WHERE id = $2::uuid
+			   AND author_user_id = $3
+			   AND deleted_at IS NULL
+			   AND status = $4`,
+			string(to),
+			questionID,
+			userID,
+			string(from),
+		)
+		if err != nil {
+			return apperror.Internal("公開状態の更新に失敗しました", fmt.Errorf("update question status: %w", err))
+		}
+		if tag.RowsAffected() == 0 {
+			return apperror.FailedPrecondition("公開状態が変更されています。最新の状態を確認してください")
+		}
+		return insertStatusChange(ctx, tx, domain.QuestionStatusChange{
+			QuestionID:  questionID,
+			ActorUserID: userID,
+			Reason:      domain.QuestionStatusChangeManual,
+			From:        from,
+			To:          to,
+		})
+	})
 	if err != nil {
-		return nil, apperror.Internal("作成済み問題の一覧取得に失敗しました", fmt.Errorf("select my questions: %w", err))
+		return domain.QuestionDetail{}, err
 	}
-	defer rows.Close()
+	return r.GetMyQuestion(ctx, userID, questionID)
+}
 
-	questions := make([]domain.QuestionSummary, 0, limit)
-	for rows.Next() {
-		var q domain.QuestionSummary
-		var updatedAt time.Time
-		if err := rows.Scan(&q.ID, &q.Prompt, &updatedAt); err != nil {
-			return nil, apperror.Internal("作成済み問題の読み取りに失敗しました", fmt.Errorf("scan my questions: %w", err))
+func (r *QuestionRepository) SoftDeleteQuestion(ctx context.Context, userID string, questionID string) error {
+	return withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			`UPDATE questions
+			 SET deleted_at = NOW()
+			 WHERE id = $1::uuid
+			   AND author_user_id = $2
+			   AND deleted_at IS NULL`,
+			questionID,
+			userID,
+		)
+		if err != nil {
+			return apperror.InvalidArgument("question_id が不正です")
+		}
+		if tag.RowsAffected() == 0 {
+			return apperror.NotFound("問題が見つかりません")
 		}
-		q.UpdatedAt = updatedAt
-		questions = append(questions, q)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, apperror.Internal("作成済み問題の一覧取得に失敗しました", fmt.Errorf("my questions rows: %w", err))
-	}
 
-	return questions, nil
+		return softDeleteOrphanedAttachments(ctx, tx, []string{questionID}, "")
+	})
+}
+
+// softDeleteOrphanedAttachments は論理削除した問題（と史料）が参照していた添付を削除扱いにする。
+// ただし、他の（削除されていない）問題や史料からも参照されている添付は残す。passageID は史料を削除しない場合は空。
+func softDeleteOrphanedAttachments(ctx context.Context, tx pgx.Tx, questionIDs []string, passageID string) error {
+	if _, err := tx.Exec(
+		ctx,
+		`UPDATE attachments a
+		 SET deleted_at = NOW()
+		 WHERE a.deleted_at IS NULL
+		   AND (a.id IN (SELECT attachment_id FROM question_attachments WHERE question_id = ANY($1::uuid[]))
+		        OR a.id IN (SELECT attachment_id FROM passages WHERE id::text = $2))
+		   AND NOT EXISTS (
+		         SELECT 1
+		         FROM question_attachments qa
+		         JOIN questions q ON q.id = qa.question_id
+		         WHERE qa.attachment_id = a.id
+		           AND q.deleted_at IS NULL
+		       )
+		   AND NOT EXISTS (
+		         SELECT 1
+		         FROM passages p
+		         WHERE p.attachment_id = a.id
+		           AND p.deleted_at IS NULL
+		       )`,
+		questionIDs,
+		passageID,
+	); err != nil {
+		return apperror.Internal("添付の削除に失敗しました", fmt.Errorf("soft delete attachments: %w", err))
+	}
+	return nil
 }
 
 func (r *QuestionRepository) GetQuestionAuthor(ctx context.Context, questionID string) (string, bool, error) {
@@ -334,8 +750,8 @@ func (r *QuestionRepository) GetQuestionAuthor(ctx context.Context, questionID s
 	return authorUserID, deletedAt != nil, nil
 }
 
-func (r *QuestionRepository) listChoices(ctx context.Context, questionID string) ([]domain.Choice, error) {
-	rows, err := r.pool.Query(
+func listChoices(ctx context.Context, q querier, questionID string) ([]domain.Choice, error) {
+	rows, err := q.Query(
 		ctx,
 		`SELECT id::text, label, ordinal
 		 FROM choices
@@ -362,9 +778,265 @@ func (r *QuestionRepository) listChoices(ctx context.Context, questionID string)
 	return choices, nil
 }
 
+func listAnswerAliases(ctx context.Context, q querier, questionID string) ([]string, error) {
+	rows, err := q.Query(
+		ctx,
+		`SELECT alias
+		 FROM question_answer_aliases
+		 WHERE question_id = $1::uuid
+		 ORDER BY ordinal ASC`,
+		questionID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("別表記の取得に失敗しました", fmt.Errorf("select answer aliases: %w", err))
+	}
+	defer rows.Close()
+
+	var aliases []string
+	for rows.Next() {
+		var alias string
+		if err := rows.Scan(&alias); err != nil {
+			return nil, apperror.Internal("別表記の読み取りに失敗しました", fmt.Errorf("scan answer aliases: %w", err))
+		}
+		aliases = append(aliases, alias)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("別表記の取得に失敗しました", fmt.Errorf("answer alias rows: %w", err))
+	}
+	return aliases, nil
+}
+
+// replaceAnswerAliases は記述式の別表記を入れ替える（作成/更新で共通）。
+func replaceAnswerAliases(ctx context.Context, tx pgx.Tx, questionID string, aliases []string) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM question_answer_aliases WHERE question_id = $1::uuid`, questionID); err != nil {
+		return apperror.Internal("別表記の更新に失敗しました", fmt.Errorf("delete answer aliases: %w", err))
+	}
+	for i, alias := range aliases {
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO question_answer_aliases (question_id, ordinal, alias)
+			 VALUES ($1::uuid, $2, $3)`,
+			questionID,
+			int32(i),
+			alias,
+		); err != nil {
+			return apperror.InvalidArgument("別表記の保存に失敗しました（入力が不正です）")
+		}
+	}
+	return nil
+}
+
+func listTags(ctx context.Context, q querier, questionID string) ([]string, error) {
+	rows, err := q.Query(
+		ctx,
+		`SELECT tag
+		 FROM question_tags
+		 WHERE question_id = $1::uuid
+		 ORDER BY ordinal ASC`,
+		questionID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("タグの取得に失敗しました", fmt.Errorf("select tags: %w", err))
+	}
+	defer rows.Close()
+
+	var tags []string
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, apperror.Internal("タグの読み取りに失敗しました", fmt.Errorf("scan tags: %w", err))
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("タグの取得に失敗しました", fmt.Errorf("tag rows: %w", err))
+	}
+	return tags, nil
+}
+
+// replaceTags はタグを入れ替える（作成/更新で共通）。
+func replaceTags(ctx context.Context, tx pgx.Tx, questionID string, tags []string) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM question_tags WHERE question_id = $1::uuid`, questionID); err != nil {
+		return apperror.Internal("タグの更新に失敗しました", fmt.Errorf("delete tags: %w", err))
+	}
+	for i, tag := range tags {
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO question_tags (question_id, ordinal, tag)
+			 VALUES ($1::uuid, $2, $3)`,
+			questionID,
+			int32(i),
+			tag,
+		); err != nil {
+			return apperror.InvalidArgument("タグの保存に失敗しました（入力が不正です）")
+		}
+	}
+	return nil
+}
+
+// replaceQuestionAttachments は問題の添付を入れ替え、表示順の添付を返す（作成/更新で共通）。
+// 混同しやすい点: 指定できるのは作者自身がアップロードした、削除されていない添付だけ。
+// 他人の添付 ID を知っていても自分の問題に流用できないよう、同じトランザクション内で所有者を確認する。
+func replaceQuestionAttachments(ctx context.Context, tx pgx.Tx, authorUserID string, questionID string, refs []domain.AttachmentRef) ([]domain.QuestionAttachment, error) {
+	if _, err := tx.Exec(ctx, `DELETE FROM question_attachments WHERE question_id = $1::uuid`, questionID); err != nil {
+		return nil, apperror.Internal("添付の更新に失敗しました", fmt.Errorf("delete question attachments: %w", err))
+	}
+
+	attachments := make([]domain.QuestionAttachment, 0, len(refs))
+	for i, ref := range refs {
+		a := domain.QuestionAttachment{AttachmentID: ref.AttachmentID, AltText: ref.AltText}
+		var kind string
+		err := tx.QueryRow(
+			ctx,
+			`SELECT kind, content_type, size_bytes
+			 FROM attachments
+			 WHERE id = $1::uuid
+			   AND owner_user_id = $2
+			   AND deleted_at IS NULL
+			 FOR SHARE`,
+			ref.AttachmentID,
+			authorUserID,
+		).Scan(&kind, &a.ContentType, &a.SizeBytes)
+		if err != nil {
+			field := "draft.attachments[" + strconv.Itoa(i) + "].attachment_id"
+			if err == pgx.ErrNoRows {
+				return nil, apperror.InvalidArgument("添付が見つかりません", apperror.FieldViolation{Field: field, Description: "アップロード済みの自分の添付を指定してください"})
+			}
+			return nil, apperror.InvalidArgument("添付の指定が不正です", apperror.FieldViolation{Field: field, Description: "UUID 形式で指定してください"})
+		}
+		a.Kind = domain.AttachmentKind(kind)
+
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO question_attachments (question_id, ordinal, attachment_id, alt_text)
+			 VALUES ($1::uuid, $2, $3::uuid, $4)`,
+			questionID,
+			int32(i),
+			ref.AttachmentID,
+			ref.AltText,
+		); err != nil {
+			return nil, apperror.InvalidArgument("添付の保存に失敗しました（入力が不正です）")
+		}
+		attachments = append(attachments, a)
+	}
+	return attachments, nil
+}
+
+func (r *QuestionRepository) listQuestionAttachments(ctx context.Context, questionID string) ([]domain.QuestionAttachment, error) {
+	byQuestion, err := r.listQuestionAttachmentsByQuestionIDs(ctx, []string{questionID})
+	if err != nil {
+		return nil, err
+	}
+	return byQuestion[questionID], nil
+}
+
+func (r *QuestionRepository) listQuestionAttachmentsByQuestionIDs(ctx context.Context, questionIDs []string) (map[string][]domain.QuestionAttachment, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT qa.question_id::text, a.id::text, a.kind, a.content_type, a.size_bytes, qa.alt_text
+		 FROM question_attachments qa
+		 JOIN attachments a ON a.id = qa.attachment_id
+		 WHERE qa.question_id = ANY($1::uuid[])
+		 ORDER BY qa.question_id, qa.ordinal ASC`,
+		questionIDs,
+	)
+	if err != nil {
+		return nil, apperror.Internal("添付の取得に失敗しました", fmt.Errorf("select question attachments: %w", err))
+	}
+	defer rows.Close()
+
+	byQuestion := make(map[string][]domain.QuestionAttachment, len(questionIDs))
+	for rows.Next() {
+		var questionID string
+		var a domain.QuestionAttachment
+		var kind string
+		if err := rows.Scan(&questionID, &a.AttachmentID, &kind, &a.ContentType, &a.SizeBytes, &a.AltText); err != nil {
+			return nil, apperror.Internal("添付の読み取りに失敗しました", fmt.Errorf("scan question attachments: %w", err))
+		}
+		a.Kind = domain.AttachmentKind(kind)
+		byQuestion[questionID] = append(byQuestion[questionID], a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("添付の取得に失敗しました", fmt.Errorf("question attachment rows: %w", err))
+	}
+	return byQuestion, nil
+}
+
+// replaceCitations は出典を入れ替える（作成/更新で共通）。
+func replaceCitations(ctx context.Context, tx pgx.Tx, questionID string, citations []domain.Citation) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM question_citations WHERE question_id = $1::uuid`, questionID); err != nil {
+		return apperror.Internal("出典の更新に失敗しました", fmt.Errorf("delete citations: %w", err))
+	}
+	for i, c := range citations {
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO question_citations (question_id, ordinal, kind, title, author, locator, url)
+			 VALUES ($1::uuid, $2, $3, $4, $5, $6, $7)`,
+			questionID,
+			int32(i),
+			string(c.Kind),
+			c.Title,
+			c.Author,
+			c.Locator,
+			c.URL,
+		); err != nil {
+			return apperror.InvalidArgument("出典の保存に失敗しました（入力が不正です）")
+		}
+	}
+	return nil
+}
+
+func (r *QuestionRepository) ListCitations(ctx context.Context, questionID string) ([]domain.Citation, error) {
+	return listCitations(ctx, r.pool, questionID)
+}
+
+func listCitations(ctx context.Context, q querier, questionID string) ([]domain.Citation, error) {
+	byQuestion, err := listCitationsByQuestionIDs(ctx, q, []string{questionID})
+	if err != nil {
+		return nil, err
+	}
+	return byQuestion[questionID], nil
+}
+
+func listCitationsByQuestionIDs(ctx context.Context, q querier, questionIDs []string) (map[string][]domain.Citation, error) {
+	rows, err := q.Query(
+		ctx,
+		`SELECT question_id::text, kind, title, author, locator, url
+		 FROM question_citations
+		 WHERE question_id = ANY($1::uuid[])
+		 ORDER BY question_id, ordinal ASC`,
+		questionIDs,
+	)
+	if err != nil {
+		return nil, apperror.Internal("出典の取得に失敗しました", fmt.Errorf("select citations: %w", err))
+	}
+	defer rows.Close()
+
+	byQuestion := make(map[string][]domain.Citation, len(questionIDs))
+	for rows.Next() {
+		var questionID string
+		var c domain.Citation
+		var kind string
+		if err := rows.Scan(&questionID, &kind, &c.Title, &c.Author, &c.Locator, &c.URL); err != nil {
+			return nil, apperror.Internal("出典の読み取りに失敗しました", fmt.Errorf("scan citations: %w", err))
+		}
+		c.Kind = domain.CitationKind(kind)
+		byQuestion[questionID] = append(byQuestion[questionID], c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("出典の取得に失敗しました", fmt.Errorf("citation rows: %w", err))
+	}
+	return byQuestion, nil
+}
+
 // insertChoicesAndAnswerKey は choices を 4件挿入し、answer_keys を設定する。
 // NOTE: 正解の choice_id は挿入後に確定するため、ordinal をキーにして対応付ける。
+// 地図でだけ答える問題は選択肢も正解の選択肢も持たないため何もしない。
 func insertChoicesAndAnswerKey(ctx context.Context, tx pgx.Tx, questionID string, draft domain.QuestionDraft) ([]domain.Choice, string, error) {
+	if draft.Kind == domain.QuestionKindLocation {
+		return []domain.Choice{}, "", nil
+	}
+
 	choices := make([]domain.Choice, 0, len(draft.Choices))
 	correctChoiceID := ""
 
@@ -412,6 +1084,23 @@ func insertChoicesAndAnswerKey(ctx context.Context, tx pgx.Tx, questionID string
 	return choices, correctChoiceID, nil
 }
 
+// questionKindOf は questions.kind に保存する種類を返す（空は4択）。
+func questionKindOf(draft domain.QuestionDraft) domain.QuestionKind {
+	if draft.Kind == "" {
+		return domain.QuestionKindChoice
+	}
+	return draft.Kind
+}
+
+// promptShingles は prompt_shingles 列に保存する値を返す（NOT NULL のため空でも空配列にする）。
+func promptShingles(prompt string) []string {
+	shingles := similarity.Shingles(prompt)
+	if shingles == nil {
+		return []string{}
+	}
+	return shingles
+}
+
 // nullIfEmpty は空文字を NULL に変換する（DB の列を nullable として扱うため）。
 func nullIfEmpty(s string) any {
 	if s == "" {
@@ -419,3 +1108,275 @@ func nullIfEmpty(s string) any {
 	}
 	return s
 }
+
+func (r *QuestionRepository) ListMyQuestionDetails(ctx context.Context, userID string, afterQuestionID string, limit int32) ([]domain.QuestionDetail, error) {
+	// 混同しやすい点: updated_at 順だと書き出し中の編集で行が前後に移動して重複/欠落するため、
+	// 変わらない (created_at, id) の keyset で進める。
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT q.id::text, q.prompt, COALESCE(q.explanation, ''), q.updated_at, q.status, q.hidden_at IS NOT NULL, q.version, COALESCE(ak.correct_choice_id::text, ''), q.kind
+		 FROM questions q
+		 LEFT JOIN answer_keys ak ON ak.question_id = q.id
+		 WHERE q.author_user_id = $1
+		   AND q.deleted_at IS NULL
+		   AND ($2 = '' OR (q.created_at, q.id) > (SELECT created_at, id FROM questions WHERE id = NULLIF($2, '')::uuid))
+		 ORDER BY q.created_at ASC, q.id ASC
+		 LIMIT $3`,
+		userID,
+		afterQuestionID,
+		limit,
+	)
+	if err != nil {
+		return nil, apperror.Internal("作成済み問題の取得に失敗しました", fmt.Errorf("select my question details: %w", err))
+	}
+	defer rows.Close()
+
+	details := make([]domain.QuestionDetail, 0, limit)
+	ids := make([]string, 0, limit)
+	for rows.Next() {
+		var q domain.QuestionDetail
+		var status, kind string
+		if err := rows.Scan(&q.ID, &q.Prompt, &q.Explanation, &q.UpdatedAt, &status, &q.Hidden, &q.Version, &q.CorrectChoiceID, &kind); err != nil {
+			return nil, apperror.Internal("作成済み問題の読み取りに失敗しました", fmt.Errorf("scan my question details: %w", err))
+		}
+		q.Status = domain.QuestionStatus(status)
+		q.Kind = domain.QuestionKind(kind)
+		details = append(details, q)
+		ids = append(ids, q.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("作成済み問題の取得に失敗しました", fmt.Errorf("my question detail rows: %w", err))
+	}
+	if len(details) == 0 {
+		return details, nil
+	}
+
+	// 子テーブルはページ単位でまとめて取得する（1問ずつ引くと N+1 になる）。
+	choices, err := r.listChoicesByQuestionIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := r.listOrderedTextsByQuestionIDs(ctx, "question_answer_aliases", "alias", ids)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := r.listOrderedTextsByQuestionIDs(ctx, "question_tags", "tag", ids)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := r.listQuestionAttachmentsByQuestionIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	citations, err := listCitationsByQuestionIDs(ctx, r.pool, ids)
+	if err != nil {
+		return nil, err
+	}
+	locations, err := r.listQuestionLocationsByQuestionIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range details {
+		id := details[i].ID
+		details[i].Choices = choices[id]
+		details[i].AcceptedAnswers = aliases[id]
+		details[i].Tags = tags[id]
+		details[i].Attachments = attachments[id]
+		details[i].Citations = citations[id]
+		details[i].Location = locations[id]
+	}
+	return details, nil
+}
+
+func (r *QuestionRepository) listChoicesByQuestionIDs(ctx context.Context, questionIDs []string) (map[string][]domain.Choice, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT question_id::text, id::text, label, ordinal
+		 FROM choices
+		 WHERE question_id = ANY($1::uuid[])
+		 ORDER BY question_id, ordinal ASC`,
+		questionIDs,
+	)
+	if err != nil {
+		return nil, apperror.Internal("選択肢の取得に失敗しました", fmt.Errorf("select choices by question ids: %w", err))
+	}
+	defer rows.Close()
+
+	byQuestion := make(map[string][]domain.Choice, len(questionIDs))
+	for rows.Next() {
+		var questionID string
+		var c domain.Choice
+		if err := rows.Scan(&questionID, &c.ID, &c.Label, &c.Ordinal); err != nil {
+			return nil, apperror.Internal("選択肢の読み取りに失敗しました", fmt.Errorf("scan choices by question ids: %w", err))
+		}
+		byQuestion[questionID] = append(byQuestion[questionID], c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("選択肢の取得に失敗しました", fmt.Errorf("choice by question ids rows: %w", err))
+	}
+	return byQuestion, nil
+}
+
+// listOrderedTextsByQuestionIDs は (question_id, ordinal, <column>) 形式の子テーブルを問題ごとにまとめて返す。
+// NOTE: table/column は呼び出し側の定数のみを渡す（ユーザー入力を埋め込まない）。
+func (r *QuestionRepository) listOrderedTextsByQuestionIDs(ctx context.Context, table string, column string, questionIDs []string) (map[string][]string, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT question_id::text, `+column+`
+		 FROM `+table+`
+		 WHERE question_id = ANY($1::uuid[])
+		 ORDER BY question_id, ordinal ASC`,
+		questionIDs,
+	)
+	if err != nil {
+		return nil, apperror.Internal(table+" の取得に失敗しました", fmt.Errorf("select %s by question ids: %w", table, err))
+	}
+	defer rows.Close()
+
+	byQuestion := make(map[string][]string, len(questionIDs))
+	for rows.Next() {
+		var questionID string
+		var value string
+		if err := rows.Scan(&questionID, &value); err != nil {
+			return nil, apperror.Internal(table+" の読み取りに失敗しました", fmt.Errorf("scan %s by question ids: %w", table, err))
+		}
+		byQuestion[questionID] = append(byQuestion[questionID], value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal(table+" の取得に失敗しました", fmt.Errorf("%s by question ids rows: %w", table, err))
+	}
+	return byQuestion, nil
+}
+
+// jaccardSQL は questions の別名 a/b の prompt_shingles の Jaccard 係数を計算する SQL 式。
+// NOTE: prompt_shingles は重複なしで保存しているため、cardinality で集合の大きさになる。
+const jaccardSQL = `(
+	cardinality(ARRAY(SELECT unnest(a.prompt_shingles) INTERSECT SELECT unnest(b.prompt_shingles)))::float8
+	/ NULLIF(cardinality(ARRAY(SELECT unnest(a.prompt_shingles) UNION SELECT unnest(b.prompt_shingles))), 0)
+)`
+
+func (r *QuestionRepository) FindSimilarQuestions(ctx context.Context, userID string, excludeQuestionID string, shingles []string, minSimilarity float64, limit int32) ([]domain.SimilarQuestion, error) {
+	if len(shingles) == 0 {
+		return nil, nil
+	}
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT id, prompt, status, mine, similarity
+		 FROM (
+		   SELECT b.id::text AS id, b.prompt, b.status, b.author_user_id = $1 AS mine, `+jaccardSQL+` AS similarity
+		   FROM (SELECT $2::text[] AS prompt_shingles) a
+		   JOIN questions b ON b.prompt_shingles && a.prompt_shingles
+		   WHERE b.deleted_at IS NULL
+		     AND (b.author_user_id = $1 OR (b.status = 'published' AND b.hidden_at IS NULL))
+		     AND ($3 = '' OR b.id <> NULLIF($3, '')::uuid)
+		 ) candidates
+		 WHERE similarity >= $4
+		 ORDER BY similarity DESC, id ASC
+		 LIMIT $5`,
+		userID,
+		shingles,
+		excludeQuestionID,
+		minSimilarity,
+		limit,
+	)
+	if err != nil {
+		return nil, apperror.Internal("類似問題の検索に失敗しました", fmt.Errorf("select similar questions: %w", err))
+	}
+	defer rows.Close()
+
+	var similar []domain.SimilarQuestion
+	for rows.Next() {
+		var q domain.SimilarQuestion
+		var status string
+		if err := rows.Scan(&q.QuestionID, &q.Prompt, &status, &q.Mine, &q.Similarity); err != nil {
+			return nil, apperror.Internal("類似問題の読み取りに失敗しました", fmt.Errorf("scan similar questions: %w", err))
+		}
+		q.Status = domain.QuestionStatus(status)
+		similar = append(similar, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("類似問題の検索に失敗しました", fmt.Errorf("similar question rows: %w", err))
+	}
+	return similar, nil
+}
+
+func (r *QuestionRepository) ListSimilarQuestionPairs(ctx context.Context, minSimilarity float64, limit int32) ([]domain.SimilarQuestionPair, error) {
+	// 混同しやすい点: 全組み合わせの比較は O(n^2) になるため、GIN index の && で
+	// bigram を1つ以上共有する組だけに絞ってから類似度を計算する。
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT a_id, a_author, a_prompt, a_status, a_created_at, b_id, b_author, b_prompt, b_status, b_created_at, similarity
+		 FROM (
+		   SELECT a.id::text AS a_id, a.author_user_id AS a_author, a.prompt AS a_prompt, a.status AS a_status, a.created_at AS a_created_at,
+		          b.id::text AS b_id, b.author_user_id AS b_author, b.prompt AS b_prompt, b.status AS b_status, b.created_at AS b_created_at,
+		          `+jaccardSQL+` AS similarity
+		   FROM questions a
+		   JOIN questions b ON a.id < b.id AND b.prompt_shingles && a.prompt_shingles AND b.deleted_at IS NULL
+		   WHERE a.deleted_at IS NULL
+		 ) pairs
+		 WHERE similarity >= $1
+		 ORDER BY similarity DESC, a_id ASC, b_id ASC
+		 LIMIT $2`,
+		minSimilarity,
+		limit,
+	)
+	if err != nil {
+		return nil, apperror.Internal("重複候補の取得に失敗しました", fmt.Errorf("select similar question pairs: %w", err))
+	}
+	defer rows.Close()
+
+	var pairs []domain.SimilarQuestionPair
+	for rows.Next() {
+		var p domain.SimilarQuestionPair
+		var aStatus, bStatus string
+		if err := rows.Scan(
+			&p.A.QuestionID, &p.A.AuthorUserID, &p.A.Prompt, &aStatus, &p.A.CreatedAt,
+			&p.B.QuestionID, &p.B.AuthorUserID, &p.B.Prompt, &bStatus, &p.B.CreatedAt,
+			&p.Similarity,
+		); err != nil {
+			return nil, apperror.Internal("重複候補の読み取りに失敗しました", fmt.Errorf("scan similar question pairs: %w", err))
+		}
+		p.A.Status = domain.QuestionStatus(aStatus)
+		p.B.Status = domain.QuestionStatus(bStatus)
+		pairs = append(pairs, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("重複候補の取得に失敗しました", fmt.Errorf("similar question pair rows: %w", err))
+	}
+	return pairs, nil
+}
+
+func (r *QuestionRepository) ListUncitedQuestions(ctx context.Context, afterQuestionID string, limit int32) ([]domain.UncitedQuestion, error) {
+	// 混同しやすい点: 出典を足すと updated_at が変わり、ページをまたいで行が移動するため、変わらない (created_at, id) の keyset で進める。
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT q.id::text, q.author_user_id, q.prompt, q.created_at
+		 FROM questions q
+		 WHERE q.status = 'published'
+		   AND q.hidden_at IS NULL
+		   AND q.deleted_at IS NULL
+		   AND NOT EXISTS (SELECT 1 FROM question_citations c WHERE c.question_id = q.id)
+		   AND ($1 = '' OR (q.created_at, q.id) > (SELECT created_at, id FROM questions WHERE id = NULLIF($1, '')::uuid))
+		 ORDER BY q.created_at ASC, q.id ASC
+		 LIMIT $2`,
+		afterQuestionID,
+		limit,
+	)
+	if err != nil {
+		return nil, apperror.Internal("出典のない問題の取得に失敗しました", fmt.Errorf("select uncited questions: %w", err))
+	}
+	defer rows.Close()
+
+	var questions []domain.UncitedQuestion
+	for rows.Next() {
+		var q domain.UncitedQuestion
+		if err := rows.Scan(&q.QuestionID, &q.AuthorUserID, &q.Prompt, &q.CreatedAt); err != nil {
+			return nil, apperror.Internal("出典のない問題の読み取りに失敗しました", fmt.Errorf("scan uncited questions: %w", err))
+		}
+		questions = append(questions, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("出典のない問題の取得に失敗しました", fmt.Errorf("uncited question rows: %w", err))
+	}
+	return questions, nil
+}
@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/jackc/pgx/v5"
+)
+
+// ApplyDueQuestionSchedules は時刻が来た予約を適用する（公開 → 公開終了の順）。
+// 混同しやすい点: 同じトランザクションで公開してから公開終了を見るため、停止中に両方の時刻を過ぎた問題は限定公開で終わる（記録は2件）。
+// 複数のサーバーが同時に実行しても、FOR UPDATE SKIP LOCKED で同じ行を二重に変更しない。
+func (r *QuestionRepository) ApplyDueQuestionSchedules(ctx context.Context, now time.Time, limit int32) ([]domain.QuestionStatusChange, error) {
+	var changes []domain.QuestionStatusChange
+	err := withTx(ctx, r.pool, func(tx pgx.Tx) error {
+		published, err := applyDueSchedule(
+			ctx,
+			tx,
+			`WITH due AS (
+			   SELECT id, status
+			   FROM questions
+			   WHERE publish_at <= $1
+			     AND deleted_at IS NULL
+			     AND status IN ('draft', 'unlisted')
+			   ORDER BY publish_at ASC, id ASC
+			   LIMIT $2
+			   FOR UPDATE SKIP LOCKED
+			 )
+			 UPDATE questions q
+			 SET status = 'published',
+			     publish_at = NULL,
+			     published_at = COALESCE(q.published_at, $1)
+			 FROM due
+			 WHERE q.id = due.id
+			 RETURNING q.id::text, due.status`,
+			now,
+			limit,
+			domain.QuestionStatusPublished,
+			domain.QuestionStatusChangeScheduledPublish,
+		)
+		if err != nil {
+			return err
+		}
+
+		unpublished, err := applyDueSchedule(
+			ctx,
+			tx,
+			`WITH due AS (
+			   SELECT id, status
+			   FROM questions
+			   WHERE unpublish_at <= $1
+			     AND deleted_at IS NULL
+			     AND status = 'published'
+			   ORDER BY unpublish_at ASC, id ASC
+			   LIMIT $2
+			   FOR UPDATE SKIP LOCKED
+			 )
+			 UPDATE questions q
+			 SET status = 'unlisted',
+			     unpublish_at = NULL
+			 FROM due
+			 WHERE q.id = due.id
+			 RETURNING q.id::text, due.status`,
+			now,
+			limit,
+			domain.QuestionStatusUnlisted,
+			domain.QuestionStatusChangeScheduledUnpublish,
+		)
+		if err != nil {
+			return err
+		}
+
+		// 公開されないまま時刻を過ぎた公開終了の予約は、状態を変えずに取り消す（後から公開した途端に外れないように）。
+		if _, err := tx.Exec(
+			ctx,
+			`UPDATE questions
+			 SET unpublish_at = NULL
+			 WHERE unpublish_at <= $1
+			   AND status <> 'published'
+			   AND publish_at IS NULL`,
+			now,
+		); err != nil {
+			return apperror.Internal("予約の適用に失敗しました", fmt.Errorf("clear stale unpublish_at: %w", err))
+		}
+
+		changes = append(published, unpublished...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// applyDueSchedule は予約を適用する UPDATE（RETURNING id, 変更前の status）を実行し、変更を記録する。
+func applyDueSchedule(ctx context.Context, tx pgx.Tx, sql string, now time.Time, limit int32, to domain.QuestionStatus, reason domain.QuestionStatusChangeReason) ([]domain.QuestionStatusChange, error) {
+	rows, err := tx.Query(ctx, sql, now, limit)
+	if err != nil {
+		return nil, apperror.Internal("予約の適用に失敗しました", fmt.Errorf("apply %s: %w", reason, err))
+	}
+	var changes []domain.QuestionStatusChange
+	for rows.Next() {
+		var questionID string
+		var from string
+		if err := rows.Scan(&questionID, &from); err != nil {
+			rows.Close()
+			return nil, apperror.Internal("予約の適用結果の読み取りに失敗しました", fmt.Errorf("scan %s: %w", reason, err))
+		}
+		changes = append(changes, domain.QuestionStatusChange{
+			QuestionID: questionID,
+			Reason:     reason,
+			From:       domain.QuestionStatus(from),
+			To:         to,
+			ChangedAt:  now,
+		})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("予約の適用に失敗しました", fmt.Errorf("%s rows: %w", reason, err))
+	}
+
+	// 混同しやすい点: rows を閉じるまで同じ tx で次の SQL は実行できないため、記録は読み終わってから入れる。
+	for _, c := range changes {
+		if err := insertStatusChange(ctx, tx, c); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// insertStatusChange は公開状態の変更を question_status_changes に記録する。
+// ActorUserID が空の場合はサーバーの定期処理、ChangedAt がゼロ値の場合は現在時刻として記録する。
+func insertStatusChange(ctx context.Context, tx pgx.Tx, c domain.QuestionStatusChange) error {
+	if _, err := tx.Exec(
+		ctx,
+		`INSERT INTO question_status_changes (question_id, actor_user_id, reason, from_status, to_status, changed_at)
+		 VALUES ($1::uuid, $2, $3, $4, $5, COALESCE($6::timestamptz, NOW()))`,
+		c.QuestionID,
+		nullIfEmpty(c.ActorUserID),
+		string(c.Reason),
+		string(c.From),
+		string(c.To),
+		nullIfZeroTime(c.ChangedAt),
+	); err != nil {
+		return apperror.Internal("公開状態の変更の記録に失敗しました", fmt.Errorf("insert question status change: %w", err))
+	}
+	return nil
+}
+
+// checkScheduleAllowed は現在の公開状態に予約を設定できるかを確認する（schedule が nil の場合は変更しないので常に可）。
+func checkScheduleAllowed(status domain.QuestionStatus, schedule *domain.QuestionSchedule) error {
+	if schedule == nil || schedule.IsZero() {
+		return nil
+	}
+	switch status {
+	case domain.QuestionStatusArchived:
+		return apperror.FailedPrecondition("アーカイブ済みの問題には公開の予約を設定できません")
+	case domain.QuestionStatusPublished:
+		if !schedule.PublishAt.IsZero() {
+			return apperror.FailedPrecondition("公開中の問題には公開の予約を設定できません（公開終了の予約のみ設定できます）")
+		}
+	}
+	return nil
+}
+
+// nullIfZeroTime はゼロ値の時刻を NULL として渡す。
+func nullIfZeroTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// toQuestionSchedule は NULL 許容の予約の列を domain.QuestionSchedule に変換する。
+func toQuestionSchedule(publishAt *time.Time, unpublishAt *time.Time) domain.QuestionSchedule {
+	var s domain.QuestionSchedule
+	if publishAt != nil {
+		s.PublishAt = *publishAt
+	}
+	if unpublishAt != nil {
+		s.UnpublishAt = *unpublishAt
+	}
+	return s
+}
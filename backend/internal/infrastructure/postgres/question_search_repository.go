@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/searchtext"
+	"github.com/history-quiz/historyquiz/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// QuestionSearchRepository は Postgres 実装の問題の全文検索リポジトリ。
+type QuestionSearchRepository struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.QuestionSearchRepository = (*QuestionSearchRepository)(nil)
+
+// NewQuestionSearchRepository は QuestionSearchRepository を生成する。
+func NewQuestionSearchRepository(pool *pgxpool.Pool) *QuestionSearchRepository {
+	return &QuestionSearchRepository{pool: pool}
+}
+
+func (r *QuestionSearchRepository) SearchQuestions(ctx context.Context, query domain.QuestionSearchQuery) ([]domain.QuestionSearchHit, error) {
+	statuses := make([]string, 0, len(query.Statuses))
+	for _, s := range query.Statuses {
+		statuses = append(statuses, string(s))
+	}
+	var afterScore *int32
+	afterQuestionID := ""
+	if query.After != nil {
+		afterScore = &query.After.Score
+		afterQuestionID = query.After.QuestionID
+	}
+	bigrams := query.Bigrams
+	if bigrams == nil {
+		bigrams = []string{}
+	}
+
+	// 混同しやすい点: bigram の包含は候補の絞り込みにすぎない（"ろーま" と "ーまろ" は同じ bigram を持つ）。
+	// 一致の確定は strpos による部分一致で行う。
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT id, author_user_id, prompt, explanation, status, updated_at, choices, tags, score
+		 FROM (
+		   SELECT q.id::text AS id, q.author_user_id, q.prompt, COALESCE(q.explanation, '') AS explanation, q.status, q.updated_at,
+		          ARRAY(SELECT c.label FROM choices c WHERE c.question_id = q.id ORDER BY c.ordinal) AS choices,
+		          ARRAY(SELECT t.tag FROM question_tags t WHERE t.question_id = q.id ORDER BY t.ordinal) AS tags,
+		          (SELECT SUM(
+		             CASE WHEN strpos(d.prompt_text, term) > 0 THEN 3 ELSE 0 END
+		           + CASE WHEN strpos(d.choices_text, term) > 0 THEN 2 ELSE 0 END
+		           + CASE WHEN strpos(d.explanation_text, term) > 0 THEN 1 ELSE 0 END
+		          ) FROM unnest($1::text[]) AS term)::int AS score
+		   FROM question_search_documents d
+		   JOIN questions q ON q.id = d.question_id
+		   WHERE q.deleted_at IS NULL
+		     AND d.bigrams @> $2::text[]
+		     AND NOT EXISTS (
+		       SELECT 1 FROM unnest($1::text[]) AS term
+		       WHERE strpos(d.prompt_text, term) = 0
+		         AND strpos(d.choices_text, term) = 0
+		         AND strpos(d.explanation_text, term) = 0
+		     )
+		     AND ($3 = '' OR q.author_user_id = $3)
+		     AND ($4 = '' OR EXISTS (SELECT 1 FROM question_tags t WHERE t.question_id = q.id AND t.tag = $4))
+		     AND (cardinality($5::text[]) = 0 OR q.status = ANY($5::text[]))
+		 ) hits
+		 WHERE ($6::int IS NULL OR score < $6::int OR (score = $6::int AND id > $7))
+		 ORDER BY score DESC, id ASC
+		 LIMIT $8`,
+		query.Terms,
+		bigrams,
+		query.AuthorUserID,
+		query.Tag,
+		statuses,
+		afterScore,
+		afterQuestionID,
+		query.Limit,
+	)
+	if err != nil {
+		return nil, apperror.Internal("問題の検索に失敗しました", fmt.Errorf("search questions: %w", err))
+	}
+	defer rows.Close()
+
+	hits := make([]domain.QuestionSearchHit, 0, query.Limit)
+	for rows.Next() {
+		var h domain.QuestionSearchHit
+		var status string
+		if err := rows.Scan(&h.QuestionID, &h.AuthorUserID, &h.Prompt, &h.Explanation, &status, &h.UpdatedAt, &h.Choices, &h.Tags, &h.Score); err != nil {
+			return nil, apperror.Internal("検索結果の読み取りに失敗しました", fmt.Errorf("scan search questions: %w", err))
+		}
+		h.Status = domain.QuestionStatus(status)
+		hits = append(hits, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("問題の検索に失敗しました", fmt.Errorf("search question rows: %w", err))
+	}
+	return hits, nil
+}
+
+// upsertSearchDocument は問題の検索用文書を作成/更新する（作成/更新と同じトランザクション内で呼ぶ）。
+func upsertSearchDocument(ctx context.Context, tx pgx.Tx, questionID string, draft domain.QuestionDraft) error {
+	doc := searchtext.NewDocument(draft.Prompt, draft.Choices, draft.Explanation)
+	bigrams := doc.Bigrams
+	if bigrams == nil {
+		bigrams = []string{}
+	}
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO question_search_documents (question_id, prompt_text, choices_text, explanation_text, bigrams)
+		 VALUES ($1::uuid, $2, $3, $4, $5)
+		 ON CONFLICT (question_id) DO UPDATE
+		 SET prompt_text = EXCLUDED.prompt_text,
+		     choices_text = EXCLUDED.choices_text,
+		     explanation_text = EXCLUDED.explanation_text,
+		     bigrams = EXCLUDED.bigrams`,
+		questionID,
+		doc.Prompt,
+		doc.Choices,
+		doc.Explanation,
+		bigrams,
+	)
+	if err != nil {
+		return apperror.Internal("検索用データの保存に失敗しました", fmt.Errorf("upsert search document: %w", err))
+	}
+	return nil
+}
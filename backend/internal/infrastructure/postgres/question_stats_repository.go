@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func (r *QuestionRepository) GetQuestionAttemptStats(ctx context.Context, questionID string, trendSince time.Time, strong domain.StrongPlayerCriteria) (domain.QuestionStats, error) {
+	var stats domain.QuestionStats
+	if err := r.pool.QueryRow(
+		ctx,
+		`SELECT
+		   COUNT(*)::bigint,
+		   COALESCE(SUM(CASE WHEN is_correct THEN 1 ELSE 0 END), 0)::bigint,
+		   COALESCE(SUM(CASE WHEN answer_text IS NOT NULL THEN 1 ELSE 0 END), 0)::bigint,
+		   COALESCE(SUM(CASE WHEN answer_latitude IS NOT NULL THEN 1 ELSE 0 END), 0)::bigint
+		 FROM attempts
+		 WHERE question_id = $1::uuid`,
+		questionID,
+	).Scan(&stats.TotalAttempts, &stats.CorrectAttempts, &stats.TextAttempts, &stats.LocationAttempts); err != nil {
+		return domain.QuestionStats{}, apperror.Internal("回答の集計に失敗しました", fmt.Errorf("select attempt totals: %w", err))
+	}
+
+	if err := r.pool.QueryRow(
+		ctx,
+		`SELECT COUNT(*)::bigint
+		 FROM questions
+		 WHERE origin_question_id = $1::uuid
+		   AND deleted_at IS NULL`,
+		questionID,
+	).Scan(&stats.ForkCount); err != nil {
+		return domain.QuestionStats{}, apperror.Internal("複製数の集計に失敗しました", fmt.Errorf("select fork count: %w", err))
+	}
+
+	choices, err := r.listChoiceStats(ctx, questionID)
+	if err != nil {
+		return domain.QuestionStats{}, err
+	}
+	stats.Choices = choices
+
+	trend, err := r.listWeeklyAttemptStats(ctx, questionID, trendSince)
+	if err != nil {
+		return domain.QuestionStats{}, err
+	}
+	stats.Trend = trend
+
+	// 混同しやすい点: プレイヤーの強さは「この問題以外」への回答で判定する。
+	// 対象はこの問題に回答したプレイヤーだけに絞ってから集計する（attempts 全体を集計しない）。
+	if err := r.pool.QueryRow(
+		ctx,
+		`WITH players AS (
+		   SELECT DISTINCT user_id
+		   FROM attempts
+		   WHERE question_id = $1::uuid
+		 ), strong_players AS (
+		   SELECT a.user_id
+		   FROM attempts a
+		   JOIN players p ON p.user_id = a.user_id
+		   WHERE a.question_id <> $1::uuid
+		   GROUP BY a.user_id
+		   HAVING COUNT(*) >= $2
+		      AND AVG(CASE WHEN a.is_correct THEN 1.0 ELSE 0.0 END) >= $3
+		 )
+		 SELECT
+		   COUNT(*)::bigint,
+		   COALESCE(SUM(CASE WHEN a.is_correct THEN 1 ELSE 0 END), 0)::bigint
+		 FROM attempts a
+		 JOIN strong_players s ON s.user_id = a.user_id
+		 WHERE a.question_id = $1::uuid`,
+		questionID,
+		strong.MinAttempts,
+		strong.MinAccuracy,
+	).Scan(&stats.StrongPlayerAttempts, &stats.StrongPlayerCorrect); err != nil {
+		return domain.QuestionStats{}, apperror.Internal("回答の集計に失敗しました", fmt.Errorf("select strong player attempts: %w", err))
+	}
+	return stats, nil
+}
+
+// listChoiceStats は選択肢ごとの選ばれた数を ordinal 順に返す（選ばれていない選択肢も 0 件で返す）。
+func (r *QuestionRepository) listChoiceStats(ctx context.Context, questionID string) ([]domain.ChoiceStats, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT c.id::text, c.label, c.ordinal, COALESCE(ak.correct_choice_id = c.id, false), COUNT(a.id)::bigint
+		 FROM choices c
+		 LEFT JOIN answer_keys ak ON ak.question_id = c.question_id
+		 LEFT JOIN attempts a ON a.question_id = c.question_id AND a.selected_choice_id = c.id
+		 WHERE c.question_id = $1::uuid
+		 GROUP BY c.id, c.label, c.ordinal, ak.correct_choice_id
+		 ORDER BY c.ordinal ASC`,
+		questionID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("回答の集計に失敗しました", fmt.Errorf("select choice stats: %w", err))
+	}
+	defer rows.Close()
+
+	var choices []domain.ChoiceStats
+	for rows.Next() {
+		var c domain.ChoiceStats
+		if err := rows.Scan(&c.ChoiceID, &c.Label, &c.Ordinal, &c.IsCorrect, &c.Picks); err != nil {
+			return nil, apperror.Internal("回答の集計の読み取りに失敗しました", fmt.Errorf("scan choice stats: %w", err))
+		}
+		choices = append(choices, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("回答の集計に失敗しました", fmt.Errorf("choice stats rows: %w", err))
+	}
+	return choices, nil
+}
+
+// listWeeklyAttemptStats は since 以降の回答を週（UTC の月曜始まり）ごとに集計する。
+func (r *QuestionRepository) listWeeklyAttemptStats(ctx context.Context, questionID string, since time.Time) ([]domain.StatsBucket, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT
+		   date_trunc('week', answered_at AT TIME ZONE 'UTC') AS week_start,
+		   COUNT(*)::bigint,
+		   COALESCE(SUM(CASE WHEN is_correct THEN 1 ELSE 0 END), 0)::bigint
+		 FROM attempts
+		 WHERE question_id = $1::uuid
+		   AND answered_at >= $2
+		 GROUP BY week_start
+		 ORDER BY week_start ASC`,
+		questionID,
+		since,
+	)
+	if err != nil {
+		return nil, apperror.Internal("回答の集計に失敗しました", fmt.Errorf("select weekly stats: %w", err))
+	}
+	defer rows.Close()
+
+	var buckets []domain.StatsBucket
+	for rows.Next() {
+		var b domain.StatsBucket
+		if err := rows.Scan(&b.Start, &b.Attempts, &b.CorrectAttempts); err != nil {
+			return nil, apperror.Internal("回答の集計の読み取りに失敗しました", fmt.Errorf("scan weekly stats: %w", err))
+		}
+		// timestamp（タイムゾーンなし）で返るため、UTC として扱う。
+		b.Start = time.Date(b.Start.Year(), b.Start.Month(), b.Start.Day(), 0, 0, 0, 0, time.UTC)
+		buckets = append(buckets, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("回答の集計に失敗しました", fmt.Errorf("weekly stats rows: %w", err))
+	}
+	return buckets, nil
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *QuestionRepository) UpsertQuestionTranslation(ctx context.Context, questionID string, translation domain.QuestionTranslation) (domain.QuestionTranslation, error) {
+	// 混同しやすい点: source_version は同じ文の中で questions から読み、原文の更新と翻訳の保存の前後関係を取り違えないようにする。
+	err := r.pool.QueryRow(
+		ctx,
+		`INSERT INTO question_translations (question_id, locale, prompt, choices, explanation, source_version)
+		 SELECT q.id, $2, $3, $4, $5, q.version
+		 FROM questions q
+		 WHERE q.id = $1::uuid
+		   AND q.deleted_at IS NULL
+		 ON CONFLICT (question_id, locale) DO UPDATE
+		 SET prompt = EXCLUDED.prompt,
+		     choices = EXCLUDED.choices,
+		     explanation = EXCLUDED.explanation,
+		     source_version = EXCLUDED.source_version,
+		     updated_at = NOW()
+		 RETURNING source_version, updated_at`,
+		questionID,
+		translation.Locale,
+		translation.Prompt,
+		translation.Choices,
+		nullIfEmpty(translation.Explanation),
+	).Scan(&translation.SourceVersion, &translation.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return domain.QuestionTranslation{}, apperror.NotFound("問題が見つかりません")
+	}
+	if err != nil {
+		return domain.QuestionTranslation{}, apperror.InvalidArgument("翻訳の保存に失敗しました（入力が不正です）")
+	}
+	translation.Stale = false
+	return translation, nil
+}
+
+func (r *QuestionRepository) DeleteQuestionTranslation(ctx context.Context, questionID string, locale string) error {
+	tag, err := r.pool.Exec(
+		ctx,
+		`DELETE FROM question_translations
+		 WHERE question_id = $1::uuid
+		   AND locale = $2`,
+		questionID,
+		locale,
+	)
+	if err != nil {
+		return apperror.Internal("翻訳の削除に失敗しました", fmt.Errorf("delete question translation: %w", err))
+	}
+	if tag.RowsAffected() == 0 {
+		return apperror.NotFound("翻訳が見つかりません")
+	}
+	return nil
+}
+
+func (r *QuestionRepository) ListQuestionTranslations(ctx context.Context, questionID string) ([]domain.QuestionTranslation, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT t.locale, t.prompt, t.choices, COALESCE(t.explanation, ''), t.source_version, t.updated_at, t.source_version < q.version
+		 FROM question_translations t
+		 JOIN questions q ON q.id = t.question_id
+		 WHERE t.question_id = $1::uuid
+		 ORDER BY t.locale ASC`,
+		questionID,
+	)
+	if err != nil {
+		return nil, apperror.Internal("翻訳の取得に失敗しました", fmt.Errorf("select question translations: %w", err))
+	}
+	defer rows.Close()
+
+	var translations []domain.QuestionTranslation
+	for rows.Next() {
+		var t domain.QuestionTranslation
+		if err := rows.Scan(&t.Locale, &t.Prompt, &t.Choices, &t.Explanation, &t.SourceVersion, &t.UpdatedAt, &t.Stale); err != nil {
+			return nil, apperror.Internal("翻訳の読み取りに失敗しました", fmt.Errorf("scan question translations: %w", err))
+		}
+		translations = append(translations, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Internal("翻訳の取得に失敗しました", fmt.Errorf("question translation rows: %w", err))
+	}
+	return translations, nil
+}
@@ -8,6 +8,13 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// querier は pool とトランザクションの両方で使える読み取り用の最小インターフェース。
+// NOTE: トランザクション内の読み取りは tx を渡し、pool から別の接続を取らないようにする。
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
 // withTx はトランザクションの begin/commit/rollback を共通化する。
 // 混同しやすい点: 途中で error が返った場合は必ず rollback してから上位に返す。
 func withTx(ctx context.Context, pool *pgxpool.Pool, fn func(tx pgx.Tx) error) error {
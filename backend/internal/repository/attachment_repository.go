@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// AttachmentRepository は添付のメタデータの永続化を抽象化する。
+// NOTE: 問題との対応（question_attachments）は QuestionRepository の作成/更新と同じトランザクションで保存する。
+type AttachmentRepository interface {
+	// CreateAttachment は添付のメタデータを保存し、採番した ID を含めて返す。
+	CreateAttachment(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error)
+	// GetAttachment は添付を返す（Public/Deleted は参照元の問題の状態から求める）。
+	GetAttachment(ctx context.Context, attachmentID string) (domain.Attachment, error)
+	// ListPurgeableAttachments は本体ごと削除してよい添付を古い順に返す。
+	// 対象は、論理削除されていない問題から参照されておらず、
+	// 未使用のまま unusedBefore より前に作成されたもの、または deletedBefore より前に論理削除されたもの。
+	ListPurgeableAttachments(ctx context.Context, unusedBefore time.Time, deletedBefore time.Time, limit int32) ([]domain.Attachment, error)
+	// DeleteUnusedAttachment は、論理削除されていない問題から参照されていない場合だけメタデータを物理削除する。
+	// 掃除の対象を選んだ後に参照された場合は削除せず false を返す（BlobStore の本体は削除した場合だけ消す）。
+	DeleteUnusedAttachment(ctx context.Context, attachmentID string) (deleted bool, err error)
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 
 	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
 )
 
 // AttemptRepository は attempts の永続化を抽象化する。
 type AttemptRepository interface {
 	CreateAttempt(ctx context.Context, userID string, questionID string, selectedChoiceID string, isCorrect bool) (attemptID string, err error)
-	ListMyAttempts(ctx context.Context, userID string, limit int32) ([]domain.Attempt, error)
+	CreateTextAttempt(ctx context.Context, userID string, questionID string, answerText string, isCorrect bool) (attemptID string, err error)
+	// CreateLocationAttempt は地図で答えた地点と、正解の地点からの距離（km）を保存する。
+	CreateLocationAttempt(ctx context.Context, userID string, questionID string, answer geo.Point, distanceKm float64, isCorrect bool) (attemptID string, err error)
+	// ListMyAttempts は自分の解答履歴を新しい順に返す。after が nil でない場合は、その位置より後ろから返す。
+	ListMyAttempts(ctx context.Context, userID string, after *domain.AttemptListCursor, limit int32) ([]domain.Attempt, error)
 	GetMyStats(ctx context.Context, userID string) (domain.Stats, error)
 }
 
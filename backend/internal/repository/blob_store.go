@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStore は添付の本体（バイト列）の保存先を抽象化する。
+// 実装はローカル/テスト用のファイルシステムと、本番用の S3 互換ストレージ。
+type BlobStore interface {
+	// Put は key に data を保存する（同じ key は上書き）。
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	// Get は key の本体を返す。存在しない場合は CodeNotFound を返す。
+	// NOTE: 呼び出し側で Close すること。
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete は key を削除する。存在しない場合も成功として扱う（掃除の再実行を安全にするため）。
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// DeckRepository は decks/deck_questions の永続化を抽象化する。
+// NOTE: デッキでの出題候補は QuestionRepository.ListQuizCandidateDeckQuestionIDs で引く（他の出題候補と同じ条件で絞るため）。
+type DeckRepository interface {
+	// CreateDeck はデッキと問題の並びを1トランザクションで保存する。
+	CreateDeck(ctx context.Context, ownerUserID string, shareSlug string, draft domain.DeckDraft) (domain.Deck, error)
+	// UpdateDeck は題名/説明を更新し、問題の並びを置き換える。
+	UpdateDeck(ctx context.Context, deckID string, draft domain.DeckDraft) (domain.Deck, error)
+	// SoftDeleteDeck はデッキを論理削除する。
+	SoftDeleteDeck(ctx context.Context, deckID string) error
+	// GetDeck は論理削除されていないデッキを返す（無い場合は NOT_FOUND）。
+	GetDeck(ctx context.Context, deckID string) (domain.Deck, error)
+	// GetDeckBySlug は共有用の文字列からデッキを返す（無い場合は NOT_FOUND）。
+	GetDeckBySlug(ctx context.Context, shareSlug string) (domain.Deck, error)
+	// ListMyDecks は自分のデッキを作成の新しい順に返す（論理削除は除外）。
+	ListMyDecks(ctx context.Context, ownerUserID string, limit int32) ([]domain.Deck, error)
+	// ListAddableQuestionIDs は questionIDs のうち、userID がデッキに入れてよい問題を返す。
+	// 入れてよいのは、論理削除されていない自分の問題と、他人の公開中（非表示でない）問題。
+	ListAddableQuestionIDs(ctx context.Context, userID string, questionIDs []string) ([]string, error)
+
+	// GetDeckOwner は所有者チェックのために所有者を返す（deleted_at も含めて取得する）。
+	GetDeckOwner(ctx context.Context, deckID string) (ownerUserID string, deleted bool, err error)
+}
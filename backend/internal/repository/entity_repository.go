@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// EntityRepository は entities/entity_relations/question_entities の永続化を抽象化する。
+// NOTE: エンティティでの出題候補は QuestionRepository.ListQuizCandidateEntityQuestionIDs で引く（他の出題候補と同じ条件で絞るため）。
+type EntityRepository interface {
+	// CreateEntity はエンティティを作成する（key が既にある場合は ALREADY_EXISTS）。
+	CreateEntity(ctx context.Context, draft domain.EntityDraft) (domain.Entity, error)
+	// UpdateEntity はエンティティを更新する（kind は変えない。key が他のエンティティと重なる場合は ALREADY_EXISTS）。
+	UpdateEntity(ctx context.Context, entityID string, draft domain.EntityDraft) (domain.Entity, error)
+	// DeleteEntity はエンティティを削除する。関係と問題への紐づけも消える（無い場合は NOT_FOUND）。
+	DeleteEntity(ctx context.Context, entityID string) error
+	// GetEntity はエンティティを関係と出題できる問題の数付きで返す（無い場合は NOT_FOUND）。
+	GetEntity(ctx context.Context, entityID string) (domain.EntityDetail, error)
+	// ListEntities は query の条件でエンティティを名前順に返す。
+	ListEntities(ctx context.Context, query domain.EntityListQuery) ([]domain.Entity, error)
+	// ListEntitiesByIDs は entityIDs のうち存在するエンティティを返す（順不同）。
+	ListEntitiesByIDs(ctx context.Context, entityIDs []string) ([]domain.Entity, error)
+	// ListEntitiesByKeys は keys のうち存在するエンティティを返す（順不同）。
+	ListEntitiesByKeys(ctx context.Context, keys []string) ([]domain.Entity, error)
+
+	// AddEntityRelation は関係を追加する（既にある場合は ALREADY_EXISTS）。
+	AddEntityRelation(ctx context.Context, relation domain.EntityRelation) error
+	// RemoveEntityRelation は関係を削除する（無い場合は NOT_FOUND）。
+	RemoveEntityRelation(ctx context.Context, relation domain.EntityRelation) error
+
+	// ImportEntities はエンティティを key で照合して作成/更新し、関係を追加する（既にある関係はそのまま）。
+	// すべて1トランザクションで行う。関係の key は entities か既存のエンティティにあること（呼び出し側で検証する）。
+	ImportEntities(ctx context.Context, entities []domain.EntityDraft, relations []domain.EntityImportRelation) (domain.EntityImportResult, error)
+
+	// SetQuestionEntities は問題に付けるエンティティを丸ごと置き換える。
+	SetQuestionEntities(ctx context.Context, questionID string, entityIDs []string) error
+	// ListQuestionEntities は問題に付いたエンティティを名前順に返す（QuestionRepository.ListQuestionEntities と同じ）。
+	ListQuestionEntities(ctx context.Context, questionID string) ([]domain.EntityRef, error)
+	// GetQuestionAuthor は所有者チェックのために問題の作成者を返す（QuestionRepository.GetQuestionAuthor と同じ）。
+	GetQuestionAuthor(ctx context.Context, questionID string) (authorUserID string, deleted bool, err error)
+}
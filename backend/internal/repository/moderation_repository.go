@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// ModerationRepository は question_reports と問題の非表示フラグの永続化を抽象化する。
+type ModerationRepository interface {
+	CreateReport(ctx context.Context, reporterUserID string, questionID string, reason domain.ReportReason, detail string) (domain.QuestionReport, error)
+	CountOpenReports(ctx context.Context, questionID string) (int64, error)
+	ListOpenReports(ctx context.Context, limit int32) ([]domain.QuestionReport, error)
+	ListOpenReportsForQuestion(ctx context.Context, questionID string) ([]domain.QuestionReport, error)
+	GetReport(ctx context.Context, reportID string) (domain.QuestionReport, error)
+	// ResolveOpenReports は同じ問題の未対応の報告をまとめて解決済みにし、件数を返す。
+	// 対応に応じた問題の変更も同じトランザクションで行う（HIDE: 非表示、DISMISS: 自動の非表示だけ解除、EDIT: edit での書き換えと非表示の解除）。
+	ResolveOpenReports(ctx context.Context, questionID string, resolution domain.ResolutionAction, resolvedByUserID string, edit *domain.QuestionDraft) (resolved int64, err error)
+
+	// HideQuestionByReports は報告数が閾値に達した問題を自動で非表示にする。
+	HideQuestionByReports(ctx context.Context, questionID string) error
+}
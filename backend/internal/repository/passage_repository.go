@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// PassageRepository は史料（passages）と、それを共有する子の問題をまとめて永続化する。
+// NOTE: 実装は QuestionRepository と同じ（子の問題の作成/更新を共有するため）。作問のユースケースのテストで QuestionRepository 全体を差し替えずに済むように分けている。
+type PassageRepository interface {
+	// CreatePassage は史料と子の問題を1トランザクションで作成する（1問でも失敗したら全体をロールバックする）。
+	CreatePassage(ctx context.Context, authorUserID string, draft domain.PassageDraft) (domain.PassageDetail, error)
+	// UpdatePassage は expectedVersion と現在の版が一致する場合だけ、史料と子の問題を1トランザクションで更新し、版を 1 増やす。
+	// QuestionID が空の子は作成し、並びから外れた子は論理削除する。他の史料の問題/他人の問題を指定した場合は INVALID_ARGUMENT。
+	// 不一致の場合は最新の史料を載せた CodeAborted を返す。
+	UpdatePassage(ctx context.Context, userID string, passageID string, expectedVersion int64, draft domain.PassageDraft) (domain.PassageDetail, error)
+	// GetMyPassage は自分の史料を子の問題（論理削除を除く）付きで返す。
+	GetMyPassage(ctx context.Context, userID string, passageID string) (domain.PassageDetail, error)
+	// SoftDeletePassage は自分の史料と子の問題を1トランザクションで論理削除する。
+	SoftDeletePassage(ctx context.Context, userID string, passageID string) error
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/history-quiz/historyquiz/internal/domain"
 )
@@ -11,14 +12,75 @@ type QuestionRepository interface {
 	ListQuizCandidateQuestionIDs(ctx context.Context, previousQuestionID string) (ids []string, err error)
 	ListQuizCandidateSystemQuestionIDs(ctx context.Context, previousQuestionID string) (ids []string, err error)
 	ListQuizCandidateNonSystemQuestionIDs(ctx context.Context, previousQuestionID string) (ids []string, err error)
+	// ListQuizCandidateDeckQuestionIDs はデッキの問題のうち出題できるもの（公開中で非表示でない）を出題順に返す。
+	// デッキが無い/論理削除済みの場合は NOT_FOUND。
+	ListQuizCandidateDeckQuestionIDs(ctx context.Context, deckID string) (ids []string, err error)
+	// ListQuizCandidateEntityQuestionIDs はエンティティが付いた問題のうち出題できるものを返す（previousQuestionID は除く）。
+	// エンティティが無い場合は NOT_FOUND。
+	ListQuizCandidateEntityQuestionIDs(ctx context.Context, entityID string, previousQuestionID string) (ids []string, err error)
+	// GetQuizQuestion/GetCorrectChoiceID/ListAcceptedAnswers は出題できる問題（公開中、非表示でない、公開終了前）だけを返す。
+	// それ以外（下書き/非表示/論理削除済みなど）は NOT_FOUND。
 	GetQuizQuestion(ctx context.Context, questionID string) (domain.Question, error)
 	GetCorrectChoiceID(ctx context.Context, questionID string) (correctChoiceID string, err error)
 	ChoiceBelongsToQuestion(ctx context.Context, questionID string, choiceID string) (bool, error)
+	// ListAcceptedAnswers は記述式判定に使う正解表記を返す（先頭が正解の選択肢ラベル、以降が別表記）。
+	ListAcceptedAnswers(ctx context.Context, questionID string) (answers []string, err error)
+	// ListCitations は回答後に見せる出典を表示順で返す（問題が無い場合も空で返す）。
+	ListCitations(ctx context.Context, questionID string) ([]domain.Citation, error)
+	// GetQuestionLocation は地図で答える問題の正解の地点を返す（地点が無い問題は nil。問題が無い場合は NotFound）。
+	GetQuestionLocation(ctx context.Context, questionID string) (*domain.QuestionLocation, error)
+	// ListPassageQuestionIDs は questionID と同じ史料を共有する問題のうち、出題できるもの（questionID 自身は常に含む）を出題順に返す。
+	// 史料を共有しない問題/存在しない問題の場合は空で返す。
+	ListPassageQuestionIDs(ctx context.Context, questionID string) (ids []string, err error)
+	// ListQuestionEntities は問題に付いたエンティティを名前順に返す（問題が無い場合も空で返す）。
+	ListQuestionEntities(ctx context.Context, questionID string) ([]domain.EntityRef, error)
 
 	CreateQuestion(ctx context.Context, authorUserID string, draft domain.QuestionDraft) (domain.QuestionDetail, error)
-	UpdateQuestion(ctx context.Context, userID string, questionID string, draft domain.QuestionDraft) (domain.QuestionDetail, error)
+	// ForkQuestion は公開中（非表示でない）の問題を userID の下書きとして複製する（選択肢/正解/別表記/解説/タグ/出典）。
+	// 元の問題が公開中でない/存在しない場合は NOT_FOUND。
+	ForkQuestion(ctx context.Context, userID string, sourceQuestionID string) (domain.QuestionDetail, error)
+	// CreateQuestions は複数の問題を1トランザクションで作成する（1件でも失敗したら全件ロールバック）。
+	CreateQuestions(ctx context.Context, authorUserID string, drafts []domain.QuestionDraft) ([]domain.QuestionDetail, error)
+	// UpdateQuestion は expectedVersion と現在の版が一致する場合だけ更新し、版を 1 増やす。
+	// 不一致の場合は最新の問題を載せた CodeAborted を返す。expectedVersion=0 は版を確認しない（モデレーションの代理編集用）。
+	// schedule が nil の場合は予約を変えない。nil でない場合は置き換える（公開中の問題への公開の予約、アーカイブ済みの問題への予約は FAILED_PRECONDITION）。
+	UpdateQuestion(ctx context.Context, userID string, questionID string, expectedVersion int64, draft domain.QuestionDraft, schedule *domain.QuestionSchedule) (domain.QuestionDetail, error)
 	GetMyQuestion(ctx context.Context, userID string, questionID string) (domain.QuestionDetail, error)
-	ListMyQuestions(ctx context.Context, userID string, limit int32) ([]domain.QuestionSummary, error)
+	// SoftDeleteQuestion は自分の問題を論理削除し、その問題だけが参照していた添付も同じトランザクションで削除扱いにする。
+	SoftDeleteQuestion(ctx context.Context, userID string, questionID string) error
+	// ListMyQuestions は自分の問題（論理削除を除く）を query の条件で絞り込み、並び替えて回答数付きで返す。
+	ListMyQuestions(ctx context.Context, userID string, query domain.MyQuestionsQuery) ([]domain.QuestionSummary, error)
+	// ListMyQuestionDetails は自分の問題を作成順に詳細（選択肢/正解/別表記/タグ）付きで返す。
+	// afterQuestionID が空でない場合は、その問題より後ろから返す（書き出し用の keyset ページング）。
+	ListMyQuestionDetails(ctx context.Context, userID string, afterQuestionID string, limit int32) ([]domain.QuestionDetail, error)
+	// UpdateQuestionStatus は公開状態を from → to に変更する（from が現在値と一致しない場合は FAILED_PRECONDITION）。
+	// 変更は question_status_changes に記録する。公開すると公開の予約を、アーカイブすると予約をすべて取り消す。
+	UpdateQuestionStatus(ctx context.Context, userID string, questionID string, from domain.QuestionStatus, to domain.QuestionStatus) (domain.QuestionDetail, error)
+	// ApplyDueQuestionSchedules は now までに時刻が来た予約を最大 limit 件ずつ（公開/公開終了それぞれ）適用し、行った変更を返す。
+	// 変更は question_status_changes に記録し、使った予約は取り消す。複数のサーバーから同時に呼んでも同じ予約を二重に適用しない。
+	ApplyDueQuestionSchedules(ctx context.Context, now time.Time, limit int32) ([]domain.QuestionStatusChange, error)
+
+	// FindSimilarQuestions は問題文の shingle が似ている問題を類似度の高い順に返す。
+	// 対象は userID 自身の問題と、他人の公開中（非表示でない）問題。excludeQuestionID（更新中の問題）は除く。
+	FindSimilarQuestions(ctx context.Context, userID string, excludeQuestionID string, shingles []string, minSimilarity float64, limit int32) ([]domain.SimilarQuestion, error)
+	// ListDistractorOccurrences は誤答の候補を集めるため、関連する問題の選択肢を回答数とともに返す（条件は DistractorQuery を参照）。
+	ListDistractorOccurrences(ctx context.Context, query domain.DistractorQuery) ([]domain.DistractorOccurrence, error)
+	// ListSimilarQuestionPairs は全体（論理削除を除く）から類似度が minSimilarity 以上の問題の組を返す（管理者向け）。
+	ListSimilarQuestionPairs(ctx context.Context, minSimilarity float64, limit int32) ([]domain.SimilarQuestionPair, error)
+	// ListUncitedQuestions は出典が1件もない公開中（非表示でない）の問題を作成の古い順に返す（管理者向け）。
+	// afterQuestionID が空でない場合は、その問題より後ろから返す（keyset ページング）。
+	ListUncitedQuestions(ctx context.Context, afterQuestionID string, limit int32) ([]domain.UncitedQuestion, error)
+
+	// UpsertQuestionTranslation は翻訳を作成/置き換えし、原文の現在の版を SourceVersion として記録する。
+	UpsertQuestionTranslation(ctx context.Context, questionID string, translation domain.QuestionTranslation) (domain.QuestionTranslation, error)
+	// DeleteQuestionTranslation は翻訳を削除する（存在しない場合は NOT_FOUND）。
+	DeleteQuestionTranslation(ctx context.Context, questionID string, locale string) error
+	// ListQuestionTranslations は問題の翻訳を言語タグ順に返す（原文より古いものは Stale=true）。
+	ListQuestionTranslations(ctx context.Context, questionID string) ([]domain.QuestionTranslation, error)
+
+	// GetQuestionAttemptStats は問題への回答と複製数を集計する（率と注意点は呼び出し側で求める）。
+	// Trend は trendSince 以降の回答がある週だけを返し、StrongPlayer* は strong の条件を満たすプレイヤーの回答だけを数える。
+	GetQuestionAttemptStats(ctx context.Context, questionID string, trendSince time.Time, strong domain.StrongPlayerCriteria) (domain.QuestionStats, error)
 
 	// GetQuestionAuthor は所有者チェックのために作成者を返す（deleted_at も含めて取得する）。
 	GetQuestionAuthor(ctx context.Context, questionID string) (authorUserID string, deleted bool, err error)
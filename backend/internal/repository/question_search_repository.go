@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// QuestionSearchRepository は問題の全文検索を抽象化する。
+// NOTE: 検索用の文書は QuestionRepository の作成/更新と同じトランザクションで保存する。
+type QuestionSearchRepository interface {
+	// SearchQuestions は条件に一致する問題を score 降順 → question_id 昇順で返す（論理削除は除外）。
+	SearchQuestions(ctx context.Context, query domain.QuestionSearchQuery) ([]domain.QuestionSearchHit, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// TimelineRepository は「どちらが先か」の出題に使う出来事（年が分かる出来事のエンティティ）を読む。
+// NOTE: 実装は EntityRepository と同じ（entities を読むだけ）。ユースケースのテストで EntityRepository 全体を差し替えずに済むように分けている。
+type TimelineRepository interface {
+	// ListTimelineEvents は年が分かる出来事を、seed から決まる順（年に偏らない）で最大 limit 件返す。
+	// 混同しやすい点: 同じ seed なら同じ順で返す（出来事が増減しない限り）。出来事が limit 件を超えても、どの年の出来事も候補に入りうる。
+	ListTimelineEvents(ctx context.Context, seed string, limit int32) ([]domain.TimelineEvent, error)
+	// ListTimelineEventsByIDs は entityIDs のうち、年が分かる出来事を返す（順不同）。
+	ListTimelineEventsByIDs(ctx context.Context, entityIDs []string) ([]domain.TimelineEvent, error)
+}
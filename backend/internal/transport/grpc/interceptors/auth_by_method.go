@@ -31,3 +31,23 @@ func UnaryRequireAuthByMethodInterceptor(allowAnonymousMethods map[string]struct
 	}
 }
 
+
+// StreamRequireAuthByMethodInterceptor は UnaryRequireAuthByMethodInterceptor の server-streaming 版。
+func StreamRequireAuthByMethodInterceptor(allowAnonymousMethods map[string]struct{}) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if _, ok := allowAnonymousMethods[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		if userID, ok := contextkeys.UserID(ss.Context()); !ok || userID == "" {
+			return status.Error(codes.Unauthenticated, "認証が必要です")
+		}
+
+		return handler(srv, ss)
+	}
+}
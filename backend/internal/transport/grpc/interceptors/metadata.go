@@ -17,9 +17,12 @@ const (
 
 	// metadataKeyRequestID は相関ID（トレース用）を伝播するためのキー。
 	metadataKeyRequestID = "x-request-id"
+
+	// metadataKeyAcceptLanguage は出題の言語の希望（Remix がブラウザの Accept-Language をそのまま渡す）。
+	metadataKeyAcceptLanguage = "accept-language"
 )
 
-// UnaryContextInterceptor は metadata から userId/requestId を取り出し、context に格納する。
+// UnaryContextInterceptor は metadata から userId/requestId/Accept-Language を取り出し、context に格納する。
 // 認証必須のRPCでは、userId が無い場合に UNAUTHENTICATED を返す。
 func UnaryContextInterceptor(requireAuth bool) grpc.UnaryServerInterceptor {
 	return func(
@@ -39,6 +42,10 @@ func UnaryContextInterceptor(requireAuth bool) grpc.UnaryServerInterceptor {
 			ctx = contextkeys.WithUserID(ctx, userID)
 		}
 
+		if acceptLanguage := first(md.Get(metadataKeyAcceptLanguage)); acceptLanguage != "" {
+			ctx = contextkeys.WithAcceptLanguage(ctx, acceptLanguage)
+		}
+
 		// 混同しやすい点:
 		// proto の message に user_id を持たせても、バックエンドは信頼してはいけない（なりすまし可能）。
 		// 最終的な userId は、metadata から取り出した値を context に入れたものを使う。
@@ -57,3 +64,44 @@ func first(values []string) string {
 	return values[0]
 }
 
+
+// StreamContextInterceptor は UnaryContextInterceptor の server-streaming 版。
+// metadata から userId/requestId を取り出し、stream.Context() で参照できるようにする。
+func StreamContextInterceptor(requireAuth bool) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := ss.Context()
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		if requestID := first(md.Get(metadataKeyRequestID)); requestID != "" {
+			ctx = contextkeys.WithRequestID(ctx, requestID)
+		}
+
+		userID := first(md.Get(metadataKeyUserID))
+		if userID != "" {
+			ctx = contextkeys.WithUserID(ctx, userID)
+		}
+
+		if acceptLanguage := first(md.Get(metadataKeyAcceptLanguage)); acceptLanguage != "" {
+			ctx = contextkeys.WithAcceptLanguage(ctx, acceptLanguage)
+		}
+
+		if requireAuth && userID == "" {
+			return status.Error(codes.Unauthenticated, "認証が必要です")
+		}
+
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextServerStream は Context() を差し替えた grpc.ServerStream。
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context { return s.ctx }
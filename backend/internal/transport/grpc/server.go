@@ -3,9 +3,19 @@ package grpcserver
 import (
 	"github.com/history-quiz/historyquiz/internal/transport/grpc/interceptors"
 	"github.com/history-quiz/historyquiz/internal/transport/grpc/services"
+	attachmentusecase "github.com/history-quiz/historyquiz/internal/usecase/attachment"
+	deckusecase "github.com/history-quiz/historyquiz/internal/usecase/deck"
+	entityusecase "github.com/history-quiz/historyquiz/internal/usecase/entity"
+	moderationusecase "github.com/history-quiz/historyquiz/internal/usecase/moderation"
 	questionusecase "github.com/history-quiz/historyquiz/internal/usecase/question"
 	quizusecase "github.com/history-quiz/historyquiz/internal/usecase/quiz"
+	searchusecase "github.com/history-quiz/historyquiz/internal/usecase/search"
+	timelineusecase "github.com/history-quiz/historyquiz/internal/usecase/timeline"
 	userusecase "github.com/history-quiz/historyquiz/internal/usecase/user"
+	attachmentv1 "github.com/history-quiz/historyquiz/proto/attachment/v1"
+	deckv1 "github.com/history-quiz/historyquiz/proto/deck/v1"
+	entityv1 "github.com/history-quiz/historyquiz/proto/entity/v1"
+	moderationv1 "github.com/history-quiz/historyquiz/proto/moderation/v1"
 	questionv1 "github.com/history-quiz/historyquiz/proto/question/v1"
 	quizv1 "github.com/history-quiz/historyquiz/proto/quiz/v1"
 	userv1 "github.com/history-quiz/historyquiz/proto/user/v1"
@@ -16,8 +26,17 @@ import (
 type Dependencies struct {
 	QuizUsecase                   *quizusecase.Usecase
 	QuestionUsecase               *questionusecase.Usecase
+	PassageUsecase                *questionusecase.PassageUsecase
 	UserUsecase                   *userusecase.Usecase
+	ModerationUsecase             *moderationusecase.Usecase
+	SearchUsecase                 *searchusecase.Usecase
+	AttachmentUsecase             *attachmentusecase.Usecase
+	DeckUsecase                   *deckusecase.Usecase
+	EntityUsecase                 *entityusecase.Usecase
+	TimelineUsecase               *timelineusecase.Usecase
 	ObservabilityUnaryInterceptor grpc.UnaryServerInterceptor
+	// ObservabilityStreamInterceptor は server-streaming RPC（書き出し等）の観測用。
+	ObservabilityStreamInterceptor grpc.StreamServerInterceptor
 }
 
 // NewServer は gRPC サーバーを生成する。
@@ -27,6 +46,16 @@ func NewServer(deps Dependencies) *grpc.Server {
 		// クイズは未ログインでも遊べる前提（要件9ではマイページ/作問のみログイン必須）。
 		"/historyquiz.quiz.v1.QuizService/GetQuestion":  {},
 		"/historyquiz.quiz.v1.QuizService/SubmitAnswer": {},
+		// 「どちらが先か」も同じく未ログインで遊べる（回答は記録しない）。
+		"/historyquiz.quiz.v1.QuizService/GetTimelineDuel":      {},
+		"/historyquiz.quiz.v1.QuizService/SubmitTimelineAnswer": {},
+		// 出題中の問題の画像を表示するため（公開中の問題の添付に限る。判定はユースケースで行う）。
+		"/historyquiz.attachment.v1.AttachmentService/GetAttachmentContent": {},
+		// 共有リンクからデッキを開く（遊ぶのは QuizService.GetQuestion の deck_id で行う）。
+		"/historyquiz.deck.v1.DeckService/GetSharedDeck": {},
+		// エンティティ（人物/出来事/場所/王朝）を選んで遊ぶための閲覧（編集は管理者のみ）。
+		"/historyquiz.entity.v1.EntityService/GetEntity":    {},
+		"/historyquiz.entity.v1.EntityService/ListEntities": {},
 	}
 
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
@@ -41,11 +70,27 @@ func NewServer(deps Dependencies) *grpc.Server {
 	// 3) 認証必須メソッドを最終的に遮断
 	unaryInterceptors = append(unaryInterceptors, interceptors.UnaryRequireAuthByMethodInterceptor(allowAnonymous))
 
-	s := grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors...))
+	// streaming RPC も unary と同じ順序で context 注入 → 観測 → 認証を行う。
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		interceptors.StreamContextInterceptor(false),
+	}
+	if deps.ObservabilityStreamInterceptor != nil {
+		streamInterceptors = append(streamInterceptors, deps.ObservabilityStreamInterceptor)
+	}
+	streamInterceptors = append(streamInterceptors, interceptors.StreamRequireAuthByMethodInterceptor(allowAnonymous))
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 
-	quizv1.RegisterQuizServiceServer(s, services.NewQuizService(deps.QuizUsecase))
-	questionv1.RegisterQuestionServiceServer(s, services.NewQuestionService(deps.QuestionUsecase))
+	quizv1.RegisterQuizServiceServer(s, services.NewQuizService(deps.QuizUsecase, deps.TimelineUsecase))
+	questionv1.RegisterQuestionServiceServer(s, services.NewQuestionService(deps.QuestionUsecase, deps.SearchUsecase, deps.PassageUsecase))
 	userv1.RegisterUserServiceServer(s, services.NewUserService(deps.UserUsecase))
+	moderationv1.RegisterModerationServiceServer(s, services.NewModerationService(deps.ModerationUsecase))
+	attachmentv1.RegisterAttachmentServiceServer(s, services.NewAttachmentService(deps.AttachmentUsecase))
+	deckv1.RegisterDeckServiceServer(s, services.NewDeckService(deps.DeckUsecase))
+	entityv1.RegisterEntityServiceServer(s, services.NewEntityService(deps.EntityUsecase))
 
 	return s
 }
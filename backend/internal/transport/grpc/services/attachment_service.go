@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/app/contextkeys"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	attachmentusecase "github.com/history-quiz/historyquiz/internal/usecase/attachment"
+	attachmentv1 "github.com/history-quiz/historyquiz/proto/attachment/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AttachmentService は AttachmentServiceServer 実装。
+type AttachmentService struct {
+	attachmentv1.UnimplementedAttachmentServiceServer
+	usecase *attachmentusecase.Usecase
+}
+
+// NewAttachmentService は AttachmentService を生成する。
+func NewAttachmentService(usecase *attachmentusecase.Usecase) *AttachmentService {
+	return &AttachmentService{usecase: usecase}
+}
+
+func (s *AttachmentService) UploadAttachment(ctx context.Context, req *attachmentv1.UploadAttachmentRequest) (*attachmentv1.UploadAttachmentResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	created, err := s.usecase.UploadAttachment(ctx, userID, toDomainAttachmentKind(req.GetKind()), req.GetContentType(), req.GetContent())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &attachmentv1.UploadAttachmentResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Attachment: &attachmentv1.Attachment{
+			Id:          created.ID,
+			Kind:        toProtoAttachmentKind(created.Kind),
+			ContentType: created.ContentType,
+			SizeBytes:   created.SizeBytes,
+			Sha256:      created.SHA256,
+			CreatedAt:   created.CreatedAt.UTC().Format(time.RFC3339Nano),
+		},
+	}, nil
+}
+
+func (s *AttachmentService) GetAttachmentContent(req *attachmentv1.GetAttachmentContentRequest, stream attachmentv1.AttachmentService_GetAttachmentContentServer) error {
+	if s.usecase == nil {
+		return status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	ctx := stream.Context()
+	userID, _ := contextkeys.UserID(ctx)
+	a, body, err := s.usecase.OpenAttachmentContent(ctx, userID, req.GetAttachmentId())
+	if err != nil {
+		return toStatusError(err)
+	}
+	defer body.Close()
+
+	// 最初のメッセージにだけ content type と大きさを載せる（本体が空でも1通は送る）。
+	first := &attachmentv1.GetAttachmentContentResponse{
+		Context:     requestIDForResponse(ctx, req.GetContext()),
+		ContentType: a.ContentType,
+		SizeBytes:   a.SizeBytes,
+	}
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, readErr := body.Read(buf)
+		if n > 0 {
+			resp := &attachmentv1.GetAttachmentContentResponse{Chunk: append([]byte(nil), buf[:n]...)}
+			if first != nil {
+				first.Chunk = resp.Chunk
+				resp, first = first, nil
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return toStatusError(apperror.Internal("添付の読み込みに失敗しました", readErr))
+		}
+	}
+	if first != nil {
+		return stream.Send(first)
+	}
+	return nil
+}
+
+// attachmentChunkSize は GetAttachmentContent の1メッセージあたりの最大バイト数。
+const attachmentChunkSize = 32 * 1024
+
+// toQuestionAttachments はドメインの添付一覧を proto に変換する（QuestionDetail / quiz の Question で共通）。
+func toQuestionAttachments(attachments []domain.QuestionAttachment) []*attachmentv1.QuestionAttachment {
+	out := make([]*attachmentv1.QuestionAttachment, 0, len(attachments))
+	for _, a := range attachments {
+		out = append(out, &attachmentv1.QuestionAttachment{
+			AttachmentId: a.AttachmentID,
+			Kind:         toProtoAttachmentKind(a.Kind),
+			ContentType:  a.ContentType,
+			SizeBytes:    a.SizeBytes,
+			AltText:      a.AltText,
+		})
+	}
+	return out
+}
+
+func toDomainAttachmentKind(kind attachmentv1.AttachmentKind) domain.AttachmentKind {
+	switch kind {
+	case attachmentv1.AttachmentKind_ATTACHMENT_KIND_IMAGE:
+		return domain.AttachmentKindImage
+	case attachmentv1.AttachmentKind_ATTACHMENT_KIND_MAP:
+		return domain.AttachmentKindMap
+	default:
+		return ""
+	}
+}
+
+func toProtoAttachmentKind(kind domain.AttachmentKind) attachmentv1.AttachmentKind {
+	switch kind {
+	case domain.AttachmentKindImage:
+		return attachmentv1.AttachmentKind_ATTACHMENT_KIND_IMAGE
+	case domain.AttachmentKindMap:
+		return attachmentv1.AttachmentKind_ATTACHMENT_KIND_MAP
+	default:
+		return attachmentv1.AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/app/contextkeys"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	deckusecase "github.com/history-quiz/historyquiz/internal/usecase/deck"
+	commonv1 "github.com/history-quiz/historyquiz/proto/common/v1"
+	deckv1 "github.com/history-quiz/historyquiz/proto/deck/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeckService は DeckServiceServer 実装。
+type DeckService struct {
+	deckv1.UnimplementedDeckServiceServer
+	usecase *deckusecase.Usecase
+}
+
+// NewDeckService は DeckService を生成する。
+func NewDeckService(usecase *deckusecase.Usecase) *DeckService {
+	return &DeckService{usecase: usecase}
+}
+
+func (s *DeckService) CreateDeck(ctx context.Context, req *deckv1.CreateDeckRequest) (*deckv1.CreateDeckResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	created, err := s.usecase.CreateDeck(ctx, userID, toDomainDeckDraft(req.GetDraft()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &deckv1.CreateDeckResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Deck:    toProtoDeck(created),
+	}, nil
+}
+
+func (s *DeckService) UpdateDeck(ctx context.Context, req *deckv1.UpdateDeckRequest) (*deckv1.UpdateDeckResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	updated, err := s.usecase.UpdateDeck(ctx, userID, req.GetDeckId(), toDomainDeckDraft(req.GetDraft()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &deckv1.UpdateDeckResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Deck:    toProtoDeck(updated),
+	}, nil
+}
+
+func (s *DeckService) DeleteDeck(ctx context.Context, req *deckv1.DeleteDeckRequest) (*deckv1.DeleteDeckResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	if err := s.usecase.DeleteDeck(ctx, userID, req.GetDeckId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &deckv1.DeleteDeckResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+	}, nil
+}
+
+func (s *DeckService) GetMyDeck(ctx context.Context, req *deckv1.GetMyDeckRequest) (*deckv1.GetMyDeckResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	d, err := s.usecase.GetMyDeck(ctx, userID, req.GetDeckId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &deckv1.GetMyDeckResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Deck:    toProtoDeck(d),
+	}, nil
+}
+
+func (s *DeckService) ListMyDecks(ctx context.Context, req *deckv1.ListMyDecksRequest) (*deckv1.ListMyDecksResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	decks, nextToken, err := s.usecase.ListMyDecks(ctx, userID, req.GetPagination().GetPageSize())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &deckv1.ListMyDecksResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		PageInfo: &commonv1.PageInfo{
+			NextPageToken: nextToken,
+		},
+	}
+	for _, d := range decks {
+		resp.Decks = append(resp.Decks, toProtoDeck(d))
+	}
+	return resp, nil
+}
+
+func (s *DeckService) GetSharedDeck(ctx context.Context, req *deckv1.GetSharedDeckRequest) (*deckv1.GetSharedDeckResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	d, err := s.usecase.GetSharedDeck(ctx, req.GetShareSlug())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &deckv1.GetSharedDeckResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Deck:    toProtoDeck(d),
+	}, nil
+}
+
+func toDomainDeckDraft(draft *deckv1.DeckDraft) domain.DeckDraft {
+	return domain.DeckDraft{
+		Title:       draft.GetTitle(),
+		Description: draft.GetDescription(),
+		QuestionIDs: draft.GetQuestionIds(),
+	}
+}
+
+func toProtoDeck(d domain.Deck) *deckv1.Deck {
+	return &deckv1.Deck{
+		Id:            d.ID,
+		OwnerUserId:   d.OwnerUserID,
+		Title:         d.Title,
+		Description:   d.Description,
+		ShareSlug:     d.ShareSlug,
+		QuestionIds:   d.QuestionIDs,
+		PlayableCount: d.PlayableCount,
+		CreatedAt:     d.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt:     d.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
@@ -0,0 +1,335 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/app/contextkeys"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	entityusecase "github.com/history-quiz/historyquiz/internal/usecase/entity"
+	"github.com/history-quiz/historyquiz/internal/usecase/entity/entityfile"
+	questionusecase "github.com/history-quiz/historyquiz/internal/usecase/question"
+	commonv1 "github.com/history-quiz/historyquiz/proto/common/v1"
+	entityv1 "github.com/history-quiz/historyquiz/proto/entity/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EntityService は EntityServiceServer 実装。
+type EntityService struct {
+	entityv1.UnimplementedEntityServiceServer
+	usecase *entityusecase.Usecase
+}
+
+// NewEntityService は EntityService を生成する。
+func NewEntityService(usecase *entityusecase.Usecase) *EntityService {
+	return &EntityService{usecase: usecase}
+}
+
+func (s *EntityService) CreateEntity(ctx context.Context, req *entityv1.CreateEntityRequest) (*entityv1.CreateEntityResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	created, err := s.usecase.CreateEntity(ctx, userID, toDomainEntityDraft(req.GetDraft()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &entityv1.CreateEntityResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Entity:  toProtoEntity(created),
+	}, nil
+}
+
+func (s *EntityService) UpdateEntity(ctx context.Context, req *entityv1.UpdateEntityRequest) (*entityv1.UpdateEntityResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	updated, err := s.usecase.UpdateEntity(ctx, userID, req.GetEntityId(), toDomainEntityDraft(req.GetDraft()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &entityv1.UpdateEntityResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Entity:  toProtoEntity(updated),
+	}, nil
+}
+
+func (s *EntityService) DeleteEntity(ctx context.Context, req *entityv1.DeleteEntityRequest) (*entityv1.DeleteEntityResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	if err := s.usecase.DeleteEntity(ctx, userID, req.GetEntityId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &entityv1.DeleteEntityResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+	}, nil
+}
+
+func (s *EntityService) GetEntity(ctx context.Context, req *entityv1.GetEntityRequest) (*entityv1.GetEntityResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	detail, err := s.usecase.GetEntity(ctx, req.GetEntityId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &entityv1.GetEntityResponse{
+		Context:               requestIDForResponse(ctx, req.GetContext()),
+		Entity:                toProtoEntity(detail.Entity),
+		PlayableQuestionCount: detail.PlayableQuestionCount,
+	}
+	for _, r := range detail.Relations {
+		resp.Relations = append(resp.Relations, &entityv1.EntityRelationView{
+			Kind:     toProtoEntityRelationKind(r.Kind),
+			Outgoing: r.Outgoing,
+			Other:    toProtoEntityRef(r.Other),
+		})
+	}
+	return resp, nil
+}
+
+func (s *EntityService) ListEntities(ctx context.Context, req *entityv1.ListEntitiesRequest) (*entityv1.ListEntitiesResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	entities, nextToken, err := s.usecase.ListEntities(ctx, entityusecase.ListQuery{
+		Kind:      toDomainEntityKind(req.GetKind()),
+		NameQuery: req.GetNameQuery(),
+		PageSize:  req.GetPagination().GetPageSize(),
+		PageToken: req.GetPagination().GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &entityv1.ListEntitiesResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		PageInfo: &commonv1.PageInfo{
+			NextPageToken: nextToken,
+		},
+	}
+	for _, e := range entities {
+		resp.Entities = append(resp.Entities, toProtoEntity(e))
+	}
+	return resp, nil
+}
+
+func (s *EntityService) AddEntityRelation(ctx context.Context, req *entityv1.AddEntityRelationRequest) (*entityv1.AddEntityRelationResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	if err := s.usecase.AddEntityRelation(ctx, userID, toDomainEntityRelation(req.GetRelation())); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &entityv1.AddEntityRelationResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+	}, nil
+}
+
+func (s *EntityService) RemoveEntityRelation(ctx context.Context, req *entityv1.RemoveEntityRelationRequest) (*entityv1.RemoveEntityRelationResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	if err := s.usecase.RemoveEntityRelation(ctx, userID, toDomainEntityRelation(req.GetRelation())); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &entityv1.RemoveEntityRelationResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+	}, nil
+}
+
+func (s *EntityService) SetQuestionEntities(ctx context.Context, req *entityv1.SetQuestionEntitiesRequest) (*entityv1.SetQuestionEntitiesResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	refs, err := s.usecase.SetQuestionEntities(ctx, userID, req.GetQuestionId(), req.GetEntityIds())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &entityv1.SetQuestionEntitiesResponse{
+		Context:  requestIDForResponse(ctx, req.GetContext()),
+		Entities: toProtoEntityRefs(refs),
+	}, nil
+}
+
+func (s *EntityService) ListQuestionEntities(ctx context.Context, req *entityv1.ListQuestionEntitiesRequest) (*entityv1.ListQuestionEntitiesResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	refs, err := s.usecase.ListQuestionEntities(ctx, userID, req.GetQuestionId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &entityv1.ListQuestionEntitiesResponse{
+		Context:  requestIDForResponse(ctx, req.GetContext()),
+		Entities: toProtoEntityRefs(refs),
+	}, nil
+}
+
+func (s *EntityService) ImportEntities(ctx context.Context, req *entityv1.ImportEntitiesRequest) (*entityv1.ImportEntitiesResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	res, err := s.usecase.ImportEntities(ctx, userID, toEntityFileFormat(req.GetFormat()), req.GetContent(), req.GetDryRun())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	rowErrs := make([]questionusecase.ImportRowError, 0, len(res.RowErrors))
+	for _, rowErr := range res.RowErrors {
+		rowErrs = append(rowErrs, questionusecase.ImportRowError(rowErr))
+	}
+	return &entityv1.ImportEntitiesResponse{
+		Context:             requestIDForResponse(ctx, req.GetContext()),
+		TotalRows:           int32(res.TotalRows),
+		RowErrors:           toProtoImportRowErrors(rowErrs),
+		CreatedCount:        int32(res.Created),
+		UpdatedCount:        int32(res.Updated),
+		RelationsAddedCount: int32(res.RelationsAdded),
+	}, nil
+}
+
+func toDomainEntityDraft(d *entityv1.EntityDraft) domain.EntityDraft {
+	return domain.EntityDraft{
+		Key:         d.GetKey(),
+		Kind:        toDomainEntityKind(d.GetKind()),
+		Name:        d.GetName(),
+		Description: d.GetDescription(),
+		StartYear:   d.GetStartYear(),
+		EndYear:     d.GetEndYear(),
+		YearMargin:  d.GetYearMargin(),
+	}
+}
+
+func toDomainEntityRelation(r *entityv1.EntityRelation) domain.EntityRelation {
+	return domain.EntityRelation{
+		SubjectID: r.GetSubjectEntityId(),
+		Kind:      toDomainEntityRelationKind(r.GetKind()),
+		ObjectID:  r.GetObjectEntityId(),
+	}
+}
+
+func toProtoEntity(e domain.Entity) *entityv1.Entity {
+	return &entityv1.Entity{
+		Id:          e.ID,
+		Key:         e.Key,
+		Kind:        toProtoEntityKind(e.Kind),
+		Name:        e.Name,
+		Description: e.Description,
+		StartYear:   e.StartYear,
+		EndYear:     e.EndYear,
+		CreatedAt:   e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt:   e.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		YearMargin:  e.YearMargin,
+	}
+}
+
+func toProtoEntityRef(r domain.EntityRef) *entityv1.EntityRef {
+	return &entityv1.EntityRef{
+		Id:   r.ID,
+		Kind: toProtoEntityKind(r.Kind),
+		Name: r.Name,
+	}
+}
+
+func toProtoEntityRefs(refs []domain.EntityRef) []*entityv1.EntityRef {
+	out := make([]*entityv1.EntityRef, 0, len(refs))
+	for _, r := range refs {
+		out = append(out, toProtoEntityRef(r))
+	}
+	return out
+}
+
+func toDomainEntityKind(k entityv1.EntityKind) domain.EntityKind {
+	switch k {
+	case entityv1.EntityKind_ENTITY_KIND_PERSON:
+		return domain.EntityKindPerson
+	case entityv1.EntityKind_ENTITY_KIND_EVENT:
+		return domain.EntityKindEvent
+	case entityv1.EntityKind_ENTITY_KIND_PLACE:
+		return domain.EntityKindPlace
+	case entityv1.EntityKind_ENTITY_KIND_DYNASTY:
+		return domain.EntityKindDynasty
+	default:
+		return ""
+	}
+}
+
+func toProtoEntityKind(k domain.EntityKind) entityv1.EntityKind {
+	switch k {
+	case domain.EntityKindPerson:
+		return entityv1.EntityKind_ENTITY_KIND_PERSON
+	case domain.EntityKindEvent:
+		return entityv1.EntityKind_ENTITY_KIND_EVENT
+	case domain.EntityKindPlace:
+		return entityv1.EntityKind_ENTITY_KIND_PLACE
+	case domain.EntityKindDynasty:
+		return entityv1.EntityKind_ENTITY_KIND_DYNASTY
+	default:
+		return entityv1.EntityKind_ENTITY_KIND_UNSPECIFIED
+	}
+}
+
+func toDomainEntityRelationKind(k entityv1.EntityRelationKind) domain.EntityRelationKind {
+	switch k {
+	case entityv1.EntityRelationKind_ENTITY_RELATION_KIND_PARTICIPATED_IN:
+		return domain.EntityRelationParticipatedIn
+	case entityv1.EntityRelationKind_ENTITY_RELATION_KIND_LOCATED_IN:
+		return domain.EntityRelationLocatedIn
+	case entityv1.EntityRelationKind_ENTITY_RELATION_KIND_MEMBER_OF:
+		return domain.EntityRelationMemberOf
+	default:
+		return ""
+	}
+}
+
+func toProtoEntityRelationKind(k domain.EntityRelationKind) entityv1.EntityRelationKind {
+	switch k {
+	case domain.EntityRelationParticipatedIn:
+		return entityv1.EntityRelationKind_ENTITY_RELATION_KIND_PARTICIPATED_IN
+	case domain.EntityRelationLocatedIn:
+		return entityv1.EntityRelationKind_ENTITY_RELATION_KIND_LOCATED_IN
+	case domain.EntityRelationMemberOf:
+		return entityv1.EntityRelationKind_ENTITY_RELATION_KIND_MEMBER_OF
+	default:
+		return entityv1.EntityRelationKind_ENTITY_RELATION_KIND_UNSPECIFIED
+	}
+}
+
+func toEntityFileFormat(f entityv1.EntityFileFormat) entityfile.Format {
+	switch f {
+	case entityv1.EntityFileFormat_ENTITY_FILE_FORMAT_CSV:
+		return entityfile.FormatCSV
+	case entityv1.EntityFileFormat_ENTITY_FILE_FORMAT_JSON_LD:
+		return entityfile.FormatJSONLD
+	default:
+		return ""
+	}
+}
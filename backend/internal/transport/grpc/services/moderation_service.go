@@ -0,0 +1,235 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/app/contextkeys"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	moderationusecase "github.com/history-quiz/historyquiz/internal/usecase/moderation"
+	commonv1 "github.com/history-quiz/historyquiz/proto/common/v1"
+	moderationv1 "github.com/history-quiz/historyquiz/proto/moderation/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ModerationService は ModerationServiceServer 実装。
+type ModerationService struct {
+	moderationv1.UnimplementedModerationServiceServer
+	usecase *moderationusecase.Usecase
+}
+
+// NewModerationService は ModerationService を生成する。
+func NewModerationService(usecase *moderationusecase.Usecase) *ModerationService {
+	return &ModerationService{usecase: usecase}
+}
+
+func (s *ModerationService) ReportQuestion(ctx context.Context, req *moderationv1.ReportQuestionRequest) (*moderationv1.ReportQuestionResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	res, err := s.usecase.ReportQuestion(ctx, userID, req.GetQuestionId(), toDomainReportReason(req.GetReason()), req.GetDetail())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &moderationv1.ReportQuestionResponse{
+		Context:  requestIDForResponse(ctx, req.GetContext()),
+		ReportId: res.Report.ID,
+	}, nil
+}
+
+func (s *ModerationService) ListOpenReports(ctx context.Context, req *moderationv1.ListOpenReportsRequest) (*moderationv1.ListOpenReportsResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	reports, err := s.usecase.ListOpenReports(ctx, userID, req.GetPagination().GetPageSize())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &moderationv1.ListOpenReportsResponse{
+		Context:  requestIDForResponse(ctx, req.GetContext()),
+		PageInfo: &commonv1.PageInfo{},
+	}
+	for _, r := range reports {
+		resp.Reports = append(resp.Reports, toProtoQuestionReport(r))
+	}
+	return resp, nil
+}
+
+func (s *ModerationService) GetReportedQuestion(ctx context.Context, req *moderationv1.GetReportedQuestionRequest) (*moderationv1.GetReportedQuestionResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	rq, err := s.usecase.GetReportedQuestion(ctx, userID, req.GetQuestionId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &moderationv1.GetReportedQuestionResponse{
+		Context:      requestIDForResponse(ctx, req.GetContext()),
+		Question:     toQuestionDetail(rq.Question),
+		AuthorUserId: rq.AuthorUserID,
+		Hidden:       rq.Hidden,
+	}
+	for _, r := range rq.OpenReports {
+		resp.OpenReports = append(resp.OpenReports, toProtoQuestionReport(r))
+	}
+	return resp, nil
+}
+
+func (s *ModerationService) ResolveReport(ctx context.Context, req *moderationv1.ResolveReportRequest) (*moderationv1.ResolveReportResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	res, err := s.usecase.ResolveReport(ctx, userID, req.GetReportId(), toDomainResolutionAction(req.GetAction()), toDomainDraft(req.GetDraft()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &moderationv1.ResolveReportResponse{
+		Context:       requestIDForResponse(ctx, req.GetContext()),
+		ResolvedCount: res.ResolvedCount,
+		Question:      toQuestionDetail(res.Question),
+	}, nil
+}
+
+func (s *ModerationService) ListDuplicateClusters(ctx context.Context, req *moderationv1.ListDuplicateClustersRequest) (*moderationv1.ListDuplicateClustersResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	clusters, err := s.usecase.ListDuplicateClusters(ctx, userID, req.GetMinSimilarity(), req.GetPagination().GetPageSize())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &moderationv1.ListDuplicateClustersResponse{
+		Context:  requestIDForResponse(ctx, req.GetContext()),
+		PageInfo: &commonv1.PageInfo{},
+	}
+	for _, c := range clusters {
+		cluster := &moderationv1.DuplicateCluster{MaxSimilarity: c.MaxSimilarity}
+		for _, q := range c.Questions {
+			cluster.Questions = append(cluster.Questions, &moderationv1.DuplicateQuestion{
+				QuestionId:   q.QuestionID,
+				AuthorUserId: q.AuthorUserID,
+				Prompt:       q.Prompt,
+				Status:       toProtoQuestionStatus(q.Status),
+				CreatedAt:    q.CreatedAt.UTC().Format(time.RFC3339Nano),
+			})
+		}
+		resp.Clusters = append(resp.Clusters, cluster)
+	}
+	return resp, nil
+}
+
+func (s *ModerationService) ListUncitedQuestions(ctx context.Context, req *moderationv1.ListUncitedQuestionsRequest) (*moderationv1.ListUncitedQuestionsResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	questions, nextPageToken, err := s.usecase.ListUncitedQuestions(ctx, userID, req.GetPagination().GetPageToken(), req.GetPagination().GetPageSize())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &moderationv1.ListUncitedQuestionsResponse{
+		Context:  requestIDForResponse(ctx, req.GetContext()),
+		PageInfo: &commonv1.PageInfo{NextPageToken: nextPageToken},
+	}
+	for _, q := range questions {
+		resp.Questions = append(resp.Questions, &moderationv1.UncitedQuestion{
+			QuestionId:   q.QuestionID,
+			AuthorUserId: q.AuthorUserID,
+			Prompt:       q.Prompt,
+			CreatedAt:    q.CreatedAt.UTC().Format(time.RFC3339Nano),
+		})
+	}
+	return resp, nil
+}
+
+func toProtoQuestionReport(r domain.QuestionReport) *moderationv1.QuestionReport {
+	return &moderationv1.QuestionReport{
+		Id:             r.ID,
+		QuestionId:     r.QuestionID,
+		QuestionPrompt: r.QuestionPrompt,
+		ReporterUserId: r.ReporterUserID,
+		Reason:         toProtoReportReason(r.Reason),
+		Detail:         r.Detail,
+		Status:         toProtoReportStatus(r.Status),
+		CreatedAt:      r.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func toDomainReportReason(r moderationv1.ReportReason) domain.ReportReason {
+	switch r {
+	case moderationv1.ReportReason_REPORT_REASON_WRONG_ANSWER:
+		return domain.ReportReasonWrongAnswer
+	case moderationv1.ReportReason_REPORT_REASON_AMBIGUOUS:
+		return domain.ReportReasonAmbiguous
+	case moderationv1.ReportReason_REPORT_REASON_TYPO:
+		return domain.ReportReasonTypo
+	case moderationv1.ReportReason_REPORT_REASON_INAPPROPRIATE:
+		return domain.ReportReasonInappropriate
+	case moderationv1.ReportReason_REPORT_REASON_OTHER:
+		return domain.ReportReasonOther
+	default:
+		// UNSPECIFIED は usecase 側で INVALID_ARGUMENT にする。
+		return ""
+	}
+}
+
+func toProtoReportReason(r domain.ReportReason) moderationv1.ReportReason {
+	switch r {
+	case domain.ReportReasonWrongAnswer:
+		return moderationv1.ReportReason_REPORT_REASON_WRONG_ANSWER
+	case domain.ReportReasonAmbiguous:
+		return moderationv1.ReportReason_REPORT_REASON_AMBIGUOUS
+	case domain.ReportReasonTypo:
+		return moderationv1.ReportReason_REPORT_REASON_TYPO
+	case domain.ReportReasonInappropriate:
+		return moderationv1.ReportReason_REPORT_REASON_INAPPROPRIATE
+	case domain.ReportReasonOther:
+		return moderationv1.ReportReason_REPORT_REASON_OTHER
+	default:
+		return moderationv1.ReportReason_REPORT_REASON_UNSPECIFIED
+	}
+}
+
+func toProtoReportStatus(s domain.ReportStatus) moderationv1.ReportStatus {
+	switch s {
+	case domain.ReportStatusOpen:
+		return moderationv1.ReportStatus_REPORT_STATUS_OPEN
+	case domain.ReportStatusDismissed:
+		return moderationv1.ReportStatus_REPORT_STATUS_DISMISSED
+	case domain.ReportStatusResolved:
+		return moderationv1.ReportStatus_REPORT_STATUS_RESOLVED
+	default:
+		return moderationv1.ReportStatus_REPORT_STATUS_UNSPECIFIED
+	}
+}
+
+func toDomainResolutionAction(a moderationv1.ResolutionAction) domain.ResolutionAction {
+	switch a {
+	case moderationv1.ResolutionAction_RESOLUTION_ACTION_DISMISS:
+		return domain.ResolutionDismiss
+	case moderationv1.ResolutionAction_RESOLUTION_ACTION_HIDE:
+		return domain.ResolutionHide
+	case moderationv1.ResolutionAction_RESOLUTION_ACTION_EDIT:
+		return domain.ResolutionEdit
+	default:
+		return ""
+	}
+}
@@ -1,12 +1,19 @@
 package services
 
 import (
+	"bufio"
 	"context"
 	"time"
 
 	"github.com/history-quiz/historyquiz/internal/app/contextkeys"
 	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
+	"github.com/history-quiz/historyquiz/internal/domain/richtext"
 	questionusecase "github.com/history-quiz/historyquiz/internal/usecase/question"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questiontemplate"
+	searchusecase "github.com/history-quiz/historyquiz/internal/usecase/search"
 	commonv1 "github.com/history-quiz/historyquiz/proto/common/v1"
 	questionv1 "github.com/history-quiz/historyquiz/proto/question/v1"
 	"google.golang.org/grpc/codes"
@@ -16,12 +23,14 @@ import (
 // QuestionService は QuestionServiceServer 実装。
 type QuestionService struct {
 	questionv1.UnimplementedQuestionServiceServer
-	usecase *questionusecase.Usecase
+	usecase        *questionusecase.Usecase
+	searchUsecase  *searchusecase.Usecase
+	passageUsecase *questionusecase.PassageUsecase
 }
 
 // NewQuestionService は QuestionService を生成する。
-func NewQuestionService(usecase *questionusecase.Usecase) *QuestionService {
-	return &QuestionService{usecase: usecase}
+func NewQuestionService(usecase *questionusecase.Usecase, searchUsecase *searchusecase.Usecase, passageUsecase *questionusecase.PassageUsecase) *QuestionService {
+	return &QuestionService{usecase: usecase, searchUsecase: searchUsecase, passageUsecase: passageUsecase}
 }
 
 func (s *QuestionService) CreateQuestion(ctx context.Context, req *questionv1.CreateQuestionRequest) (*questionv1.CreateQuestionResponse, error) {
@@ -30,21 +39,17 @@ func (s *QuestionService) CreateQuestion(ctx context.Context, req *questionv1.Cr
 	}
 
 	userID, _ := contextkeys.UserID(ctx)
-	draft := domain.QuestionDraft{
-		Prompt:         req.GetDraft().GetPrompt(),
-		Choices:        req.GetDraft().GetChoices(),
-		CorrectOrdinal: req.GetDraft().GetCorrectOrdinal(),
-		Explanation:    req.GetDraft().GetExplanation(),
-	}
+	draft := toDomainDraft(req.GetDraft())
 
-	created, err := s.usecase.CreateQuestion(ctx, userID, draft)
+	created, similar, err := s.usecase.CreateQuestion(ctx, userID, draft)
 	if err != nil {
 		return nil, toStatusError(err)
 	}
 
 	return &questionv1.CreateQuestionResponse{
-		Context:  requestIDForResponse(ctx, req.GetContext()),
-		Question: toQuestionDetail(created),
+		Context:          requestIDForResponse(ctx, req.GetContext()),
+		Question:         toQuestionDetail(created),
+		SimilarQuestions: toSimilarQuestions(similar),
 	}, nil
 }
 
@@ -54,21 +59,32 @@ func (s *QuestionService) UpdateQuestion(ctx context.Context, req *questionv1.Up
 	}
 
 	userID, _ := contextkeys.UserID(ctx)
-	draft := domain.QuestionDraft{
-		Prompt:         req.GetDraft().GetPrompt(),
-		Choices:        req.GetDraft().GetChoices(),
-		CorrectOrdinal: req.GetDraft().GetCorrectOrdinal(),
-		Explanation:    req.GetDraft().GetExplanation(),
-	}
+	draft := toDomainDraft(req.GetDraft())
 
-	updated, err := s.usecase.UpdateQuestion(ctx, userID, req.GetQuestionId(), draft)
+	updated, similar, err := s.usecase.UpdateQuestion(ctx, userID, req.GetQuestionId(), req.GetExpectedVersion(), draft, toScheduleInput(req.GetSchedule()), time.Now())
 	if err != nil {
 		return nil, toStatusError(err)
 	}
 
 	return &questionv1.UpdateQuestionResponse{
-		Context:  requestIDForResponse(ctx, req.GetContext()),
-		Question: toQuestionDetail(updated),
+		Context:          requestIDForResponse(ctx, req.GetContext()),
+		Question:         toQuestionDetail(updated),
+		SimilarQuestions: toSimilarQuestions(similar),
+	}, nil
+}
+
+func (s *QuestionService) DeleteQuestion(ctx context.Context, req *questionv1.DeleteQuestionRequest) (*questionv1.DeleteQuestionResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	if err := s.usecase.DeleteQuestion(ctx, userID, req.GetQuestionId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.DeleteQuestionResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
 	}, nil
 }
 
@@ -89,13 +105,146 @@ func (s *QuestionService) GetMyQuestion(ctx context.Context, req *questionv1.Get
 	}, nil
 }
 
+func (s *QuestionService) ForkQuestion(ctx context.Context, req *questionv1.ForkQuestionRequest) (*questionv1.ForkQuestionResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	q, err := s.usecase.ForkQuestion(ctx, userID, req.GetQuestionId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.ForkQuestionResponse{
+		Context:  requestIDForResponse(ctx, req.GetContext()),
+		Question: toQuestionDetail(q),
+	}, nil
+}
+
+func (s *QuestionService) CreatePassage(ctx context.Context, req *questionv1.CreatePassageRequest) (*questionv1.CreatePassageResponse, error) {
+	if s.passageUsecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	created, err := s.passageUsecase.CreatePassage(ctx, userID, toDomainPassageDraft(req.GetDraft()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.CreatePassageResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Passage: toProtoPassageDetail(created),
+	}, nil
+}
+
+func (s *QuestionService) UpdatePassage(ctx context.Context, req *questionv1.UpdatePassageRequest) (*questionv1.UpdatePassageResponse, error) {
+	if s.passageUsecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	updated, err := s.passageUsecase.UpdatePassage(ctx, userID, req.GetPassageId(), req.GetExpectedVersion(), toDomainPassageDraft(req.GetDraft()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.UpdatePassageResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Passage: toProtoPassageDetail(updated),
+	}, nil
+}
+
+func (s *QuestionService) GetMyPassage(ctx context.Context, req *questionv1.GetMyPassageRequest) (*questionv1.GetMyPassageResponse, error) {
+	if s.passageUsecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	p, err := s.passageUsecase.GetMyPassage(ctx, userID, req.GetPassageId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.GetMyPassageResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Passage: toProtoPassageDetail(p),
+	}, nil
+}
+
+func (s *QuestionService) DeletePassage(ctx context.Context, req *questionv1.DeletePassageRequest) (*questionv1.DeletePassageResponse, error) {
+	if s.passageUsecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	if err := s.passageUsecase.DeletePassage(ctx, userID, req.GetPassageId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.DeletePassageResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+	}, nil
+}
+
+func (s *QuestionService) SuggestDistractors(ctx context.Context, req *questionv1.SuggestDistractorsRequest) (*questionv1.SuggestDistractorsResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	suggestions, err := s.usecase.SuggestDistractors(ctx, userID, questionusecase.DistractorRequest{
+		Prompt:            req.GetPrompt(),
+		CorrectAnswer:     req.GetCorrectAnswer(),
+		Tags:              req.GetTags(),
+		ExcludeChoices:    req.GetExcludeChoices(),
+		ExcludeQuestionID: req.GetExcludeQuestionId(),
+		Limit:             req.GetLimit(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	out := make([]*questionv1.DistractorSuggestion, 0, len(suggestions))
+	for _, sg := range suggestions {
+		out = append(out, &questionv1.DistractorSuggestion{
+			Label:             sg.Label,
+			Score:             sg.Score,
+			CoOccurrenceCount: sg.CoOccurrenceCount,
+			SameTagCount:      sg.SameTagCount,
+			PickCount:         sg.PickCount,
+			QuestionCount:     sg.QuestionCount,
+		})
+	}
+	return &questionv1.SuggestDistractorsResponse{
+		Context:     requestIDForResponse(ctx, req.GetContext()),
+		Suggestions: out,
+	}, nil
+}
+
 func (s *QuestionService) ListMyQuestions(ctx context.Context, req *questionv1.ListMyQuestionsRequest) (*questionv1.ListMyQuestionsResponse, error) {
 	if s.usecase == nil {
 		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
 	}
 
 	userID, _ := contextkeys.UserID(ctx)
-	questions, nextToken, err := s.usecase.ListMyQuestions(ctx, userID, req.GetPagination().GetPageSize())
+	statuses := make([]domain.QuestionStatus, 0, len(req.GetStatuses()))
+	for _, st := range req.GetStatuses() {
+		statuses = append(statuses, toDomainQuestionStatus(st))
+	}
+	questions, nextToken, err := s.usecase.ListMyQuestions(ctx, userID, questionusecase.ListQuery{
+		Statuses:       statuses,
+		Tag:            req.GetTag(),
+		CreatedFrom:    req.GetCreatedFrom(),
+		CreatedTo:      req.GetCreatedTo(),
+		HasExplanation: toHasExplanation(req.GetExplanation()),
+		Keyword:        req.GetKeyword(),
+		Sort:           toDomainQuestionSortKey(req.GetSort()),
+		Ascending:      req.GetAscending(),
+		PageToken:      req.GetPagination().GetPageToken(),
+		PageSize:       req.GetPagination().GetPageSize(),
+	})
 	if err != nil {
 		return nil, toStatusError(err)
 	}
@@ -108,14 +257,481 @@ func (s *QuestionService) ListMyQuestions(ctx context.Context, req *questionv1.L
 	}
 	for _, q := range questions {
 		resp.Questions = append(resp.Questions, &questionv1.QuestionSummary{
-			Id:        q.ID,
-			Prompt:    q.Prompt,
-			UpdatedAt: q.UpdatedAt.UTC().Format(time.RFC3339Nano),
+			Id:              q.ID,
+			Prompt:          q.Prompt,
+			UpdatedAt:       q.UpdatedAt.UTC().Format(time.RFC3339Nano),
+			Status:          toProtoQuestionStatus(q.Status),
+			CreatedAt:       q.CreatedAt.UTC().Format(time.RFC3339Nano),
+			AttemptCount:    q.AttemptCount,
+			CorrectAttempts: q.CorrectAttempts,
+			Accuracy:        q.Accuracy,
 		})
 	}
 	return resp, nil
 }
 
+// toDomainQuestionSortKey は proto の並び替えの基準をドメインの値に変換する（未指定は空文字 = 既定）。
+// 未知の値はそのまま文字列にして usecase で INVALID_ARGUMENT にする。
+func toDomainQuestionSortKey(k questionv1.QuestionSortKey) domain.QuestionSortKey {
+	switch k {
+	case questionv1.QuestionSortKey_QUESTION_SORT_KEY_UNSPECIFIED:
+		return ""
+	case questionv1.QuestionSortKey_QUESTION_SORT_KEY_UPDATED_AT:
+		return domain.QuestionSortUpdatedAt
+	case questionv1.QuestionSortKey_QUESTION_SORT_KEY_CREATED_AT:
+		return domain.QuestionSortCreatedAt
+	case questionv1.QuestionSortKey_QUESTION_SORT_KEY_ACCURACY:
+		return domain.QuestionSortAccuracy
+	case questionv1.QuestionSortKey_QUESTION_SORT_KEY_ATTEMPT_COUNT:
+		return domain.QuestionSortAttemptCount
+	default:
+		return domain.QuestionSortKey(k.String())
+	}
+}
+
+// toHasExplanation は解説の有無での絞り込みを変換する（未指定は nil = 絞り込まない）。
+func toHasExplanation(f questionv1.ExplanationFilter) *bool {
+	var has bool
+	switch f {
+	case questionv1.ExplanationFilter_EXPLANATION_FILTER_WITH_EXPLANATION:
+		has = true
+	case questionv1.ExplanationFilter_EXPLANATION_FILTER_WITHOUT_EXPLANATION:
+		has = false
+	default:
+		return nil
+	}
+	return &has
+}
+
+func (s *QuestionService) PublishQuestion(ctx context.Context, req *questionv1.PublishQuestionRequest) (*questionv1.PublishQuestionResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	q, err := s.usecase.PublishQuestion(ctx, userID, req.GetQuestionId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.PublishQuestionResponse{
+		Context:  requestIDForResponse(ctx, req.GetContext()),
+		Question: toQuestionDetail(q),
+	}, nil
+}
+
+func (s *QuestionService) UnpublishQuestion(ctx context.Context, req *questionv1.UnpublishQuestionRequest) (*questionv1.UnpublishQuestionResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	q, err := s.usecase.UnpublishQuestion(ctx, userID, req.GetQuestionId(), toDomainQuestionStatus(req.GetTargetStatus()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.UnpublishQuestionResponse{
+		Context:  requestIDForResponse(ctx, req.GetContext()),
+		Question: toQuestionDetail(q),
+	}, nil
+}
+
+func (s *QuestionService) ImportQuestions(ctx context.Context, req *questionv1.ImportQuestionsRequest) (*questionv1.ImportQuestionsResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	res, err := s.usecase.ImportQuestions(ctx, userID, toQuestionFileFormat(req.GetFormat()), req.GetContent(), req.GetDryRun())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &questionv1.ImportQuestionsResponse{
+		Context:   requestIDForResponse(ctx, req.GetContext()),
+		TotalRows: int32(res.TotalRows),
+	}
+	resp.RowErrors = toProtoImportRowErrors(res.RowErrors)
+	for _, q := range res.Imported {
+		resp.Questions = append(resp.Questions, toCreatedQuestionSummary(q))
+	}
+	return resp, nil
+}
+
+func (s *QuestionService) GenerateQuestionsFromTemplate(ctx context.Context, req *questionv1.GenerateQuestionsFromTemplateRequest) (*questionv1.GenerateQuestionsFromTemplateResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	tmpl := req.GetTemplate()
+	res, err := s.usecase.GenerateQuestionsFromTemplate(ctx, userID, questiontemplate.Template{
+		Prompt:      tmpl.GetPrompt(),
+		Answer:      tmpl.GetAnswer(),
+		Explanation: tmpl.GetExplanation(),
+		EraField:    tmpl.GetEraField(),
+		Tags:        tmpl.GetTags(),
+	}, toQuestionFileFormat(req.GetFormat()), req.GetDataset(), req.GetDryRun())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &questionv1.GenerateQuestionsFromTemplateResponse{
+		Context:   requestIDForResponse(ctx, req.GetContext()),
+		TotalRows: int32(res.TotalRows),
+		RowErrors: toProtoImportRowErrors(res.RowErrors),
+		Skipped:   toProtoImportRowErrors(res.Skipped),
+	}
+	for _, p := range res.Previews {
+		resp.Previews = append(resp.Previews, &questionv1.TemplatePreview{
+			Row: int32(p.Row),
+			Draft: &questionv1.QuestionDraft{
+				Prompt:          p.Draft.Prompt,
+				Choices:         p.Draft.Choices,
+				CorrectOrdinal:  p.Draft.CorrectOrdinal,
+				Explanation:     p.Draft.Explanation,
+				AcceptedAnswers: p.Draft.AcceptedAnswers,
+				Tags:            p.Draft.Tags,
+			},
+		})
+	}
+	for _, q := range res.Created {
+		resp.Questions = append(resp.Questions, toCreatedQuestionSummary(q))
+	}
+	return resp, nil
+}
+
+func toProtoImportRowErrors(rowErrs []questionusecase.ImportRowError) []*questionv1.ImportRowError {
+	var out []*questionv1.ImportRowError
+	for _, rowErr := range rowErrs {
+		protoRowErr := &questionv1.ImportRowError{Row: int32(rowErr.Row)}
+		for _, v := range rowErr.Violations {
+			protoRowErr.FieldViolations = append(protoRowErr.FieldViolations, &commonv1.FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		out = append(out, protoRowErr)
+	}
+	return out
+}
+
+// toCreatedQuestionSummary は一括作成した問題の一覧の要素を返す（一覧と違い、タグ/回答数などは含めない）。
+func toCreatedQuestionSummary(q domain.QuestionDetail) *questionv1.QuestionSummary {
+	return &questionv1.QuestionSummary{
+		Id:        q.ID,
+		Prompt:    q.Prompt,
+		UpdatedAt: q.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		Status:    toProtoQuestionStatus(q.Status),
+	}
+}
+
+func (s *QuestionService) ExportMyQuestions(req *questionv1.ExportMyQuestionsRequest, stream questionv1.QuestionService_ExportMyQuestionsServer) error {
+	if s.usecase == nil {
+		return status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	ctx := stream.Context()
+	userID, _ := contextkeys.UserID(ctx)
+
+	// chunk 単位で送る（1メッセージが大きくなりすぎないように、書き出し結果をバッファしてから送信する）。
+	sender := &exportChunkSender{stream: stream}
+	buffered := bufio.NewWriterSize(sender, exportChunkSize)
+	count, err := s.usecase.ExportMyQuestions(ctx, userID, toQuestionFileFormat(req.GetFormat()), buffered)
+	if err != nil {
+		return toStatusError(err)
+	}
+	if err := buffered.Flush(); err != nil {
+		return toStatusError(err)
+	}
+
+	return stream.Send(&questionv1.ExportMyQuestionsResponse{
+		Context:       requestIDForResponse(ctx, req.GetContext()),
+		QuestionCount: int32(count),
+	})
+}
+
+// exportChunkSize は ExportMyQuestions の1メッセージあたりの最大バイト数。
+const exportChunkSize = 32 * 1024
+
+// exportChunkSender は書き込まれたバイト列を ExportMyQuestionsResponse.chunk として送る io.Writer。
+type exportChunkSender struct {
+	stream questionv1.QuestionService_ExportMyQuestionsServer
+}
+
+func (w *exportChunkSender) Write(p []byte) (int, error) {
+	// NOTE: Send 後に p が再利用されても壊れないようコピーする。
+	chunk := append([]byte(nil), p...)
+	if err := w.stream.Send(&questionv1.ExportMyQuestionsResponse{Chunk: chunk}); err != nil {
+		return 0, apperror.Internal("書き出しの送信に失敗しました", err)
+	}
+	return len(p), nil
+}
+
+// toQuestionFileFormat は proto のファイル形式を questionfile.Format に変換する（未指定は空文字）。
+func (s *QuestionService) SearchQuestions(ctx context.Context, req *questionv1.SearchQuestionsRequest) (*questionv1.SearchQuestionsResponse, error) {
+	if s.searchUsecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	statuses := make([]domain.QuestionStatus, 0, len(req.GetStatuses()))
+	for _, st := range req.GetStatuses() {
+		statuses = append(statuses, toDomainQuestionStatus(st))
+	}
+	hits, nextToken, err := s.searchUsecase.SearchQuestions(ctx, userID, searchusecase.Query{
+		Text:         req.GetQuery(),
+		AuthorUserID: req.GetAuthorUserId(),
+		Tag:          req.GetTag(),
+		Statuses:     statuses,
+		PageSize:     req.GetPagination().GetPageSize(),
+		PageToken:    req.GetPagination().GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &questionv1.SearchQuestionsResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		PageInfo: &commonv1.PageInfo{
+			NextPageToken: nextToken,
+		},
+	}
+	for _, h := range hits {
+		hit := &questionv1.QuestionSearchHit{
+			Question: &questionv1.QuestionSummary{
+				Id:        h.Question.QuestionID,
+				Prompt:    h.Question.Prompt,
+				UpdatedAt: h.Question.UpdatedAt.UTC().Format(time.RFC3339Nano),
+				Status:    toProtoQuestionStatus(h.Question.Status),
+			},
+			AuthorUserId: h.Question.AuthorUserID,
+			Tags:         h.Question.Tags,
+			Score:        h.Question.Score,
+		}
+		for _, sn := range h.Snippets {
+			snippet := &questionv1.SearchSnippet{
+				Field:         toProtoSearchField(sn.Field),
+				ChoiceOrdinal: sn.ChoiceOrdinal,
+			}
+			for _, seg := range sn.Segments {
+				snippet.Segments = append(snippet.Segments, &questionv1.SearchSnippetSegment{Text: seg.Text, Highlighted: seg.Highlighted})
+			}
+			hit.Snippets = append(hit.Snippets, snippet)
+		}
+		resp.Hits = append(resp.Hits, hit)
+	}
+	return resp, nil
+}
+
+func toProtoSearchField(f searchusecase.Field) questionv1.SearchField {
+	switch f {
+	case searchusecase.FieldPrompt:
+		return questionv1.SearchField_SEARCH_FIELD_PROMPT
+	case searchusecase.FieldChoice:
+		return questionv1.SearchField_SEARCH_FIELD_CHOICE
+	case searchusecase.FieldExplanation:
+		return questionv1.SearchField_SEARCH_FIELD_EXPLANATION
+	default:
+		return questionv1.SearchField_SEARCH_FIELD_UNSPECIFIED
+	}
+}
+
+func toQuestionFileFormat(f questionv1.QuestionFileFormat) questionfile.Format {
+	switch f {
+	case questionv1.QuestionFileFormat_QUESTION_FILE_FORMAT_CSV:
+		return questionfile.FormatCSV
+	case questionv1.QuestionFileFormat_QUESTION_FILE_FORMAT_JSON:
+		return questionfile.FormatJSON
+	case questionv1.QuestionFileFormat_QUESTION_FILE_FORMAT_ANKI_TSV:
+		return questionfile.FormatAnkiTSV
+	default:
+		return ""
+	}
+}
+
+// toDomainDraft は proto の QuestionDraft をドメインモデルに変換する（作成/更新で共通）。
+func toDomainDraft(d *questionv1.QuestionDraft) domain.QuestionDraft {
+	return domain.QuestionDraft{
+		Kind:            toDomainQuestionKind(d.GetKind()),
+		Prompt:          d.GetPrompt(),
+		Choices:         d.GetChoices(),
+		CorrectOrdinal:  d.GetCorrectOrdinal(),
+		Explanation:     d.GetExplanation(),
+		AcceptedAnswers: d.GetAcceptedAnswers(),
+		Tags:            d.GetTags(),
+		Attachments:     toDomainAttachmentRefs(d.GetAttachments()),
+		Citations:       toDomainCitations(d.GetCitations()),
+		Location:        toDomainQuestionLocation(d.GetLocation()),
+	}
+}
+
+func toDomainPassageDraft(d *questionv1.PassageDraft) domain.PassageDraft {
+	draft := domain.PassageDraft{
+		Body:   d.GetBody(),
+		Source: toDomainCitation(d.GetSource()),
+	}
+	if ref := d.GetAttachment(); ref != nil {
+		draft.Attachment = &domain.AttachmentRef{AttachmentID: ref.GetAttachmentId(), AltText: ref.GetAltText()}
+	}
+	for _, q := range d.GetQuestions() {
+		draft.Questions = append(draft.Questions, domain.PassageQuestionDraft{
+			QuestionID: q.GetQuestionId(),
+			Draft:      toDomainDraft(q.GetDraft()),
+		})
+	}
+	return draft
+}
+
+// toProtoPassage は史料を proto に変換する（PassageDetail / 出題中の QuizPassage で共通）。
+func toProtoPassage(p domain.Passage) *questionv1.Passage {
+	out := &questionv1.Passage{
+		Id:       p.ID,
+		Body:     p.Body,
+		Source:   toProtoCitations([]domain.Citation{p.Source})[0],
+		BodyRich: toProtoRichText(p.Body),
+	}
+	if p.Attachment != nil {
+		out.Attachment = toQuestionAttachments([]domain.QuestionAttachment{*p.Attachment})[0]
+	}
+	return out
+}
+
+func toProtoPassageDetail(p domain.PassageDetail) *questionv1.PassageDetail {
+	out := &questionv1.PassageDetail{
+		Passage:   toProtoPassage(p.Passage),
+		Version:   p.Version,
+		UpdatedAt: p.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+	for _, q := range p.Questions {
+		out.Questions = append(out.Questions, toQuestionDetail(q))
+	}
+	return out
+}
+
+// toDomainQuestionKind は問題の種類をドメインモデルに変換する（未指定は空 = 4択）。
+// 混同しやすい点: 未知の値は空にせず残し、ValidateDraft で draft.kind の違反にする。
+func toDomainQuestionKind(kind questionv1.QuestionKind) domain.QuestionKind {
+	switch kind {
+	case questionv1.QuestionKind_QUESTION_KIND_UNSPECIFIED:
+		return ""
+	case questionv1.QuestionKind_QUESTION_KIND_CHOICE:
+		return domain.QuestionKindChoice
+	case questionv1.QuestionKind_QUESTION_KIND_LOCATION:
+		return domain.QuestionKindLocation
+	default:
+		return domain.QuestionKind(kind.String())
+	}
+}
+
+// toProtoQuestionKind は問題の種類を proto に変換する（QuestionDetail / 出題中の Question で共通。空は4択）。
+func toProtoQuestionKind(kind domain.QuestionKind) questionv1.QuestionKind {
+	switch kind {
+	case "", domain.QuestionKindChoice:
+		return questionv1.QuestionKind_QUESTION_KIND_CHOICE
+	case domain.QuestionKindLocation:
+		return questionv1.QuestionKind_QUESTION_KIND_LOCATION
+	default:
+		return questionv1.QuestionKind_QUESTION_KIND_UNSPECIFIED
+	}
+}
+
+// toDomainQuestionLocation は正解の地点をドメインモデルに変換する（未設定の場合は nil）。
+func toDomainQuestionLocation(l *questionv1.QuestionLocation) *domain.QuestionLocation {
+	if l == nil {
+		return nil
+	}
+	return &domain.QuestionLocation{
+		Point:    geo.Point{Lat: l.GetPoint().GetLatitude(), Lng: l.GetPoint().GetLongitude()},
+		RadiusKm: l.GetRadiusKm(),
+	}
+}
+
+// toProtoQuestionLocation は正解の地点を proto に変換する（QuestionDetail / SubmitAnswerResponse で共通）。
+func toProtoQuestionLocation(l *domain.QuestionLocation) *questionv1.QuestionLocation {
+	if l == nil {
+		return nil
+	}
+	return &questionv1.QuestionLocation{
+		Point:    &commonv1.LatLng{Latitude: l.Point.Lat, Longitude: l.Point.Lng},
+		RadiusKm: l.RadiusKm,
+	}
+}
+
+func toDomainAttachmentRefs(refs []*questionv1.AttachmentRef) []domain.AttachmentRef {
+	if len(refs) == 0 {
+		return nil
+	}
+	out := make([]domain.AttachmentRef, 0, len(refs))
+	for _, ref := range refs {
+		out = append(out, domain.AttachmentRef{AttachmentID: ref.GetAttachmentId(), AltText: ref.GetAltText()})
+	}
+	return out
+}
+
+func toDomainCitations(citations []*questionv1.Citation) []domain.Citation {
+	if len(citations) == 0 {
+		return nil
+	}
+	out := make([]domain.Citation, 0, len(citations))
+	for _, c := range citations {
+		out = append(out, toDomainCitation(c))
+	}
+	return out
+}
+
+func toDomainCitation(c *questionv1.Citation) domain.Citation {
+	return domain.Citation{
+		Kind:    toDomainCitationKind(c.GetKind()),
+		Title:   c.GetTitle(),
+		Author:  c.GetAuthor(),
+		Locator: c.GetLocator(),
+		URL:     c.GetUrl(),
+	}
+}
+
+// toProtoCitations は出典を proto に変換する（QuestionDetail / SubmitAnswerResponse で共通）。
+func toProtoCitations(citations []domain.Citation) []*questionv1.Citation {
+	out := make([]*questionv1.Citation, 0, len(citations))
+	for _, c := range citations {
+		out = append(out, &questionv1.Citation{
+			Kind:    toProtoCitationKind(c.Kind),
+			Title:   c.Title,
+			Author:  c.Author,
+			Locator: c.Locator,
+			Url:     c.URL,
+		})
+	}
+	return out
+}
+
+func toDomainCitationKind(kind questionv1.CitationKind) domain.CitationKind {
+	switch kind {
+	case questionv1.CitationKind_CITATION_KIND_BOOK:
+		return domain.CitationKindBook
+	case questionv1.CitationKind_CITATION_KIND_WEB:
+		return domain.CitationKindWeb
+	case questionv1.CitationKind_CITATION_KIND_PRIMARY_SOURCE:
+		return domain.CitationKindPrimarySource
+	default:
+		return ""
+	}
+}
+
+func toProtoCitationKind(kind domain.CitationKind) questionv1.CitationKind {
+	switch kind {
+	case domain.CitationKindBook:
+		return questionv1.CitationKind_CITATION_KIND_BOOK
+	case domain.CitationKindWeb:
+		return questionv1.CitationKind_CITATION_KIND_WEB
+	case domain.CitationKindPrimarySource:
+		return questionv1.CitationKind_CITATION_KIND_PRIMARY_SOURCE
+	default:
+		return questionv1.CitationKind_CITATION_KIND_UNSPECIFIED
+	}
+}
+
 // toQuestionDetail はドメインモデルを proto の QuestionDetail に変換する。
 func toQuestionDetail(q domain.QuestionDetail) *questionv1.QuestionDetail {
 	d := &questionv1.QuestionDetail{
@@ -124,6 +740,20 @@ func toQuestionDetail(q domain.QuestionDetail) *questionv1.QuestionDetail {
 		CorrectChoiceId:  q.CorrectChoiceID,
 		Explanation:      q.Explanation,
 		UpdatedAt:        q.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		AcceptedAnswers:  q.AcceptedAnswers,
+		Status:           toProtoQuestionStatus(q.Status),
+		Hidden:           q.Hidden,
+		Tags:             q.Tags,
+		Version:          q.Version,
+		Attachments:      toQuestionAttachments(q.Attachments),
+		Citations:        toProtoCitations(q.Citations),
+		OriginQuestionId: q.OriginQuestionID,
+		PromptRich:       toProtoRichText(q.Prompt),
+		ExplanationRich:  toProtoRichText(q.Explanation),
+		Schedule:         toProtoQuestionSchedule(q.Schedule),
+		Location:         toProtoQuestionLocation(q.Location),
+		PassageId:        q.PassageID,
+		Kind:             toProtoQuestionKind(q.Kind),
 	}
 	for _, c := range q.Choices {
 		d.Choices = append(d.Choices, &questionv1.Choice{
@@ -134,3 +764,245 @@ func toQuestionDetail(q domain.QuestionDetail) *questionv1.QuestionDetail {
 	}
 	return d
 }
+
+// toProtoQuestionSchedule は予約を RFC3339 の文字列にする（予約が無い時刻は空文字）。
+func toProtoQuestionSchedule(s domain.QuestionSchedule) *questionv1.QuestionSchedule {
+	out := &questionv1.QuestionSchedule{}
+	if !s.PublishAt.IsZero() {
+		out.PublishAt = s.PublishAt.UTC().Format(time.RFC3339)
+	}
+	if !s.UnpublishAt.IsZero() {
+		out.UnpublishAt = s.UnpublishAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+// toScheduleInput は UpdateQuestionRequest.schedule を変換する（未指定の場合は nil = 予約を変えない）。
+func toScheduleInput(s *questionv1.QuestionSchedule) *questionusecase.ScheduleInput {
+	if s == nil {
+		return nil
+	}
+	return &questionusecase.ScheduleInput{
+		PublishAt:   s.GetPublishAt(),
+		UnpublishAt: s.GetUnpublishAt(),
+	}
+}
+
+// toProtoRichText は書式付きの文字列を構文木にする。
+// NOTE: 保存時に書式を検証しているため誤りは無視する（書式の導入前の問題も、誤りの箇所は文字列として返る）。
+func toProtoRichText(markup string) *commonv1.RichText {
+	nodes, _ := richtext.Parse(markup)
+	return &commonv1.RichText{Nodes: toProtoRichTextNodes(nodes)}
+}
+
+func toProtoRichTextNodes(nodes []richtext.Node) []*commonv1.RichTextNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+	out := make([]*commonv1.RichTextNode, 0, len(nodes))
+	for _, n := range nodes {
+		out = append(out, &commonv1.RichTextNode{
+			Kind:     toProtoRichTextNodeKind(n.Kind),
+			Text:     n.Text,
+			Reading:  n.Reading,
+			Url:      n.URL,
+			Children: toProtoRichTextNodes(n.Children),
+		})
+	}
+	return out
+}
+
+func toProtoRichTextNodeKind(k richtext.Kind) commonv1.RichTextNodeKind {
+	switch k {
+	case richtext.KindText:
+		return commonv1.RichTextNodeKind_RICH_TEXT_NODE_KIND_TEXT
+	case richtext.KindRuby:
+		return commonv1.RichTextNodeKind_RICH_TEXT_NODE_KIND_RUBY
+	case richtext.KindEmphasis:
+		return commonv1.RichTextNodeKind_RICH_TEXT_NODE_KIND_EMPHASIS
+	case richtext.KindLineBreak:
+		return commonv1.RichTextNodeKind_RICH_TEXT_NODE_KIND_LINE_BREAK
+	case richtext.KindLink:
+		return commonv1.RichTextNodeKind_RICH_TEXT_NODE_KIND_LINK
+	default:
+		return commonv1.RichTextNodeKind_RICH_TEXT_NODE_KIND_UNSPECIFIED
+	}
+}
+
+func toSimilarQuestions(similar []domain.SimilarQuestion) []*questionv1.SimilarQuestion {
+	out := make([]*questionv1.SimilarQuestion, 0, len(similar))
+	for _, q := range similar {
+		out = append(out, &questionv1.SimilarQuestion{
+			QuestionId: q.QuestionID,
+			Prompt:     q.Prompt,
+			Status:     toProtoQuestionStatus(q.Status),
+			Mine:       q.Mine,
+			Similarity: q.Similarity,
+		})
+	}
+	return out
+}
+
+// toProtoQuestionStatus はドメインの公開状態を proto の enum に変換する。
+func toProtoQuestionStatus(s domain.QuestionStatus) questionv1.QuestionStatus {
+	switch s {
+	case domain.QuestionStatusDraft:
+		return questionv1.QuestionStatus_QUESTION_STATUS_DRAFT
+	case domain.QuestionStatusPublished:
+		return questionv1.QuestionStatus_QUESTION_STATUS_PUBLISHED
+	case domain.QuestionStatusUnlisted:
+		return questionv1.QuestionStatus_QUESTION_STATUS_UNLISTED
+	case domain.QuestionStatusArchived:
+		return questionv1.QuestionStatus_QUESTION_STATUS_ARCHIVED
+	default:
+		return questionv1.QuestionStatus_QUESTION_STATUS_UNSPECIFIED
+	}
+}
+
+// toDomainQuestionStatus は proto の enum をドメインの公開状態に変換する（未指定は空文字）。
+func toDomainQuestionStatus(s questionv1.QuestionStatus) domain.QuestionStatus {
+	switch s {
+	case questionv1.QuestionStatus_QUESTION_STATUS_DRAFT:
+		return domain.QuestionStatusDraft
+	case questionv1.QuestionStatus_QUESTION_STATUS_PUBLISHED:
+		return domain.QuestionStatusPublished
+	case questionv1.QuestionStatus_QUESTION_STATUS_UNLISTED:
+		return domain.QuestionStatusUnlisted
+	case questionv1.QuestionStatus_QUESTION_STATUS_ARCHIVED:
+		return domain.QuestionStatusArchived
+	default:
+		return ""
+	}
+}
+
+func (s *QuestionService) UpsertQuestionTranslation(ctx context.Context, req *questionv1.UpsertQuestionTranslationRequest) (*questionv1.UpsertQuestionTranslationResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	t := req.GetTranslation()
+	saved, err := s.usecase.UpsertQuestionTranslation(ctx, userID, req.GetQuestionId(), domain.QuestionTranslation{
+		Locale:      t.GetLocale(),
+		Prompt:      t.GetPrompt(),
+		Choices:     t.GetChoices(),
+		Explanation: t.GetExplanation(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.UpsertQuestionTranslationResponse{
+		Context:     requestIDForResponse(ctx, req.GetContext()),
+		Translation: toProtoTranslation(saved),
+	}, nil
+}
+
+func (s *QuestionService) DeleteQuestionTranslation(ctx context.Context, req *questionv1.DeleteQuestionTranslationRequest) (*questionv1.DeleteQuestionTranslationResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	if err := s.usecase.DeleteQuestionTranslation(ctx, userID, req.GetQuestionId(), req.GetLocale()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &questionv1.DeleteQuestionTranslationResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+	}, nil
+}
+
+func (s *QuestionService) ListQuestionTranslations(ctx context.Context, req *questionv1.ListQuestionTranslationsRequest) (*questionv1.ListQuestionTranslationsResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	translations, err := s.usecase.ListQuestionTranslations(ctx, userID, req.GetQuestionId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &questionv1.ListQuestionTranslationsResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+	}
+	for _, t := range translations {
+		resp.Translations = append(resp.Translations, toProtoTranslation(t))
+	}
+	return resp, nil
+}
+
+func toProtoTranslation(t domain.QuestionTranslation) *questionv1.QuestionTranslation {
+	return &questionv1.QuestionTranslation{
+		Locale:        t.Locale,
+		Prompt:        t.Prompt,
+		Choices:       t.Choices,
+		Explanation:   t.Explanation,
+		SourceVersion: t.SourceVersion,
+		Stale:         t.Stale,
+		UpdatedAt:     t.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func (s *QuestionService) GetQuestionStats(ctx context.Context, req *questionv1.GetQuestionStatsRequest) (*questionv1.GetQuestionStatsResponse, error) {
+	if s.usecase == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	userID, _ := contextkeys.UserID(ctx)
+	stats, err := s.usecase.GetQuestionStats(ctx, userID, req.GetQuestionId(), time.Now())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &questionv1.GetQuestionStatsResponse{
+		Context: requestIDForResponse(ctx, req.GetContext()),
+		Stats: &questionv1.QuestionStats{
+			TotalAttempts:        stats.TotalAttempts,
+			CorrectAttempts:      stats.CorrectAttempts,
+			Accuracy:             stats.Accuracy,
+			TextAttempts:         stats.TextAttempts,
+			LocationAttempts:     stats.LocationAttempts,
+			StrongPlayerAttempts: stats.StrongPlayerAttempts,
+			StrongPlayerAccuracy: stats.StrongPlayerAccuracy,
+			ForkCount:            stats.ForkCount,
+		},
+	}
+	for _, c := range stats.Choices {
+		resp.Stats.Choices = append(resp.Stats.Choices, &questionv1.ChoiceStats{
+			ChoiceId:  c.ChoiceID,
+			Label:     c.Label,
+			Ordinal:   c.Ordinal,
+			IsCorrect: c.IsCorrect,
+			Picks:     c.Picks,
+			PickRate:  c.PickRate,
+		})
+	}
+	for _, b := range stats.Trend {
+		resp.Stats.Trend = append(resp.Stats.Trend, &questionv1.StatsBucket{
+			Start:           b.Start.UTC().Format(time.RFC3339),
+			Attempts:        b.Attempts,
+			CorrectAttempts: b.CorrectAttempts,
+			Accuracy:        b.Accuracy,
+		})
+	}
+	for _, f := range stats.Flags {
+		resp.Stats.Flags = append(resp.Stats.Flags, &questionv1.QualityFlag{
+			Kind:     toProtoQualityFlagKind(f.Kind),
+			ChoiceId: f.ChoiceID,
+		})
+	}
+	return resp, nil
+}
+
+func toProtoQualityFlagKind(kind domain.QuestionQualityFlagKind) questionv1.QualityFlagKind {
+	switch kind {
+	case domain.QualityFlagUnpickedDistractor:
+		return questionv1.QualityFlagKind_QUALITY_FLAG_KIND_UNPICKED_DISTRACTOR
+	case domain.QualityFlagStrongPlayersMiss:
+		return questionv1.QualityFlagKind_QUALITY_FLAG_KIND_STRONG_PLAYERS_MISS
+	default:
+		return questionv1.QualityFlagKind_QUALITY_FLAG_KIND_UNSPECIFIED
+	}
+}
@@ -5,10 +5,14 @@ import (
 	"errors"
 
 	"github.com/history-quiz/historyquiz/internal/app/contextkeys"
+	"github.com/history-quiz/historyquiz/internal/domain"
 	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
 	quizusecase "github.com/history-quiz/historyquiz/internal/usecase/quiz"
+	timelineusecase "github.com/history-quiz/historyquiz/internal/usecase/timeline"
 	commonv1 "github.com/history-quiz/historyquiz/proto/common/v1"
 	quizv1 "github.com/history-quiz/historyquiz/proto/quiz/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -16,12 +20,13 @@ import (
 // QuizService は QuizServiceServer 実装。
 type QuizService struct {
 	quizv1.UnimplementedQuizServiceServer
-	usecase *quizusecase.Usecase
+	usecase  *quizusecase.Usecase
+	timeline *timelineusecase.Usecase
 }
 
 // NewQuizService は QuizService を生成する。
-func NewQuizService(usecase *quizusecase.Usecase) *QuizService {
-	return &QuizService{usecase: usecase}
+func NewQuizService(usecase *quizusecase.Usecase, timeline *timelineusecase.Usecase) *QuizService {
+	return &QuizService{usecase: usecase, timeline: timeline}
 }
 
 func (s *QuizService) GetQuestion(ctx context.Context, req *quizv1.GetQuestionRequest) (*quizv1.GetQuestionResponse, error) {
@@ -30,7 +35,19 @@ func (s *QuizService) GetQuestion(ctx context.Context, req *quizv1.GetQuestionRe
 	}
 
 	requestID := requestIDForResponse(ctx, req.GetContext())
-	q, err := s.usecase.GetQuestion(ctx, requestID.GetRequestId(), req.GetPreviousQuestionId())
+	acceptLanguage, _ := contextkeys.AcceptLanguage(ctx) // 未指定なら原文で返す
+	var q domain.Question
+	var err error
+	switch {
+	case req.GetDeckId() != "" && req.GetEntityId() != "":
+		err = apperror.InvalidArgument("deck_id と entity_id は同時に指定できません", apperror.FieldViolation{Field: "entity_id", Description: "deck_id と同時には指定できません"})
+	case req.GetDeckId() != "":
+		q, err = s.usecase.GetDeckQuestion(ctx, req.GetDeckId(), req.GetPreviousQuestionId(), acceptLanguage)
+	case req.GetEntityId() != "":
+		q, err = s.usecase.GetEntityQuestion(ctx, requestID.GetRequestId(), req.GetEntityId(), req.GetPreviousQuestionId(), acceptLanguage)
+	default:
+		q, err = s.usecase.GetQuestion(ctx, requestID.GetRequestId(), req.GetPreviousQuestionId(), acceptLanguage)
+	}
 	if err != nil {
 		return nil, toStatusError(err)
 	}
@@ -38,9 +55,16 @@ func (s *QuizService) GetQuestion(ctx context.Context, req *quizv1.GetQuestionRe
 	resp := &quizv1.GetQuestionResponse{
 		Context: requestID,
 		Question: &quizv1.Question{
-			Id:          q.ID,
-			Prompt:      q.Prompt,
-			Explanation: q.Explanation,
+			Id:              q.ID,
+			Prompt:          q.Prompt,
+			Explanation:     q.Explanation,
+			Attachments:     toQuestionAttachments(q.Attachments),
+			Locale:          q.Locale,
+			PromptRich:      toProtoRichText(q.Prompt),
+			ExplanationRich: toProtoRichText(q.Explanation),
+			AcceptsLocation: q.AcceptsLocation,
+			Passage:         toProtoQuizPassage(q.Passage),
+			Kind:            toProtoQuestionKind(q.Kind),
 		},
 	}
 	for _, c := range q.Choices {
@@ -60,7 +84,19 @@ func (s *QuizService) SubmitAnswer(ctx context.Context, req *quizv1.SubmitAnswer
 
 	userID, _ := contextkeys.UserID(ctx) // 未ログインの場合は空でよい（履歴は保存しない）
 
-	result, err := s.usecase.SubmitAnswer(ctx, userID, req.GetQuestionId(), req.GetSelectedChoiceId())
+	var result quizusecase.SubmitAnswerResult
+	var err error
+	switch {
+	case req.GetSelectedChoiceId() == "" && req.GetAnswerText() != "":
+		// 記述式モード: 選択肢ではなく入力文字列で判定する。
+		result, err = s.usecase.SubmitTextAnswer(ctx, userID, req.GetQuestionId(), req.GetAnswerText())
+	case req.GetSelectedChoiceId() == "" && req.GetLocation() != nil:
+		// 地図モード: 選んだ地点と正解の地点の距離で採点する。
+		point := geo.Point{Lat: req.GetLocation().GetLatitude(), Lng: req.GetLocation().GetLongitude()}
+		result, err = s.usecase.SubmitLocationAnswer(ctx, userID, req.GetQuestionId(), point)
+	default:
+		result, err = s.usecase.SubmitAnswer(ctx, userID, req.GetQuestionId(), req.GetSelectedChoiceId())
+	}
 	if err != nil {
 		return nil, toStatusError(err)
 	}
@@ -70,9 +106,123 @@ func (s *QuizService) SubmitAnswer(ctx context.Context, req *quizv1.SubmitAnswer
 		IsCorrect:       result.IsCorrect,
 		CorrectChoiceId: result.CorrectChoiceID,
 		AttemptId:       result.AttemptID,
+		MatchedAnswer:   result.MatchedAnswer,
+		Citations:       toProtoCitations(result.Citations),
+		Entities:        toProtoEntityRefs(result.Entities),
+		LocationResult:  toProtoLocationResult(result.Location),
+	}, nil
+}
+
+// toProtoLocationResult は地図モードの採点結果を proto に変換する（他のモードでは nil）。
+func toProtoLocationResult(r *quizusecase.LocationResult) *quizv1.LocationResult {
+	if r == nil {
+		return nil
+	}
+	return &quizv1.LocationResult{
+		DistanceKm:      r.DistanceKm,
+		Band:            toProtoLocationBand(r.Band),
+		Score:           r.Score,
+		CorrectLocation: toProtoQuestionLocation(&r.Correct),
+	}
+}
+
+func toProtoLocationBand(band geo.Band) quizv1.LocationBand {
+	switch band {
+	case geo.BandWithin:
+		return quizv1.LocationBand_LOCATION_BAND_WITHIN
+	case geo.BandNear:
+		return quizv1.LocationBand_LOCATION_BAND_NEAR
+	case geo.BandRegion:
+		return quizv1.LocationBand_LOCATION_BAND_REGION
+	case geo.BandFar:
+		return quizv1.LocationBand_LOCATION_BAND_FAR
+	case geo.BandMiss:
+		return quizv1.LocationBand_LOCATION_BAND_MISS
+	default:
+		return quizv1.LocationBand_LOCATION_BAND_UNSPECIFIED
+	}
+}
+
+func (s *QuizService) GetTimelineDuel(ctx context.Context, req *quizv1.GetTimelineDuelRequest) (*quizv1.GetTimelineDuelResponse, error) {
+	if s.timeline == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	requestID := requestIDForResponse(ctx, req.GetContext())
+	duel, err := s.timeline.GetDuel(ctx, requestID.GetRequestId(), toDomainTimelineDifficulty(req.GetDifficulty()), req.GetEventCount(), req.GetPreviousEventIds())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	// 混同しやすい点: 年は答えそのものなので、出題では名前だけを返す。
+	events := make([]*quizv1.TimelineEvent, 0, len(duel.Events))
+	for _, e := range duel.Events {
+		events = append(events, &quizv1.TimelineEvent{EntityId: e.EntityID, Name: e.Name})
+	}
+	return &quizv1.GetTimelineDuelResponse{
+		Context:    requestID,
+		Events:     events,
+		Difficulty: toProtoTimelineDifficulty(duel.Difficulty),
+	}, nil
+}
+
+func (s *QuizService) SubmitTimelineAnswer(ctx context.Context, req *quizv1.SubmitTimelineAnswerRequest) (*quizv1.SubmitTimelineAnswerResponse, error) {
+	if s.timeline == nil {
+		return nil, status.Error(codes.FailedPrecondition, "サーバ初期化が未完了です")
+	}
+
+	result, err := s.timeline.SubmitDuel(ctx, req.GetEventIds(), req.GetChosenEventId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	events := make([]*quizv1.DatedTimelineEvent, 0, len(result.Events))
+	for _, e := range result.Events {
+		events = append(events, &quizv1.DatedTimelineEvent{
+			EntityId:   e.EntityID,
+			Name:       e.Name,
+			Year:       e.Date.Year,
+			YearMargin: e.Date.Margin,
+		})
+	}
+	return &quizv1.SubmitTimelineAnswerResponse{
+		Context:         requestIDForResponse(ctx, req.GetContext()),
+		IsCorrect:       result.IsCorrect,
+		EarliestEventId: result.EarliestEventID,
+		Events:          events,
+		YearGap:         result.YearGap,
 	}, nil
 }
 
+func toDomainTimelineDifficulty(d quizv1.TimelineDifficulty) domain.TimelineDifficulty {
+	switch d {
+	case quizv1.TimelineDifficulty_TIMELINE_DIFFICULTY_UNSPECIFIED:
+		return domain.TimelineDifficultyAny
+	case quizv1.TimelineDifficulty_TIMELINE_DIFFICULTY_EASY:
+		return domain.TimelineDifficultyEasy
+	case quizv1.TimelineDifficulty_TIMELINE_DIFFICULTY_NORMAL:
+		return domain.TimelineDifficultyNormal
+	case quizv1.TimelineDifficulty_TIMELINE_DIFFICULTY_HARD:
+		return domain.TimelineDifficultyHard
+	default:
+		// 未知の値は usecase で INVALID_ARGUMENT にする。
+		return domain.TimelineDifficulty(d.String())
+	}
+}
+
+func toProtoTimelineDifficulty(d domain.TimelineDifficulty) quizv1.TimelineDifficulty {
+	switch d {
+	case domain.TimelineDifficultyEasy:
+		return quizv1.TimelineDifficulty_TIMELINE_DIFFICULTY_EASY
+	case domain.TimelineDifficultyNormal:
+		return quizv1.TimelineDifficulty_TIMELINE_DIFFICULTY_NORMAL
+	case domain.TimelineDifficultyHard:
+		return quizv1.TimelineDifficulty_TIMELINE_DIFFICULTY_HARD
+	default:
+		return quizv1.TimelineDifficulty_TIMELINE_DIFFICULTY_UNSPECIFIED
+	}
+}
+
 // requestIDForResponse は response に載せる request_id を決定する。
 // 混同しやすい点: request_id は「追跡用」なので、message 側より metadata→context を優先する。
 func requestIDForResponse(ctx context.Context, reqCtx *commonv1.RequestContext) *commonv1.RequestContext {
@@ -91,11 +241,17 @@ func toStatusError(err error) error {
 	if errors.As(err, &appErr) {
 		switch appErr.Code {
 		case apperror.CodeInvalidArgument:
-			return status.Error(codes.InvalidArgument, appErr.Message)
+			return invalidArgumentStatusError(appErr)
 		case apperror.CodeNotFound:
 			return status.Error(codes.NotFound, appErr.Message)
 		case apperror.CodePermissionDenied:
 			return status.Error(codes.PermissionDenied, appErr.Message)
+		case apperror.CodeFailedPrecondition:
+			return status.Error(codes.FailedPrecondition, appErr.Message)
+		case apperror.CodeAlreadyExists:
+			return status.Error(codes.AlreadyExists, appErr.Message)
+		case apperror.CodeAborted:
+			return abortedStatusError(appErr)
 		case apperror.CodeUnauthenticated:
 			return status.Error(codes.Unauthenticated, appErr.Message)
 		default:
@@ -104,3 +260,53 @@ func toStatusError(err error) error {
 	}
 	return status.Error(codes.Internal, "内部エラー")
 }
+
+// invalidArgumentStatusError は入力エラーを INVALID_ARGUMENT に変換し、フィールド単位の違反を status の details（errdetails.BadRequest）に載せる。
+// NOTE: 違反が無い場合や details に載せられない場合は、メッセージだけの INVALID_ARGUMENT を返す。
+func invalidArgumentStatusError(appErr *apperror.Error) error {
+	st := status.New(codes.InvalidArgument, appErr.Message)
+	if len(appErr.FieldViolations) == 0 {
+		return st.Err()
+	}
+	badRequest := &errdetails.BadRequest{FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(appErr.FieldViolations))}
+	for _, v := range appErr.FieldViolations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Description})
+	}
+	withDetails, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// abortedStatusError は競合エラーを ABORTED に変換し、サーバ側の最新値を status の details に載せる。
+// NOTE: details に載せられない値（未対応の型や変換失敗）の場合は、メッセージだけの ABORTED を返す。
+func abortedStatusError(appErr *apperror.Error) error {
+	st := status.New(codes.Aborted, appErr.Message)
+	var withDetails *status.Status
+	var err error
+	switch current := appErr.Current.(type) {
+	case domain.QuestionDetail:
+		withDetails, err = st.WithDetails(toQuestionDetail(current))
+	case domain.PassageDetail:
+		withDetails, err = st.WithDetails(toProtoPassageDetail(current))
+	default:
+		return st.Err()
+	}
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// toProtoQuizPassage は出題中の問題が共有する史料を proto に変換する（史料が無い場合は nil）。
+func toProtoQuizPassage(p *domain.QuizPassage) *quizv1.QuizPassage {
+	if p == nil {
+		return nil
+	}
+	return &quizv1.QuizPassage{
+		Passage:  toProtoPassage(p.Passage),
+		Position: p.Position,
+		Total:    p.Total,
+	}
+}
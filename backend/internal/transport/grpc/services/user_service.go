@@ -29,7 +29,7 @@ func (s *UserService) ListMyAttempts(ctx context.Context, req *userv1.ListMyAtte
 	}
 
 	userID, _ := contextkeys.UserID(ctx)
-	attempts, nextToken, err := s.usecase.ListMyAttempts(ctx, userID, req.GetPagination().GetPageSize())
+	attempts, nextToken, err := s.usecase.ListMyAttempts(ctx, userID, req.GetPagination().GetPageToken(), req.GetPagination().GetPageSize())
 	if err != nil {
 		return nil, toStatusError(err)
 	}
@@ -46,6 +46,7 @@ func (s *UserService) ListMyAttempts(ctx context.Context, req *userv1.ListMyAtte
 			QuestionId:       a.QuestionID,
 			QuestionPrompt:   a.QuestionPrompt,
 			SelectedChoiceId: a.SelectedChoiceID,
+			AnswerText:       a.AnswerText,
 			IsCorrect:        a.IsCorrect,
 			AnsweredAt:       a.AnsweredAt.UTC().Format(time.RFC3339Nano),
 		})
@@ -0,0 +1,172 @@
+package attachment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+)
+
+// MaxAttachmentBytes は添付1件の上限（gRPC の既定の受信上限 4MiB に収まる大きさ）。
+const MaxAttachmentBytes = 2 << 20
+
+// 掃除（PurgeUnusedAttachments）の猶予。
+const (
+	// UnusedAttachmentTTL は、どの問題にも付けられていない添付を残しておく時間（アップロード→作問保存の猶予）。
+	UnusedAttachmentTTL = 24 * time.Hour
+	// DeletedAttachmentRetention は、問題の論理削除で削除扱いになった添付を残しておく時間。
+	DeletedAttachmentRetention = 7 * 24 * time.Hour
+	// purgeBatchSize は1回の掃除で削除する上限。
+	purgeBatchSize = 100
+)
+
+// allowedContentTypes はアップロードを受け付ける形式。
+// 混同しやすい点: SVG はスクリプトを埋め込めるため受け付けない（地図も PNG/WebP 等で上げてもらう）。
+var allowedContentTypes = map[string]struct{}{
+	"image/png":  {},
+	"image/jpeg": {},
+	"image/webp": {},
+	"image/gif":  {},
+}
+
+// Usecase は問題に付ける添付（画像/地図）のユースケースを提供する。
+type Usecase struct {
+	attachmentRepo repository.AttachmentRepository
+	blobStore      repository.BlobStore
+	userRepo       repository.UserRepository
+}
+
+// NewUsecase は AttachmentUsecase を生成する。
+func NewUsecase(attachmentRepo repository.AttachmentRepository, blobStore repository.BlobStore, userRepo repository.UserRepository) *Usecase {
+	return &Usecase{
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		userRepo:       userRepo,
+	}
+}
+
+// BlobKey は添付の本体を BlobStore に保存するキー。
+func BlobKey(attachmentID string) string {
+	return "attachments/" + attachmentID
+}
+
+// UploadAttachment は添付を検証して保存し、メタデータを返す。
+func (u *Usecase) UploadAttachment(ctx context.Context, userID string, kind domain.AttachmentKind, contentType string, content []byte) (domain.Attachment, error) {
+	if userID == "" {
+		return domain.Attachment{}, apperror.Unauthenticated("認証が必要です")
+	}
+
+	var violations []apperror.FieldViolation
+	if kind != domain.AttachmentKindImage && kind != domain.AttachmentKindMap {
+		violations = append(violations, apperror.FieldViolation{Field: "kind", Description: "IMAGE または MAP を指定してください"})
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	_, allowed := allowedContentTypes[mediaType]
+	allowed = allowed && err == nil
+	if !allowed {
+		violations = append(violations, apperror.FieldViolation{Field: "content_type", Description: "image/png, image/jpeg, image/webp, image/gif のいずれかを指定してください"})
+	}
+	switch {
+	case len(content) == 0:
+		violations = append(violations, apperror.FieldViolation{Field: "content", Description: "必須です"})
+	case len(content) > MaxAttachmentBytes:
+		violations = append(violations, apperror.FieldViolation{Field: "content", Description: "2MiB 以内で指定してください"})
+	case allowed && http.DetectContentType(content) != mediaType:
+		// 宣言だけを信じると、画像と偽った別形式のファイルを配信してしまうため、中身からも判定する。
+		violations = append(violations, apperror.FieldViolation{Field: "content", Description: "content_type と中身の形式が一致しません"})
+	}
+	if len(violations) > 0 {
+		return domain.Attachment{}, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	// attachments.owner_user_id の外部キーのため、users が存在する前提に揃える。
+	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	sum := sha256.Sum256(content)
+	created, err := u.attachmentRepo.CreateAttachment(ctx, domain.Attachment{
+		OwnerUserID: userID,
+		Kind:        kind,
+		ContentType: mediaType,
+		SizeBytes:   int64(len(content)),
+		SHA256:      hex.EncodeToString(sum[:]),
+	})
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	// NOTE: 本体の保存に失敗した場合、メタデータはどの問題からも参照されないまま残り、掃除で消える。
+	if err := u.blobStore.Put(ctx, BlobKey(created.ID), created.ContentType, content); err != nil {
+		return domain.Attachment{}, err
+	}
+	return created, nil
+}
+
+// OpenAttachmentContent は添付のメタデータと本体を返す（呼び出し側で本体を Close すること）。
+// 公開中の問題の添付は誰でも、それ以外はアップロードした本人だけが取得できる。
+// 混同しやすい点: 下書きの添付の存在を漏らさないよう、見せられない場合は PERMISSION_DENIED ではなく NOT_FOUND を返す。
+func (u *Usecase) OpenAttachmentContent(ctx context.Context, userID string, attachmentID string) (domain.Attachment, io.ReadCloser, error) {
+	if attachmentID == "" {
+		return domain.Attachment{}, nil, apperror.InvalidArgument("attachment_id が空です", apperror.FieldViolation{Field: "attachment_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(attachmentID); err != nil {
+		return domain.Attachment{}, nil, apperror.InvalidArgument("attachment_id が不正です", apperror.FieldViolation{Field: "attachment_id", Description: "UUID 形式で指定してください"})
+	}
+
+	a, err := u.attachmentRepo.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+	if a.Deleted || (!a.Public && (userID == "" || a.OwnerUserID != userID)) {
+		return domain.Attachment{}, nil, apperror.NotFound("添付が見つかりません")
+	}
+
+	body, err := u.blobStore.Get(ctx, BlobKey(a.ID))
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+	return a, body, nil
+}
+
+// PurgeUnusedAttachments は、使われていない添付と、問題の論理削除から保持期間が過ぎた添付を本体ごと削除する。
+// 削除した件数を返す。1件の失敗で止めず、残りも試してから失敗をまとめて返す（次回の掃除で再試行される）。
+func (u *Usecase) PurgeUnusedAttachments(ctx context.Context, now time.Time) (int, error) {
+	attachments, err := u.attachmentRepo.ListPurgeableAttachments(ctx, now.Add(-UnusedAttachmentTTL), now.Add(-DeletedAttachmentRetention), purgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	var errs []error
+	for _, a := range attachments {
+		// 混同しやすい点: 一覧の取得後に問題へ付けられた添付を消さないよう、メタデータの削除（参照の再確認付き）を先に行う。
+		// 本体の削除に失敗した場合は本体だけが残るが、参照が壊れるよりは安全なのでこの順にしている。
+		deleted, err := u.attachmentRepo.DeleteUnusedAttachment(ctx, a.ID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !deleted {
+			continue
+		}
+		if err := u.blobStore.Delete(ctx, BlobKey(a.ID)); err != nil {
+			errs = append(errs, fmt.Errorf("attachment %s: %w", a.ID, err))
+			continue
+		}
+		purged++
+	}
+	if len(errs) > 0 {
+		return purged, apperror.Internal("添付の掃除に一部失敗しました", errors.Join(errs...))
+	}
+	return purged, nil
+}
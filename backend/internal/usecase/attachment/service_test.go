@@ -0,0 +1,234 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// fakeAttachmentRepo は attachment.Usecase のユニットテスト用のリポジトリ差し替え。
+// NOTE: 実装は関数フィールドで差し替え、各テストで「呼ばれてよい/よくない」を明示する。
+type fakeAttachmentRepo struct {
+	createFn     func(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error)
+	getFn        func(ctx context.Context, attachmentID string) (domain.Attachment, error)
+	listPurgeFn  func(ctx context.Context, unusedBefore time.Time, deletedBefore time.Time, limit int32) ([]domain.Attachment, error)
+	deleteUnused func(ctx context.Context, attachmentID string) (bool, error)
+}
+
+func (f *fakeAttachmentRepo) CreateAttachment(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error) {
+	return f.createFn(ctx, attachment)
+}
+func (f *fakeAttachmentRepo) GetAttachment(ctx context.Context, attachmentID string) (domain.Attachment, error) {
+	return f.getFn(ctx, attachmentID)
+}
+func (f *fakeAttachmentRepo) ListPurgeableAttachments(ctx context.Context, unusedBefore time.Time, deletedBefore time.Time, limit int32) ([]domain.Attachment, error) {
+	return f.listPurgeFn(ctx, unusedBefore, deletedBefore, limit)
+}
+func (f *fakeAttachmentRepo) DeleteUnusedAttachment(ctx context.Context, attachmentID string) (bool, error) {
+	return f.deleteUnused(ctx, attachmentID)
+}
+
+type fakeBlobStore struct {
+	putFn    func(ctx context.Context, key string, contentType string, data []byte) error
+	getFn    func(ctx context.Context, key string) (io.ReadCloser, error)
+	deleteFn func(ctx context.Context, key string) error
+}
+
+func (f *fakeBlobStore) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	return f.putFn(ctx, key, contentType, data)
+}
+func (f *fakeBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return f.getFn(ctx, key)
+}
+func (f *fakeBlobStore) Delete(ctx context.Context, key string) error {
+	return f.deleteFn(ctx, key)
+}
+
+type fakeUserRepo struct {
+	ensureUserExistsFn func(ctx context.Context, userID string) error
+}
+
+func (f *fakeUserRepo) EnsureUserExists(ctx context.Context, userID string) error {
+	return f.ensureUserExistsFn(ctx, userID)
+}
+
+// pngBytes は PNG のシグネチャで始まるバイト列（http.DetectContentType が image/png と判定する）。
+var pngBytes = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 32)...)
+
+func TestUsecase_UploadAttachment_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		kind        domain.AttachmentKind
+		contentType string
+		content     []byte
+		field       string
+	}{
+		{name: "種類が未指定", kind: "", contentType: "image/png", content: pngBytes, field: "kind"},
+		{name: "SVG は受け付けない", kind: domain.AttachmentKindMap, contentType: "image/svg+xml", content: []byte("<svg></svg>"), field: "content_type"},
+		{name: "空", kind: domain.AttachmentKindImage, contentType: "image/png", content: nil, field: "content"},
+		{name: "上限超過", kind: domain.AttachmentKindImage, contentType: "image/png", content: append(append([]byte(nil), pngBytes...), make([]byte, MaxAttachmentBytes)...), field: "content"},
+		{name: "宣言と中身が不一致", kind: domain.AttachmentKindImage, contentType: "image/jpeg", content: pngBytes, field: "content"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := NewUsecase(
+				&fakeAttachmentRepo{createFn: func(context.Context, domain.Attachment) (domain.Attachment, error) {
+					t.Fatal("入力が不正な場合、CreateAttachment は呼ばれない想定です")
+					return domain.Attachment{}, nil
+				}},
+				&fakeBlobStore{},
+				&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+					t.Fatal("入力が不正な場合、EnsureUserExists は呼ばれない想定です")
+					return nil
+				}},
+			)
+
+			_, err := u.UploadAttachment(context.Background(), "user-1", tt.kind, tt.contentType, tt.content)
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+			if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.field {
+				t.Fatalf("%s の FieldViolation を期待しました: %+v", tt.field, appErr.FieldViolations)
+			}
+		})
+	}
+}
+
+func TestUsecase_UploadAttachment_Success(t *testing.T) {
+	t.Parallel()
+
+	attachmentID := uuid.NewString()
+	var putKey string
+
+	u := NewUsecase(
+		&fakeAttachmentRepo{createFn: func(_ context.Context, a domain.Attachment) (domain.Attachment, error) {
+			if a.OwnerUserID != "user-1" || a.ContentType != "image/png" || a.SizeBytes != int64(len(pngBytes)) || len(a.SHA256) != 64 {
+				t.Fatalf("CreateAttachment の引数が期待と異なります: %+v", a)
+			}
+			a.ID = attachmentID
+			return a, nil
+		}},
+		&fakeBlobStore{putFn: func(_ context.Context, key string, contentType string, data []byte) error {
+			putKey = key
+			if contentType != "image/png" || !bytes.Equal(data, pngBytes) {
+				t.Fatalf("Put の引数が期待と異なります: contentType=%s", contentType)
+			}
+			return nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+	)
+
+	// content type のパラメータや大文字は正規化して扱う。
+	got, err := u.UploadAttachment(context.Background(), "user-1", domain.AttachmentKindMap, "Image/PNG; charset=binary", pngBytes)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.ID != attachmentID || putKey != "attachments/"+attachmentID {
+		t.Fatalf("採番した ID をキーにして保存する想定です: got=%+v key=%s", got, putKey)
+	}
+}
+
+func TestUsecase_OpenAttachmentContent_Visibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		userID     string
+		attachment domain.Attachment
+		wantOK     bool
+	}{
+		{name: "公開中の問題の添付は未ログインでも取得できる", userID: "", attachment: domain.Attachment{OwnerUserID: "owner", Public: true}, wantOK: true},
+		{name: "下書きの添付は本人なら取得できる", userID: "owner", attachment: domain.Attachment{OwnerUserID: "owner"}, wantOK: true},
+		{name: "下書きの添付は他人には見せない", userID: "other", attachment: domain.Attachment{OwnerUserID: "owner"}, wantOK: false},
+		{name: "下書きの添付は未ログインには見せない", userID: "", attachment: domain.Attachment{OwnerUserID: ""}, wantOK: false},
+		{name: "削除扱いの添付は本人にも見せない", userID: "owner", attachment: domain.Attachment{OwnerUserID: "owner", Deleted: true, Public: true}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			attachmentID := uuid.NewString()
+			u := NewUsecase(
+				&fakeAttachmentRepo{getFn: func(context.Context, string) (domain.Attachment, error) {
+					a := tt.attachment
+					a.ID = attachmentID
+					return a, nil
+				}},
+				&fakeBlobStore{getFn: func(context.Context, string) (io.ReadCloser, error) {
+					if !tt.wantOK {
+						t.Fatal("見せられない添付の本体は読まない想定です")
+					}
+					return io.NopCloser(bytes.NewReader(pngBytes)), nil
+				}},
+				&fakeUserRepo{},
+			)
+
+			_, body, err := u.OpenAttachmentContent(context.Background(), tt.userID, attachmentID)
+			if tt.wantOK {
+				if err != nil {
+					t.Fatalf("err は nil を期待しました: %v", err)
+				}
+				body.Close()
+				return
+			}
+			if !apperror.IsCode(err, apperror.CodeNotFound) {
+				t.Fatalf("存在を漏らさないよう NOT_FOUND を期待しました: err=%v", err)
+			}
+		})
+	}
+}
+
+func TestUsecase_PurgeUnusedAttachments(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 17, 0, 0, 0, time.UTC)
+	var deletedKeys []string
+
+	u := NewUsecase(
+		&fakeAttachmentRepo{
+			listPurgeFn: func(_ context.Context, unusedBefore time.Time, deletedBefore time.Time, _ int32) ([]domain.Attachment, error) {
+				if !unusedBefore.Equal(now.Add(-UnusedAttachmentTTL)) || !deletedBefore.Equal(now.Add(-DeletedAttachmentRetention)) {
+					t.Fatalf("猶予の計算が期待と異なります: unused=%s deleted=%s", unusedBefore, deletedBefore)
+				}
+				return []domain.Attachment{{ID: "a1"}, {ID: "a2"}, {ID: "a3"}}, nil
+			},
+			deleteUnused: func(_ context.Context, attachmentID string) (bool, error) {
+				// a2 は一覧の取得後に問題へ付けられた想定（削除しない）。
+				return attachmentID != "a2", nil
+			},
+		},
+		&fakeBlobStore{deleteFn: func(_ context.Context, key string) error {
+			deletedKeys = append(deletedKeys, key)
+			if key == "attachments/a3" {
+				return apperror.Internal("添付の削除に失敗しました", errors.New("boom"))
+			}
+			return nil
+		}},
+		&fakeUserRepo{},
+	)
+
+	purged, err := u.PurgeUnusedAttachments(context.Background(), now)
+	if !apperror.IsCode(err, apperror.CodeInternal) {
+		t.Fatalf("一部の失敗は INTERNAL として返す想定です: err=%v", err)
+	}
+	if purged != 1 {
+		t.Fatalf("削除できた件数は 1 を期待しました: %d", purged)
+	}
+	if len(deletedKeys) != 2 || deletedKeys[0] != "attachments/a1" || deletedKeys[1] != "attachments/a3" {
+		t.Fatalf("参照された添付の本体は消さない想定です: %v", deletedKeys)
+	}
+}
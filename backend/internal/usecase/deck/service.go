@@ -0,0 +1,294 @@
+package deck
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+)
+
+// デッキの入力の上限。
+const (
+	maxTitleRunes       = 100
+	maxDescriptionRunes = 1000
+	maxDeckQuestions    = 100
+	// maxShareSlugLen は共有用文字列として受け付ける長さの上限（生成するのは 16 文字）。
+	maxShareSlugLen = 64
+)
+
+// shareSlugEncoding は共有用文字列の表記（URL にそのまま載せられる小文字英数字）。
+var shareSlugEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// Usecase はデッキ（ユーザーが作る問題集）のユースケースを提供する。
+type Usecase struct {
+	deckRepo repository.DeckRepository
+	userRepo repository.UserRepository
+}
+
+// NewUsecase は DeckUsecase を生成する。
+func NewUsecase(deckRepo repository.DeckRepository, userRepo repository.UserRepository) *Usecase {
+	return &Usecase{
+		deckRepo: deckRepo,
+		userRepo: userRepo,
+	}
+}
+
+// CreateDeck はデッキを作成する。共有用文字列はここで生成する。
+func (u *Usecase) CreateDeck(ctx context.Context, userID string, draft domain.DeckDraft) (domain.Deck, error) {
+	if userID == "" {
+		return domain.Deck{}, apperror.Unauthenticated("認証が必要です")
+	}
+	draft = normalizeDraft(draft)
+	if err := validateDraft(draft); err != nil {
+		return domain.Deck{}, err
+	}
+	if err := u.checkAddable(ctx, userID, draft.QuestionIDs, nil); err != nil {
+		return domain.Deck{}, err
+	}
+
+	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+		return domain.Deck{}, err
+	}
+	shareSlug, err := newShareSlug()
+	if err != nil {
+		return domain.Deck{}, err
+	}
+	return u.deckRepo.CreateDeck(ctx, userID, shareSlug, draft)
+}
+
+// UpdateDeck は自分のデッキの題名/説明/問題の並びを置き換える（所有者チェック含む）。
+// 混同しやすい点: 既にデッキに入っている問題は、後から非公開になっていても入れたままにできる（並べ替えを妨げないため）。
+func (u *Usecase) UpdateDeck(ctx context.Context, userID string, deckID string, draft domain.DeckDraft) (domain.Deck, error) {
+	if userID == "" {
+		return domain.Deck{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if err := validateDeckID(deckID); err != nil {
+		return domain.Deck{}, err
+	}
+	draft = normalizeDraft(draft)
+	if err := validateDraft(draft); err != nil {
+		return domain.Deck{}, err
+	}
+
+	if err := u.authorizeOwner(ctx, userID, deckID); err != nil {
+		return domain.Deck{}, err
+	}
+	current, err := u.deckRepo.GetDeck(ctx, deckID)
+	if err != nil {
+		return domain.Deck{}, err
+	}
+	if err := u.checkAddable(ctx, userID, draft.QuestionIDs, current.QuestionIDs); err != nil {
+		return domain.Deck{}, err
+	}
+	return u.deckRepo.UpdateDeck(ctx, deckID, draft)
+}
+
+// DeleteDeck は自分のデッキを論理削除する（所有者チェック含む）。共有用文字列でも開けなくなる。
+func (u *Usecase) DeleteDeck(ctx context.Context, userID string, deckID string) error {
+	if userID == "" {
+		return apperror.Unauthenticated("認証が必要です")
+	}
+	if err := validateDeckID(deckID); err != nil {
+		return err
+	}
+	if err := u.authorizeOwner(ctx, userID, deckID); err != nil {
+		return err
+	}
+	return u.deckRepo.SoftDeleteDeck(ctx, deckID)
+}
+
+// GetMyDeck は自分のデッキを問題の並び付きで返す（所有者チェック含む）。
+func (u *Usecase) GetMyDeck(ctx context.Context, userID string, deckID string) (domain.Deck, error) {
+	if userID == "" {
+		return domain.Deck{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if err := validateDeckID(deckID); err != nil {
+		return domain.Deck{}, err
+	}
+	if err := u.authorizeOwner(ctx, userID, deckID); err != nil {
+		return domain.Deck{}, err
+	}
+	return u.deckRepo.GetDeck(ctx, deckID)
+}
+
+// ListMyDecks は自分のデッキ一覧を返す（論理削除は除外）。
+func (u *Usecase) ListMyDecks(ctx context.Context, userID string, pageSize int32) ([]domain.Deck, string, error) {
+	if userID == "" {
+		return nil, "", apperror.Unauthenticated("認証が必要です")
+	}
+
+	decks, err := u.deckRepo.ListMyDecks(ctx, userID, normalizePageSize(pageSize))
+	if err != nil {
+		return nil, "", err
+	}
+	// NOTE: デッキは件数が少ない想定のため、page_token は未実装（next_page_token は空）。
+	return decks, "", nil
+}
+
+// GetSharedDeck は共有用文字列からデッキを返す（未ログインでも呼べる）。
+// 混同しやすい点: 所有者の下書きの問題 ID が含まれうるため、問題の並びは返さない（出題は deck_id で行う）。
+func (u *Usecase) GetSharedDeck(ctx context.Context, shareSlug string) (domain.Deck, error) {
+	shareSlug = strings.TrimSpace(shareSlug)
+	if shareSlug == "" {
+		return domain.Deck{}, apperror.InvalidArgument("share_slug が空です", apperror.FieldViolation{Field: "share_slug", Description: "必須です"})
+	}
+	if len(shareSlug) > maxShareSlugLen {
+		// 生成する文字列より長いものは存在しないため、DB を引かずに NOT_FOUND にする。
+		return domain.Deck{}, apperror.NotFound("デッキが見つかりません")
+	}
+
+	d, err := u.deckRepo.GetDeckBySlug(ctx, shareSlug)
+	if err != nil {
+		return domain.Deck{}, err
+	}
+	d.QuestionIDs = nil
+	return d, nil
+}
+
+// authorizeOwner はデッキの所有者かを確認する（question.Usecase の所有者チェックと同じ扱い）。
+func (u *Usecase) authorizeOwner(ctx context.Context, userID string, deckID string) error {
+	ownerUserID, deleted, err := u.deckRepo.GetDeckOwner(ctx, deckID)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		return apperror.NotFound("デッキが見つかりません")
+	}
+	if ownerUserID != userID {
+		return apperror.PermissionDenied("権限がありません")
+	}
+	return nil
+}
+
+// checkAddable は questionIDs がすべてデッキに入れてよい問題かを確認する。
+// alreadyIncluded（更新前のデッキの問題）は確認しない。
+func (u *Usecase) checkAddable(ctx context.Context, userID string, questionIDs []string, alreadyIncluded []string) error {
+	included := make(map[string]struct{}, len(alreadyIncluded))
+	for _, id := range alreadyIncluded {
+		included[id] = struct{}{}
+	}
+	var toCheck []string
+	for _, id := range questionIDs {
+		if _, ok := included[id]; !ok {
+			toCheck = append(toCheck, id)
+		}
+	}
+	if len(toCheck) == 0 {
+		return nil
+	}
+
+	addableIDs, err := u.deckRepo.ListAddableQuestionIDs(ctx, userID, toCheck)
+	if err != nil {
+		return err
+	}
+	addable := make(map[string]struct{}, len(addableIDs))
+	for _, id := range addableIDs {
+		addable[id] = struct{}{}
+	}
+
+	var violations []apperror.FieldViolation
+	for i, id := range questionIDs {
+		if _, ok := included[id]; ok {
+			continue
+		}
+		if _, ok := addable[id]; !ok {
+			violations = append(violations, apperror.FieldViolation{Field: "question_ids[" + strconv.Itoa(i) + "]", Description: "自分の問題か、公開中の問題を指定してください"})
+		}
+	}
+	if len(violations) > 0 {
+		return apperror.InvalidArgument("デッキに入れられない問題があります", violations...)
+	}
+	return nil
+}
+
+func validateDeckID(deckID string) error {
+	if deckID == "" {
+		return apperror.InvalidArgument("deck_id が空です", apperror.FieldViolation{Field: "deck_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(deckID); err != nil {
+		return apperror.InvalidArgument("deck_id が不正です", apperror.FieldViolation{Field: "deck_id", Description: "UUID 形式で指定してください"})
+	}
+	return nil
+}
+
+// normalizeDraft は題名/説明の前後空白を除き、問題 ID を小文字の標準形にそろえる（重複の判定のため）。
+func normalizeDraft(draft domain.DeckDraft) domain.DeckDraft {
+	questionIDs := make([]string, 0, len(draft.QuestionIDs))
+	for _, id := range draft.QuestionIDs {
+		id = strings.TrimSpace(id)
+		if parsed, err := uuid.Parse(id); err == nil {
+			id = parsed.String()
+		}
+		questionIDs = append(questionIDs, id)
+	}
+	return domain.DeckDraft{
+		Title:       strings.TrimSpace(draft.Title),
+		Description: strings.TrimSpace(draft.Description),
+		QuestionIDs: questionIDs,
+	}
+}
+
+// validateDraft は入力の形式だけを確認する（問題を入れてよいかは checkAddable で確認する）。
+func validateDraft(draft domain.DeckDraft) error {
+	var violations []apperror.FieldViolation
+
+	switch {
+	case draft.Title == "":
+		violations = append(violations, apperror.FieldViolation{Field: "title", Description: "必須です"})
+	case utf8.RuneCountInString(draft.Title) > maxTitleRunes:
+		violations = append(violations, apperror.FieldViolation{Field: "title", Description: strconv.Itoa(maxTitleRunes) + " 文字以内で入力してください"})
+	}
+	if utf8.RuneCountInString(draft.Description) > maxDescriptionRunes {
+		violations = append(violations, apperror.FieldViolation{Field: "description", Description: strconv.Itoa(maxDescriptionRunes) + " 文字以内で入力してください"})
+	}
+
+	if len(draft.QuestionIDs) > maxDeckQuestions {
+		violations = append(violations, apperror.FieldViolation{Field: "question_ids", Description: "問題は " + strconv.Itoa(maxDeckQuestions) + " 件までです"})
+	} else {
+		seen := make(map[string]struct{}, len(draft.QuestionIDs))
+		for i, id := range draft.QuestionIDs {
+			field := "question_ids[" + strconv.Itoa(i) + "]"
+			if _, err := uuid.Parse(id); err != nil {
+				violations = append(violations, apperror.FieldViolation{Field: field, Description: "UUID 形式で指定してください"})
+				continue
+			}
+			if _, dup := seen[id]; dup {
+				violations = append(violations, apperror.FieldViolation{Field: field, Description: "同じ問題が重複しています"})
+				continue
+			}
+			seen[id] = struct{}{}
+		}
+	}
+
+	if len(violations) > 0 {
+		return apperror.InvalidArgument("入力が不正です", violations...)
+	}
+	return nil
+}
+
+// newShareSlug は共有用文字列（80bit の乱数を 16 文字で表したもの）を生成する。
+func newShareSlug() (string, error) {
+	var b [10]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", apperror.Internal("共有用文字列の生成に失敗しました", fmt.Errorf("read random: %w", err))
+	}
+	return shareSlugEncoding.EncodeToString(b[:]), nil
+}
+
+func normalizePageSize(pageSize int32) int32 {
+	if pageSize <= 0 {
+		return 20
+	}
+	if pageSize > 100 {
+		return 100
+	}
+	return pageSize
+}
@@ -0,0 +1,267 @@
+package deck
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// fakeDeckRepo は deck.Usecase のユニットテスト用のリポジトリ差し替え。
+// NOTE: 実装は関数フィールドで差し替え、各テストで「呼ばれてよい/よくない」を明示する。
+type fakeDeckRepo struct {
+	createDeckFn   func(ctx context.Context, ownerUserID string, shareSlug string, draft domain.DeckDraft) (domain.Deck, error)
+	updateDeckFn   func(ctx context.Context, deckID string, draft domain.DeckDraft) (domain.Deck, error)
+	softDeleteFn   func(ctx context.Context, deckID string) error
+	getDeckFn      func(ctx context.Context, deckID string) (domain.Deck, error)
+	getDeckBySlug  func(ctx context.Context, shareSlug string) (domain.Deck, error)
+	listMyDecksFn  func(ctx context.Context, ownerUserID string, limit int32) ([]domain.Deck, error)
+	listAddableFn  func(ctx context.Context, userID string, questionIDs []string) ([]string, error)
+	getDeckOwnerFn func(ctx context.Context, deckID string) (string, bool, error)
+}
+
+func (f *fakeDeckRepo) CreateDeck(ctx context.Context, ownerUserID string, shareSlug string, draft domain.DeckDraft) (domain.Deck, error) {
+	return f.createDeckFn(ctx, ownerUserID, shareSlug, draft)
+}
+func (f *fakeDeckRepo) UpdateDeck(ctx context.Context, deckID string, draft domain.DeckDraft) (domain.Deck, error) {
+	return f.updateDeckFn(ctx, deckID, draft)
+}
+func (f *fakeDeckRepo) SoftDeleteDeck(ctx context.Context, deckID string) error {
+	return f.softDeleteFn(ctx, deckID)
+}
+func (f *fakeDeckRepo) GetDeck(ctx context.Context, deckID string) (domain.Deck, error) {
+	return f.getDeckFn(ctx, deckID)
+}
+func (f *fakeDeckRepo) GetDeckBySlug(ctx context.Context, shareSlug string) (domain.Deck, error) {
+	return f.getDeckBySlug(ctx, shareSlug)
+}
+func (f *fakeDeckRepo) ListMyDecks(ctx context.Context, ownerUserID string, limit int32) ([]domain.Deck, error) {
+	return f.listMyDecksFn(ctx, ownerUserID, limit)
+}
+func (f *fakeDeckRepo) ListAddableQuestionIDs(ctx context.Context, userID string, questionIDs []string) ([]string, error) {
+	return f.listAddableFn(ctx, userID, questionIDs)
+}
+func (f *fakeDeckRepo) GetDeckOwner(ctx context.Context, deckID string) (string, bool, error) {
+	return f.getDeckOwnerFn(ctx, deckID)
+}
+
+type fakeUserRepo struct {
+	ensureUserExistsFn func(ctx context.Context, userID string) error
+}
+
+func (f *fakeUserRepo) EnsureUserExists(ctx context.Context, userID string) error {
+	return f.ensureUserExistsFn(ctx, userID)
+}
+
+func TestUsecase_CreateDeck_Validation(t *testing.T) {
+	t.Parallel()
+
+	questionID := uuid.NewString()
+	tests := []struct {
+		name  string
+		draft domain.DeckDraft
+		field string
+	}{
+		{name: "題名が空", draft: domain.DeckDraft{Title: " "}, field: "title"},
+		{name: "題名が長すぎる", draft: domain.DeckDraft{Title: strings.Repeat("幕", maxTitleRunes+1)}, field: "title"},
+		{name: "説明が長すぎる", draft: domain.DeckDraft{Title: "幕末", Description: strings.Repeat("a", maxDescriptionRunes+1)}, field: "description"},
+		{name: "問題 ID が UUID でない", draft: domain.DeckDraft{Title: "幕末", QuestionIDs: []string{"q1"}}, field: "question_ids[0]"},
+		// 大文字で書いても同じ問題として扱う。
+		{name: "問題が重複", draft: domain.DeckDraft{Title: "幕末", QuestionIDs: []string{questionID, strings.ToUpper(questionID)}}, field: "question_ids[1]"},
+		{name: "問題が多すぎる", draft: domain.DeckDraft{Title: "幕末", QuestionIDs: make([]string, maxDeckQuestions+1)}, field: "question_ids"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := NewUsecase(
+				&fakeDeckRepo{listAddableFn: func(context.Context, string, []string) ([]string, error) {
+					t.Fatal("入力が不正な場合、問題の確認は行わない想定です")
+					return nil, nil
+				}},
+				&fakeUserRepo{},
+			)
+
+			_, err := u.CreateDeck(context.Background(), "user-1", tt.draft)
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+			if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.field {
+				t.Fatalf("%s の FieldViolation を期待しました: %+v", tt.field, appErr.FieldViolations)
+			}
+		})
+	}
+}
+
+func TestUsecase_CreateDeck_RejectsQuestionsNotAddable(t *testing.T) {
+	t.Parallel()
+
+	ownID := uuid.NewString()
+	privateID := uuid.NewString()
+
+	u := NewUsecase(
+		&fakeDeckRepo{
+			listAddableFn: func(_ context.Context, userID string, questionIDs []string) ([]string, error) {
+				if userID != "user-1" || len(questionIDs) != 2 {
+					t.Fatalf("ListAddableQuestionIDs の引数が期待と異なります: user=%s ids=%v", userID, questionIDs)
+				}
+				// 他人の下書き（privateID）は入れられない。
+				return []string{ownID}, nil
+			},
+			createDeckFn: func(context.Context, string, string, domain.DeckDraft) (domain.Deck, error) {
+				t.Fatal("入れられない問題がある場合、CreateDeck は呼ばれない想定です")
+				return domain.Deck{}, nil
+			},
+		},
+		&fakeUserRepo{},
+	)
+
+	_, err := u.CreateDeck(context.Background(), "user-1", domain.DeckDraft{Title: "幕末", QuestionIDs: []string{ownID, privateID}})
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+	if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != "question_ids[1]" {
+		t.Fatalf("question_ids[1] の FieldViolation を期待しました: %+v", appErr.FieldViolations)
+	}
+}
+
+func TestUsecase_CreateDeck_Success(t *testing.T) {
+	t.Parallel()
+
+	questionID := uuid.NewString()
+	var gotSlug string
+
+	u := NewUsecase(
+		&fakeDeckRepo{
+			listAddableFn: func(_ context.Context, _ string, questionIDs []string) ([]string, error) {
+				return questionIDs, nil
+			},
+			createDeckFn: func(_ context.Context, ownerUserID string, shareSlug string, draft domain.DeckDraft) (domain.Deck, error) {
+				gotSlug = shareSlug
+				if ownerUserID != "user-1" || draft.Title != "幕末10問" || len(draft.QuestionIDs) != 1 {
+					t.Fatalf("CreateDeck の引数が期待と異なります: owner=%s draft=%+v", ownerUserID, draft)
+				}
+				return domain.Deck{ID: uuid.NewString(), ShareSlug: shareSlug}, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+	)
+
+	if _, err := u.CreateDeck(context.Background(), "user-1", domain.DeckDraft{Title: " 幕末10問 ", QuestionIDs: []string{questionID}}); err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(gotSlug) != 16 || strings.Trim(gotSlug, "abcdefghijklmnopqrstuvwxyz234567") != "" {
+		t.Fatalf("共有用文字列は小文字英数字 16 文字の想定です: %q", gotSlug)
+	}
+}
+
+func TestUsecase_UpdateDeck_PermissionDenied(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeDeckRepo{
+			getDeckOwnerFn: func(context.Context, string) (string, bool, error) {
+				return "other", false, nil
+			},
+			updateDeckFn: func(context.Context, string, domain.DeckDraft) (domain.Deck, error) {
+				t.Fatal("権限がない場合、UpdateDeck は呼ばれない想定です")
+				return domain.Deck{}, nil
+			},
+		},
+		&fakeUserRepo{},
+	)
+
+	_, err := u.UpdateDeck(context.Background(), "user-1", uuid.NewString(), domain.DeckDraft{Title: "幕末"})
+	if !apperror.IsCode(err, apperror.CodePermissionDenied) {
+		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_UpdateDeck_KeepsAlreadyIncludedQuestions(t *testing.T) {
+	t.Parallel()
+
+	deckID := uuid.NewString()
+	unpublishedID := uuid.NewString()
+	newID := uuid.NewString()
+
+	u := NewUsecase(
+		&fakeDeckRepo{
+			getDeckOwnerFn: func(context.Context, string) (string, bool, error) {
+				return "user-1", false, nil
+			},
+			getDeckFn: func(context.Context, string) (domain.Deck, error) {
+				return domain.Deck{ID: deckID, QuestionIDs: []string{unpublishedID}}, nil
+			},
+			listAddableFn: func(_ context.Context, _ string, questionIDs []string) ([]string, error) {
+				// 既にデッキに入っている問題は確認しない（後から非公開になっていても残せる）。
+				if len(questionIDs) != 1 || questionIDs[0] != newID {
+					t.Fatalf("追加した問題だけを確認する想定です: %v", questionIDs)
+				}
+				return questionIDs, nil
+			},
+			updateDeckFn: func(_ context.Context, _ string, draft domain.DeckDraft) (domain.Deck, error) {
+				return domain.Deck{ID: deckID, QuestionIDs: draft.QuestionIDs}, nil
+			},
+		},
+		&fakeUserRepo{},
+	)
+
+	got, err := u.UpdateDeck(context.Background(), "user-1", deckID, domain.DeckDraft{Title: "幕末", QuestionIDs: []string{newID, unpublishedID}})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(got.QuestionIDs) != 2 || got.QuestionIDs[0] != newID {
+		t.Fatalf("並べ替えた順で保存する想定です: %v", got.QuestionIDs)
+	}
+}
+
+func TestUsecase_DeleteDeck_DeletedDeckIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeDeckRepo{
+			getDeckOwnerFn: func(context.Context, string) (string, bool, error) {
+				return "user-1", true, nil
+			},
+			softDeleteFn: func(context.Context, string) error {
+				t.Fatal("削除済みのデッキに SoftDeleteDeck は呼ばれない想定です")
+				return nil
+			},
+		},
+		&fakeUserRepo{},
+	)
+
+	err := u.DeleteDeck(context.Background(), "user-1", uuid.NewString())
+	if !apperror.IsCode(err, apperror.CodeNotFound) {
+		t.Fatalf("NOT_FOUND を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_GetSharedDeck_HidesQuestionIDs(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeDeckRepo{getDeckBySlug: func(_ context.Context, shareSlug string) (domain.Deck, error) {
+			if shareSlug != "abcdefghijklmnop" {
+				t.Fatalf("share_slug mismatch: %s", shareSlug)
+			}
+			return domain.Deck{ID: uuid.NewString(), Title: "幕末", QuestionIDs: []string{uuid.NewString()}, PlayableCount: 1}, nil
+		}},
+		&fakeUserRepo{},
+	)
+
+	got, err := u.GetSharedDeck(context.Background(), " abcdefghijklmnop ")
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(got.QuestionIDs) != 0 || got.PlayableCount != 1 {
+		t.Fatalf("共有では問題の並びを返さず、件数だけ返す想定です: %+v", got)
+	}
+}
@@ -0,0 +1,394 @@
+// Package entityfile は、エンティティ（人物/出来事/場所/王朝）と関係の取り込みファイル（CSV/JSON-LD）を読む。
+package entityfile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+)
+
+// Format は取り込みファイルの形式。
+type Format string
+
+const (
+	FormatCSV Format = "csv"
+	// FormatJSONLD は JSON-LD（@graph のノード、またはノードの配列）。@context は解釈しない（後述）。
+	FormatJSONLD Format = "jsonld"
+)
+
+// CSV の列名。ヘッダ行で列の順序を決めるため、並び順は自由。
+// 関係の列（participated_in など）には、目的語のエンティティの key を "|" 区切りで書く。
+const (
+	columnKey         = "key"
+	columnKind        = "kind"
+	columnName        = "name"
+	columnDescription = "description"
+	columnStartYear   = "start_year"
+	columnEndYear     = "end_year"
+	columnYearMargin  = "year_margin"
+)
+
+// relationKinds は関係の種類（CSV の列名、JSON-LD のプロパティ名の順に照合する）。
+var relationKinds = []domain.EntityRelationKind{
+	domain.EntityRelationParticipatedIn,
+	domain.EntityRelationLocatedIn,
+	domain.EntityRelationMemberOf,
+}
+
+// Row はファイル内の1エンティティ分。
+type Row struct {
+	// Line は CSV の行番号（ヘッダ=1）、JSON-LD のノードの 1 始まりの位置。
+	Line  int
+	Draft domain.EntityDraft
+	// Relations はこのエンティティを主語とする関係（SubjectKey は Draft.Key）。
+	Relations []domain.EntityImportRelation
+	// Violations は値の型の不正（例: 年が整数でない）。入力の検証（必須/長さ/関係の相手）は含まない。
+	Violations []apperror.FieldViolation
+}
+
+// Parse はファイル全体を行に分解する。
+// ヘッダ不足や JSON の構文エラーなど、行単位に割り当てられない不正は INVALID_ARGUMENT を返す。
+func Parse(format Format, r io.Reader) ([]Row, error) {
+	switch format {
+	case FormatCSV:
+		return parseCSV(r)
+	case FormatJSONLD:
+		return parseJSONLD(r)
+	default:
+		return nil, apperror.InvalidArgument("format が不正です", apperror.FieldViolation{Field: "format", Description: "CSV または JSON-LD を指定してください"})
+	}
+}
+
+func parseCSV(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(questionfile.StripBOM(r))
+	reader.FieldsPerRecord = 0
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, apperror.InvalidArgument("CSV の読み取りに失敗しました", apperror.FieldViolation{Field: "content", Description: err.Error()})
+	}
+
+	index := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, dup := index[name]; dup {
+			return nil, apperror.InvalidArgument("CSV のヘッダが不正です", apperror.FieldViolation{Field: "content", Description: "列 " + name + " が重複しています"})
+		}
+		index[name] = i
+	}
+
+	var violations []apperror.FieldViolation
+	known := map[string]struct{}{columnKey: {}, columnKind: {}, columnName: {}, columnDescription: {}, columnStartYear: {}, columnEndYear: {}, columnYearMargin: {}}
+	for _, kind := range relationKinds {
+		known[string(kind)] = struct{}{}
+	}
+	for name := range index {
+		if _, ok := known[name]; !ok {
+			violations = append(violations, apperror.FieldViolation{Field: "content", Description: "未知の列です: " + name})
+		}
+	}
+	for _, name := range []string{columnKey, columnKind, columnName} {
+		if _, ok := index[name]; !ok {
+			violations = append(violations, apperror.FieldViolation{Field: "content", Description: "列 " + name + " が必要です"})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, apperror.InvalidArgument("CSV のヘッダが不正です", violations...)
+	}
+
+	cell := func(record []string, name string) string {
+		i, ok := index[name]
+		if !ok {
+			return ""
+		}
+		return record[i]
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, apperror.InvalidArgument("CSV の読み取りに失敗しました", apperror.FieldViolation{Field: "content", Description: err.Error()})
+		}
+		line, _ := reader.FieldPos(0)
+
+		row := Row{Line: line}
+		row.Draft = domain.EntityDraft{
+			Key:         cell(record, columnKey),
+			Kind:        domain.EntityKind(strings.ToLower(strings.TrimSpace(cell(record, columnKind)))),
+			Name:        cell(record, columnName),
+			Description: cell(record, columnDescription),
+		}
+		row.Draft.StartYear = row.parseYear("draft.start_year", cell(record, columnStartYear))
+		row.Draft.EndYear = row.parseYear("draft.end_year", cell(record, columnEndYear))
+		row.Draft.YearMargin = row.parseMargin(cell(record, columnYearMargin))
+		for _, kind := range relationKinds {
+			for _, objectKey := range strings.Split(cell(record, string(kind)), questionfile.ListSeparator) {
+				row.addRelation(kind, objectKey)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseJSONLD は JSON-LD のノードを読む。
+// 受け付ける形: {"@graph": [ノード...]}、[ノード...]、1つのノード。
+// ノードのプロパティは、接頭辞/名前空間を除いた名前で照合する（"schema:name" も "https://schema.org/name" も name）。
+//   - "@id" → key、"@type" → kind（Person/Event/Place/Dynasty）
+//   - name / description（文字列、{"@value": ...}、またはその配列。配列は "@language": "ja" を優先）
+//   - startYear / startDate / birthDate、endYear / endDate / deathDate（年の整数、または "1498-05-20" のような日付の先頭の年）
+//   - yearMargin（年の誤差。整数）
+//   - participatedIn / locatedIn / memberOf（"@id" の文字列、{"@id": ...}、またはその配列）
+//
+// 混同しやすい点: @context は読まない（ネットワークに取りに行かない）。上記以外のプロパティは無視する。
+func parseJSONLD(r io.Reader) ([]Row, error) {
+	decoder := json.NewDecoder(questionfile.StripBOM(r))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, apperror.InvalidArgument("JSON-LD の読み取りに失敗しました", apperror.FieldViolation{Field: "content", Description: "JSON として読み取れません"})
+	}
+
+	var nodes []any
+	switch v := doc.(type) {
+	case []any:
+		nodes = v
+	case map[string]any:
+		if graph, ok := v["@graph"]; ok {
+			list, ok := graph.([]any)
+			if !ok {
+				return nil, apperror.InvalidArgument("JSON-LD の読み取りに失敗しました", apperror.FieldViolation{Field: "content", Description: "@graph は配列で指定してください"})
+			}
+			nodes = list
+		} else {
+			nodes = []any{v}
+		}
+	default:
+		return nil, apperror.InvalidArgument("JSON-LD の読み取りに失敗しました", apperror.FieldViolation{Field: "content", Description: "ノードのオブジェクトか @graph を指定してください"})
+	}
+
+	rows := make([]Row, 0, len(nodes))
+	for i, n := range nodes {
+		row := Row{Line: i + 1}
+		node, ok := n.(map[string]any)
+		if !ok {
+			row.Violations = append(row.Violations, apperror.FieldViolation{Field: "draft", Description: "ノードのオブジェクトとして読み取れません"})
+			rows = append(rows, row)
+			continue
+		}
+		row.readNode(node)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// readNode は JSON-LD の1ノードを読む。
+func (row *Row) readNode(node map[string]any) {
+	props := map[string]any{}
+	for key, v := range node {
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		props[localName(key)] = v
+	}
+
+	if id, ok := node["@id"].(string); ok {
+		row.Draft.Key = id
+	} else if _, exists := node["@id"]; exists {
+		row.Violations = append(row.Violations, apperror.FieldViolation{Field: "draft.key", Description: "@id は文字列で指定してください"})
+	}
+	row.Draft.Kind = kindOf(node["@type"])
+	row.Draft.Name = row.readText("draft.name", props["name"])
+	row.Draft.Description = row.readText("draft.description", props["description"])
+	row.Draft.StartYear = row.readYear("draft.start_year", firstOf(props, "startyear", "startdate", "birthdate"))
+	row.Draft.EndYear = row.readYear("draft.end_year", firstOf(props, "endyear", "enddate", "deathdate"))
+	row.Draft.YearMargin = row.readMargin(props["yearmargin"])
+
+	for _, kind := range relationKinds {
+		v, ok := props[strings.ReplaceAll(string(kind), "_", "")]
+		if !ok {
+			continue
+		}
+		list, isList := v.([]any)
+		if !isList {
+			list = []any{v}
+		}
+		for _, item := range list {
+			switch ref := item.(type) {
+			case string:
+				row.addRelation(kind, ref)
+			case map[string]any:
+				if id, ok := ref["@id"].(string); ok {
+					row.addRelation(kind, id)
+					continue
+				}
+				row.Violations = append(row.Violations, apperror.FieldViolation{Field: "relations." + string(kind), Description: "{\"@id\": ...} で指定してください"})
+			default:
+				row.Violations = append(row.Violations, apperror.FieldViolation{Field: "relations." + string(kind), Description: "@id の文字列で指定してください"})
+			}
+		}
+	}
+}
+
+// readText は name/description の値（文字列、{"@value": ...}、またはその配列）を読む。
+func (row *Row) readText(field string, v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case map[string]any:
+		if s, ok := t["@value"].(string); ok {
+			return s
+		}
+	case []any:
+		// 日本語の値を優先し、無ければ先頭の値を使う。
+		var first string
+		for i, item := range t {
+			s := row.readText(field, item)
+			if m, ok := item.(map[string]any); ok && m["@language"] == "ja" {
+				return s
+			}
+			if i == 0 {
+				first = s
+			}
+		}
+		return first
+	}
+	row.Violations = append(row.Violations, apperror.FieldViolation{Field: field, Description: "文字列で指定してください"})
+	return ""
+}
+
+// readYear は年の値（整数、または日付の文字列）を読む。
+func (row *Row) readYear(field string, v any) int32 {
+	switch t := v.(type) {
+	case nil:
+		return 0
+	case json.Number:
+		return row.parseYear(field, t.String())
+	case string:
+		return row.parseYear(field, t)
+	case map[string]any:
+		if s, ok := t["@value"].(string); ok {
+			return row.parseYear(field, s)
+		}
+	}
+	row.Violations = append(row.Violations, apperror.FieldViolation{Field: field, Description: "年は整数（紀元前は負の数）で指定してください"})
+	return 0
+}
+
+// parseYear は年（"1498"、"-490"）または日付（"1498-05-20"、"-0490-09-12"）の先頭の年を読む。空は 0（不明）。
+func (row *Row) parseYear(field string, s string) int32 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	digits := s
+	if len(s) > 1 && s[0] == '-' {
+		digits = s[1:]
+	}
+	if i := strings.IndexByte(digits, '-'); i > 0 {
+		s = s[:len(s)-len(digits)+i]
+	}
+	year, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		row.Violations = append(row.Violations, apperror.FieldViolation{Field: field, Description: "年は整数（紀元前は負の数）で指定してください"})
+		return 0
+	}
+	return int32(year)
+}
+
+// readMargin は年の誤差の値（整数、または整数の文字列）を読む。
+func (row *Row) readMargin(v any) int32 {
+	switch t := v.(type) {
+	case nil:
+		return 0
+	case json.Number:
+		return row.parseMargin(t.String())
+	case string:
+		return row.parseMargin(t)
+	}
+	row.Violations = append(row.Violations, apperror.FieldViolation{Field: "draft.year_margin", Description: "年の誤差は整数で指定してください"})
+	return 0
+}
+
+// parseMargin は年の誤差（"25"）を読む。空は 0（正確な年）。範囲は入力の検証で見る。
+func (row *Row) parseMargin(s string) int32 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	margin, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		row.Violations = append(row.Violations, apperror.FieldViolation{Field: "draft.year_margin", Description: "年の誤差は整数で指定してください"})
+		return 0
+	}
+	return int32(margin)
+}
+
+// addRelation は目的語の key が空でなければ関係を追加する。
+func (row *Row) addRelation(kind domain.EntityRelationKind, objectKey string) {
+	objectKey = strings.TrimSpace(objectKey)
+	if objectKey == "" {
+		return
+	}
+	row.Relations = append(row.Relations, domain.EntityImportRelation{
+		SubjectKey: strings.TrimSpace(row.Draft.Key),
+		Kind:       kind,
+		ObjectKey:  objectKey,
+	})
+}
+
+// kindOf は @type（文字列または配列）からエンティティの種類を決める（該当なしは空）。
+func kindOf(v any) domain.EntityKind {
+	types, isList := v.([]any)
+	if !isList {
+		types = []any{v}
+	}
+	for _, t := range types {
+		s, ok := t.(string)
+		if !ok {
+			continue
+		}
+		switch kind := domain.EntityKind(localName(s)); kind {
+		case domain.EntityKindPerson, domain.EntityKindEvent, domain.EntityKindPlace, domain.EntityKindDynasty:
+			return kind
+		}
+	}
+	return ""
+}
+
+// localName はプロパティ/型の名前から接頭辞や名前空間を除き、小文字にして "_" を除いた名前を返す。
+// 例: "schema:birthDate" → "birthdate"、"https://schema.org/Person" → "person"、"member_of" → "memberof"。
+func localName(s string) string {
+	if i := strings.LastIndexAny(s, ":/#"); i >= 0 {
+		s = s[i+1:]
+	}
+	return strings.ReplaceAll(strings.ToLower(s), "_", "")
+}
+
+// firstOf は names のうち最初にあるプロパティの値を返す。
+func firstOf(props map[string]any, names ...string) any {
+	for _, name := range names {
+		if v, ok := props[name]; ok {
+			return v
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package entityfile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestParse_CSV(t *testing.T) {
+	t.Parallel()
+
+	content := "\ufeffkey,kind,name,start_year,end_year,participated_in,member_of\n" +
+		"vasco-da-gama,Person,ヴァスコ・ダ・ガマ,1469,1524,india-route|calicut-arrival,avis\n" +
+		"india-route,event,インド航路の開拓,1497,1499,,\n" +
+		"caesar,person,カエサル,-100,紀元前44,,\n"
+
+	rows, err := Parse(FormatCSV, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("3行を期待しました: got=%d", len(rows))
+	}
+
+	first := rows[0]
+	if first.Line != 2 || len(first.Violations) != 0 {
+		t.Fatalf("1行目が期待と異なります: %+v", first)
+	}
+	if first.Draft.Kind != domain.EntityKindPerson || first.Draft.StartYear != 1469 || first.Draft.EndYear != 1524 {
+		t.Fatalf("draft が期待と異なります: %+v", first.Draft)
+	}
+	want := []domain.EntityImportRelation{
+		{SubjectKey: "vasco-da-gama", Kind: domain.EntityRelationParticipatedIn, ObjectKey: "india-route"},
+		{SubjectKey: "vasco-da-gama", Kind: domain.EntityRelationParticipatedIn, ObjectKey: "calicut-arrival"},
+		{SubjectKey: "vasco-da-gama", Kind: domain.EntityRelationMemberOf, ObjectKey: "avis"},
+	}
+	if len(first.Relations) != len(want) {
+		t.Fatalf("関係が期待と異なります: %+v", first.Relations)
+	}
+	for i := range want {
+		if first.Relations[i] != want[i] {
+			t.Fatalf("関係[%d]が期待と異なります: got=%+v want=%+v", i, first.Relations[i], want[i])
+		}
+	}
+
+	if len(rows[1].Relations) != 0 {
+		t.Fatalf("空の関係列は関係なしを期待しました: %+v", rows[1].Relations)
+	}
+
+	third := rows[2]
+	if third.Draft.StartYear != -100 {
+		t.Fatalf("紀元前は負の数を期待しました: got=%d", third.Draft.StartYear)
+	}
+	if len(third.Violations) != 1 || third.Violations[0].Field != "draft.end_year" {
+		t.Fatalf("end_year の型エラーを期待しました: %+v", third.Violations)
+	}
+}
+
+func TestParse_YearMargin(t *testing.T) {
+	t.Parallel()
+
+	csvRows, err := Parse(FormatCSV, strings.NewReader("key,kind,name,start_year,year_margin\nmarathon,event,マラトンの戦い,-490,\nbuddha,person,ゴータマ・シッダッタ,-563,25\nx,event,X,100,約10\n"))
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if csvRows[0].Draft.YearMargin != 0 || csvRows[1].Draft.YearMargin != 25 {
+		t.Fatalf("year_margin が期待と異なります: %+v / %+v", csvRows[0].Draft, csvRows[1].Draft)
+	}
+	if len(csvRows[2].Violations) != 1 || csvRows[2].Violations[0].Field != "draft.year_margin" {
+		t.Fatalf("year_margin の型エラーを期待しました: %+v", csvRows[2].Violations)
+	}
+
+	jsonRows, err := Parse(FormatJSONLD, strings.NewReader(`[{"@id": "buddha", "@type": "Person", "name": "ゴータマ・シッダッタ", "birthDate": "-0563", "schema:yearMargin": 25}]`))
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if jsonRows[0].Draft.YearMargin != 25 || len(jsonRows[0].Violations) != 0 {
+		t.Fatalf("yearMargin が期待と異なります: %+v", jsonRows[0])
+	}
+}
+
+func TestParse_CSVHeaderErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "必須列の欠落", content: "key,name\na,A\n"},
+		{name: "未知の列", content: "key,kind,name,founded_by\na,person,A,b\n"},
+		{name: "列の重複", content: "key,kind,name,Name\na,person,A,B\n"},
+		{name: "列数の不一致", content: "key,kind,name\na,person\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Parse(FormatCSV, strings.NewReader(tt.content))
+			if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+		})
+	}
+}
+
+func TestParse_JSONLD(t *testing.T) {
+	t.Parallel()
+
+	content := `{
+	  "@context": "https://schema.org/",
+	  "@graph": [
+	    {
+	      "@id": "vasco-da-gama",
+	      "@type": "Person",
+	      "name": [{"@value": "Vasco da Gama", "@language": "en"}, {"@value": "ヴァスコ・ダ・ガマ", "@language": "ja"}],
+	      "schema:birthDate": "1469",
+	      "deathDate": "1524-12-24",
+	      "participatedIn": [{"@id": "india-route"}, "calicut-arrival"],
+	      "member_of": {"@id": "avis"},
+	      "sameAs": "https://example.com/ignored"
+	    },
+	    {"@id": "india-route", "@type": ["Thing", "https://schema.org/Event"], "name": "インド航路の開拓", "startYear": 1497, "locatedIn": "calicut"},
+	    {"@id": "caesar", "@type": "Person", "name": {"@value": "カエサル"}, "startDate": "-0100-07-12", "endYear": "44 BC"},
+	    "not-a-node"
+	  ]
+	}`
+
+	rows, err := Parse(FormatJSONLD, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("4件を期待しました: got=%d", len(rows))
+	}
+
+	first := rows[0]
+	if len(first.Violations) != 0 {
+		t.Fatalf("1件目はエラーなしを期待しました: %+v", first.Violations)
+	}
+	if first.Draft.Key != "vasco-da-gama" || first.Draft.Kind != domain.EntityKindPerson || first.Draft.Name != "ヴァスコ・ダ・ガマ" {
+		t.Fatalf("draft が期待と異なります: %+v", first.Draft)
+	}
+	if first.Draft.StartYear != 1469 || first.Draft.EndYear != 1524 {
+		t.Fatalf("年が期待と異なります: %+v", first.Draft)
+	}
+	if len(first.Relations) != 3 || first.Relations[1].ObjectKey != "calicut-arrival" || first.Relations[2].Kind != domain.EntityRelationMemberOf {
+		t.Fatalf("関係が期待と異なります: %+v", first.Relations)
+	}
+
+	second := rows[1]
+	if second.Draft.Kind != domain.EntityKindEvent || second.Draft.StartYear != 1497 {
+		t.Fatalf("2件目が期待と異なります: %+v", second.Draft)
+	}
+	if len(second.Relations) != 1 || second.Relations[0].Kind != domain.EntityRelationLocatedIn {
+		t.Fatalf("located_in を期待しました: %+v", second.Relations)
+	}
+
+	third := rows[2]
+	if third.Draft.StartYear != -100 || third.Draft.Name != "カエサル" {
+		t.Fatalf("3件目が期待と異なります: %+v", third.Draft)
+	}
+	if len(third.Violations) != 1 || third.Violations[0].Field != "draft.end_year" {
+		t.Fatalf("end_year の型エラーを期待しました: %+v", third.Violations)
+	}
+
+	if rows[3].Line != 4 || len(rows[3].Violations) != 1 || rows[3].Violations[0].Field != "draft" {
+		t.Fatalf("ノードでない要素は行エラーを期待しました: %+v", rows[3])
+	}
+}
+
+func TestParse_JSONLDSingleNodeAndInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	rows, err := Parse(FormatJSONLD, strings.NewReader(`{"@id": "avis", "@type": "schema:Dynasty", "name": "アヴィス朝"}`))
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(rows) != 1 || rows[0].Draft.Kind != domain.EntityKindDynasty {
+		t.Fatalf("1件のノードを期待しました: %+v", rows)
+	}
+
+	if _, err := Parse(FormatJSONLD, strings.NewReader(`{"@graph": {"@id": "a"}}`)); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("配列でない @graph は INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+	if _, err := Parse(FormatJSONLD, strings.NewReader(`[{`)); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("JSON の構文エラーは INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+	if _, err := Parse(Format("xlsx"), strings.NewReader("")); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("未知の形式は INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
@@ -0,0 +1,138 @@
+package entity
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/entity/entityfile"
+)
+
+// 一括取り込みの上限。全行を1トランザクションで作成/更新するため、長時間のロックを避ける大きさに抑える。
+const (
+	maxImportBytes = 1 << 20
+	maxImportRows  = 500
+)
+
+// ImportRowError は取り込みで不正だった行と、その理由。
+type ImportRowError struct {
+	Row        int
+	Violations []apperror.FieldViolation
+}
+
+// ImportResult は ImportEntities の結果。
+type ImportResult struct {
+	TotalRows int
+	// RowErrors が空でない場合は何も変更していない。
+	RowErrors []ImportRowError
+	// Created/Updated/RelationsAdded は反映した件数（dryRun または RowErrors がある場合は 0）。
+	Created        int
+	Updated        int
+	RelationsAdded int
+}
+
+// ImportEntities は CSV/JSON-LD のエンティティと関係を key で照合して作成/更新する（管理者のみ）。
+// 1行でも不正があれば何も変更せず、行ごとの FieldViolation を返す。dryRun の場合は検証のみ行う。
+// 関係の相手は、同じファイル内か既存のエンティティの key で指す。既にある関係はそのまま（削除はしない）。
+func (u *Usecase) ImportEntities(ctx context.Context, userID string, format entityfile.Format, content []byte, dryRun bool) (ImportResult, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return ImportResult{}, err
+	}
+	if len(content) == 0 {
+		return ImportResult{}, apperror.InvalidArgument("content が空です", apperror.FieldViolation{Field: "content", Description: "必須です"})
+	}
+	if len(content) > maxImportBytes {
+		return ImportResult{}, apperror.InvalidArgument("content が大きすぎます", apperror.FieldViolation{Field: "content", Description: "1MiB 以内で指定してください"})
+	}
+
+	rows, err := entityfile.Parse(format, bytes.NewReader(content))
+	if err != nil {
+		return ImportResult{}, err
+	}
+	if len(rows) == 0 {
+		return ImportResult{}, apperror.InvalidArgument("エンティティが含まれていません", apperror.FieldViolation{Field: "content", Description: "1件以上含めてください"})
+	}
+	if len(rows) > maxImportRows {
+		return ImportResult{}, apperror.InvalidArgument("エンティティ数が多すぎます", apperror.FieldViolation{Field: "content", Description: strconv.Itoa(maxImportRows) + "件以内に分割してください"})
+	}
+
+	// 既存のエンティティ（ファイル内の key と関係の相手の key）をまとめて引き、種類の照合に使う。
+	var keys []string
+	for _, row := range rows {
+		keys = append(keys, normalizeDraft(row.Draft).Key)
+		for _, rel := range row.Relations {
+			keys = append(keys, rel.ObjectKey)
+		}
+	}
+	existing, err := u.entityRepo.ListEntitiesByKeys(ctx, keys)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	existingKinds := make(map[string]domain.EntityKind, len(existing))
+	for _, e := range existing {
+		existingKinds[e.Key] = e.Kind
+	}
+
+	result := ImportResult{TotalRows: len(rows)}
+	drafts := make([]domain.EntityDraft, 0, len(rows))
+	fileKinds := make(map[string]domain.EntityKind, len(rows))
+	rowViolations := make([][]apperror.FieldViolation, len(rows))
+	for i, row := range rows {
+		draft := normalizeDraft(row.Draft)
+		violations := append(row.Violations, validateDraft(draft)...)
+		if draft.Key != "" {
+			if _, dup := fileKinds[draft.Key]; dup {
+				violations = append(violations, apperror.FieldViolation{Field: "draft.key", Description: "ファイル内で key が重複しています"})
+			}
+			if kind, ok := existingKinds[draft.Key]; ok && isKnownKind(draft.Kind) && kind != draft.Kind {
+				violations = append(violations, apperror.FieldViolation{Field: "draft.kind", Description: "既存のエンティティと種類が異なります（種類は変更できません）"})
+			}
+			fileKinds[draft.Key] = draft.Kind
+		}
+		rowViolations[i] = violations
+		drafts = append(drafts, draft)
+	}
+
+	// 関係はファイル全体の key が揃ってから検証する（後ろの行のエンティティを前の行から指せるように）。
+	var relations []domain.EntityImportRelation
+	for i, row := range rows {
+		subjectKind := drafts[i].Kind
+		for _, rel := range row.Relations {
+			field := "relations." + string(rel.Kind)
+			objectKind, ok := fileKinds[rel.ObjectKey]
+			if !ok {
+				objectKind, ok = existingKinds[rel.ObjectKey]
+			}
+			switch {
+			case !ok:
+				rowViolations[i] = append(rowViolations[i], apperror.FieldViolation{Field: field, Description: "key が見つかりません: " + rel.ObjectKey})
+			case rel.ObjectKey == drafts[i].Key:
+				rowViolations[i] = append(rowViolations[i], apperror.FieldViolation{Field: field, Description: "自分自身との関係は作れません"})
+			case !rel.Kind.Allows(subjectKind, objectKind):
+				rowViolations[i] = append(rowViolations[i], apperror.FieldViolation{Field: field, Description: relationRule(rel.Kind)})
+			default:
+				relations = append(relations, domain.EntityImportRelation{SubjectKey: drafts[i].Key, Kind: rel.Kind, ObjectKey: rel.ObjectKey})
+			}
+		}
+	}
+
+	for i, row := range rows {
+		if len(rowViolations[i]) > 0 {
+			result.RowErrors = append(result.RowErrors, ImportRowError{Row: row.Line, Violations: rowViolations[i]})
+		}
+	}
+	if len(result.RowErrors) > 0 || dryRun {
+		return result, nil
+	}
+
+	imported, err := u.entityRepo.ImportEntities(ctx, drafts, relations)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	result.Created = imported.Created
+	result.Updated = imported.Updated
+	result.RelationsAdded = imported.RelationsAdded
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"encoding/base64"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// entitiesPageTokenKind はエンティティ一覧のページトークンの種類（他の一覧のトークンを弾く）。
+const entitiesPageTokenKind = "entities"
+
+// encodeEntitiesPageToken はエンティティ一覧のページング位置を署名付きの不透明な文字列にする。
+// NOTE: 名前は ":" を含み得るため base64 にしてから載せる（pagetoken の区切り文字と衝突させない）。
+func encodeEntitiesPageToken(codec pagetoken.Codec, c domain.EntityListCursor) string {
+	return codec.Encode(entitiesPageTokenKind, base64.RawURLEncoding.EncodeToString([]byte(c.Name)), c.EntityID)
+}
+
+// decodeEntitiesPageToken はページトークンを検証して復元する（空の場合は nil = 先頭から）。
+func decodeEntitiesPageToken(codec pagetoken.Codec, token string) (*domain.EntityListCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	fields, err := codec.Decode(entitiesPageTokenKind, token, 2)
+	if err != nil {
+		return nil, err
+	}
+	name, err := base64.RawURLEncoding.DecodeString(fields[0])
+	if err != nil {
+		return nil, pagetoken.ErrInvalid
+	}
+	if _, err := uuid.Parse(fields[1]); err != nil {
+		return nil, pagetoken.ErrInvalid
+	}
+	return &domain.EntityListCursor{Name: string(name), EntityID: fields[1]}, nil
+}
@@ -0,0 +1,454 @@
+package entity
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/authz"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+)
+
+// エンティティの入力の上限。
+const (
+	maxKeyLen            = 200
+	maxNameRunes         = 200
+	maxDescriptionRunes  = 2000
+	maxNameQueryRunes    = 100
+	maxYearAbs           = 9999
+	maxYearMargin        = 500
+	maxQuestionEntities  = 10
+	defaultEntitiesLimit = 20
+	maxEntitiesLimit     = 100
+)
+
+// Usecase はエンティティ（歴史上の人物/出来事/場所/王朝）と、その関係・問題への紐づけのユースケースを提供する。
+// エンティティと関係の編集は管理者のみ、閲覧は誰でも、問題への紐づけは問題の作成者が行う。
+type Usecase struct {
+	entityRepo repository.EntityRepository
+	admins     authz.AdminSet
+	pageTokens pagetoken.Codec
+}
+
+// NewUsecase は EntityUsecase を生成する。
+func NewUsecase(entityRepo repository.EntityRepository, admins authz.AdminSet, pageTokens pagetoken.Codec) *Usecase {
+	return &Usecase{
+		entityRepo: entityRepo,
+		admins:     admins,
+		pageTokens: pageTokens,
+	}
+}
+
+// CreateEntity はエンティティを作成する（管理者のみ）。
+func (u *Usecase) CreateEntity(ctx context.Context, userID string, draft domain.EntityDraft) (domain.Entity, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return domain.Entity{}, err
+	}
+	draft = normalizeDraft(draft)
+	if violations := validateDraft(draft); len(violations) > 0 {
+		return domain.Entity{}, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+	return u.entityRepo.CreateEntity(ctx, draft)
+}
+
+// UpdateEntity はエンティティを更新する（管理者のみ）。
+// 混同しやすい点: kind は変えられない（既存の関係の組み合わせが崩れるため）。変える場合は作り直す。
+func (u *Usecase) UpdateEntity(ctx context.Context, userID string, entityID string, draft domain.EntityDraft) (domain.Entity, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return domain.Entity{}, err
+	}
+	if err := validateEntityID("entity_id", entityID); err != nil {
+		return domain.Entity{}, err
+	}
+	draft = normalizeDraft(draft)
+	if violations := validateDraft(draft); len(violations) > 0 {
+		return domain.Entity{}, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	found, err := u.entityRepo.ListEntitiesByIDs(ctx, []string{entityID})
+	if err != nil {
+		return domain.Entity{}, err
+	}
+	if len(found) == 0 {
+		return domain.Entity{}, apperror.NotFound("エンティティが見つかりません")
+	}
+	if found[0].Kind != draft.Kind {
+		return domain.Entity{}, apperror.FailedPrecondition("エンティティの種類は変更できません")
+	}
+	return u.entityRepo.UpdateEntity(ctx, entityID, draft)
+}
+
+// DeleteEntity はエンティティを削除する（管理者のみ）。関係と問題への紐づけも消える。
+func (u *Usecase) DeleteEntity(ctx context.Context, userID string, entityID string) error {
+	if err := u.requireAdmin(userID); err != nil {
+		return err
+	}
+	if err := validateEntityID("entity_id", entityID); err != nil {
+		return err
+	}
+	return u.entityRepo.DeleteEntity(ctx, entityID)
+}
+
+// GetEntity はエンティティを関係と出題できる問題の数付きで返す（未ログインでも閲覧できる）。
+func (u *Usecase) GetEntity(ctx context.Context, entityID string) (domain.EntityDetail, error) {
+	if err := validateEntityID("entity_id", entityID); err != nil {
+		return domain.EntityDetail{}, err
+	}
+	return u.entityRepo.GetEntity(ctx, entityID)
+}
+
+// ListQuery はエンティティ一覧の条件（入力そのまま）。
+type ListQuery struct {
+	// Kind が空の場合はすべての種類。
+	Kind      domain.EntityKind
+	NameQuery string
+	PageSize  int32
+	PageToken string
+}
+
+// ListEntities はエンティティを名前順に返す（未ログインでも閲覧できる）。
+// 次のページがある場合は nextPageToken を返す。
+func (u *Usecase) ListEntities(ctx context.Context, q ListQuery) (entities []domain.Entity, nextPageToken string, err error) {
+	var violations []apperror.FieldViolation
+	if q.Kind != "" && !isKnownKind(q.Kind) {
+		violations = append(violations, apperror.FieldViolation{Field: "kind", Description: "種類が不正です"})
+	}
+	nameQuery := strings.TrimSpace(q.NameQuery)
+	if utf8.RuneCountInString(nameQuery) > maxNameQueryRunes {
+		violations = append(violations, apperror.FieldViolation{Field: "name_query", Description: strconv.Itoa(maxNameQueryRunes) + " 文字以内で入力してください"})
+	}
+	after, err := decodeEntitiesPageToken(u.pageTokens, q.PageToken)
+	if err != nil {
+		violations = append(violations, apperror.FieldViolation{Field: "pagination.page_token", Description: "不正なページトークンです"})
+	}
+	if len(violations) > 0 {
+		return nil, "", apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	limit := normalizePageSize(q.PageSize)
+	entities, err = u.entityRepo.ListEntities(ctx, domain.EntityListQuery{
+		Kind:      q.Kind,
+		NameQuery: nameQuery,
+		After:     after,
+		// 次のページの有無を判定するため1件多く読む。
+		Limit: limit + 1,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if int32(len(entities)) > limit {
+		entities = entities[:limit]
+		last := entities[len(entities)-1]
+		nextPageToken = encodeEntitiesPageToken(u.pageTokens, domain.EntityListCursor{Name: last.Name, EntityID: last.ID})
+	}
+	return entities, nextPageToken, nil
+}
+
+// AddEntityRelation は関係を追加する（管理者のみ）。
+// 種類の組み合わせ（例: participated_in は人物 → 出来事）に合わない場合は INVALID_ARGUMENT。
+func (u *Usecase) AddEntityRelation(ctx context.Context, userID string, relation domain.EntityRelation) error {
+	if err := u.requireAdmin(userID); err != nil {
+		return err
+	}
+	relation = normalizeRelation(relation)
+	if err := validateRelation(relation); err != nil {
+		return err
+	}
+
+	found, err := u.entityRepo.ListEntitiesByIDs(ctx, []string{relation.SubjectID, relation.ObjectID})
+	if err != nil {
+		return err
+	}
+	kinds := make(map[string]domain.EntityKind, len(found))
+	for _, e := range found {
+		kinds[e.ID] = e.Kind
+	}
+	subjectKind, okSubject := kinds[relation.SubjectID]
+	objectKind, okObject := kinds[relation.ObjectID]
+	if !okSubject || !okObject {
+		return apperror.NotFound("エンティティが見つかりません")
+	}
+	if !relation.Kind.Allows(subjectKind, objectKind) {
+		return apperror.InvalidArgument("関係の種類とエンティティの種類が合いません", apperror.FieldViolation{Field: "relation.kind", Description: relationRule(relation.Kind)})
+	}
+	return u.entityRepo.AddEntityRelation(ctx, relation)
+}
+
+// RemoveEntityRelation は関係を削除する（管理者のみ）。
+func (u *Usecase) RemoveEntityRelation(ctx context.Context, userID string, relation domain.EntityRelation) error {
+	if err := u.requireAdmin(userID); err != nil {
+		return err
+	}
+	relation = normalizeRelation(relation)
+	if err := validateRelation(relation); err != nil {
+		return err
+	}
+	return u.entityRepo.RemoveEntityRelation(ctx, relation)
+}
+
+// SetQuestionEntities は問題に付けるエンティティを丸ごと置き換え、付いたエンティティを返す（問題の作成者のみ）。
+// 空を渡すとすべて外す。
+func (u *Usecase) SetQuestionEntities(ctx context.Context, userID string, questionID string, entityIDs []string) ([]domain.EntityRef, error) {
+	if userID == "" {
+		return nil, apperror.Unauthenticated("認証が必要です")
+	}
+	if err := validateQuestionID(questionID); err != nil {
+		return nil, err
+	}
+	entityIDs = normalizeIDs(entityIDs)
+	if err := validateEntityIDs(entityIDs); err != nil {
+		return nil, err
+	}
+	if err := u.authorizeQuestionOwner(ctx, userID, questionID); err != nil {
+		return nil, err
+	}
+
+	if len(entityIDs) > 0 {
+		found, err := u.entityRepo.ListEntitiesByIDs(ctx, entityIDs)
+		if err != nil {
+			return nil, err
+		}
+		exists := make(map[string]struct{}, len(found))
+		for _, e := range found {
+			exists[e.ID] = struct{}{}
+		}
+		var violations []apperror.FieldViolation
+		for i, id := range entityIDs {
+			if _, ok := exists[id]; !ok {
+				violations = append(violations, apperror.FieldViolation{Field: "entity_ids[" + strconv.Itoa(i) + "]", Description: "エンティティが見つかりません"})
+			}
+		}
+		if len(violations) > 0 {
+			return nil, apperror.InvalidArgument("存在しないエンティティがあります", violations...)
+		}
+	}
+
+	if err := u.entityRepo.SetQuestionEntities(ctx, questionID, entityIDs); err != nil {
+		return nil, err
+	}
+	return u.entityRepo.ListQuestionEntities(ctx, questionID)
+}
+
+// ListQuestionEntities は問題に付いたエンティティを返す（問題の作成者のみ。解答者には SubmitAnswer の結果で返す）。
+func (u *Usecase) ListQuestionEntities(ctx context.Context, userID string, questionID string) ([]domain.EntityRef, error) {
+	if userID == "" {
+		return nil, apperror.Unauthenticated("認証が必要です")
+	}
+	if err := validateQuestionID(questionID); err != nil {
+		return nil, err
+	}
+	if err := u.authorizeQuestionOwner(ctx, userID, questionID); err != nil {
+		return nil, err
+	}
+	return u.entityRepo.ListQuestionEntities(ctx, questionID)
+}
+
+func (u *Usecase) requireAdmin(userID string) error {
+	if userID == "" {
+		return apperror.Unauthenticated("認証が必要です")
+	}
+	if !u.admins.IsAdmin(userID) {
+		return apperror.PermissionDenied("権限がありません")
+	}
+	return nil
+}
+
+func (u *Usecase) authorizeQuestionOwner(ctx context.Context, userID string, questionID string) error {
+	authorUserID, deleted, err := u.entityRepo.GetQuestionAuthor(ctx, questionID)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		return apperror.NotFound("問題が見つかりません")
+	}
+	if authorUserID != userID {
+		return apperror.PermissionDenied("権限がありません")
+	}
+	return nil
+}
+
+func validateEntityID(field string, entityID string) error {
+	if entityID == "" {
+		return apperror.InvalidArgument(field+" が空です", apperror.FieldViolation{Field: field, Description: "必須です"})
+	}
+	if _, err := uuid.Parse(entityID); err != nil {
+		return apperror.InvalidArgument(field+" が不正です", apperror.FieldViolation{Field: field, Description: "UUID 形式で指定してください"})
+	}
+	return nil
+}
+
+func validateQuestionID(questionID string) error {
+	if questionID == "" {
+		return apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(questionID); err != nil {
+		return apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+	}
+	return nil
+}
+
+func validateRelation(relation domain.EntityRelation) error {
+	if err := validateEntityID("relation.subject_entity_id", relation.SubjectID); err != nil {
+		return err
+	}
+	if err := validateEntityID("relation.object_entity_id", relation.ObjectID); err != nil {
+		return err
+	}
+	if !isKnownRelationKind(relation.Kind) {
+		return apperror.InvalidArgument("関係の種類が不正です", apperror.FieldViolation{Field: "relation.kind", Description: "participated_in / located_in / member_of のいずれかを指定してください"})
+	}
+	if relation.SubjectID == relation.ObjectID {
+		return apperror.InvalidArgument("自分自身との関係は作れません", apperror.FieldViolation{Field: "relation.object_entity_id", Description: "主語と異なるエンティティを指定してください"})
+	}
+	return nil
+}
+
+func validateEntityIDs(entityIDs []string) error {
+	if len(entityIDs) > maxQuestionEntities {
+		return apperror.InvalidArgument("入力が不正です", apperror.FieldViolation{Field: "entity_ids", Description: "エンティティは " + strconv.Itoa(maxQuestionEntities) + " 件までです"})
+	}
+	var violations []apperror.FieldViolation
+	seen := make(map[string]struct{}, len(entityIDs))
+	for i, id := range entityIDs {
+		field := "entity_ids[" + strconv.Itoa(i) + "]"
+		if _, err := uuid.Parse(id); err != nil {
+			violations = append(violations, apperror.FieldViolation{Field: field, Description: "UUID 形式で指定してください"})
+			continue
+		}
+		if _, dup := seen[id]; dup {
+			violations = append(violations, apperror.FieldViolation{Field: field, Description: "同じエンティティが重複しています"})
+			continue
+		}
+		seen[id] = struct{}{}
+	}
+	if len(violations) > 0 {
+		return apperror.InvalidArgument("入力が不正です", violations...)
+	}
+	return nil
+}
+
+// normalizeIDs は UUID を正規形（小文字、ハイフン区切り）に揃える（重複の判定を表記揺れに左右させない）。
+func normalizeIDs(ids []string) []string {
+	normalized := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if parsed, err := uuid.Parse(id); err == nil {
+			id = parsed.String()
+		}
+		normalized = append(normalized, id)
+	}
+	return normalized
+}
+
+func normalizeRelation(relation domain.EntityRelation) domain.EntityRelation {
+	ids := normalizeIDs([]string{relation.SubjectID, relation.ObjectID})
+	return domain.EntityRelation{SubjectID: ids[0], Kind: relation.Kind, ObjectID: ids[1]}
+}
+
+func normalizeDraft(draft domain.EntityDraft) domain.EntityDraft {
+	return domain.EntityDraft{
+		Key:         strings.TrimSpace(draft.Key),
+		Kind:        domain.EntityKind(strings.ToLower(strings.TrimSpace(string(draft.Kind)))),
+		Name:        strings.TrimSpace(draft.Name),
+		Description: strings.TrimSpace(draft.Description),
+		StartYear:   draft.StartYear,
+		EndYear:     draft.EndYear,
+		YearMargin:  draft.YearMargin,
+	}
+}
+
+// validateDraft は正規化済みの draft を検証し、違反を返す（取り込みでは行ごとに集めるため error にしない）。
+func validateDraft(draft domain.EntityDraft) []apperror.FieldViolation {
+	var violations []apperror.FieldViolation
+
+	switch {
+	case draft.Key == "":
+		violations = append(violations, apperror.FieldViolation{Field: "draft.key", Description: "必須です"})
+	case len(draft.Key) > maxKeyLen:
+		violations = append(violations, apperror.FieldViolation{Field: "draft.key", Description: strconv.Itoa(maxKeyLen) + " バイト以内で入力してください"})
+	case strings.IndexFunc(draft.Key, unicode.IsSpace) >= 0:
+		violations = append(violations, apperror.FieldViolation{Field: "draft.key", Description: "空白を含めないでください"})
+	}
+	if !isKnownKind(draft.Kind) {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.kind", Description: "person / event / place / dynasty のいずれかを指定してください"})
+	}
+	switch {
+	case draft.Name == "":
+		violations = append(violations, apperror.FieldViolation{Field: "draft.name", Description: "必須です"})
+	case utf8.RuneCountInString(draft.Name) > maxNameRunes:
+		violations = append(violations, apperror.FieldViolation{Field: "draft.name", Description: strconv.Itoa(maxNameRunes) + " 文字以内で入力してください"})
+	}
+	if utf8.RuneCountInString(draft.Description) > maxDescriptionRunes {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.description", Description: strconv.Itoa(maxDescriptionRunes) + " 文字以内で入力してください"})
+	}
+
+	yearOK := true
+	if draft.StartYear < -maxYearAbs || draft.StartYear > maxYearAbs {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.start_year", Description: "-9999..9999 の範囲で指定してください"})
+		yearOK = false
+	}
+	if draft.EndYear < -maxYearAbs || draft.EndYear > maxYearAbs {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.end_year", Description: "-9999..9999 の範囲で指定してください"})
+		yearOK = false
+	}
+	// 0 は不明のため、両方わかっている場合だけ前後を見る。
+	if yearOK && draft.StartYear != 0 && draft.EndYear != 0 && draft.StartYear > draft.EndYear {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.end_year", Description: "start_year 以降の年を指定してください"})
+	}
+	switch {
+	case draft.YearMargin < 0 || draft.YearMargin > maxYearMargin:
+		violations = append(violations, apperror.FieldViolation{Field: "draft.year_margin", Description: "0..500 の範囲で指定してください"})
+	case draft.YearMargin > 0 && draft.StartYear == 0 && draft.EndYear == 0:
+		violations = append(violations, apperror.FieldViolation{Field: "draft.year_margin", Description: "年が不明の場合は指定できません"})
+	}
+	return violations
+}
+
+// relationRule は関係の種類ごとに使える組み合わせの説明を返す。
+func relationRule(kind domain.EntityRelationKind) string {
+	switch kind {
+	case domain.EntityRelationParticipatedIn:
+		return "participated_in は 人物 → 出来事 で指定してください"
+	case domain.EntityRelationLocatedIn:
+		return "located_in は 出来事/場所 → 場所 で指定してください"
+	case domain.EntityRelationMemberOf:
+		return "member_of は 人物 → 王朝 で指定してください"
+	default:
+		return "関係の種類が不正です"
+	}
+}
+
+func isKnownKind(kind domain.EntityKind) bool {
+	switch kind {
+	case domain.EntityKindPerson, domain.EntityKindEvent, domain.EntityKindPlace, domain.EntityKindDynasty:
+		return true
+	default:
+		return false
+	}
+}
+
+func isKnownRelationKind(kind domain.EntityRelationKind) bool {
+	switch kind {
+	case domain.EntityRelationParticipatedIn, domain.EntityRelationLocatedIn, domain.EntityRelationMemberOf:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizePageSize は pageSize のデフォルト/上限を統一する。
+func normalizePageSize(pageSize int32) int32 {
+	if pageSize <= 0 {
+		return defaultEntitiesLimit
+	}
+	if pageSize > maxEntitiesLimit {
+		return maxEntitiesLimit
+	}
+	return pageSize
+}
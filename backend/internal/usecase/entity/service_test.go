@@ -0,0 +1,408 @@
+package entity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/authz"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/entity/entityfile"
+)
+
+// fakeEntityRepo は entity.Usecase のユニットテスト用のリポジトリ差し替え。
+type fakeEntityRepo struct {
+	createEntityFn         func(ctx context.Context, draft domain.EntityDraft) (domain.Entity, error)
+	updateEntityFn         func(ctx context.Context, entityID string, draft domain.EntityDraft) (domain.Entity, error)
+	listEntitiesFn         func(ctx context.Context, query domain.EntityListQuery) ([]domain.Entity, error)
+	listEntitiesByIDsFn    func(ctx context.Context, entityIDs []string) ([]domain.Entity, error)
+	listEntitiesByKeysFn   func(ctx context.Context, keys []string) ([]domain.Entity, error)
+	addEntityRelationFn    func(ctx context.Context, relation domain.EntityRelation) error
+	importEntitiesFn       func(ctx context.Context, entities []domain.EntityDraft, relations []domain.EntityImportRelation) (domain.EntityImportResult, error)
+	setQuestionEntitiesFn  func(ctx context.Context, questionID string, entityIDs []string) error
+	listQuestionEntitiesFn func(ctx context.Context, questionID string) ([]domain.EntityRef, error)
+	getQuestionAuthorFn    func(ctx context.Context, questionID string) (string, bool, error)
+}
+
+func (f *fakeEntityRepo) CreateEntity(ctx context.Context, draft domain.EntityDraft) (domain.Entity, error) {
+	return f.createEntityFn(ctx, draft)
+}
+
+func (f *fakeEntityRepo) UpdateEntity(ctx context.Context, entityID string, draft domain.EntityDraft) (domain.Entity, error) {
+	return f.updateEntityFn(ctx, entityID, draft)
+}
+
+func (f *fakeEntityRepo) DeleteEntity(context.Context, string) error {
+	panic("not used in entity usecase tests")
+}
+
+func (f *fakeEntityRepo) GetEntity(context.Context, string) (domain.EntityDetail, error) {
+	panic("not used in entity usecase tests")
+}
+
+func (f *fakeEntityRepo) ListEntities(ctx context.Context, query domain.EntityListQuery) ([]domain.Entity, error) {
+	return f.listEntitiesFn(ctx, query)
+}
+
+func (f *fakeEntityRepo) ListEntitiesByIDs(ctx context.Context, entityIDs []string) ([]domain.Entity, error) {
+	return f.listEntitiesByIDsFn(ctx, entityIDs)
+}
+
+func (f *fakeEntityRepo) ListEntitiesByKeys(ctx context.Context, keys []string) ([]domain.Entity, error) {
+	return f.listEntitiesByKeysFn(ctx, keys)
+}
+
+func (f *fakeEntityRepo) AddEntityRelation(ctx context.Context, relation domain.EntityRelation) error {
+	return f.addEntityRelationFn(ctx, relation)
+}
+
+func (f *fakeEntityRepo) RemoveEntityRelation(context.Context, domain.EntityRelation) error {
+	panic("not used in entity usecase tests")
+}
+
+func (f *fakeEntityRepo) ImportEntities(ctx context.Context, entities []domain.EntityDraft, relations []domain.EntityImportRelation) (domain.EntityImportResult, error) {
+	return f.importEntitiesFn(ctx, entities, relations)
+}
+
+func (f *fakeEntityRepo) SetQuestionEntities(ctx context.Context, questionID string, entityIDs []string) error {
+	return f.setQuestionEntitiesFn(ctx, questionID, entityIDs)
+}
+
+func (f *fakeEntityRepo) ListQuestionEntities(ctx context.Context, questionID string) ([]domain.EntityRef, error) {
+	return f.listQuestionEntitiesFn(ctx, questionID)
+}
+
+func (f *fakeEntityRepo) GetQuestionAuthor(ctx context.Context, questionID string) (string, bool, error) {
+	return f.getQuestionAuthorFn(ctx, questionID)
+}
+
+// testPageTokens はテスト用の page_token の署名鍵。
+var testPageTokens = pagetoken.NewCodec([]byte("test"))
+
+func TestUsecase_CreateEntity_RequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := uuid.NewString()
+	repo := &fakeEntityRepo{createEntityFn: func(context.Context, domain.EntityDraft) (domain.Entity, error) {
+		t.Fatal("管理者以外は repo を呼ばない想定です")
+		return domain.Entity{}, nil
+	}}
+	u := NewUsecase(repo, authz.ParseAdminSet(adminUserID), testPageTokens)
+	draft := domain.EntityDraft{Key: "vasco-da-gama", Kind: domain.EntityKindPerson, Name: "ヴァスコ・ダ・ガマ"}
+
+	if _, err := u.CreateEntity(context.Background(), "", draft); !apperror.IsCode(err, apperror.CodeUnauthenticated) {
+		t.Fatalf("UNAUTHENTICATED を期待しました: err=%v", err)
+	}
+	if _, err := u.CreateEntity(context.Background(), uuid.NewString(), draft); !apperror.IsCode(err, apperror.CodePermissionDenied) {
+		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+	}
+
+	var got domain.EntityDraft
+	repo.createEntityFn = func(_ context.Context, d domain.EntityDraft) (domain.Entity, error) {
+		got = d
+		return domain.Entity{ID: uuid.NewString(), Key: d.Key, Kind: d.Kind, Name: d.Name}, nil
+	}
+	if _, err := u.CreateEntity(context.Background(), adminUserID, domain.EntityDraft{Key: " avis ", Kind: "Dynasty", Name: " アヴィス朝 "}); err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.Key != "avis" || got.Kind != domain.EntityKindDynasty || got.Name != "アヴィス朝" {
+		t.Fatalf("正規化した draft を期待しました: %+v", got)
+	}
+}
+
+func TestValidateDraft(t *testing.T) {
+	t.Parallel()
+
+	valid := domain.EntityDraft{Key: "caesar", Kind: domain.EntityKindPerson, Name: "カエサル", StartYear: -100, EndYear: -44}
+
+	tests := []struct {
+		name      string
+		mutate    func(d *domain.EntityDraft)
+		wantField string
+	}{
+		{name: "正常（紀元前）", mutate: func(*domain.EntityDraft) {}},
+		{name: "年が片方不明", mutate: func(d *domain.EntityDraft) { d.StartYear = 0; d.EndYear = 1524 }},
+		{name: "key 必須", mutate: func(d *domain.EntityDraft) { d.Key = "" }, wantField: "draft.key"},
+		{name: "key に空白", mutate: func(d *domain.EntityDraft) { d.Key = "julius caesar" }, wantField: "draft.key"},
+		{name: "未知の種類", mutate: func(d *domain.EntityDraft) { d.Kind = "artifact" }, wantField: "draft.kind"},
+		{name: "名前必須", mutate: func(d *domain.EntityDraft) { d.Name = "" }, wantField: "draft.name"},
+		{name: "名前が長すぎる", mutate: func(d *domain.EntityDraft) { d.Name = strings.Repeat("あ", maxNameRunes+1) }, wantField: "draft.name"},
+		{name: "年の範囲外", mutate: func(d *domain.EntityDraft) { d.StartYear = -10000 }, wantField: "draft.start_year"},
+		{name: "終了が開始より前", mutate: func(d *domain.EntityDraft) { d.StartYear = -44; d.EndYear = -100 }, wantField: "draft.end_year"},
+		{name: "年の誤差", mutate: func(d *domain.EntityDraft) { d.YearMargin = 25 }},
+		{name: "年の誤差が範囲外", mutate: func(d *domain.EntityDraft) { d.YearMargin = maxYearMargin + 1 }, wantField: "draft.year_margin"},
+		{name: "年が不明なのに誤差", mutate: func(d *domain.EntityDraft) { d.StartYear = 0; d.EndYear = 0; d.YearMargin = 5 }, wantField: "draft.year_margin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := valid
+			tt.mutate(&d)
+			violations := validateDraft(d)
+			if tt.wantField == "" {
+				if len(violations) != 0 {
+					t.Fatalf("違反なしを期待しました: %+v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 || violations[0].Field != tt.wantField {
+				t.Fatalf("%s の違反を期待しました: %+v", tt.wantField, violations)
+			}
+		})
+	}
+}
+
+func TestUsecase_UpdateEntity_KindIsImmutable(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := uuid.NewString()
+	entityID := uuid.NewString()
+	u := NewUsecase(&fakeEntityRepo{
+		listEntitiesByIDsFn: func(context.Context, []string) ([]domain.Entity, error) {
+			return []domain.Entity{{ID: entityID, Kind: domain.EntityKindEvent}}, nil
+		},
+		updateEntityFn: func(context.Context, string, domain.EntityDraft) (domain.Entity, error) {
+			t.Fatal("種類が変わる場合は更新しない想定です")
+			return domain.Entity{}, nil
+		},
+	}, authz.ParseAdminSet(adminUserID), testPageTokens)
+
+	_, err := u.UpdateEntity(context.Background(), adminUserID, entityID, domain.EntityDraft{Key: "calicut", Kind: domain.EntityKindPlace, Name: "カリカット"})
+	if !apperror.IsCode(err, apperror.CodeFailedPrecondition) {
+		t.Fatalf("FAILED_PRECONDITION を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_AddEntityRelation_ChecksKinds(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := uuid.NewString()
+	person := domain.Entity{ID: uuid.NewString(), Kind: domain.EntityKindPerson}
+	event := domain.Entity{ID: uuid.NewString(), Kind: domain.EntityKindEvent}
+	place := domain.Entity{ID: uuid.NewString(), Kind: domain.EntityKindPlace}
+
+	tests := []struct {
+		name     string
+		relation domain.EntityRelation
+		wantCode apperror.Code
+	}{
+		{name: "人物 → 出来事", relation: domain.EntityRelation{SubjectID: person.ID, Kind: domain.EntityRelationParticipatedIn, ObjectID: event.ID}},
+		{name: "出来事 → 場所", relation: domain.EntityRelation{SubjectID: event.ID, Kind: domain.EntityRelationLocatedIn, ObjectID: place.ID}},
+		{name: "向きが逆", relation: domain.EntityRelation{SubjectID: event.ID, Kind: domain.EntityRelationParticipatedIn, ObjectID: person.ID}, wantCode: apperror.CodeInvalidArgument},
+		{name: "人物は場所に含まれない", relation: domain.EntityRelation{SubjectID: person.ID, Kind: domain.EntityRelationLocatedIn, ObjectID: place.ID}, wantCode: apperror.CodeInvalidArgument},
+		{name: "未知の関係", relation: domain.EntityRelation{SubjectID: person.ID, Kind: "born_in", ObjectID: place.ID}, wantCode: apperror.CodeInvalidArgument},
+		{name: "自分自身", relation: domain.EntityRelation{SubjectID: place.ID, Kind: domain.EntityRelationLocatedIn, ObjectID: place.ID}, wantCode: apperror.CodeInvalidArgument},
+		{name: "存在しない相手", relation: domain.EntityRelation{SubjectID: person.ID, Kind: domain.EntityRelationParticipatedIn, ObjectID: uuid.NewString()}, wantCode: apperror.CodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			added := false
+			u := NewUsecase(&fakeEntityRepo{
+				listEntitiesByIDsFn: func(context.Context, []string) ([]domain.Entity, error) {
+					return []domain.Entity{person, event, place}, nil
+				},
+				addEntityRelationFn: func(context.Context, domain.EntityRelation) error {
+					added = true
+					return nil
+				},
+			}, authz.ParseAdminSet(adminUserID), testPageTokens)
+
+			err := u.AddEntityRelation(context.Background(), adminUserID, tt.relation)
+			if tt.wantCode != "" {
+				if !apperror.IsCode(err, tt.wantCode) || added {
+					t.Fatalf("%s で追加しないことを期待しました: err=%v added=%v", tt.wantCode, err, added)
+				}
+				return
+			}
+			if err != nil || !added {
+				t.Fatalf("追加することを期待しました: err=%v added=%v", err, added)
+			}
+		})
+	}
+}
+
+func TestUsecase_ListEntities_PageToken(t *testing.T) {
+	t.Parallel()
+
+	first := domain.Entity{ID: uuid.NewString(), Name: "A:B"}
+	second := domain.Entity{ID: uuid.NewString(), Name: "C"}
+
+	var gotAfter *domain.EntityListCursor
+	u := NewUsecase(&fakeEntityRepo{listEntitiesFn: func(_ context.Context, query domain.EntityListQuery) ([]domain.Entity, error) {
+		gotAfter = query.After
+		if query.Limit != 2 {
+			t.Fatalf("1件多く読むことを期待しました: limit=%d", query.Limit)
+		}
+		return []domain.Entity{first, second}, nil
+	}}, nil, testPageTokens)
+
+	entities, next, err := u.ListEntities(context.Background(), ListQuery{PageSize: 1})
+	if err != nil || len(entities) != 1 || next == "" {
+		t.Fatalf("1件と次のページを期待しました: entities=%d next=%q err=%v", len(entities), next, err)
+	}
+	if _, _, err := u.ListEntities(context.Background(), ListQuery{PageSize: 1, PageToken: next}); err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if gotAfter == nil || gotAfter.Name != "A:B" || gotAfter.EntityID != first.ID {
+		t.Fatalf("名前に \":\" を含む位置も復元できることを期待しました: %+v", gotAfter)
+	}
+
+	if _, _, err := u.ListEntities(context.Background(), ListQuery{PageToken: "broken"}); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("不正なトークンは INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+	if _, _, err := u.ListEntities(context.Background(), ListQuery{Kind: "artifact"}); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("未知の種類は INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_SetQuestionEntities(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.NewString()
+	questionID := uuid.NewString()
+	known := uuid.NewString()
+
+	newRepo := func(set *[]string) *fakeEntityRepo {
+		return &fakeEntityRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return userID, false, nil
+			},
+			listEntitiesByIDsFn: func(context.Context, []string) ([]domain.Entity, error) {
+				return []domain.Entity{{ID: known, Kind: domain.EntityKindPerson, Name: "カエサル"}}, nil
+			},
+			setQuestionEntitiesFn: func(_ context.Context, _ string, entityIDs []string) error {
+				*set = entityIDs
+				return nil
+			},
+			listQuestionEntitiesFn: func(context.Context, string) ([]domain.EntityRef, error) {
+				return []domain.EntityRef{{ID: known, Kind: domain.EntityKindPerson, Name: "カエサル"}}, nil
+			},
+		}
+	}
+
+	t.Run("作成者はエンティティを付けられる", func(t *testing.T) {
+		t.Parallel()
+		var set []string
+		u := NewUsecase(newRepo(&set), nil, testPageTokens)
+		refs, err := u.SetQuestionEntities(context.Background(), userID, questionID, []string{strings.ToUpper(known)})
+		if err != nil || len(refs) != 1 {
+			t.Fatalf("付いたエンティティを返すことを期待しました: refs=%+v err=%v", refs, err)
+		}
+		if len(set) != 1 || set[0] != known {
+			t.Fatalf("正規化した ID で保存することを期待しました: %v", set)
+		}
+	})
+
+	t.Run("作成者以外は付けられない", func(t *testing.T) {
+		t.Parallel()
+		var set []string
+		u := NewUsecase(newRepo(&set), nil, testPageTokens)
+		if _, err := u.SetQuestionEntities(context.Background(), uuid.NewString(), questionID, []string{known}); !apperror.IsCode(err, apperror.CodePermissionDenied) {
+			t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+		}
+		if set != nil {
+			t.Fatalf("保存しないことを期待しました: %v", set)
+		}
+	})
+
+	t.Run("存在しない/重複したエンティティは入力不正", func(t *testing.T) {
+		t.Parallel()
+		var set []string
+		u := NewUsecase(newRepo(&set), nil, testPageTokens)
+		if _, err := u.SetQuestionEntities(context.Background(), userID, questionID, []string{known, uuid.NewString()}); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+			t.Fatalf("存在しないエンティティは INVALID_ARGUMENT を期待しました: err=%v", err)
+		}
+		if _, err := u.SetQuestionEntities(context.Background(), userID, questionID, []string{known, known}); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+			t.Fatalf("重複は INVALID_ARGUMENT を期待しました: err=%v", err)
+		}
+		if set != nil {
+			t.Fatalf("保存しないことを期待しました: %v", set)
+		}
+	})
+}
+
+func TestUsecase_ImportEntities(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := uuid.NewString()
+	existing := []domain.Entity{
+		{ID: uuid.NewString(), Key: "avis", Kind: domain.EntityKindDynasty},
+		{ID: uuid.NewString(), Key: "calicut", Kind: domain.EntityKindPlace},
+	}
+
+	t.Run("ファイル内と既存の key で関係を作る", func(t *testing.T) {
+		t.Parallel()
+
+		var gotRelations []domain.EntityImportRelation
+		u := NewUsecase(&fakeEntityRepo{
+			listEntitiesByKeysFn: func(context.Context, []string) ([]domain.Entity, error) { return existing, nil },
+			importEntitiesFn: func(_ context.Context, entities []domain.EntityDraft, relations []domain.EntityImportRelation) (domain.EntityImportResult, error) {
+				gotRelations = relations
+				return domain.EntityImportResult{Created: len(entities), RelationsAdded: len(relations)}, nil
+			},
+		}, authz.ParseAdminSet(adminUserID), testPageTokens)
+
+		content := "key,kind,name,participated_in,member_of,located_in\n" +
+			"vasco-da-gama,person,ヴァスコ・ダ・ガマ,india-route,avis,\n" +
+			"india-route,event,インド航路の開拓,,,calicut\n"
+		result, err := u.ImportEntities(context.Background(), adminUserID, entityfile.FormatCSV, []byte(content), false)
+		if err != nil {
+			t.Fatalf("err は nil を期待しました: %v", err)
+		}
+		if len(result.RowErrors) != 0 || result.Created != 2 || result.RelationsAdded != 3 || len(gotRelations) != 3 {
+			t.Fatalf("結果が期待と異なります: %+v relations=%+v", result, gotRelations)
+		}
+	})
+
+	t.Run("不正な行があれば何も取り込まない", func(t *testing.T) {
+		t.Parallel()
+
+		u := NewUsecase(&fakeEntityRepo{
+			listEntitiesByKeysFn: func(context.Context, []string) ([]domain.Entity, error) { return existing, nil },
+			importEntitiesFn: func(context.Context, []domain.EntityDraft, []domain.EntityImportRelation) (domain.EntityImportResult, error) {
+				t.Fatal("行エラーがある場合は取り込まない想定です")
+				return domain.EntityImportResult{}, nil
+			},
+		}, authz.ParseAdminSet(adminUserID), testPageTokens)
+
+		content := "key,kind,name,participated_in,located_in\n" +
+			"vasco-da-gama,person,ヴァスコ・ダ・ガマ,calicut,\n" +
+			"avis,place,アヴィス,,\n" +
+			"lisbon,place,リスボン,,portugal\n" +
+			"lisbon,place,リスボン,,\n"
+		result, err := u.ImportEntities(context.Background(), adminUserID, entityfile.FormatCSV, []byte(content), false)
+		if err != nil {
+			t.Fatalf("err は nil を期待しました: %v", err)
+		}
+		want := map[int]string{
+			2: "relations.participated_in", // 人物 → 場所 は participated_in にできない
+			3: "draft.kind",                // 既存の王朝と種類が異なる
+			4: "relations.located_in",      // 相手の key が無い
+			5: "draft.key",                 // ファイル内で重複
+		}
+		if len(result.RowErrors) != len(want) {
+			t.Fatalf("行エラーが期待と異なります: %+v", result.RowErrors)
+		}
+		for _, rowErr := range result.RowErrors {
+			if len(rowErr.Violations) != 1 || rowErr.Violations[0].Field != want[rowErr.Row] {
+				t.Fatalf("%d 行目の違反が期待と異なります: %+v", rowErr.Row, rowErr.Violations)
+			}
+		}
+	})
+
+	t.Run("管理者以外は取り込めない", func(t *testing.T) {
+		t.Parallel()
+
+		u := NewUsecase(&fakeEntityRepo{}, authz.ParseAdminSet(adminUserID), testPageTokens)
+		if _, err := u.ImportEntities(context.Background(), uuid.NewString(), entityfile.FormatCSV, []byte("key,kind,name\n"), false); !apperror.IsCode(err, apperror.CodePermissionDenied) {
+			t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+		}
+	})
+}
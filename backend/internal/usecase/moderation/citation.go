@@ -0,0 +1,35 @@
+package moderation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// ListUncitedQuestions は出典が1件もない公開中の問題を古い順に返す（管理者のみ）。
+// pageToken には前のページの nextPageToken（最後の問題の ID）を渡す。次のページが無い場合、nextPageToken は空。
+func (u *Usecase) ListUncitedQuestions(ctx context.Context, userID string, pageToken string, pageSize int32) ([]domain.UncitedQuestion, string, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return nil, "", err
+	}
+	if pageToken != "" {
+		if _, err := uuid.Parse(pageToken); err != nil {
+			return nil, "", apperror.InvalidArgument("page_token が不正です", apperror.FieldViolation{Field: "pagination.page_token", Description: "前のページの next_page_token を指定してください"})
+		}
+	}
+
+	// 次のページの有無を知るため、1件多く読む。
+	limit := normalizePageSize(pageSize)
+	questions, err := u.questionRepo.ListUncitedQuestions(ctx, pageToken, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	nextPageToken := ""
+	if len(questions) > int(limit) {
+		questions = questions[:limit]
+		nextPageToken = questions[len(questions)-1].QuestionID
+	}
+	return questions, nextPageToken, nil
+}
@@ -0,0 +1,82 @@
+package moderation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/app/authz"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestUsecase_ListUncitedQuestions_RequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeModerationRepo{}, &fakeQuestionRepo{}, &fakeUserRepo{}, authz.ParseAdminSet(mustUUID(t)), 0, testDraftRules)
+
+	_, _, err := u.ListUncitedQuestions(context.Background(), mustUUID(t), "", 0)
+	if !apperror.IsCode(err, apperror.CodePermissionDenied) {
+		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_ListUncitedQuestions_InvalidPageToken(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := mustUUID(t)
+	u := NewUsecase(&fakeModerationRepo{}, &fakeQuestionRepo{}, &fakeUserRepo{}, authz.ParseAdminSet(adminUserID), 0, testDraftRules)
+
+	_, _, err := u.ListUncitedQuestions(context.Background(), adminUserID, "not-a-token", 0)
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_ListUncitedQuestions_Pagination(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := mustUUID(t)
+	ids := []string{mustUUID(t), mustUUID(t), mustUUID(t)}
+
+	var gotAfter []string
+	u := NewUsecase(
+		&fakeModerationRepo{},
+		&fakeQuestionRepo{listUncitedFn: func(_ context.Context, afterQuestionID string, limit int32) ([]domain.UncitedQuestion, error) {
+			gotAfter = append(gotAfter, afterQuestionID)
+			var page []domain.UncitedQuestion
+			started := afterQuestionID == ""
+			for _, id := range ids {
+				if started && int32(len(page)) < limit {
+					page = append(page, domain.UncitedQuestion{QuestionID: id})
+				}
+				if id == afterQuestionID {
+					started = true
+				}
+			}
+			return page, nil
+		}},
+		&fakeUserRepo{},
+		authz.ParseAdminSet(adminUserID),
+		0,
+		testDraftRules,
+	)
+
+	first, next, err := u.ListUncitedQuestions(context.Background(), adminUserID, "", 2)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(first) != 2 || next != ids[1] {
+		t.Fatalf("1ページ目は2件と次のページのトークンを期待しました: got=%+v next=%s", first, next)
+	}
+
+	second, next, err := u.ListUncitedQuestions(context.Background(), adminUserID, next, 2)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(second) != 1 || second[0].QuestionID != ids[2] || next != "" {
+		t.Fatalf("最後のページは1件でトークンなしを期待しました: got=%+v next=%s", second, next)
+	}
+	if len(gotAfter) != 2 || gotAfter[0] != "" || gotAfter[1] != ids[1] {
+		t.Fatalf("前のページの最後の問題から読む想定です: %v", gotAfter)
+	}
+}
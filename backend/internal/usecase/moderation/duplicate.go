@@ -0,0 +1,112 @@
+package moderation
+
+import (
+	"context"
+	"sort"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// 重複候補のまとまりの抽出。
+const (
+	// DefaultDuplicateMinSimilarity は min_similarity 未指定時の類似度の下限。
+	DefaultDuplicateMinSimilarity = 0.6
+	// minDuplicateSimilarity 未満を許すと、共通の言い回し（"〜は誰？" 等）だけで全体が1つにつながる。
+	minDuplicateSimilarity = 0.3
+	// maxDuplicatePairs は1回の抽出で読む組の上限（類似度の高い順）。
+	maxDuplicatePairs = 2000
+)
+
+// ListDuplicateClusters は問題文がよく似た問題のまとまりを、大きい順に返す（管理者のみ）。
+// minSimilarity が 0 の場合は DefaultDuplicateMinSimilarity を使う。
+func (u *Usecase) ListDuplicateClusters(ctx context.Context, userID string, minSimilarity float64, pageSize int32) ([]domain.DuplicateCluster, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return nil, err
+	}
+	if minSimilarity == 0 {
+		minSimilarity = DefaultDuplicateMinSimilarity
+	}
+	if minSimilarity < minDuplicateSimilarity || minSimilarity > 1 {
+		return nil, apperror.InvalidArgument("min_similarity が不正です", apperror.FieldViolation{Field: "min_similarity", Description: "0.3..1.0 の範囲で指定してください"})
+	}
+
+	pairs, err := u.questionRepo.ListSimilarQuestionPairs(ctx, minSimilarity, maxDuplicatePairs)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := clusterPairs(pairs)
+	if limit := int(normalizePageSize(pageSize)); len(clusters) > limit {
+		clusters = clusters[:limit]
+	}
+	return clusters, nil
+}
+
+// clusterPairs は類似する組を union-find で連結し、まとまりごとにまとめる。
+// 並び順: まとまりは問題数の多い順 → 最大類似度の高い順、まとまり内の問題は古い順。
+func clusterPairs(pairs []domain.SimilarQuestionPair) []domain.DuplicateCluster {
+	parent := map[string]string{}
+	questions := map[string]domain.DuplicateQuestion{}
+	var find func(id string) string
+	find = func(id string) string {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+	add := func(q domain.DuplicateQuestion) {
+		if _, ok := parent[q.QuestionID]; !ok {
+			parent[q.QuestionID] = q.QuestionID
+			questions[q.QuestionID] = q
+		}
+	}
+
+	for _, p := range pairs {
+		add(p.A)
+		add(p.B)
+		if ra, rb := find(p.A.QuestionID), find(p.B.QuestionID); ra != rb {
+			parent[rb] = ra
+		}
+	}
+
+	byRoot := map[string]*domain.DuplicateCluster{}
+	for id, q := range questions {
+		root := find(id)
+		c, ok := byRoot[root]
+		if !ok {
+			c = &domain.DuplicateCluster{}
+			byRoot[root] = c
+		}
+		c.Questions = append(c.Questions, q)
+	}
+	for _, p := range pairs {
+		c := byRoot[find(p.A.QuestionID)]
+		if p.Similarity > c.MaxSimilarity {
+			c.MaxSimilarity = p.Similarity
+		}
+	}
+
+	clusters := make([]domain.DuplicateCluster, 0, len(byRoot))
+	for _, c := range byRoot {
+		sort.Slice(c.Questions, func(i, j int) bool {
+			a, b := c.Questions[i], c.Questions[j]
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+			return a.QuestionID < b.QuestionID
+		})
+		clusters = append(clusters, *c)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		a, b := clusters[i], clusters[j]
+		if len(a.Questions) != len(b.Questions) {
+			return len(a.Questions) > len(b.Questions)
+		}
+		if a.MaxSimilarity != b.MaxSimilarity {
+			return a.MaxSimilarity > b.MaxSimilarity
+		}
+		return a.Questions[0].QuestionID < b.Questions[0].QuestionID
+	})
+	return clusters
+}
@@ -0,0 +1,84 @@
+package moderation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/app/authz"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestUsecase_ListDuplicateClusters_RequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeModerationRepo{}, &fakeQuestionRepo{}, &fakeUserRepo{}, authz.ParseAdminSet(mustUUID(t)), 0, testDraftRules)
+
+	_, err := u.ListDuplicateClusters(context.Background(), mustUUID(t), 0, 0)
+	if !apperror.IsCode(err, apperror.CodePermissionDenied) {
+		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_ListDuplicateClusters_InvalidMinSimilarity(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := mustUUID(t)
+	u := NewUsecase(&fakeModerationRepo{}, &fakeQuestionRepo{}, &fakeUserRepo{}, authz.ParseAdminSet(adminUserID), 0, testDraftRules)
+
+	for _, v := range []float64{0.1, 1.5, -1} {
+		_, err := u.ListDuplicateClusters(context.Background(), adminUserID, v, 0)
+		if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+			t.Fatalf("min_similarity=%v は INVALID_ARGUMENT を期待しました: err=%v", v, err)
+		}
+	}
+}
+
+func TestUsecase_ListDuplicateClusters_ConnectsPairs(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := mustUUID(t)
+	base := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	q := func(id string, minutes int) domain.DuplicateQuestion {
+		return domain.DuplicateQuestion{QuestionID: id, Prompt: "Q" + id, CreatedAt: base.Add(time.Duration(minutes) * time.Minute)}
+	}
+
+	var gotMin float64
+	u := NewUsecase(
+		&fakeModerationRepo{},
+		&fakeQuestionRepo{listSimilarPairsFn: func(_ context.Context, minSimilarity float64, _ int32) ([]domain.SimilarQuestionPair, error) {
+			gotMin = minSimilarity
+			return []domain.SimilarQuestionPair{
+				{A: q("x", 5), B: q("y", 6), Similarity: 0.95},
+				{A: q("a", 3), B: q("b", 1), Similarity: 0.8},
+				{A: q("b", 1), B: q("c", 2), Similarity: 0.7},
+			}, nil
+		}},
+		&fakeUserRepo{},
+		authz.ParseAdminSet(adminUserID),
+		0,
+		testDraftRules,
+	)
+
+	got, err := u.ListDuplicateClusters(context.Background(), adminUserID, 0, 0)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if gotMin != DefaultDuplicateMinSimilarity {
+		t.Fatalf("未指定の場合はデフォルトの閾値を期待しました: %v", gotMin)
+	}
+	if len(got) != 2 {
+		t.Fatalf("2つのまとまりを期待しました: %+v", got)
+	}
+	// a≒b, b≒c は1つのまとまり（問題数が多い方が先、古い順）。
+	if len(got[0].Questions) != 3 || got[0].Questions[0].QuestionID != "b" || got[0].Questions[1].QuestionID != "c" || got[0].Questions[2].QuestionID != "a" {
+		t.Fatalf("b, c, a の順を期待しました: %+v", got[0].Questions)
+	}
+	if got[0].MaxSimilarity != 0.8 {
+		t.Fatalf("まとまり内の最大類似度を期待しました: %v", got[0].MaxSimilarity)
+	}
+	if len(got[1].Questions) != 2 || got[1].MaxSimilarity != 0.95 {
+		t.Fatalf("x, y のまとまりを期待しました: %+v", got[1])
+	}
+}
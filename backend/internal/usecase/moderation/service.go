@@ -0,0 +1,256 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/authz"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+	questionusecase "github.com/history-quiz/historyquiz/internal/usecase/question"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/draftrule"
+)
+
+// DefaultHideThreshold は自動非表示にする未対応報告数のデフォルト値。
+const DefaultHideThreshold = 3
+
+// maxReportDetailRunes は報告の補足テキストの上限。
+const maxReportDetailRunes = 1000
+
+// Usecase は問題の報告（プレイヤー）とモデレーション（管理者）のユースケースを提供する。
+type Usecase struct {
+	moderationRepo repository.ModerationRepository
+	questionRepo   repository.QuestionRepository
+	userRepo       repository.UserRepository
+	admins         authz.AdminSet
+	hideThreshold  int64
+	draftRules     *draftrule.Pipeline
+}
+
+// NewUsecase は ModerationUsecase を生成する。
+// hideThreshold が 0 以下の場合は DefaultHideThreshold を使う。
+// draftRules は管理者による修正（ResolveReport の EDIT）にも作問と同じ内容の検証を適用するために使う。
+func NewUsecase(moderationRepo repository.ModerationRepository, questionRepo repository.QuestionRepository, userRepo repository.UserRepository, admins authz.AdminSet, hideThreshold int, draftRules *draftrule.Pipeline) *Usecase {
+	if hideThreshold <= 0 {
+		hideThreshold = DefaultHideThreshold
+	}
+	return &Usecase{
+		moderationRepo: moderationRepo,
+		questionRepo:   questionRepo,
+		userRepo:       userRepo,
+		admins:         admins,
+		hideThreshold:  int64(hideThreshold),
+		draftRules:     draftRules,
+	}
+}
+
+// ReportQuestionResult は ReportQuestion の結果。
+type ReportQuestionResult struct {
+	Report domain.QuestionReport
+	// Hidden は今回の報告で閾値を超え、問題が自動的に非表示になったことを表す。
+	Hidden bool
+}
+
+// ResolveReportResult は ResolveReport の結果。
+type ResolveReportResult struct {
+	ResolvedCount int64
+	Question      domain.QuestionDetail
+}
+
+// ReportQuestion は問題の報告を受け付け、未対応の報告数が閾値に達したら問題を非表示にする。
+func (u *Usecase) ReportQuestion(ctx context.Context, userID string, questionID string, reason domain.ReportReason, detail string) (ReportQuestionResult, error) {
+	if userID == "" {
+		return ReportQuestionResult{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if err := validateQuestionID(questionID); err != nil {
+		return ReportQuestionResult{}, err
+	}
+
+	var violations []apperror.FieldViolation
+	if !isValidReason(reason) {
+		violations = append(violations, apperror.FieldViolation{Field: "reason", Description: "報告理由を指定してください"})
+	}
+	detail = strings.TrimSpace(detail)
+	if utf8.RuneCountInString(detail) > maxReportDetailRunes {
+		violations = append(violations, apperror.FieldViolation{Field: "detail", Description: "1000文字以内で入力してください"})
+	}
+	if reason == domain.ReportReasonOther && detail == "" {
+		violations = append(violations, apperror.FieldViolation{Field: "detail", Description: "「その他」の場合は内容を入力してください"})
+	}
+	if len(violations) > 0 {
+		return ReportQuestionResult{}, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+		return ReportQuestionResult{}, err
+	}
+
+	report, err := u.moderationRepo.CreateReport(ctx, userID, questionID, reason, detail)
+	if err != nil {
+		return ReportQuestionResult{}, err
+	}
+
+	openCount, err := u.moderationRepo.CountOpenReports(ctx, questionID)
+	if err != nil {
+		return ReportQuestionResult{}, err
+	}
+	if openCount < u.hideThreshold {
+		return ReportQuestionResult{Report: report}, nil
+	}
+
+	// 閾値に達したら管理者の判断を待たずに出題から外す（誤った正解で遊ばれ続けるのを防ぐ）。
+	if err := u.moderationRepo.HideQuestionByReports(ctx, questionID); err != nil {
+		return ReportQuestionResult{}, err
+	}
+	return ReportQuestionResult{Report: report, Hidden: true}, nil
+}
+
+// ListOpenReports は未対応の報告を古い順に返す（管理者のみ）。
+func (u *Usecase) ListOpenReports(ctx context.Context, userID string, pageSize int32) ([]domain.QuestionReport, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return nil, err
+	}
+	return u.moderationRepo.ListOpenReports(ctx, normalizePageSize(pageSize))
+}
+
+// GetReportedQuestion は報告された問題を正解情報と未対応の報告と合わせて返す（管理者のみ）。
+func (u *Usecase) GetReportedQuestion(ctx context.Context, userID string, questionID string) (domain.ReportedQuestion, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return domain.ReportedQuestion{}, err
+	}
+	if err := validateQuestionID(questionID); err != nil {
+		return domain.ReportedQuestion{}, err
+	}
+	return u.loadReportedQuestion(ctx, questionID)
+}
+
+// ResolveReport は報告を解決する（管理者のみ）。
+// 同じ問題に対する未対応の報告は、同じ判断でまとめて解決する。
+func (u *Usecase) ResolveReport(ctx context.Context, userID string, reportID string, action domain.ResolutionAction, draft domain.QuestionDraft) (ResolveReportResult, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return ResolveReportResult{}, err
+	}
+	if reportID == "" {
+		return ResolveReportResult{}, apperror.InvalidArgument("report_id が空です", apperror.FieldViolation{Field: "report_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(reportID); err != nil {
+		return ResolveReportResult{}, apperror.InvalidArgument("report_id が不正です", apperror.FieldViolation{Field: "report_id", Description: "UUID 形式で指定してください"})
+	}
+	switch action {
+	case domain.ResolutionDismiss, domain.ResolutionHide:
+	case domain.ResolutionEdit:
+		checked, err := questionusecase.CheckDraft(u.draftRules, draft)
+		if err != nil {
+			return ResolveReportResult{}, err
+		}
+		draft = checked
+	default:
+		return ResolveReportResult{}, apperror.InvalidArgument("action が不正です", apperror.FieldViolation{Field: "action", Description: "DISMISS/HIDE/EDIT のいずれかを指定してください"})
+	}
+
+	report, err := u.moderationRepo.GetReport(ctx, reportID)
+	if err != nil {
+		return ResolveReportResult{}, err
+	}
+	if report.Status != domain.ReportStatusOpen {
+		return ResolveReportResult{}, apperror.FailedPrecondition("この報告はすでに対応済みです")
+	}
+
+	authorUserID, deleted, err := u.questionRepo.GetQuestionAuthor(ctx, report.QuestionID)
+	if err != nil {
+		return ResolveReportResult{}, err
+	}
+	if deleted {
+		return ResolveReportResult{}, apperror.NotFound("問題が見つかりません")
+	}
+
+	// 管理者の修正も作者の問題として保存する（所有者は変えない）。
+	// NOTE: 版は確認しない。報告への対応は作者の編集より優先する。版は通常どおり 1 増える。
+	var edit *domain.QuestionDraft
+	if action == domain.ResolutionEdit {
+		normalized := questionusecase.NormalizeDraft(draft)
+		edit = &normalized
+	}
+	resolved, err := u.moderationRepo.ResolveOpenReports(ctx, report.QuestionID, action, userID, edit)
+	if err != nil {
+		return ResolveReportResult{}, err
+	}
+
+	q, err := u.questionRepo.GetMyQuestion(ctx, authorUserID, report.QuestionID)
+	if err != nil {
+		return ResolveReportResult{}, err
+	}
+	return ResolveReportResult{ResolvedCount: resolved, Question: q}, nil
+}
+
+func (u *Usecase) loadReportedQuestion(ctx context.Context, questionID string) (domain.ReportedQuestion, error) {
+	authorUserID, deleted, err := u.questionRepo.GetQuestionAuthor(ctx, questionID)
+	if err != nil {
+		return domain.ReportedQuestion{}, err
+	}
+	if deleted {
+		return domain.ReportedQuestion{}, apperror.NotFound("問題が見つかりません")
+	}
+
+	// GetMyQuestion は作者を条件に取得するため、管理者閲覧でも作者の userId を渡す。
+	q, err := u.questionRepo.GetMyQuestion(ctx, authorUserID, questionID)
+	if err != nil {
+		return domain.ReportedQuestion{}, err
+	}
+
+	reports, err := u.moderationRepo.ListOpenReportsForQuestion(ctx, questionID)
+	if err != nil {
+		return domain.ReportedQuestion{}, err
+	}
+
+	return domain.ReportedQuestion{
+		Question:     q,
+		AuthorUserID: authorUserID,
+		Hidden:       q.Hidden,
+		OpenReports:  reports,
+	}, nil
+}
+
+// requireAdmin は管理者であることを確認する。
+func (u *Usecase) requireAdmin(userID string) error {
+	if userID == "" {
+		return apperror.Unauthenticated("認証が必要です")
+	}
+	if !u.admins.IsAdmin(userID) {
+		return apperror.PermissionDenied("権限がありません")
+	}
+	return nil
+}
+
+func validateQuestionID(questionID string) error {
+	if questionID == "" {
+		return apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(questionID); err != nil {
+		return apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+	}
+	return nil
+}
+
+func isValidReason(reason domain.ReportReason) bool {
+	switch reason {
+	case domain.ReportReasonWrongAnswer, domain.ReportReasonAmbiguous, domain.ReportReasonTypo, domain.ReportReasonInappropriate, domain.ReportReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizePageSize は pageSize のデフォルト/上限を統一する。
+func normalizePageSize(pageSize int32) int32 {
+	if pageSize <= 0 {
+		return 20
+	}
+	if pageSize > 100 {
+		return 100
+	}
+	return pageSize
+}
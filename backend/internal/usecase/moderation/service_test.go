@@ -0,0 +1,441 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/authz"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/draftrule"
+)
+
+// fakeModerationRepo は moderation.Usecase のユニットテスト用のリポジトリ差し替え。
+// NOTE: 実装は関数フィールドで差し替え、各テストで「呼ばれてよい/よくない」を明示する。
+type fakeModerationRepo struct {
+	createReportFn       func(ctx context.Context, reporterUserID string, questionID string, reason domain.ReportReason, detail string) (domain.QuestionReport, error)
+	countOpenReportsFn   func(ctx context.Context, questionID string) (int64, error)
+	listOpenReportsFn    func(ctx context.Context, limit int32) ([]domain.QuestionReport, error)
+	listForQuestionFn    func(ctx context.Context, questionID string) ([]domain.QuestionReport, error)
+	getReportFn          func(ctx context.Context, reportID string) (domain.QuestionReport, error)
+	resolveOpenReportsFn func(ctx context.Context, questionID string, resolution domain.ResolutionAction, resolvedByUserID string, edit *domain.QuestionDraft) (int64, error)
+	hideByReportsFn      func(ctx context.Context, questionID string) error
+}
+
+func (f *fakeModerationRepo) CreateReport(ctx context.Context, reporterUserID string, questionID string, reason domain.ReportReason, detail string) (domain.QuestionReport, error) {
+	return f.createReportFn(ctx, reporterUserID, questionID, reason, detail)
+}
+func (f *fakeModerationRepo) CountOpenReports(ctx context.Context, questionID string) (int64, error) {
+	return f.countOpenReportsFn(ctx, questionID)
+}
+func (f *fakeModerationRepo) ListOpenReports(ctx context.Context, limit int32) ([]domain.QuestionReport, error) {
+	return f.listOpenReportsFn(ctx, limit)
+}
+func (f *fakeModerationRepo) ListOpenReportsForQuestion(ctx context.Context, questionID string) ([]domain.QuestionReport, error) {
+	return f.listForQuestionFn(ctx, questionID)
+}
+func (f *fakeModerationRepo) GetReport(ctx context.Context, reportID string) (domain.QuestionReport, error) {
+	return f.getReportFn(ctx, reportID)
+}
+func (f *fakeModerationRepo) ResolveOpenReports(ctx context.Context, questionID string, resolution domain.ResolutionAction, resolvedByUserID string, edit *domain.QuestionDraft) (int64, error) {
+	return f.resolveOpenReportsFn(ctx, questionID, resolution, resolvedByUserID, edit)
+}
+func (f *fakeModerationRepo) HideQuestionByReports(ctx context.Context, questionID string) error {
+	return f.hideByReportsFn(ctx, questionID)
+}
+
+type fakeQuestionRepo struct {
+	updateQuestionFn    func(ctx context.Context, userID string, questionID string, expectedVersion int64, draft domain.QuestionDraft, schedule *domain.QuestionSchedule) (domain.QuestionDetail, error)
+	getMyQuestionFn     func(ctx context.Context, userID string, questionID string) (domain.QuestionDetail, error)
+	getQuestionAuthorFn func(ctx context.Context, questionID string) (authorUserID string, deleted bool, err error)
+	listSimilarPairsFn  func(ctx context.Context, minSimilarity float64, limit int32) ([]domain.SimilarQuestionPair, error)
+	listUncitedFn       func(ctx context.Context, afterQuestionID string, limit int32) ([]domain.UncitedQuestion, error)
+}
+
+func (f *fakeQuestionRepo) UpdateQuestion(ctx context.Context, userID string, questionID string, expectedVersion int64, draft domain.QuestionDraft, schedule *domain.QuestionSchedule) (domain.QuestionDetail, error) {
+	return f.updateQuestionFn(ctx, userID, questionID, expectedVersion, draft, schedule)
+}
+func (f *fakeQuestionRepo) GetMyQuestion(ctx context.Context, userID string, questionID string) (domain.QuestionDetail, error) {
+	return f.getMyQuestionFn(ctx, userID, questionID)
+}
+func (f *fakeQuestionRepo) GetQuestionAuthor(ctx context.Context, questionID string) (string, bool, error) {
+	return f.getQuestionAuthorFn(ctx, questionID)
+}
+func (f *fakeQuestionRepo) ListSimilarQuestionPairs(ctx context.Context, minSimilarity float64, limit int32) ([]domain.SimilarQuestionPair, error) {
+	return f.listSimilarPairsFn(ctx, minSimilarity, limit)
+}
+func (f *fakeQuestionRepo) ListUncitedQuestions(ctx context.Context, afterQuestionID string, limit int32) ([]domain.UncitedQuestion, error) {
+	return f.listUncitedFn(ctx, afterQuestionID, limit)
+}
+
+// moderation 側で使わないメソッドは、誤って呼ばれたらテストを落とす。
+func (*fakeQuestionRepo) GetQuestionAttemptStats(context.Context, string, time.Time, domain.StrongPlayerCriteria) (domain.QuestionStats, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ForkQuestion(context.Context, string, string) (domain.QuestionDetail, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) UpsertQuestionTranslation(context.Context, string, domain.QuestionTranslation) (domain.QuestionTranslation, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) DeleteQuestionTranslation(context.Context, string, string) error {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListQuestionTranslations(context.Context, string) ([]domain.QuestionTranslation, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListCitations(context.Context, string) ([]domain.Citation, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) GetQuestionLocation(context.Context, string) (*domain.QuestionLocation, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListPassageQuestionIDs(context.Context, string) ([]string, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) SoftDeleteQuestion(context.Context, string, string) error {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListQuizCandidateQuestionIDs(context.Context, string) ([]string, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListQuizCandidateDeckQuestionIDs(context.Context, string) ([]string, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListQuizCandidateEntityQuestionIDs(context.Context, string, string) ([]string, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListDistractorOccurrences(context.Context, domain.DistractorQuery) ([]domain.DistractorOccurrence, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListQuestionEntities(context.Context, string) ([]domain.EntityRef, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListQuizCandidateSystemQuestionIDs(context.Context, string) ([]string, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListQuizCandidateNonSystemQuestionIDs(context.Context, string) ([]string, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) GetQuizQuestion(context.Context, string) (domain.Question, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) GetCorrectChoiceID(context.Context, string) (string, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ChoiceBelongsToQuestion(context.Context, string, string) (bool, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListAcceptedAnswers(context.Context, string) ([]string, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) CreateQuestion(context.Context, string, domain.QuestionDraft) (domain.QuestionDetail, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) CreateQuestions(context.Context, string, []domain.QuestionDraft) ([]domain.QuestionDetail, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListMyQuestions(context.Context, string, domain.MyQuestionsQuery) ([]domain.QuestionSummary, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ListMyQuestionDetails(context.Context, string, string, int32) ([]domain.QuestionDetail, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) FindSimilarQuestions(context.Context, string, string, []string, float64, int32) ([]domain.SimilarQuestion, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) UpdateQuestionStatus(context.Context, string, string, domain.QuestionStatus, domain.QuestionStatus) (domain.QuestionDetail, error) {
+	panic("not used in moderation usecase tests")
+}
+func (*fakeQuestionRepo) ApplyDueQuestionSchedules(context.Context, time.Time, int32) ([]domain.QuestionStatusChange, error) {
+	panic("not used in moderation usecase tests")
+}
+
+type fakeUserRepo struct {
+	ensureUserExistsFn func(ctx context.Context, userID string) error
+}
+
+func (f *fakeUserRepo) EnsureUserExists(ctx context.Context, userID string) error {
+	return f.ensureUserExistsFn(ctx, userID)
+}
+
+// testDraftRules はテスト用の作問入力の検証ルール（禁止語 "禁止語" を設定）。
+var testDraftRules = func() *draftrule.Pipeline {
+	cfg := draftrule.DefaultConfig()
+	cfg.BannedWords = []string{"禁止語"}
+	p, err := draftrule.New(cfg)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}()
+
+// mustUUID はテストで UUID を生成するヘルパー。
+func mustUUID(t *testing.T) string {
+	t.Helper()
+	return uuid.NewString()
+}
+
+func TestUsecase_ReportQuestion_InvalidReason(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeModerationRepo{createReportFn: func(context.Context, string, string, domain.ReportReason, string) (domain.QuestionReport, error) {
+			t.Fatal("入力不正の場合、CreateReport は呼ばれない想定です")
+			return domain.QuestionReport{}, nil
+		}},
+		&fakeQuestionRepo{},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			t.Fatal("入力不正の場合、EnsureUserExists は呼ばれない想定です")
+			return nil
+		}},
+		nil,
+		0,
+		testDraftRules,
+	)
+
+	_, err := u.ReportQuestion(context.Background(), mustUUID(t), mustUUID(t), domain.ReportReason("spam"), "")
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_ReportQuestion_HidesAtThreshold(t *testing.T) {
+	t.Parallel()
+
+	questionID := mustUUID(t)
+
+	tests := []struct {
+		name       string
+		openCount  int64
+		wantHidden bool
+	}{
+		{name: "閾値未満は表示のまま", openCount: 1, wantHidden: false},
+		{name: "閾値に達したら非表示", openCount: 2, wantHidden: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hiddenCalls := 0
+			u := NewUsecase(
+				&fakeModerationRepo{
+					createReportFn: func(_ context.Context, _ string, gotQuestionID string, reason domain.ReportReason, _ string) (domain.QuestionReport, error) {
+						return domain.QuestionReport{ID: mustUUID(t), QuestionID: gotQuestionID, Reason: reason, Status: domain.ReportStatusOpen}, nil
+					},
+					countOpenReportsFn: func(context.Context, string) (int64, error) {
+						return tt.openCount, nil
+					},
+					hideByReportsFn: func(_ context.Context, gotQuestionID string) error {
+						hiddenCalls++
+						if gotQuestionID != questionID {
+							t.Fatalf("HideQuestionByReports の引数が期待と異なります: questionID=%s", gotQuestionID)
+						}
+						return nil
+					},
+				},
+				&fakeQuestionRepo{},
+				&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+				nil,
+				2,
+				testDraftRules,
+			)
+
+			got, err := u.ReportQuestion(context.Background(), mustUUID(t), questionID, domain.ReportReasonWrongAnswer, "")
+			if err != nil {
+				t.Fatalf("err は nil を期待しました: %v", err)
+			}
+			if got.Hidden != tt.wantHidden {
+				t.Fatalf("Hidden=%v を期待しました: got=%+v", tt.wantHidden, got)
+			}
+			wantCalls := 0
+			if tt.wantHidden {
+				wantCalls = 1
+			}
+			if hiddenCalls != wantCalls {
+				t.Fatalf("HideQuestionByReports=%d を期待: got=%d", wantCalls, hiddenCalls)
+			}
+		})
+	}
+}
+
+func TestUsecase_ListOpenReports_NonAdminDenied(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeModerationRepo{listOpenReportsFn: func(context.Context, int32) ([]domain.QuestionReport, error) {
+			t.Fatal("管理者以外の場合、ListOpenReports は呼ばれない想定です")
+			return nil, nil
+		}},
+		&fakeQuestionRepo{},
+		&fakeUserRepo{},
+		authz.ParseAdminSet(mustUUID(t)),
+		0,
+		testDraftRules,
+	)
+
+	_, err := u.ListOpenReports(context.Background(), mustUUID(t), 20)
+	if !apperror.IsCode(err, apperror.CodePermissionDenied) {
+		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_ResolveReport_Dismiss(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := mustUUID(t)
+	authorUserID := mustUUID(t)
+	questionID := mustUUID(t)
+	reportID := mustUUID(t)
+
+	u := NewUsecase(
+		&fakeModerationRepo{
+			getReportFn: func(context.Context, string) (domain.QuestionReport, error) {
+				return domain.QuestionReport{ID: reportID, QuestionID: questionID, Status: domain.ReportStatusOpen}, nil
+			},
+			resolveOpenReportsFn: func(_ context.Context, gotQuestionID string, resolution domain.ResolutionAction, resolvedBy string, edit *domain.QuestionDraft) (int64, error) {
+				if gotQuestionID != questionID || resolution != domain.ResolutionDismiss || resolvedBy != adminUserID || edit != nil {
+					t.Fatalf("ResolveOpenReports の引数が期待と異なります: questionID=%s resolution=%s resolvedBy=%s edit=%+v", gotQuestionID, resolution, resolvedBy, edit)
+				}
+				return 3, nil
+			},
+		},
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return authorUserID, false, nil
+			},
+			updateQuestionFn: func(context.Context, string, string, int64, domain.QuestionDraft, *domain.QuestionSchedule) (domain.QuestionDetail, error) {
+				t.Fatal("DISMISS の場合、UpdateQuestion は呼ばれない想定です")
+				return domain.QuestionDetail{}, nil
+			},
+			getMyQuestionFn: func(_ context.Context, userID string, _ string) (domain.QuestionDetail, error) {
+				if userID != authorUserID {
+					t.Fatalf("GetMyQuestion は作者の userID で呼ぶ想定です: got=%s", userID)
+				}
+				return domain.QuestionDetail{ID: questionID}, nil
+			},
+		},
+		&fakeUserRepo{},
+		authz.ParseAdminSet(adminUserID),
+		0,
+		testDraftRules,
+	)
+
+	got, err := u.ResolveReport(context.Background(), adminUserID, reportID, domain.ResolutionDismiss, domain.QuestionDraft{})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.ResolvedCount != 3 || got.Question.ID != questionID {
+		t.Fatalf("結果が期待と異なります: got=%+v", got)
+	}
+}
+
+func TestUsecase_ResolveReport_EditValidatesDraft(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := mustUUID(t)
+
+	u := NewUsecase(
+		&fakeModerationRepo{getReportFn: func(context.Context, string) (domain.QuestionReport, error) {
+			t.Fatal("draft が不正な場合、GetReport は呼ばれない想定です")
+			return domain.QuestionReport{}, nil
+		}},
+		&fakeQuestionRepo{},
+		&fakeUserRepo{},
+		authz.ParseAdminSet(adminUserID),
+		0,
+		testDraftRules,
+	)
+
+	_, err := u.ResolveReport(context.Background(), adminUserID, mustUUID(t), domain.ResolutionEdit, domain.QuestionDraft{
+		Prompt:  "Q",
+		Choices: []string{"a"},
+	})
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_ResolveReport_EditAppliesDraftRules(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := mustUUID(t)
+
+	u := NewUsecase(
+		&fakeModerationRepo{getReportFn: func(context.Context, string) (domain.QuestionReport, error) {
+			t.Fatal("draft が検証ルールに違反する場合、GetReport は呼ばれない想定です")
+			return domain.QuestionReport{}, nil
+		}},
+		&fakeQuestionRepo{},
+		&fakeUserRepo{},
+		authz.ParseAdminSet(adminUserID),
+		0,
+		testDraftRules,
+	)
+
+	_, err := u.ResolveReport(context.Background(), adminUserID, mustUUID(t), domain.ResolutionEdit, domain.QuestionDraft{
+		Prompt:      "鎌倉幕府を開いたのは？",
+		Choices:     []string{"源頼朝", "足利尊氏", "徳川家康", "平清盛"},
+		Explanation: "解説に禁止語を含む",
+	})
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument || len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != "draft.explanation" {
+		t.Fatalf("draft.explanation の INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_ResolveReport_EditResolvesWithDraft(t *testing.T) {
+	t.Parallel()
+
+	adminUserID := mustUUID(t)
+	authorUserID := mustUUID(t)
+	questionID := mustUUID(t)
+
+	var gotEdit *domain.QuestionDraft
+	u := NewUsecase(
+		&fakeModerationRepo{
+			getReportFn: func(_ context.Context, reportID string) (domain.QuestionReport, error) {
+				return domain.QuestionReport{ID: reportID, QuestionID: questionID, Status: domain.ReportStatusOpen}, nil
+			},
+			resolveOpenReportsFn: func(_ context.Context, _ string, resolution domain.ResolutionAction, _ string, edit *domain.QuestionDraft) (int64, error) {
+				if resolution != domain.ResolutionEdit {
+					t.Fatalf("resolution=%s, want edit", resolution)
+				}
+				gotEdit = edit
+				return 1, nil
+			},
+		},
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return authorUserID, false, nil
+			},
+			updateQuestionFn: func(context.Context, string, string, int64, domain.QuestionDraft, *domain.QuestionSchedule) (domain.QuestionDetail, error) {
+				t.Fatal("修正は報告の解決と同じトランザクションで行うため、UpdateQuestion は呼ばれない想定です")
+				return domain.QuestionDetail{}, nil
+			},
+			getMyQuestionFn: func(context.Context, string, string) (domain.QuestionDetail, error) {
+				return domain.QuestionDetail{ID: questionID}, nil
+			},
+		},
+		&fakeUserRepo{},
+		authz.ParseAdminSet(adminUserID),
+		0,
+		testDraftRules,
+	)
+
+	_, err := u.ResolveReport(context.Background(), adminUserID, mustUUID(t), domain.ResolutionEdit, domain.QuestionDraft{
+		Prompt:         "鎌倉幕府を開いたのは？",
+		Choices:        []string{"源頼朝", "足利尊氏", "徳川家康", "平清盛"},
+		CorrectOrdinal: 0,
+	})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if gotEdit == nil || gotEdit.Prompt != "鎌倉幕府を開いたのは？" {
+		t.Fatalf("修正後の draft を ResolveOpenReports に渡す想定です: got=%+v", gotEdit)
+	}
+}
@@ -0,0 +1,226 @@
+package question
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/similarity"
+	"github.com/history-quiz/historyquiz/internal/usecase/quiz/answermatch"
+)
+
+// 誤答の候補の提案（SuggestDistractors）の設定。
+const (
+	defaultDistractorLimit = 10
+	maxDistractorLimit     = 20
+
+	maxDistractorAnswerRunes  = 200
+	maxDistractorPromptRunes  = 2000
+	maxDistractorExcludeCount = 10
+
+	// distractorMinPromptSimilarity 以上の問題文を「似た問題」として候補の出どころにする。
+	// NOTE: 作成時の警告（similarWarnThreshold）より低い。言い回しが違っても同じ話題の問題を拾うため。
+	distractorMinPromptSimilarity = 0.2
+	// distractorMaxQuestions は候補を集める関連問題の上限（関連の強い順）。
+	distractorMaxQuestions = 200
+)
+
+// DistractorRequest は誤答の候補を提案する入力。
+type DistractorRequest struct {
+	Prompt        string
+	CorrectAnswer string
+	// Tags は作成中の問題のタグ。時代や地域もタグで表すため、同じタグの問題の選択肢を「同じ時代の候補」とみなす。
+	Tags []string
+	// ExcludeChoices は既に入力済みの選択肢（候補から除く）。
+	ExcludeChoices []string
+	// ExcludeQuestionID は編集中の問題自身（空の場合は除外しない）。
+	ExcludeQuestionID string
+	Limit             int32
+}
+
+// DistractorSuggestion は誤答の候補1件。
+type DistractorSuggestion struct {
+	// Label はコーパスで最も多い表記。
+	Label string
+	Score float64
+	// CoOccurrenceCount は正解と同じ問題に選択肢として並んだ回数。
+	CoOccurrenceCount int32
+	// SameTagCount はタグが重なる問題に出てきた回数。
+	SameTagCount int32
+	// PickCount は他の問題で誤答として選ばれた回数。
+	PickCount int64
+	// QuestionCount は候補が出てきた関連問題の数。
+	QuestionCount int32
+}
+
+// SuggestDistractors は正解（と問題文/タグ）から、既存の問題の選択肢を誤答の候補として提案する。
+// 候補は関連する問題（正解が選択肢にある/タグが重なる/問題文が似ている）の選択肢で、関連の強さと、
+// 他の問題で誤答として選ばれた割合の高さで順位を付ける。
+// 混同しやすい点: 外部の辞書や生成は使わず、choices と attempts だけで完結する（コーパスに無い語は出ない）。
+func (u *Usecase) SuggestDistractors(ctx context.Context, userID string, req DistractorRequest) ([]DistractorSuggestion, error) {
+	if userID == "" {
+		return nil, apperror.Unauthenticated("認証が必要です")
+	}
+	if req.ExcludeQuestionID != "" {
+		if _, err := uuid.Parse(req.ExcludeQuestionID); err != nil {
+			return nil, apperror.InvalidArgument("exclude_question_id が不正です", apperror.FieldViolation{Field: "exclude_question_id", Description: "UUID 形式で指定してください"})
+		}
+	}
+
+	answer := strings.TrimSpace(req.CorrectAnswer)
+	tags := normalizeTags(req.Tags)
+	var violations []apperror.FieldViolation
+	switch {
+	case answer == "":
+		violations = append(violations, apperror.FieldViolation{Field: "correct_answer", Description: "必須です"})
+	case utf8.RuneCountInString(answer) > maxDistractorAnswerRunes:
+		violations = append(violations, apperror.FieldViolation{Field: "correct_answer", Description: "200文字以内で指定してください"})
+	}
+	if utf8.RuneCountInString(req.Prompt) > maxDistractorPromptRunes {
+		violations = append(violations, apperror.FieldViolation{Field: "prompt", Description: "2000文字以内で指定してください"})
+	}
+	if len(tags) > maxTags {
+		violations = append(violations, apperror.FieldViolation{Field: "tags", Description: "タグは10件以内で指定してください"})
+	} else {
+		for i, tag := range tags {
+			if tag == "" || utf8.RuneCountInString(tag) > maxTagRunes {
+				violations = append(violations, apperror.FieldViolation{Field: "tags[" + strconv.Itoa(i) + "]", Description: "1..30文字で指定してください"})
+			}
+		}
+	}
+	if len(req.ExcludeChoices) > maxDistractorExcludeCount {
+		violations = append(violations, apperror.FieldViolation{Field: "exclude_choices", Description: "10件以内で指定してください"})
+	}
+	if req.Limit < 0 || req.Limit > maxDistractorLimit {
+		violations = append(violations, apperror.FieldViolation{Field: "limit", Description: "0..20 の範囲で指定してください"})
+	}
+	if len(violations) > 0 {
+		return nil, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultDistractorLimit
+	}
+
+	occurrences, err := u.questionRepo.ListDistractorOccurrences(ctx, domain.DistractorQuery{
+		UserID:              userID,
+		AnswerLabel:         answer,
+		Tags:                tags,
+		PromptShingles:      similarity.Shingles(req.Prompt),
+		MinPromptSimilarity: distractorMinPromptSimilarity,
+		ExcludeQuestionID:   req.ExcludeQuestionID,
+		MaxQuestions:        distractorMaxQuestions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rankDistractors(occurrences, answer, req.ExcludeChoices, limit), nil
+}
+
+// distractorCandidate は正規化した表記ごとの集計。
+type distractorCandidate struct {
+	labels    map[string]int
+	questions map[string]struct{}
+	relevance float64
+	picks     int64
+	attempts  int64
+	coOccur   int32
+	sameTag   int32
+}
+
+// rankDistractors は選択肢を正規化した表記でまとめ、スコアの高い順に最大 limit 件返す。
+//
+// スコアは「関連の強さ」と「選ばれやすさ」の和。
+//   - 関連の強さ: 出てきた問題ごとに、正解と同じ問題なら 3、重なるタグ1つにつき 1（3 まで）、問題文の類似度の 2 倍を足す。
+//   - 選ばれやすさ: 誤答として出てきた問題での 4×(選ばれた回数+1)/(回答数+4)。
+//     4択で偶然選ばれる割合（1/4）のとき 1 になる。回答が少ない問題は 1 に寄せ、少数の回答で順位が振れないようにする。
+//
+// 正解そのもの、正解とほぼ同じ表記（表記揺れ）、入力済みの選択肢は除く。
+// 混同しやすい点: 「ほぼ同じ」は answermatch.Normalize 後に一致するものだけで、記述式の照合（answermatch.Match）の1文字違いの許容は使わない。
+// "徳川家康" に対する "徳川家光" のように1文字だけ違う語は、よい誤答の候補になるため。
+func rankDistractors(occurrences []domain.DistractorOccurrence, answer string, excludeChoices []string, limit int) []DistractorSuggestion {
+	excluded := map[string]struct{}{answermatch.Normalize(answer): {}}
+	for _, choice := range excludeChoices {
+		excluded[answermatch.Normalize(choice)] = struct{}{}
+	}
+
+	byKey := make(map[string]*distractorCandidate)
+	var order []string
+	for _, o := range occurrences {
+		label := strings.TrimSpace(o.Label)
+		key := answermatch.Normalize(label)
+		if key == "" {
+			continue
+		}
+		c, ok := byKey[key]
+		if !ok {
+			if _, skip := excluded[key]; skip {
+				continue
+			}
+			c = &distractorCandidate{labels: map[string]int{}, questions: map[string]struct{}{}}
+			byKey[key] = c
+			order = append(order, key)
+		}
+		c.labels[label]++
+		if _, seen := c.questions[o.QuestionID]; seen {
+			// 同じ問題に同じ表記の選択肢が並ぶことは無いはずだが、二重に数えない。
+			continue
+		}
+		c.questions[o.QuestionID] = struct{}{}
+
+		if o.HasAnswer {
+			c.relevance += 3
+			c.coOccur++
+		}
+		if o.SharedTags > 0 {
+			c.relevance += float64(min(o.SharedTags, 3))
+			c.sameTag++
+		}
+		c.relevance += 2 * o.PromptSimilarity
+		if !o.IsCorrect {
+			c.picks += o.PickCount
+			c.attempts += o.AttemptCount
+		}
+	}
+
+	suggestions := make([]DistractorSuggestion, 0, len(order))
+	for _, key := range order {
+		c := byKey[key]
+		attraction := 4 * float64(c.picks+1) / float64(c.attempts+4)
+		suggestions = append(suggestions, DistractorSuggestion{
+			Label:             mostFrequentLabel(c.labels),
+			Score:             c.relevance + attraction,
+			CoOccurrenceCount: c.coOccur,
+			SameTagCount:      c.sameTag,
+			PickCount:         c.picks,
+			QuestionCount:     int32(len(c.questions)),
+		})
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Label < suggestions[j].Label
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// mostFrequentLabel は最も多い表記を返す（同数は辞書順で先のもの）。
+func mostFrequentLabel(labels map[string]int) string {
+	best, bestCount := "", 0
+	for label, count := range labels {
+		if count > bestCount || (count == bestCount && label < best) {
+			best, bestCount = label, count
+		}
+	}
+	return best
+}
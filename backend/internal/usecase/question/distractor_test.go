@@ -0,0 +1,172 @@
+package question
+
+import (
+	"context"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestUsecase_SuggestDistractors_RanksByCoOccurrenceAndPicks(t *testing.T) {
+	t.Parallel()
+
+	userID, editingID := mustUUID(t), mustUUID(t)
+	q1, q2, q3 := mustUUID(t), mustUUID(t), mustUUID(t)
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			distractorsFn: func(_ context.Context, query domain.DistractorQuery) ([]domain.DistractorOccurrence, error) {
+				if query.UserID != userID || query.AnswerLabel != "織田信長" || query.ExcludeQuestionID != editingID {
+					t.Fatalf("条件が期待と異なります: %+v", query)
+				}
+				if len(query.Tags) != 1 || query.Tags[0] != "戦国" {
+					t.Fatalf("タグは正規化して渡す想定です: %+v", query.Tags)
+				}
+				if len(query.PromptShingles) == 0 {
+					t.Fatal("問題文の shingle を渡す想定です")
+				}
+				return []domain.DistractorOccurrence{
+					// q1: 正解と同じ問題。「豊臣秀吉」はよく選ばれ、「徳川家康」はほとんど選ばれない。
+					{QuestionID: q1, Label: "織田信長", IsCorrect: true, HasAnswer: true, SharedTags: 1, PickCount: 60, AttemptCount: 100},
+					{QuestionID: q1, Label: "豊臣秀吉", HasAnswer: true, SharedTags: 1, PickCount: 30, AttemptCount: 100},
+					{QuestionID: q1, Label: "徳川家康", HasAnswer: true, SharedTags: 1, PickCount: 2, AttemptCount: 100},
+					{QuestionID: q1, Label: "今川義元", HasAnswer: true, SharedTags: 1, PickCount: 8, AttemptCount: 100},
+					// q2: 同じタグの問題（正解は無い）。表記揺れ（空白）は同じ候補にまとめ、正解の表記揺れは除く。
+					{QuestionID: q2, Label: "武田信玄", IsCorrect: true, SharedTags: 1, PickCount: 10, AttemptCount: 20},
+					{QuestionID: q2, Label: " 豊臣秀吉　", SharedTags: 1, PickCount: 5, AttemptCount: 20},
+					{QuestionID: q2, Label: "織田 信長", SharedTags: 1, PickCount: 3, AttemptCount: 20},
+					{QuestionID: q2, Label: "上杉謙信", SharedTags: 1, PickCount: 2, AttemptCount: 20},
+					// q3: 似た問題文だけの問題。入力済みの選択肢は除く。
+					{QuestionID: q3, Label: "明智光秀", PromptSimilarity: 0.3, PickCount: 0, AttemptCount: 0},
+				}, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, err := u.SuggestDistractors(context.Background(), userID, DistractorRequest{
+		Prompt:            "本能寺の変で討たれた戦国大名は誰か",
+		CorrectAnswer:     " 織田信長 ",
+		Tags:              []string{" 戦国 ", "戦国"},
+		ExcludeChoices:    []string{"明智光秀"},
+		ExcludeQuestionID: editingID,
+	})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+
+	var labels []string
+	for _, s := range got {
+		labels = append(labels, s.Label)
+	}
+	want := []string{"豊臣秀吉", "今川義元", "徳川家康", "武田信玄", "上杉謙信"}
+	if len(labels) != len(want) {
+		t.Fatalf("候補が期待と異なります: %v", labels)
+	}
+	for i := range want {
+		if labels[i] != want[i] {
+			t.Fatalf("順位が期待と異なります: got=%v want=%v", labels, want)
+		}
+	}
+
+	top := got[0]
+	if top.CoOccurrenceCount != 1 || top.SameTagCount != 2 || top.QuestionCount != 2 || top.PickCount != 35 {
+		t.Fatalf("集計が期待と異なります: %+v", top)
+	}
+	for _, s := range got {
+		if s.Label == "武田信玄" && s.PickCount != 0 {
+			t.Fatalf("正解として出てきた問題の回答は誤答の選ばれやすさに数えない想定です: %+v", s)
+		}
+	}
+}
+
+func TestUsecase_SuggestDistractors_AppliesLimit(t *testing.T) {
+	t.Parallel()
+
+	q1 := mustUUID(t)
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			distractorsFn: func(context.Context, domain.DistractorQuery) ([]domain.DistractorOccurrence, error) {
+				return []domain.DistractorOccurrence{
+					{QuestionID: q1, Label: "ローマ", HasAnswer: true},
+					{QuestionID: q1, Label: "アテネ", HasAnswer: true},
+					{QuestionID: q1, Label: "スパルタ", HasAnswer: true},
+				}, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, err := u.SuggestDistractors(context.Background(), mustUUID(t), DistractorRequest{CorrectAnswer: "カルタゴ", Limit: 2})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	// 同点は表記の辞書順。
+	if len(got) != 2 || got[0].Label != "アテネ" || got[1].Label != "スパルタ" {
+		t.Fatalf("上位2件を期待しました: %+v", got)
+	}
+}
+
+// 正解や入力済みの選択肢と1文字だけ違う語は、表記揺れではなくよい誤答の候補として残す。
+func TestUsecase_SuggestDistractors_KeepsOneCharacterOffNames(t *testing.T) {
+	t.Parallel()
+
+	q1 := mustUUID(t)
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			distractorsFn: func(context.Context, domain.DistractorQuery) ([]domain.DistractorOccurrence, error) {
+				return []domain.DistractorOccurrence{
+					{QuestionID: q1, Label: "徳川家康", IsCorrect: true, HasAnswer: true},
+					{QuestionID: q1, Label: "徳川家光", HasAnswer: true},
+					{QuestionID: q1, Label: "徳川 家康", HasAnswer: true},
+					{QuestionID: q1, Label: "北条時宗", HasAnswer: true},
+					{QuestionID: q1, Label: "北条時政", HasAnswer: true},
+				}, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, err := u.SuggestDistractors(context.Background(), mustUUID(t), DistractorRequest{CorrectAnswer: "徳川家康", ExcludeChoices: []string{"北条時宗"}})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	// 正解の表記揺れ（空白）と入力済みの選択肢だけを除く。
+	if len(got) != 2 || got[0].Label != "北条時政" || got[1].Label != "徳川家光" {
+		t.Fatalf("1文字違いの候補を残す想定です: %+v", got)
+	}
+}
+
+func TestUsecase_SuggestDistractors_Rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		userID string
+		req    DistractorRequest
+		want   apperror.Code
+	}{
+		{name: "未ログイン", userID: "", req: DistractorRequest{CorrectAnswer: "a"}, want: apperror.CodeUnauthenticated},
+		{name: "正解が空", userID: "u1", req: DistractorRequest{CorrectAnswer: "  "}, want: apperror.CodeInvalidArgument},
+		{name: "exclude_question_id が UUID でない", userID: "u1", req: DistractorRequest{CorrectAnswer: "a", ExcludeQuestionID: "q1"}, want: apperror.CodeInvalidArgument},
+		{name: "タグが多すぎる", userID: "u1", req: DistractorRequest{CorrectAnswer: "a", Tags: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}}, want: apperror.CodeInvalidArgument},
+		{name: "limit が上限超え", userID: "u1", req: DistractorRequest{CorrectAnswer: "a", Limit: 21}, want: apperror.CodeInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// 検証で弾くため repo は呼ばれない（呼ばれると nil 関数で panic する）。
+			u := NewUsecase(&fakeQuestionRepo{}, &fakeUserRepo{}, testPageTokens, testDraftRules)
+			if _, err := u.SuggestDistractors(context.Background(), tt.userID, tt.req); !apperror.IsCode(err, tt.want) {
+				t.Fatalf("%s を期待しました: err=%v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package draftrule は作問入力の内容の検証ルール（文字数/選択肢の重複/正解の漏れ/禁止語）を提供する。
+// NOTE: 必須項目や件数などの構造の検証は question.ValidateDraft が行い、ここではデプロイごとに変えたい内容の検証だけを扱う。
+package draftrule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// ルール名（Config.Disabled で指定する）。
+const (
+	RuleLength          = "length"
+	RuleDistinctChoices = "distinct_choices"
+	RuleAnswerLeak      = "answer_leak"
+	RuleBannedWords     = "banned_words"
+)
+
+// 文字数の上限のデフォルト値。
+const (
+	DefaultMaxPromptRunes      = 1000
+	DefaultMaxChoiceRunes      = 100
+	DefaultMaxExplanationRunes = 3000
+)
+
+// Rule は作問入力の検証ルール。違反は FieldViolation（Field は "draft.*"）で返す。
+// 混同しやすい点: 入力は StripControlChars で整えた後の値で、選択肢の件数などの構造は不正な場合もある。
+type Rule interface {
+	Name() string
+	Check(draft domain.QuestionDraft) []apperror.FieldViolation
+}
+
+// Config はデプロイごとに変えられる設定（main で環境変数から組み立てる）。
+type Config struct {
+	MaxPromptRunes      int
+	MaxChoiceRunes      int
+	MaxExplanationRunes int
+	// BannedWords は作問に使えない語句（比較は全角/半角、大文字/小文字、カタカナ/ひらがな、空白と区切り文字を無視する）。
+	BannedWords []string
+	// Disabled は無効にするルール名（例: RuleAnswerLeak）。
+	Disabled []string
+}
+
+// DefaultConfig はデフォルトの設定を返す（禁止語は無し）。
+func DefaultConfig() Config {
+	return Config{
+		MaxPromptRunes:      DefaultMaxPromptRunes,
+		MaxChoiceRunes:      DefaultMaxChoiceRunes,
+		MaxExplanationRunes: DefaultMaxExplanationRunes,
+	}
+}
+
+// Pipeline は有効なルールを順に適用する。
+type Pipeline struct {
+	rules []Rule
+}
+
+// New は設定から Pipeline を作る。extra は組み込みのルールの後に追加で適用するルール。
+// 0 以下の上限はデフォルト値を使う。Disabled に未知のルール名がある場合はエラー（設定の誤りに起動時に気付けるように）。
+func New(cfg Config, extra ...Rule) (*Pipeline, error) {
+	if cfg.MaxPromptRunes <= 0 {
+		cfg.MaxPromptRunes = DefaultMaxPromptRunes
+	}
+	if cfg.MaxChoiceRunes <= 0 {
+		cfg.MaxChoiceRunes = DefaultMaxChoiceRunes
+	}
+	if cfg.MaxExplanationRunes <= 0 {
+		cfg.MaxExplanationRunes = DefaultMaxExplanationRunes
+	}
+
+	builtin := []Rule{
+		lengthRule{maxPrompt: cfg.MaxPromptRunes, maxChoice: cfg.MaxChoiceRunes, maxExplanation: cfg.MaxExplanationRunes},
+		distinctChoicesRule{},
+		answerLeakRule{},
+		newBannedWordsRule(cfg.BannedWords),
+	}
+	disabled := make(map[string]struct{}, len(cfg.Disabled))
+	for _, name := range cfg.Disabled {
+		disabled[name] = struct{}{}
+	}
+
+	p := &Pipeline{}
+	for _, r := range builtin {
+		if _, ok := disabled[r.Name()]; ok {
+			delete(disabled, r.Name())
+			continue
+		}
+		p.rules = append(p.rules, r)
+	}
+	for name := range disabled {
+		return nil, fmt.Errorf("未知の検証ルールです: %q", name)
+	}
+	p.rules = append(p.rules, extra...)
+	return p, nil
+}
+
+// Check は全ルールを適用し、違反をルールの順にまとめて返す（違反が無い場合は nil）。
+func (p *Pipeline) Check(draft domain.QuestionDraft) []apperror.FieldViolation {
+	var violations []apperror.FieldViolation
+	for _, r := range p.rules {
+		violations = append(violations, r.Check(draft)...)
+	}
+	return violations
+}
+
+// ParseList はカンマ区切りの一覧を分割する（前後空白を除去し、空要素は無視する）。
+// 例: BACKEND_DRAFT_BANNED_WORDS="語句1, 語句2"
+func ParseList(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
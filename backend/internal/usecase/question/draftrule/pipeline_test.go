@@ -0,0 +1,148 @@
+package draftrule
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func validDraft() domain.QuestionDraft {
+	return domain.QuestionDraft{
+		Prompt:         "1853年に浦賀へ来航したアメリカの提督は？",
+		Choices:        []string{"ペリー", "ハリス", "ビッドル", "プチャーチン"},
+		CorrectOrdinal: 0,
+		Explanation:    "翌年に日米和親条約を結んだ。",
+	}
+}
+
+func mustPipeline(t *testing.T, cfg Config) *Pipeline {
+	t.Helper()
+	p, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return p
+}
+
+func fieldsOf(violations []apperror.FieldViolation) []string {
+	fields := make([]string, 0, len(violations))
+	for _, v := range violations {
+		fields = append(fields, v.Field)
+	}
+	return fields
+}
+
+func TestPipeline_Check(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultConfig()
+	cfg.MaxPromptRunes = 40
+	cfg.BannedWords = []string{"バカ"}
+	p := mustPipeline(t, cfg)
+
+	tests := []struct {
+		name   string
+		modify func(d *domain.QuestionDraft)
+		want   []string
+	}{
+		{name: "問題なし", modify: func(*domain.QuestionDraft) {}},
+		{name: "問題文が長すぎる", modify: func(d *domain.QuestionDraft) { d.Prompt = strings.Repeat("あ", 41) }, want: []string{"draft.prompt"}},
+		{name: "選択肢が長すぎる", modify: func(d *domain.QuestionDraft) { d.Choices[3] = strings.Repeat("あ", 101) }, want: []string{"draft.choices[3]"}},
+		{name: "同じ選択肢", modify: func(d *domain.QuestionDraft) { d.Choices[2] = " ハリス" }, want: []string{"draft.choices[2]"}},
+		{name: "表記揺れだけの選択肢", modify: func(d *domain.QuestionDraft) { d.Choices[3] = "はりす" }, want: []string{"draft.choices[3]"}},
+		{name: "年号が違う選択肢は重複にしない", modify: func(d *domain.QuestionDraft) {
+			d.Prompt = "ペリーが浦賀へ来航したのは？"
+			d.Choices = []string{"1853年", "1854年", "1858年", "1860年"}
+		}},
+		{name: "1文字違いの人名は重複にしない", modify: func(d *domain.QuestionDraft) {
+			d.Prompt = "江戸幕府を開いたのは？"
+			d.Choices = []string{"徳川家康", "徳川家光", "徳川家茂", "北条時宗"}
+		}},
+		{name: "1文字違いの人名は重複にしない（2文字目）", modify: func(d *domain.QuestionDraft) {
+			d.Prompt = "元寇のときの執権は？"
+			d.Choices = []string{"北条時宗", "北条時政", "北条泰時", "北条義時"}
+		}},
+		{name: "漢数字だけが違う選択肢は重複にしない", modify: func(d *domain.QuestionDraft) {
+			d.Prompt = "サラエボ事件をきっかけに始まった戦争は？"
+			d.Choices = []string{"第一次世界大戦", "第二次世界大戦", "普仏戦争", "クリミア戦争"}
+		}},
+		{name: "問題文に正解が含まれる", modify: func(d *domain.QuestionDraft) { d.Prompt = "ペリー提督が来航した港は？" }, want: []string{"draft.prompt"}},
+		{name: "問題文に別表記が含まれる", modify: func(d *domain.QuestionDraft) {
+			d.AcceptedAnswers = []string{"マシュー・ペリー"}
+			d.Choices[0] = "M.C.ペリー"
+			d.Prompt = "マシューペリーの来航は何年？"
+		}, want: []string{"draft.prompt"}},
+		{name: "誤答が問題文に含まれるのは許す", modify: func(d *domain.QuestionDraft) { d.Prompt = "ハリスではない人物は？" }},
+		{name: "禁止語（表記を変えても検出する）", modify: func(d *domain.QuestionDraft) {
+			d.Explanation = "ﾊﾞ カ"
+			d.Tags = []string{"ばか"}
+		}, want: []string{"draft.explanation", "draft.tags[0]"}},
+		{name: "選択肢の件数が不正でも落ちない", modify: func(d *domain.QuestionDraft) {
+			d.Choices = nil
+			d.CorrectOrdinal = 5
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := validDraft()
+			tt.modify(&d)
+			got := fieldsOf(p.Check(d))
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("違反の項目 = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew_Disabled(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultConfig()
+	cfg.Disabled = []string{RuleAnswerLeak}
+	p := mustPipeline(t, cfg)
+
+	d := validDraft()
+	d.Prompt = "ペリー提督が来航した港は？"
+	if got := p.Check(d); len(got) != 0 {
+		t.Fatalf("無効にしたルールは適用しない想定です: %+v", got)
+	}
+
+	cfg.Disabled = []string{"answer-leak"}
+	if _, err := New(cfg); err == nil {
+		t.Fatal("未知のルール名はエラーの想定です")
+	}
+}
+
+func TestStripControlChars(t *testing.T) {
+	t.Parallel()
+
+	d := StripControlChars(domain.QuestionDraft{
+		Prompt:          "一行目\r\n二行目\x00\u202e",
+		Choices:         []string{"ペリー\n提督", "ハリ\u200bス"},
+		Explanation:     "\tインデント\x07",
+		AcceptedAnswers: []string{"ペリ\x1bー"},
+		Citations:       []domain.Citation{{Title: "日本史\u202d史料"}},
+	})
+
+	if d.Prompt != "一行目\n二行目" {
+		t.Fatalf("Prompt = %q", d.Prompt)
+	}
+	if d.Choices[0] != "ペリー 提督" || d.Choices[1] != "ハリス" {
+		t.Fatalf("Choices = %q", d.Choices)
+	}
+	if d.Explanation != "\tインデント" || d.AcceptedAnswers[0] != "ペリー" || d.Citations[0].Title != "日本史史料" {
+		t.Fatalf("制御文字が残っています: %+v", d)
+	}
+}
+
+func TestParseList(t *testing.T) {
+	t.Parallel()
+
+	got := ParseList(" 語句1, ,語句2 ,")
+	if strings.Join(got, "|") != "語句1|語句2" {
+		t.Fatalf("ParseList = %q", got)
+	}
+}
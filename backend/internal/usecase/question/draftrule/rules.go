@@ -0,0 +1,160 @@
+package draftrule
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/quiz/answermatch"
+)
+
+// minLeakRunes 未満の短い正解は、問題文に含まれていても漏れとみなさない（"A" や1文字の漢字での誤検知を避ける）。
+const minLeakRunes = 2
+
+// lengthRule は問題文/選択肢/解説の文字数の上限を検証する。
+type lengthRule struct {
+	maxPrompt      int
+	maxChoice      int
+	maxExplanation int
+}
+
+func (lengthRule) Name() string { return RuleLength }
+
+func (r lengthRule) Check(draft domain.QuestionDraft) []apperror.FieldViolation {
+	var violations []apperror.FieldViolation
+	if utf8.RuneCountInString(strings.TrimSpace(draft.Prompt)) > r.maxPrompt {
+		violations = append(violations, tooLong("draft.prompt", r.maxPrompt))
+	}
+	for i, c := range draft.Choices {
+		if utf8.RuneCountInString(strings.TrimSpace(c)) > r.maxChoice {
+			violations = append(violations, tooLong(choiceField(i), r.maxChoice))
+		}
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(draft.Explanation)) > r.maxExplanation {
+		violations = append(violations, tooLong("draft.explanation", r.maxExplanation))
+	}
+	return violations
+}
+
+func tooLong(field string, max int) apperror.FieldViolation {
+	return apperror.FieldViolation{Field: field, Description: strconv.Itoa(max) + "文字以内で入力してください"}
+}
+
+// distinctChoicesRule は同じ/ほぼ同じ選択肢が無いことを検証する。
+// 「ほぼ同じ」は answermatch.Normalize 後に一致する組とする（全角/半角、カタカナ/ひらがな、空白や記号だけの違い）。
+// 混同しやすい点: 記述式回答の照合（answermatch.Match）の1文字違いの許容は使わない。
+// "徳川家康" と "徳川家光" のように1文字だけ違う誤答は普通に作るため。
+type distinctChoicesRule struct{}
+
+func (distinctChoicesRule) Name() string { return RuleDistinctChoices }
+
+func (distinctChoicesRule) Check(draft domain.QuestionDraft) []apperror.FieldViolation {
+	var violations []apperror.FieldViolation
+	for j := 1; j < len(draft.Choices); j++ {
+		if strings.TrimSpace(draft.Choices[j]) == "" {
+			continue
+		}
+		for i := 0; i < j; i++ {
+			if !nearlySame(draft.Choices[i], draft.Choices[j]) {
+				continue
+			}
+			violations = append(violations, apperror.FieldViolation{
+				Field:       choiceField(j),
+				Description: "選択肢 " + strconv.Itoa(i+1) + " と同じ（またはほぼ同じ）です",
+			})
+			break
+		}
+	}
+	return violations
+}
+
+func nearlySame(a, b string) bool {
+	return answermatch.Normalize(a) == answermatch.Normalize(b)
+}
+
+// answerLeakRule は問題文に正解（正解の選択肢/別表記）がそのまま含まれていないことを検証する。
+// 混同しやすい点: 誤答の選択肢が問題文に含まれるのは問題にしない（「〜ではないものは？」の形式があるため）。
+type answerLeakRule struct{}
+
+func (answerLeakRule) Name() string { return RuleAnswerLeak }
+
+func (answerLeakRule) Check(draft domain.QuestionDraft) []apperror.FieldViolation {
+	prompt := answermatch.Normalize(draft.Prompt)
+	if prompt == "" || draft.CorrectOrdinal < 0 || int(draft.CorrectOrdinal) >= len(draft.Choices) {
+		return nil
+	}
+
+	answers := append([]string{draft.Choices[draft.CorrectOrdinal]}, draft.AcceptedAnswers...)
+	for _, answer := range answers {
+		normalized := answermatch.Normalize(answer)
+		if utf8.RuneCountInString(normalized) < minLeakRunes {
+			continue
+		}
+		if strings.Contains(prompt, normalized) {
+			return []apperror.FieldViolation{{Field: "draft.prompt", Description: "問題文に正解（" + strings.TrimSpace(answer) + "）が含まれています"}}
+		}
+	}
+	return nil
+}
+
+// bannedWordsRule は禁止語を含まないことを検証する。
+// NOTE: 違反の説明には語句を含めない（禁止語の一覧を探られないようにするため）。
+type bannedWordsRule struct {
+	words []string // answermatch.Normalize 済み
+}
+
+func newBannedWordsRule(words []string) bannedWordsRule {
+	r := bannedWordsRule{}
+	for _, w := range words {
+		if normalized := answermatch.Normalize(w); normalized != "" {
+			r.words = append(r.words, normalized)
+		}
+	}
+	return r
+}
+
+func (bannedWordsRule) Name() string { return RuleBannedWords }
+
+func (r bannedWordsRule) Check(draft domain.QuestionDraft) []apperror.FieldViolation {
+	if len(r.words) == 0 {
+		return nil
+	}
+
+	var violations []apperror.FieldViolation
+	check := func(field string, text string) {
+		if r.contains(text) {
+			violations = append(violations, apperror.FieldViolation{Field: field, Description: "使用できない語句が含まれています"})
+		}
+	}
+	check("draft.prompt", draft.Prompt)
+	for i, c := range draft.Choices {
+		check(choiceField(i), c)
+	}
+	check("draft.explanation", draft.Explanation)
+	for i, a := range draft.AcceptedAnswers {
+		check("draft.accepted_answers["+strconv.Itoa(i)+"]", a)
+	}
+	for i, tag := range draft.Tags {
+		check("draft.tags["+strconv.Itoa(i)+"]", tag)
+	}
+	return violations
+}
+
+func (r bannedWordsRule) contains(text string) bool {
+	normalized := answermatch.Normalize(text)
+	if normalized == "" {
+		return false
+	}
+	for _, w := range r.words {
+		if strings.Contains(normalized, w) {
+			return true
+		}
+	}
+	return false
+}
+
+func choiceField(i int) string {
+	return "draft.choices[" + strconv.Itoa(i) + "]"
+}
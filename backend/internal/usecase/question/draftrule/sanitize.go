@@ -0,0 +1,110 @@
+package draftrule
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// StripControlChars は作問入力から制御文字を取り除く（元の slice は書き換えない）。
+// 問題文と解説は改行とタブを残し、それ以外の1行の項目では空白に置き換える。
+// 混同しやすい点: 表示を崩す書字方向の制御（U+202E など）やゼロ幅スペースも取り除く。
+// 絵文字の合成に使うゼロ幅接合子（U+200D）は残す。
+func StripControlChars(draft domain.QuestionDraft) domain.QuestionDraft {
+	draft.Prompt = stripMultiline(draft.Prompt)
+	draft.Explanation = stripMultiline(draft.Explanation)
+	draft.Choices = stripEach(draft.Choices)
+	draft.AcceptedAnswers = stripEach(draft.AcceptedAnswers)
+	draft.Tags = stripEach(draft.Tags)
+
+	if len(draft.Attachments) > 0 {
+		attachments := make([]domain.AttachmentRef, 0, len(draft.Attachments))
+		for _, ref := range draft.Attachments {
+			ref.AltText = stripLine(ref.AltText)
+			attachments = append(attachments, ref)
+		}
+		draft.Attachments = attachments
+	}
+	if len(draft.Citations) > 0 {
+		citations := make([]domain.Citation, 0, len(draft.Citations))
+		for _, c := range draft.Citations {
+			c.Title = stripLine(c.Title)
+			c.Author = stripLine(c.Author)
+			c.Locator = stripLine(c.Locator)
+			c.URL = stripLine(c.URL)
+			citations = append(citations, c)
+		}
+		draft.Citations = citations
+	}
+	return draft
+}
+
+// StripPassageControlChars は史料の入力（本文/出典/添付の代替テキスト）から制御文字を取り除く。
+// 子の問題は CheckDraft で StripControlChars を通すため、ここでは扱わない。
+func StripPassageControlChars(draft domain.PassageDraft) domain.PassageDraft {
+	draft.Body = stripMultiline(draft.Body)
+	draft.Source.Title = stripLine(draft.Source.Title)
+	draft.Source.Author = stripLine(draft.Source.Author)
+	draft.Source.Locator = stripLine(draft.Source.Locator)
+	draft.Source.URL = stripLine(draft.Source.URL)
+	if draft.Attachment != nil {
+		ref := *draft.Attachment
+		ref.AltText = stripLine(ref.AltText)
+		draft.Attachment = &ref
+	}
+	return draft
+}
+
+func stripEach(values []string) []string {
+	if len(values) == 0 {
+		return values
+	}
+	stripped := make([]string, 0, len(values))
+	for _, v := range values {
+		stripped = append(stripped, stripLine(v))
+	}
+	return stripped
+}
+
+// stripMultiline は改行（CRLF/CR は LF にそろえる）とタブを残して制御文字を取り除く。
+func stripMultiline(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\r':
+			return '\n'
+		case r == '\n' || r == '\t':
+			return r
+		case isInvisibleControl(r):
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// stripLine は改行/タブを空白に置き換え、それ以外の制御文字を取り除く。
+func stripLine(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\r' || r == '\t':
+			return ' '
+		case isInvisibleControl(r):
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// isInvisibleControl は取り除く文字（C0/C1 制御文字、書字方向の制御、ゼロ幅スペース、BOM）かを返す。
+func isInvisibleControl(r rune) bool {
+	switch {
+	case unicode.IsControl(r):
+		return true
+	case r >= '\u202a' && r <= '\u202e', r >= '\u2066' && r <= '\u2069':
+		return true
+	case r == '\u200b' || r == '\u200e' || r == '\u200f' || r == '\ufeff':
+		return true
+	}
+	return false
+}
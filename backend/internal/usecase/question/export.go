@@ -0,0 +1,59 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+)
+
+// exportPageSize は書き出し時に1回のクエリで読む問題数。
+const exportPageSize = 100
+
+// ExportMyQuestions は自分の問題をすべて format で w に書き出し、書き出した問題数を返す。
+// NOTE: ListMyQuestions（上限100件）ではなく、リポジトリの keyset ページングで全件を読む。
+func (u *Usecase) ExportMyQuestions(ctx context.Context, userID string, format questionfile.Format, w io.Writer) (int, error) {
+	if userID == "" {
+		return 0, apperror.Unauthenticated("認証が必要です")
+	}
+
+	writer, err := questionfile.NewWriter(format, w)
+	if err != nil {
+		return 0, exportWriteError(err)
+	}
+
+	count := 0
+	afterQuestionID := ""
+	for {
+		page, err := u.questionRepo.ListMyQuestionDetails(ctx, userID, afterQuestionID, exportPageSize)
+		if err != nil {
+			return count, err
+		}
+		for _, q := range page {
+			if err := writer.Write(q); err != nil {
+				return count, exportWriteError(err)
+			}
+			count++
+		}
+		if len(page) < exportPageSize {
+			break
+		}
+		afterQuestionID = page[len(page)-1].ID
+	}
+
+	if err := writer.Close(); err != nil {
+		return count, exportWriteError(err)
+	}
+	return count, nil
+}
+
+// exportWriteError は書き出し先のエラーを apperror に寄せる（apperror はそのまま返す）。
+func exportWriteError(err error) error {
+	var appErr *apperror.Error
+	if errors.As(err, &appErr) {
+		return err
+	}
+	return apperror.Internal("書き出しに失敗しました", err)
+}
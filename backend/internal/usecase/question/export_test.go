@@ -0,0 +1,87 @@
+package question
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+)
+
+func TestUsecase_ExportMyQuestions_PagesThroughRepository(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	total := exportPageSize + 5
+
+	var gotCursors []string
+	u := NewUsecase(
+		&fakeQuestionRepo{listMyDetailsFn: func(_ context.Context, gotUserID string, afterQuestionID string, limit int32) ([]domain.QuestionDetail, error) {
+			if gotUserID != userID {
+				t.Fatalf("userID が一致しません: got=%s want=%s", gotUserID, userID)
+			}
+			gotCursors = append(gotCursors, afterQuestionID)
+
+			start := 0
+			if afterQuestionID != "" {
+				start, _ = strconv.Atoi(afterQuestionID)
+				start++
+			}
+			var page []domain.QuestionDetail
+			for i := start; i < total && len(page) < int(limit); i++ {
+				page = append(page, domain.QuestionDetail{
+					ID:              strconv.Itoa(i),
+					Prompt:          "Q" + strconv.Itoa(i),
+					Choices:         []domain.Choice{{ID: "a", Label: "a"}, {ID: "b", Label: "b", Ordinal: 1}, {ID: "c", Label: "c", Ordinal: 2}, {ID: "d", Label: "d", Ordinal: 3}},
+					CorrectChoiceID: "b",
+				})
+			}
+			return page, nil
+		}},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	var buf bytes.Buffer
+	count, err := u.ExportMyQuestions(context.Background(), userID, questionfile.FormatJSON, &buf)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if count != total {
+		t.Fatalf("%d 問の書き出しを期待しました: got=%d", total, count)
+	}
+	if len(gotCursors) != 2 || gotCursors[0] != "" || gotCursors[1] != strconv.Itoa(exportPageSize-1) {
+		t.Fatalf("最後の問題 ID を次ページの起点にする想定です: %v", gotCursors)
+	}
+
+	rows, err := questionfile.Parse(questionfile.FormatJSON, &buf)
+	if err != nil || len(rows) != total {
+		t.Fatalf("書き出した JSON を取り込める想定です: rows=%d err=%v", len(rows), err)
+	}
+	if rows[0].Draft.CorrectOrdinal != 1 {
+		t.Fatalf("正解は ordinal に変換する想定です: %+v", rows[0].Draft)
+	}
+}
+
+func TestUsecase_ExportMyQuestions_InvalidFormat(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{listMyDetailsFn: func(context.Context, string, string, int32) ([]domain.QuestionDetail, error) {
+			t.Fatal("形式が不正な場合、ListMyQuestionDetails は呼ばれない想定です")
+			return nil, nil
+		}},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	_, err := u.ExportMyQuestions(context.Background(), mustUUID(t), questionfile.Format(""), &bytes.Buffer{})
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
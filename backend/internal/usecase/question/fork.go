@@ -0,0 +1,42 @@
+package question
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// ForkQuestion は他のユーザーの公開中の問題を、自分の下書きとして複製する。
+// 複製は元の問題（OriginQuestionID）を参照し続け、元の作者は統計で複製数を確認できる。
+// 混同しやすい点: 複製は意図的なコピーのため、作成時の類似問題チェック（findSimilarQuestions）は行わない。
+// NOTE: 自分の問題は複製せずに編集すればよいため、FAILED_PRECONDITION にする（複製数の水増しも防ぐ）。
+func (u *Usecase) ForkQuestion(ctx context.Context, userID string, questionID string) (domain.QuestionDetail, error) {
+	if userID == "" {
+		return domain.QuestionDetail{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if questionID == "" {
+		return domain.QuestionDetail{}, apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(questionID); err != nil {
+		return domain.QuestionDetail{}, apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+	}
+
+	authorUserID, deleted, err := u.questionRepo.GetQuestionAuthor(ctx, questionID)
+	if err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	if deleted {
+		return domain.QuestionDetail{}, apperror.NotFound("複製できる問題が見つかりません")
+	}
+	if authorUserID == userID {
+		return domain.QuestionDetail{}, apperror.FailedPrecondition("自分の問題は複製できません（そのまま編集してください）")
+	}
+
+	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+	// 公開中かどうか（下書き/非表示でないか）は、複製と同じトランザクションで repo が確かめる。
+	return u.questionRepo.ForkQuestion(ctx, userID, questionID)
+}
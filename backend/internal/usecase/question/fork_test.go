@@ -0,0 +1,86 @@
+package question
+
+import (
+	"context"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestUsecase_ForkQuestion_CopiesOthersQuestion(t *testing.T) {
+	t.Parallel()
+
+	userID, authorID, sourceID := mustUUID(t), mustUUID(t), mustUUID(t)
+	var ensured bool
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return authorID, false, nil
+			},
+			forkQuestionFn: func(_ context.Context, gotUserID string, gotSourceID string) (domain.QuestionDetail, error) {
+				if gotUserID != userID || gotSourceID != sourceID {
+					t.Fatalf("引数が期待と異なります: user=%q source=%q", gotUserID, gotSourceID)
+				}
+				return domain.QuestionDetail{ID: mustUUID(t), Status: domain.QuestionStatusDraft, OriginQuestionID: sourceID}, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			ensured = true
+			return nil
+		}},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, err := u.ForkQuestion(context.Background(), userID, sourceID)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.OriginQuestionID != sourceID || got.Status != domain.QuestionStatusDraft {
+		t.Fatalf("元の問題を参照する下書きを期待しました: %+v", got)
+	}
+	if !ensured {
+		t.Fatal("複製する前にユーザーを作成しておく想定です")
+	}
+}
+
+func TestUsecase_ForkQuestion_Rejects(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	tests := []struct {
+		name       string
+		questionID string
+		author     string
+		deleted    bool
+		want       apperror.Code
+	}{
+		{name: "question_id が空", questionID: "", want: apperror.CodeInvalidArgument},
+		{name: "question_id が UUID でない", questionID: "q1", want: apperror.CodeInvalidArgument},
+		{name: "削除済みの問題", questionID: mustUUID(t), author: mustUUID(t), deleted: true, want: apperror.CodeNotFound},
+		{name: "自分の問題", questionID: mustUUID(t), author: userID, want: apperror.CodeFailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			u := NewUsecase(
+				&fakeQuestionRepo{
+					getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+						return tt.author, tt.deleted, nil
+					},
+					forkQuestionFn: func(context.Context, string, string) (domain.QuestionDetail, error) {
+						t.Fatal("複製できない場合、repo の ForkQuestion は呼ばれない想定です")
+						return domain.QuestionDetail{}, nil
+					},
+				},
+				&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+				testPageTokens,
+				testDraftRules,
+			)
+			if _, err := u.ForkQuestion(context.Background(), userID, tt.questionID); !apperror.IsCode(err, tt.want) {
+				t.Fatalf("%s を期待しました: err=%v", tt.want, err)
+			}
+		})
+	}
+}
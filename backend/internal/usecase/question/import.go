@@ -0,0 +1,110 @@
+package question
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+)
+
+// 一括取り込みの上限。全行を1トランザクションで作成するため、長時間のロックを避ける大きさに抑える。
+const (
+	maxImportBytes = 1 << 20
+	maxImportRows  = 500
+)
+
+// ImportRowError は取り込みで不正だった行と、その理由。
+type ImportRowError struct {
+	Row        int
+	Violations []apperror.FieldViolation
+}
+
+// ImportResult は ImportQuestions の結果。
+type ImportResult struct {
+	TotalRows int
+	// RowErrors が空でない場合は何も作成していない。
+	RowErrors []ImportRowError
+	// Imported は作成した問題（dryRun または RowErrors がある場合は空）。
+	Imported []domain.QuestionDetail
+}
+
+// ImportQuestions は CSV/JSON の全行を CreateQuestion と同じルールで検証し、問題を一括作成する。
+// ほぼ同じ問題が既にある行も CreateQuestion と同じく不正な行として扱う（draft.prompt の FieldViolation）。
+// 1行でも不正があれば何も作成せず、行ごとの FieldViolation を返す。dryRun の場合は検証のみ行う。
+func (u *Usecase) ImportQuestions(ctx context.Context, userID string, format questionfile.Format, content []byte, dryRun bool) (ImportResult, error) {
+	if userID == "" {
+		return ImportResult{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if len(content) == 0 {
+		return ImportResult{}, apperror.InvalidArgument("content が空です", apperror.FieldViolation{Field: "content", Description: "必須です"})
+	}
+	if len(content) > maxImportBytes {
+		return ImportResult{}, apperror.InvalidArgument("content が大きすぎます", apperror.FieldViolation{Field: "content", Description: "1MiB 以内で指定してください"})
+	}
+
+	rows, err := questionfile.Parse(format, bytes.NewReader(content))
+	if err != nil {
+		return ImportResult{}, err
+	}
+	if len(rows) == 0 {
+		return ImportResult{}, apperror.InvalidArgument("問題が含まれていません", apperror.FieldViolation{Field: "content", Description: "1問以上含めてください"})
+	}
+	if len(rows) > maxImportRows {
+		return ImportResult{}, apperror.InvalidArgument("問題数が多すぎます", apperror.FieldViolation{Field: "content", Description: strconv.Itoa(maxImportRows) + "問以内に分割してください"})
+	}
+
+	result := ImportResult{TotalRows: len(rows)}
+	drafts := make([]domain.QuestionDraft, 0, len(rows))
+	for _, row := range rows {
+		violations := row.Violations
+		draft, err := CheckDraft(u.draftRules, row.Draft)
+		if err != nil {
+			violations = append(violations, fieldViolationsOf(err)...)
+		}
+		if len(violations) > 0 {
+			result.RowErrors = append(result.RowErrors, ImportRowError{Row: row.Line, Violations: violations})
+			continue
+		}
+		draft = NormalizeDraft(draft)
+
+		similar, err := u.findSimilarQuestions(ctx, userID, "", draft.Prompt)
+		if err != nil {
+			return ImportResult{}, err
+		}
+		if len(similar) > 0 && similar[0].Similarity >= duplicateBlockThreshold {
+			result.RowErrors = append(result.RowErrors, ImportRowError{Row: row.Line, Violations: []apperror.FieldViolation{{
+				Field:       "draft.prompt",
+				Description: "ほぼ同じ問題が既にあります（question_id=" + similar[0].QuestionID + "）",
+			}}})
+			continue
+		}
+
+		drafts = append(drafts, draft)
+	}
+	if len(result.RowErrors) > 0 || dryRun {
+		return result, nil
+	}
+
+	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+		return ImportResult{}, err
+	}
+	imported, err := u.questionRepo.CreateQuestions(ctx, userID, drafts)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	result.Imported = imported
+	return result, nil
+}
+
+// fieldViolationsOf は検証エラー（ValidateDraft/CheckDraft）から FieldViolation を取り出す。
+func fieldViolationsOf(err error) []apperror.FieldViolation {
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) || len(appErr.FieldViolations) == 0 {
+		return []apperror.FieldViolation{{Field: "draft", Description: err.Error()}}
+	}
+	return appErr.FieldViolations
+}
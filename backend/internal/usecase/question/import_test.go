@@ -0,0 +1,176 @@
+package question
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+)
+
+const importCSVHeader = "prompt,choice_1,choice_2,choice_3,choice_4,correct_ordinal,explanation,accepted_answers\n"
+
+func TestUsecase_ImportQuestions_RowErrorsCreateNothing(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{createQuestionsFn: func(context.Context, string, []domain.QuestionDraft) ([]domain.QuestionDetail, error) {
+			t.Fatal("不正な行がある場合、CreateQuestions は呼ばれない想定です")
+			return nil, nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			t.Fatal("不正な行がある場合、EnsureUserExists は呼ばれない想定です")
+			return nil
+		}},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	content := importCSVHeader +
+		"Q1,a,b,c,d,0,,\n" +
+		" ,a,b,c,d,5,,\n" +
+		"Q3,a,,c,d,1,,\n"
+
+	got, err := u.ImportQuestions(context.Background(), mustUUID(t), questionfile.FormatCSV, []byte(content), false)
+	if err != nil {
+		t.Fatalf("行エラーは err ではなく結果で返す想定です: %v", err)
+	}
+	if got.TotalRows != 3 || len(got.Imported) != 0 {
+		t.Fatalf("結果が期待と異なります: %+v", got)
+	}
+	if len(got.RowErrors) != 2 {
+		t.Fatalf("2行のエラーを期待しました: %+v", got.RowErrors)
+	}
+	if got.RowErrors[0].Row != 3 || len(got.RowErrors[0].Violations) != 2 {
+		t.Fatalf("3行目は prompt/correct_ordinal の2件を期待しました: %+v", got.RowErrors[0])
+	}
+	if got.RowErrors[1].Row != 4 || got.RowErrors[1].Violations[0].Field != "draft.choices[1]" {
+		t.Fatalf("4行目は choices[1] のエラーを期待しました: %+v", got.RowErrors[1])
+	}
+}
+
+func TestUsecase_ImportQuestions_DryRun(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{createQuestionsFn: func(context.Context, string, []domain.QuestionDraft) ([]domain.QuestionDetail, error) {
+			t.Fatal("dry-run の場合、CreateQuestions は呼ばれない想定です")
+			return nil, nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			t.Fatal("dry-run の場合、EnsureUserExists は呼ばれない想定です")
+			return nil
+		}},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	content := `[{"prompt": "Q", "choices": ["a", "b", "c", "d"], "correct_ordinal": 3}]`
+	got, err := u.ImportQuestions(context.Background(), mustUUID(t), questionfile.FormatJSON, []byte(content), true)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.TotalRows != 1 || len(got.RowErrors) != 0 || len(got.Imported) != 0 {
+		t.Fatalf("結果が期待と異なります: %+v", got)
+	}
+}
+
+func TestUsecase_ImportQuestions_Success(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	createCalled := 0
+
+	u := NewUsecase(
+		&fakeQuestionRepo{createQuestionsFn: func(_ context.Context, gotUserID string, drafts []domain.QuestionDraft) ([]domain.QuestionDetail, error) {
+			createCalled++
+			if gotUserID != userID || len(drafts) != 2 {
+				t.Fatalf("CreateQuestions の引数が期待と異なります: userID=%s drafts=%+v", gotUserID, drafts)
+			}
+			if drafts[1].AcceptedAnswers[0] != "B" {
+				t.Fatalf("別表記は前後空白を除去する想定です: %+v", drafts[1].AcceptedAnswers)
+			}
+			details := make([]domain.QuestionDetail, 0, len(drafts))
+			for _, d := range drafts {
+				details = append(details, domain.QuestionDetail{ID: mustUUID(t), Prompt: d.Prompt})
+			}
+			return details, nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	content := importCSVHeader +
+		"Q1,a,b,c,d,0,,\n" +
+		"Q2,a,b,c,d,1,解説, B |\n"
+
+	got, err := u.ImportQuestions(context.Background(), userID, questionfile.FormatCSV, []byte(content), false)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if createCalled != 1 || len(got.Imported) != 2 {
+		t.Fatalf("CreateQuestions=1 / 2件作成を期待: called=%d got=%+v", createCalled, got)
+	}
+}
+
+func TestUsecase_ImportQuestions_NearDuplicateRowCreatesNothing(t *testing.T) {
+	t.Parallel()
+
+	existingID := mustUUID(t)
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			findSimilarFn: func(_ context.Context, _ string, _ string, shingles []string, _ float64, _ int32) ([]domain.SimilarQuestion, error) {
+				// 2行目（鎌倉幕府）だけが既存の問題とほぼ同じ。
+				for _, s := range shingles {
+					if s == "鎌倉" {
+						return []domain.SimilarQuestion{{QuestionID: existingID, Similarity: 0.95}}, nil
+					}
+				}
+				return []domain.SimilarQuestion{{QuestionID: mustUUID(t), Similarity: 0.6}}, nil
+			},
+			createQuestionsFn: func(context.Context, string, []domain.QuestionDraft) ([]domain.QuestionDetail, error) {
+				t.Fatal("ほぼ同じ問題がある行を含む場合、CreateQuestions は呼ばれない想定です")
+				return nil, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			t.Fatal("ほぼ同じ問題がある行を含む場合、EnsureUserExists は呼ばれない想定です")
+			return nil
+		}},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	content := importCSVHeader +
+		"鎌倉幕府を開いたのは？,源頼朝,足利尊氏,徳川家康,平清盛,0,,\n" +
+		"室町幕府を開いたのは？,足利尊氏,源頼朝,徳川家康,平清盛,0,,\n"
+
+	for _, dryRun := range []bool{true, false} {
+		got, err := u.ImportQuestions(context.Background(), mustUUID(t), questionfile.FormatCSV, []byte(content), dryRun)
+		if err != nil {
+			t.Fatalf("行エラーは err ではなく結果で返す想定です: %v", err)
+		}
+		if len(got.Imported) != 0 || len(got.RowErrors) != 1 {
+			t.Fatalf("dryRun=%v: 1行のエラーを期待しました: %+v", dryRun, got)
+		}
+		rowErr := got.RowErrors[0]
+		if rowErr.Row != 2 || len(rowErr.Violations) != 1 || rowErr.Violations[0].Field != "draft.prompt" ||
+			!strings.Contains(rowErr.Violations[0].Description, existingID) {
+			t.Fatalf("dryRun=%v: 2行目に既存の問題を示す draft.prompt のエラーを期待しました: %+v", dryRun, rowErr)
+		}
+	}
+}
+
+func TestUsecase_ImportQuestions_EmptyContent(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeQuestionRepo{}, &fakeUserRepo{}, testPageTokens, testDraftRules)
+
+	_, err := u.ImportQuestions(context.Background(), mustUUID(t), questionfile.FormatCSV, []byte(importCSVHeader), false)
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
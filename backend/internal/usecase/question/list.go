@@ -0,0 +1,151 @@
+package question
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/searchtext"
+)
+
+// ListQuery は自分の問題一覧（ListMyQuestions）の条件。
+type ListQuery struct {
+	// Statuses が空の場合はすべての公開状態を対象にする。
+	Statuses []domain.QuestionStatus
+	Tag      string
+	// CreatedFrom/CreatedTo は作成日時の範囲（RFC3339、CreatedFrom 以上 CreatedTo 未満）。空の場合はその側を制限しない。
+	CreatedFrom string
+	CreatedTo   string
+	// HasExplanation が nil でない場合は、解説の有無で絞り込む。
+	HasExplanation *bool
+	// Keyword は空白区切りの検索語（すべてを問題文/選択肢/解説のいずれかに含む問題に限る）。
+	Keyword string
+	// Sort が空の場合は更新日時で並べる。
+	Sort      domain.QuestionSortKey
+	Ascending bool
+	PageToken string
+	PageSize  int32
+}
+
+// ListMyQuestions は自分の問題一覧を条件で絞り込み、並び替えて返す（論理削除は除外）。
+// 既定は更新の新しい順。pageToken には前のページの nextPageToken を渡す。次のページが無い場合、nextPageToken は空。
+func (u *Usecase) ListMyQuestions(ctx context.Context, userID string, q ListQuery) ([]domain.QuestionSummary, string, error) {
+	if userID == "" {
+		return nil, "", apperror.Unauthenticated("認証が必要です")
+	}
+
+	var violations []apperror.FieldViolation
+	for _, s := range q.Statuses {
+		if !isKnownStatus(s) {
+			violations = append(violations, apperror.FieldViolation{Field: "statuses", Description: "公開状態が不正です"})
+			break
+		}
+	}
+	sortKey := q.Sort
+	if sortKey == "" {
+		sortKey = domain.QuestionSortUpdatedAt
+	}
+	if !isKnownSortKey(sortKey) {
+		violations = append(violations, apperror.FieldViolation{Field: "sort", Description: "並び替えの基準が不正です"})
+	}
+	createdFrom, ok := parseOptionalTime(q.CreatedFrom)
+	if !ok {
+		violations = append(violations, apperror.FieldViolation{Field: "created_from", Description: "RFC3339 形式で指定してください"})
+	}
+	createdTo, ok := parseOptionalTime(q.CreatedTo)
+	if !ok {
+		violations = append(violations, apperror.FieldViolation{Field: "created_to", Description: "RFC3339 形式で指定してください"})
+	}
+	if !createdFrom.IsZero() && !createdTo.IsZero() && !createdFrom.Before(createdTo) {
+		violations = append(violations, apperror.FieldViolation{Field: "created_to", Description: "created_from より後の日時を指定してください"})
+	}
+	terms := searchtext.ParseQuery(q.Keyword)
+	for _, term := range terms {
+		if len([]rune(term)) > searchtext.MaxTermRunes {
+			violations = append(violations, apperror.FieldViolation{Field: "keyword", Description: "検索語は50文字以内で指定してください"})
+			break
+		}
+	}
+	after, err := decodeMyQuestionsPageToken(u.pageTokens, q.PageToken, sortKey, q.Ascending)
+	if err != nil {
+		violations = append(violations, apperror.FieldViolation{Field: "pagination.page_token", Description: "前のページの next_page_token を、同じ並び替えの条件で指定してください"})
+	}
+	if len(violations) > 0 {
+		return nil, "", apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	limit := normalizePageSize(q.PageSize)
+	questions, err := u.questionRepo.ListMyQuestions(ctx, userID, domain.MyQuestionsQuery{
+		Statuses:       q.Statuses,
+		Tag:            strings.TrimSpace(q.Tag),
+		CreatedFrom:    createdFrom,
+		CreatedTo:      createdTo,
+		HasExplanation: q.HasExplanation,
+		KeywordTerms:   terms,
+		KeywordBigrams: searchtext.QueryBigrams(terms),
+		Sort:           sortKey,
+		Ascending:      q.Ascending,
+		After:          after,
+		// 次のページの有無を判定するため1件多く読む。
+		Limit: limit + 1,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextPageToken := ""
+	if int32(len(questions)) > limit {
+		questions = questions[:limit]
+		nextPageToken = encodeMyQuestionsPageToken(u.pageTokens, sortKey, q.Ascending, listCursorOf(sortKey, q.Ascending, questions[len(questions)-1]))
+	}
+	return questions, nextPageToken, nil
+}
+
+// listCursorOf は一覧の最後の問題から次のページの位置を作る（並び替えの基準に応じた値を使う）。
+func listCursorOf(sortKey domain.QuestionSortKey, ascending bool, q domain.QuestionSummary) domain.QuestionListCursor {
+	c := domain.QuestionListCursor{QuestionID: q.ID}
+	switch sortKey {
+	case domain.QuestionSortCreatedAt:
+		c.At = q.CreatedAt
+	case domain.QuestionSortAccuracy:
+		c.Value = domain.AccuracySortValue(q.AttemptCount, q.CorrectAttempts, ascending)
+	case domain.QuestionSortAttemptCount:
+		c.Count = q.AttemptCount
+	default:
+		c.At = q.UpdatedAt
+	}
+	return c
+}
+
+// parseOptionalTime は RFC3339 の日時を解釈する（空の場合はゼロ値）。
+func parseOptionalTime(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, true
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func isKnownStatus(s domain.QuestionStatus) bool {
+	switch s {
+	case domain.QuestionStatusDraft, domain.QuestionStatusPublished, domain.QuestionStatusUnlisted, domain.QuestionStatusArchived:
+		return true
+	default:
+		return false
+	}
+}
+
+func isKnownSortKey(k domain.QuestionSortKey) bool {
+	switch k {
+	case domain.QuestionSortUpdatedAt, domain.QuestionSortCreatedAt, domain.QuestionSortAccuracy, domain.QuestionSortAttemptCount:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,167 @@
+package question
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestUsecase_ListMyQuestions_PassesFilters(t *testing.T) {
+	t.Parallel()
+
+	var got domain.MyQuestionsQuery
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			listMyQuestionsFn: func(_ context.Context, _ string, query domain.MyQuestionsQuery) ([]domain.QuestionSummary, error) {
+				got = query
+				return nil, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	has := false
+	_, _, err := u.ListMyQuestions(context.Background(), mustUUID(t), ListQuery{
+		Statuses:       []domain.QuestionStatus{domain.QuestionStatusDraft},
+		Tag:            " 幕末 ",
+		CreatedFrom:    "2026-01-01T00:00:00+09:00",
+		CreatedTo:      "2026-04-01T00:00:00+09:00",
+		HasExplanation: &has,
+		Keyword:        "  ペリー 浦賀 ",
+		Sort:           domain.QuestionSortAccuracy,
+		Ascending:      true,
+	})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+
+	if len(got.Statuses) != 1 || got.Tag != "幕末" || got.HasExplanation == nil || *got.HasExplanation {
+		t.Fatalf("絞り込みが期待と異なります: %+v", got)
+	}
+	if !got.CreatedFrom.Equal(time.Date(2025, 12, 31, 15, 0, 0, 0, time.UTC)) || !got.CreatedTo.Equal(time.Date(2026, 3, 31, 15, 0, 0, 0, time.UTC)) {
+		t.Fatalf("作成日時の範囲が期待と異なります: from=%s to=%s", got.CreatedFrom, got.CreatedTo)
+	}
+	if len(got.KeywordTerms) != 2 || len(got.KeywordBigrams) == 0 {
+		t.Fatalf("キーワードは正規化して渡す想定です: terms=%q bigrams=%q", got.KeywordTerms, got.KeywordBigrams)
+	}
+	if got.Sort != domain.QuestionSortAccuracy || !got.Ascending || got.After != nil {
+		t.Fatalf("並び替えが期待と異なります: %+v", got)
+	}
+}
+
+func TestUsecase_ListMyQuestions_DefaultsToUpdatedAt(t *testing.T) {
+	t.Parallel()
+
+	var got domain.MyQuestionsQuery
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			listMyQuestionsFn: func(_ context.Context, _ string, query domain.MyQuestionsQuery) ([]domain.QuestionSummary, error) {
+				got = query
+				return nil, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	if _, _, err := u.ListMyQuestions(context.Background(), mustUUID(t), ListQuery{}); err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.Sort != domain.QuestionSortUpdatedAt || got.Ascending {
+		t.Fatalf("既定は更新の新しい順の想定です: %+v", got)
+	}
+}
+
+func TestUsecase_ListMyQuestions_Paginates(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	q1, q2 := mustUUID(t), mustUUID(t)
+	var gotAfter *domain.QuestionListCursor
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			listMyQuestionsFn: func(_ context.Context, _ string, query domain.MyQuestionsQuery) ([]domain.QuestionSummary, error) {
+				gotAfter = query.After
+				if query.After != nil {
+					return nil, nil
+				}
+				// limit=1+1 件を返す = 次のページがある。
+				return []domain.QuestionSummary{
+					{ID: q1, AttemptCount: 3, CorrectAttempts: 1},
+					{ID: q2, AttemptCount: 3, CorrectAttempts: 1},
+				}[:query.Limit], nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	q := ListQuery{Sort: domain.QuestionSortAccuracy, PageSize: 1}
+	got, next, err := u.ListMyQuestions(context.Background(), userID, q)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != q1 || next == "" {
+		t.Fatalf("1件と next_page_token を期待しました: got=%+v next=%q", got, next)
+	}
+
+	q.PageToken = next
+	_, last, err := u.ListMyQuestions(context.Background(), userID, q)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if gotAfter == nil || gotAfter.QuestionID != q1 || gotAfter.Value != float64(1)/3 {
+		t.Fatalf("前のページの最後の位置（正答率）から読む想定です: %+v", gotAfter)
+	}
+	if last != "" {
+		t.Fatalf("最後のページでは next_page_token は空の想定です: %q", last)
+	}
+}
+
+func TestUsecase_ListMyQuestions_InvalidArgument(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			listMyQuestionsFn: func(context.Context, string, domain.MyQuestionsQuery) ([]domain.QuestionSummary, error) {
+				t.Fatal("入力が不正な場合、repo は呼ばれない想定です")
+				return nil, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+	updatedToken := encodeMyQuestionsPageToken(testPageTokens, domain.QuestionSortUpdatedAt, false, domain.QuestionListCursor{At: time.Now(), QuestionID: mustUUID(t)})
+
+	tests := []struct {
+		name  string
+		query ListQuery
+	}{
+		{name: "公開状態が不正", query: ListQuery{Statuses: []domain.QuestionStatus{"deleted"}}},
+		{name: "並び替えの基準が不正", query: ListQuery{Sort: "prompt"}},
+		{name: "作成日時が RFC3339 でない", query: ListQuery{CreatedFrom: "2026-01-01"}},
+		{name: "作成日時の範囲が逆", query: ListQuery{CreatedFrom: "2026-02-01T00:00:00Z", CreatedTo: "2026-01-01T00:00:00Z"}},
+		{name: "ページトークンが base64 でない", query: ListQuery{PageToken: "!!!"}},
+		{name: "別の鍵で署名されている", query: ListQuery{PageToken: encodeMyQuestionsPageToken(pagetoken.NewCodec([]byte("other")), domain.QuestionSortUpdatedAt, false, domain.QuestionListCursor{QuestionID: mustUUID(t)})}},
+		{name: "並び替えの基準が違うトークン", query: ListQuery{Sort: domain.QuestionSortCreatedAt, PageToken: updatedToken}},
+		{name: "並び替えの向きが違うトークン", query: ListQuery{Ascending: true, PageToken: updatedToken}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, _, err := u.ListMyQuestions(context.Background(), mustUUID(t), tt.query); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package question
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// myQuestionsPageTokenKind は自分の問題一覧のページトークンの種類（他の一覧のトークンを弾く）。
+const myQuestionsPageTokenKind = "my_questions"
+
+// encodeMyQuestionsPageToken は自分の問題一覧のページング位置を署名付きの不透明な文字列にする。
+// 並び替えの基準と向きもトークンに含め、別の並びのトークンを使い回せないようにする。
+// NOTE: 日時は Postgres の精度（マイクロ秒）に合わせて UnixMicro で持つ。
+func encodeMyQuestionsPageToken(codec pagetoken.Codec, sortKey domain.QuestionSortKey, ascending bool, c domain.QuestionListCursor) string {
+	var value string
+	switch sortKey {
+	case domain.QuestionSortAccuracy:
+		value = strconv.FormatFloat(c.Value, 'g', -1, 64)
+	case domain.QuestionSortAttemptCount:
+		value = strconv.FormatInt(c.Count, 10)
+	default:
+		value = strconv.FormatInt(c.At.UnixMicro(), 10)
+	}
+	return codec.Encode(myQuestionsPageTokenKind, string(sortKey), directionOf(ascending), value, c.QuestionID)
+}
+
+// decodeMyQuestionsPageToken はページトークンを検証して復元する（空の場合は nil = 先頭から）。
+// トークンを作った時と並び替えの基準/向きが違う場合もエラーにする。
+func decodeMyQuestionsPageToken(codec pagetoken.Codec, token string, sortKey domain.QuestionSortKey, ascending bool) (*domain.QuestionListCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	fields, err := codec.Decode(myQuestionsPageTokenKind, token, 4)
+	if err != nil {
+		return nil, err
+	}
+	if fields[0] != string(sortKey) || fields[1] != directionOf(ascending) {
+		return nil, pagetoken.ErrInvalid
+	}
+	if _, err := uuid.Parse(fields[3]); err != nil {
+		return nil, pagetoken.ErrInvalid
+	}
+
+	c := &domain.QuestionListCursor{QuestionID: fields[3]}
+	switch sortKey {
+	case domain.QuestionSortAccuracy:
+		v, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, pagetoken.ErrInvalid
+		}
+		c.Value = v
+	case domain.QuestionSortAttemptCount:
+		n, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil || n < 0 {
+			return nil, pagetoken.ErrInvalid
+		}
+		c.Count = n
+	default:
+		micros, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return nil, pagetoken.ErrInvalid
+		}
+		c.At = time.UnixMicro(micros).UTC()
+	}
+	return c, nil
+}
+
+func directionOf(ascending bool) string {
+	if ascending {
+		return "asc"
+	}
+	return "desc"
+}
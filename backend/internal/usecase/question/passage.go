@@ -0,0 +1,185 @@
+package question
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/draftrule"
+)
+
+// 史料の上限。
+const (
+	maxPassageBodyRunes = 4000
+	maxPassageQuestions = 10
+)
+
+// PassageUsecase は史料を共有する問題（ケーススタディ）の作成/更新/取得/削除を提供する。
+// NOTE: 子の問題は通常の問題と同じ検証（CheckDraft/NormalizeDraft）を通し、史料とまとめて1トランザクションで保存する。
+type PassageUsecase struct {
+	passageRepo repository.PassageRepository
+	userRepo    repository.UserRepository
+	draftRules  *draftrule.Pipeline
+}
+
+// NewPassageUsecase は PassageUsecase を生成する。draftRules は子の問題の内容の検証ルール（Usecase と同じもの）。
+func NewPassageUsecase(passageRepo repository.PassageRepository, userRepo repository.UserRepository, draftRules *draftrule.Pipeline) *PassageUsecase {
+	return &PassageUsecase{
+		passageRepo: passageRepo,
+		userRepo:    userRepo,
+		draftRules:  draftRules,
+	}
+}
+
+// CreatePassage は史料と子の問題をまとめて作成する（子の問題はすべて新規のため QuestionID は指定できない）。
+func (u *PassageUsecase) CreatePassage(ctx context.Context, userID string, draft domain.PassageDraft) (domain.PassageDetail, error) {
+	if userID == "" {
+		return domain.PassageDetail{}, apperror.Unauthenticated("認証が必要です")
+	}
+	draft, err := u.checkPassageDraft(draft, false)
+	if err != nil {
+		return domain.PassageDetail{}, err
+	}
+
+	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+		return domain.PassageDetail{}, err
+	}
+	return u.passageRepo.CreatePassage(ctx, userID, draft)
+}
+
+// UpdatePassage は史料と子の問題をまとめて更新する。
+// 子の問題は draft.Questions の並びで置き換える（QuestionID が空の問題は作成し、並びから外れた問題は論理削除する）。
+func (u *PassageUsecase) UpdatePassage(ctx context.Context, userID string, passageID string, expectedVersion int64, draft domain.PassageDraft) (domain.PassageDetail, error) {
+	if userID == "" {
+		return domain.PassageDetail{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if err := validatePassageID(passageID); err != nil {
+		return domain.PassageDetail{}, err
+	}
+	if expectedVersion <= 0 {
+		return domain.PassageDetail{}, apperror.InvalidArgument("expected_version が不正です", apperror.FieldViolation{Field: "expected_version", Description: "編集前に取得した version を指定してください"})
+	}
+	draft, err := u.checkPassageDraft(draft, true)
+	if err != nil {
+		return domain.PassageDetail{}, err
+	}
+
+	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+		return domain.PassageDetail{}, err
+	}
+	return u.passageRepo.UpdatePassage(ctx, userID, passageID, expectedVersion, draft)
+}
+
+// GetMyPassage は自分の史料を子の問題付きで返す（他人の史料は NOT_FOUND）。
+func (u *PassageUsecase) GetMyPassage(ctx context.Context, userID string, passageID string) (domain.PassageDetail, error) {
+	if userID == "" {
+		return domain.PassageDetail{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if err := validatePassageID(passageID); err != nil {
+		return domain.PassageDetail{}, err
+	}
+	return u.passageRepo.GetMyPassage(ctx, userID, passageID)
+}
+
+// DeletePassage は自分の史料と子の問題をまとめて論理削除する。
+func (u *PassageUsecase) DeletePassage(ctx context.Context, userID string, passageID string) error {
+	if userID == "" {
+		return apperror.Unauthenticated("認証が必要です")
+	}
+	if err := validatePassageID(passageID); err != nil {
+		return err
+	}
+	return u.passageRepo.SoftDeletePassage(ctx, userID, passageID)
+}
+
+func validatePassageID(passageID string) error {
+	if passageID == "" {
+		return apperror.InvalidArgument("passage_id が空です", apperror.FieldViolation{Field: "passage_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(passageID); err != nil {
+		return apperror.InvalidArgument("passage_id が不正です", apperror.FieldViolation{Field: "passage_id", Description: "UUID 形式で指定してください"})
+	}
+	return nil
+}
+
+// checkPassageDraft は史料の入力と子の問題をまとめて検証し、保存用に整えた入力を返す。
+// 子の問題の違反は "draft.questions[i].draft.prompt" のように、どの問題の違反かが分かるフィールド名にする。
+// updating=false（作成）では QuestionID を指定できない。
+func (u *PassageUsecase) checkPassageDraft(draft domain.PassageDraft, updating bool) (domain.PassageDraft, error) {
+	draft = draftrule.StripPassageControlChars(draft)
+
+	var violations []apperror.FieldViolation
+	body := strings.TrimSpace(draft.Body)
+	switch {
+	case body == "":
+		violations = append(violations, apperror.FieldViolation{Field: "draft.body", Description: "必須です"})
+	case utf8.RuneCountInString(body) > maxPassageBodyRunes:
+		violations = append(violations, apperror.FieldViolation{Field: "draft.body", Description: "4000文字以内で指定してください"})
+	}
+	violations = append(violations, markupViolations("draft.body", draft.Body)...)
+	violations = append(violations, validateCitation("draft.source", draft.Source)...)
+
+	if draft.Attachment != nil {
+		if _, err := uuid.Parse(draft.Attachment.AttachmentID); err != nil {
+			violations = append(violations, apperror.FieldViolation{Field: "draft.attachment.attachment_id", Description: "UUID 形式で指定してください"})
+		}
+		if utf8.RuneCountInString(strings.TrimSpace(draft.Attachment.AltText)) > maxAttachmentAltRunes {
+			violations = append(violations, apperror.FieldViolation{Field: "draft.attachment.alt_text", Description: "200文字以内で指定してください"})
+		}
+	}
+
+	questions := make([]domain.PassageQuestionDraft, 0, len(draft.Questions))
+	switch {
+	case len(draft.Questions) == 0:
+		violations = append(violations, apperror.FieldViolation{Field: "draft.questions", Description: "問題を1件以上指定してください"})
+	case len(draft.Questions) > maxPassageQuestions:
+		violations = append(violations, apperror.FieldViolation{Field: "draft.questions", Description: "問題は10件以内で指定してください"})
+	default:
+		seen := make(map[string]struct{}, len(draft.Questions))
+		for i, q := range draft.Questions {
+			field := "draft.questions[" + strconv.Itoa(i) + "]"
+			if q.QuestionID != "" {
+				// 問題 ID は小文字の標準形にそろえる（重複の判定と、保存済みの問題との照合のため）。
+				parsed, err := uuid.Parse(q.QuestionID)
+				if err == nil {
+					q.QuestionID = parsed.String()
+				}
+				if !updating {
+					violations = append(violations, apperror.FieldViolation{Field: field + ".question_id", Description: "作成では指定できません"})
+				} else if err != nil {
+					violations = append(violations, apperror.FieldViolation{Field: field + ".question_id", Description: "UUID 形式で指定してください"})
+				} else if _, dup := seen[q.QuestionID]; dup {
+					violations = append(violations, apperror.FieldViolation{Field: field + ".question_id", Description: "同じ問題が重複しています"})
+				}
+				seen[q.QuestionID] = struct{}{}
+			}
+
+			checked, err := CheckDraft(u.draftRules, q.Draft)
+			if err != nil {
+				// CheckDraft のフィールド名は "draft." から始まるため、子の問題の位置を前に付ける。
+				for _, v := range fieldViolationsOf(err) {
+					v.Field = field + "." + v.Field
+					violations = append(violations, v)
+				}
+				continue
+			}
+			questions = append(questions, domain.PassageQuestionDraft{QuestionID: q.QuestionID, Draft: NormalizeDraft(checked)})
+		}
+	}
+	if len(violations) > 0 {
+		return domain.PassageDraft{}, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	draft.Body = body
+	draft.Source = trimCitations([]domain.Citation{draft.Source})[0]
+	if draft.Attachment != nil {
+		draft.Attachment = &trimAttachmentAltTexts([]domain.AttachmentRef{*draft.Attachment})[0]
+	}
+	draft.Questions = questions
+	return draft, nil
+}
@@ -0,0 +1,259 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// fakePassageRepo は PassageUsecase のユニットテスト用のリポジトリ差し替え。
+type fakePassageRepo struct {
+	createPassageFn func(ctx context.Context, authorUserID string, draft domain.PassageDraft) (domain.PassageDetail, error)
+	updatePassageFn func(ctx context.Context, userID string, passageID string, expectedVersion int64, draft domain.PassageDraft) (domain.PassageDetail, error)
+	getMyPassageFn  func(ctx context.Context, userID string, passageID string) (domain.PassageDetail, error)
+	softDeleteFn    func(ctx context.Context, userID string, passageID string) error
+}
+
+func (f *fakePassageRepo) CreatePassage(ctx context.Context, authorUserID string, draft domain.PassageDraft) (domain.PassageDetail, error) {
+	return f.createPassageFn(ctx, authorUserID, draft)
+}
+func (f *fakePassageRepo) UpdatePassage(ctx context.Context, userID string, passageID string, expectedVersion int64, draft domain.PassageDraft) (domain.PassageDetail, error) {
+	return f.updatePassageFn(ctx, userID, passageID, expectedVersion, draft)
+}
+func (f *fakePassageRepo) GetMyPassage(ctx context.Context, userID string, passageID string) (domain.PassageDetail, error) {
+	return f.getMyPassageFn(ctx, userID, passageID)
+}
+func (f *fakePassageRepo) SoftDeletePassage(ctx context.Context, userID string, passageID string) error {
+	return f.softDeleteFn(ctx, userID, passageID)
+}
+
+func validPassageDraft() domain.PassageDraft {
+	return domain.PassageDraft{
+		Body:   "倭人は帯方の東南大海の中に在り、山島に依りて国邑を為す。",
+		Source: domain.Citation{Kind: domain.CitationKindPrimarySource, Title: "魏志倭人伝"},
+		Questions: []domain.PassageQuestionDraft{
+			{Draft: domain.QuestionDraft{Prompt: "この史料が記す「倭人」の住む場所は？", Choices: []string{"大海の中の山島", "大陸の平野", "砂漠のオアシス", "北方の草原"}, CorrectOrdinal: 0}},
+			{Draft: domain.QuestionDraft{Prompt: "この史料を収める歴史書は？", Choices: []string{"三国志", "漢書", "後漢書", "宋書"}, CorrectOrdinal: 0}},
+		},
+	}
+}
+
+func TestPassageUsecase_CreatePassage_NormalizesAndSaves(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	draft := validPassageDraft()
+	draft.Body = "  " + draft.Body + "\u202e  "
+	draft.Source.Title = " 魏志倭人伝 "
+	draft.Questions[1].Draft.Tags = []string{" 弥生時代 ", "弥生時代"}
+
+	ensureCalled := 0
+	u := NewPassageUsecase(
+		&fakePassageRepo{
+			createPassageFn: func(_ context.Context, gotUserID string, got domain.PassageDraft) (domain.PassageDetail, error) {
+				if gotUserID != userID {
+					t.Fatalf("CreatePassage の userID が一致しません: got=%s want=%s", gotUserID, userID)
+				}
+				if got.Body != validPassageDraft().Body {
+					t.Fatalf("本文は前後空白と制御文字を除いて保存する想定です: got=%q", got.Body)
+				}
+				if got.Source.Title != "魏志倭人伝" {
+					t.Fatalf("出典は前後空白を除いて保存する想定です: got=%q", got.Source.Title)
+				}
+				if len(got.Questions) != 2 || !reflect.DeepEqual(got.Questions[1].Draft.Tags, []string{"弥生時代"}) {
+					t.Fatalf("子の問題も NormalizeDraft を通す想定です: got=%+v", got.Questions)
+				}
+				return domain.PassageDetail{Passage: domain.Passage{ID: "p1"}, Version: 1}, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			ensureCalled++
+			return nil
+		}},
+		testDraftRules,
+	)
+
+	got, err := u.CreatePassage(context.Background(), userID, draft)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.ID != "p1" || ensureCalled != 1 {
+		t.Fatalf("作成結果が期待と異なります: got=%+v ensure=%d", got, ensureCalled)
+	}
+}
+
+func TestPassageUsecase_CreatePassage_ReportsViolationsPerQuestion(t *testing.T) {
+	t.Parallel()
+
+	draft := validPassageDraft()
+	draft.Body = " "
+	draft.Source = domain.Citation{Kind: domain.CitationKindWeb, Title: "史料集"}
+	draft.Questions[0].QuestionID = mustUUID(t)
+	draft.Questions[1].Draft.Choices = []string{"三国志", "漢書"}
+
+	u := NewPassageUsecase(
+		&fakePassageRepo{createPassageFn: func(context.Context, string, domain.PassageDraft) (domain.PassageDetail, error) {
+			t.Fatal("入力不正の場合、CreatePassage は呼ばれない想定です")
+			return domain.PassageDetail{}, nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			t.Fatal("入力不正の場合、EnsureUserExists は呼ばれない想定です")
+			return nil
+		}},
+		testDraftRules,
+	)
+
+	_, err := u.CreatePassage(context.Background(), mustUUID(t), draft)
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+	fields := make(map[string]bool, len(appErr.FieldViolations))
+	for _, v := range appErr.FieldViolations {
+		fields[v.Field] = true
+	}
+	for _, want := range []string{"draft.body", "draft.source.url", "draft.questions[0].question_id", "draft.questions[1].draft.choices"} {
+		if !fields[want] {
+			t.Errorf("%s の FieldViolation を期待しました: %+v", want, appErr.FieldViolations)
+		}
+	}
+}
+
+func TestPassageUsecase_UpdatePassage_Rejects(t *testing.T) {
+	t.Parallel()
+
+	existingID := mustUUID(t)
+	tooMany := validPassageDraft()
+	for len(tooMany.Questions) <= maxPassageQuestions {
+		tooMany.Questions = append(tooMany.Questions, tooMany.Questions[0])
+	}
+	duplicated := validPassageDraft()
+	duplicated.Questions[0].QuestionID = existingID
+	duplicated.Questions[1].QuestionID = existingID
+	duplicatedByCase := validPassageDraft()
+	duplicatedByCase.Questions[0].QuestionID = existingID
+	duplicatedByCase.Questions[1].QuestionID = strings.ToUpper(existingID)
+	empty := validPassageDraft()
+	empty.Questions = nil
+
+	tests := []struct {
+		name      string
+		passageID string
+		version   int64
+		draft     domain.PassageDraft
+		wantField string
+	}{
+		{name: "passage_id が UUID でない", passageID: "p1", version: 1, draft: validPassageDraft(), wantField: "passage_id"},
+		{name: "版の指定が無い", passageID: mustUUID(t), version: 0, draft: validPassageDraft(), wantField: "expected_version"},
+		{name: "問題が無い", passageID: mustUUID(t), version: 1, draft: empty, wantField: "draft.questions"},
+		{name: "問題が多すぎる", passageID: mustUUID(t), version: 1, draft: tooMany, wantField: "draft.questions"},
+		{name: "同じ問題を2回指定", passageID: mustUUID(t), version: 1, draft: duplicated, wantField: "draft.questions[1].question_id"},
+		{name: "同じ問題を大文字と小文字で指定", passageID: mustUUID(t), version: 1, draft: duplicatedByCase, wantField: "draft.questions[1].question_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := NewPassageUsecase(
+				&fakePassageRepo{updatePassageFn: func(context.Context, string, string, int64, domain.PassageDraft) (domain.PassageDetail, error) {
+					t.Fatal("入力不正の場合、UpdatePassage は呼ばれない想定です")
+					return domain.PassageDetail{}, nil
+				}},
+				&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+					t.Fatal("入力不正の場合、EnsureUserExists は呼ばれない想定です")
+					return nil
+				}},
+				testDraftRules,
+			)
+
+			_, err := u.UpdatePassage(context.Background(), mustUUID(t), tt.passageID, tt.version, tt.draft)
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+			if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.wantField {
+				t.Fatalf("%s の FieldViolation を期待しました: %+v", tt.wantField, appErr.FieldViolations)
+			}
+		})
+	}
+}
+
+func TestPassageUsecase_UpdatePassage_KeepsQuestionOrder(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	passageID := mustUUID(t)
+	keptID := mustUUID(t)
+	draft := validPassageDraft()
+	// 既存の問題を2問目に移し、新しい問題を先頭に入れる。
+	draft.Questions[1].QuestionID = keptID
+
+	u := NewPassageUsecase(
+		&fakePassageRepo{updatePassageFn: func(_ context.Context, gotUserID string, gotPassageID string, gotVersion int64, got domain.PassageDraft) (domain.PassageDetail, error) {
+			if gotUserID != userID || gotPassageID != passageID || gotVersion != 3 {
+				t.Fatalf("UpdatePassage args mismatch: user=%s passage=%s version=%d", gotUserID, gotPassageID, gotVersion)
+			}
+			if len(got.Questions) != 2 || got.Questions[0].QuestionID != "" || got.Questions[1].QuestionID != keptID {
+				t.Fatalf("子の問題の並びを保ったまま渡す想定です: got=%+v", got.Questions)
+			}
+			return domain.PassageDetail{Passage: domain.Passage{ID: gotPassageID}, Version: 4}, nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+		testDraftRules,
+	)
+
+	got, err := u.UpdatePassage(context.Background(), userID, passageID, 3, draft)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.Version != 4 {
+		t.Fatalf("Version = %d, want 4", got.Version)
+	}
+}
+
+// 大文字や波括弧付きの UUID も、保存済みの問題と照合できるよう小文字の標準形にそろえて渡す。
+func TestPassageUsecase_UpdatePassage_NormalizesQuestionIDs(t *testing.T) {
+	t.Parallel()
+
+	firstID, secondID := mustUUID(t), mustUUID(t)
+	draft := validPassageDraft()
+	draft.Questions[0].QuestionID = strings.ToUpper(firstID)
+	draft.Questions[1].QuestionID = "{" + secondID + "}"
+
+	u := NewPassageUsecase(
+		&fakePassageRepo{updatePassageFn: func(_ context.Context, _ string, _ string, _ int64, got domain.PassageDraft) (domain.PassageDetail, error) {
+			if len(got.Questions) != 2 || got.Questions[0].QuestionID != firstID || got.Questions[1].QuestionID != secondID {
+				t.Fatalf("問題 ID は小文字の標準形にそろえる想定です: got=%+v", got.Questions)
+			}
+			return domain.PassageDetail{}, nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+		testDraftRules,
+	)
+
+	if _, err := u.UpdatePassage(context.Background(), mustUUID(t), mustUUID(t), 1, draft); err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+}
+
+func TestPassageUsecase_DeletePassage_Unauthenticated(t *testing.T) {
+	t.Parallel()
+
+	u := NewPassageUsecase(
+		&fakePassageRepo{softDeleteFn: func(context.Context, string, string) error {
+			t.Fatal("未ログインの場合、SoftDeletePassage は呼ばれない想定です")
+			return nil
+		}},
+		&fakeUserRepo{},
+		testDraftRules,
+	)
+
+	if err := u.DeletePassage(context.Background(), "", mustUUID(t)); !apperror.IsCode(err, apperror.CodeUnauthenticated) {
+		t.Fatalf("UNAUTHENTICATED を期待しました: err=%v", err)
+	}
+}
@@ -0,0 +1,323 @@
+package questionfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
+)
+
+// Format は一括取り込み/書き出しのファイル形式。
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+	// FormatAnkiTSV は Anki に取り込めるタブ区切り形式（書き出し専用）。
+	FormatAnkiTSV Format = "anki_tsv"
+)
+
+// CSV の列名。ヘッダ行で列の順序を決めるため、並び順は自由。
+const (
+	// columnKind は問題の種類（"choice" / "location"。空は "choice"）。
+	columnKind            = "kind"
+	columnPrompt          = "prompt"
+	columnCorrectOrdinal  = "correct_ordinal"
+	columnExplanation     = "explanation"
+	columnAcceptedAnswers = "accepted_answers"
+	columnTags            = "tags"
+	// columnCitations は出典の JSON 配列（JSON 形式の citations と同じ形。出典が無ければ空）。
+	columnCitations = "citations"
+	// 地図で答える場合の正解の地点（JSON 形式の location と同じ単位。地点が無ければ3列とも空）。
+	columnLatitude  = "latitude"
+	columnLongitude = "longitude"
+	columnRadiusKm  = "radius_km"
+)
+
+// choiceColumns は選択肢の列名（choice_1..choice_4）。
+var choiceColumns = []string{"choice_1", "choice_2", "choice_3", "choice_4"}
+
+// ListSeparator は CSV の accepted_answers/tags 列で複数の値を区切る文字。
+const ListSeparator = "|"
+
+// Row はファイル内の1問分。
+type Row struct {
+	// Line は CSV の行番号（ヘッダ=1）、JSON の配列の 1 始まりの位置。
+	Line  int
+	Draft domain.QuestionDraft
+	// Violations は値の型の不正（例: correct_ordinal が整数でない）。ValidateDraft の検証は含まない。
+	Violations []apperror.FieldViolation
+}
+
+// jsonRow は JSON 形式の1問分（QuestionDraft と同じキー）。
+type jsonRow struct {
+	// Kind は問題の種類（"choice" / "location"。省略は "choice"）。
+	Kind            string   `json:"kind,omitempty"`
+	Prompt          string   `json:"prompt"`
+	Choices         []string `json:"choices"`
+	CorrectOrdinal  *int32   `json:"correct_ordinal"`
+	Explanation     string   `json:"explanation"`
+	AcceptedAnswers []string `json:"accepted_answers"`
+	Tags            []string `json:"tags"`
+	// Citations は CSV では citations 列に同じ形の JSON 配列として持つ。
+	Citations []jsonCitation `json:"citations,omitempty"`
+	// Location は地図で答える場合の正解の地点（CSV では latitude/longitude/radius_km 列に持つ）。
+	Location *jsonLocation `json:"location,omitempty"`
+}
+
+// jsonLocation は JSON 形式の正解の地点（緯度/経度は度、半径は km）。
+type jsonLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	RadiusKm  float64 `json:"radius_km"`
+}
+
+// jsonCitation は JSON 形式の出典（kind は "book" / "web" / "primary_source"）。
+type jsonCitation struct {
+	Kind    string `json:"kind"`
+	Title   string `json:"title"`
+	Author  string `json:"author,omitempty"`
+	Locator string `json:"locator,omitempty"`
+	URL     string `json:"url,omitempty"`
+}
+
+// Parse はファイル全体を行に分解する。
+// ヘッダ不足や JSON の構文エラーなど、行単位に割り当てられない不正は INVALID_ARGUMENT を返す。
+func Parse(format Format, r io.Reader) ([]Row, error) {
+	switch format {
+	case FormatCSV:
+		return parseCSV(r)
+	case FormatJSON:
+		return parseJSON(r)
+	case FormatAnkiTSV:
+		return nil, apperror.InvalidArgument("format が不正です", apperror.FieldViolation{Field: "format", Description: "Anki 形式は書き出し専用です"})
+	default:
+		return nil, apperror.InvalidArgument("format が不正です", apperror.FieldViolation{Field: "format", Description: "CSV または JSON を指定してください"})
+	}
+}
+
+func parseCSV(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(StripBOM(r))
+	// 列数はヘッダと同じであることを要求する（列ずれを行エラーではなく全体エラーで止める）。
+	reader.FieldsPerRecord = 0
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, apperror.InvalidArgument("CSV の読み取りに失敗しました", apperror.FieldViolation{Field: "content", Description: err.Error()})
+	}
+
+	index := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, dup := index[name]; dup {
+			return nil, apperror.InvalidArgument("CSV のヘッダが不正です", apperror.FieldViolation{Field: "content", Description: "列 " + name + " が重複しています"})
+		}
+		index[name] = i
+	}
+
+	var violations []apperror.FieldViolation
+	known := map[string]struct{}{columnKind: {}, columnPrompt: {}, columnCorrectOrdinal: {}, columnExplanation: {}, columnAcceptedAnswers: {}, columnTags: {}, columnCitations: {}, columnLatitude: {}, columnLongitude: {}, columnRadiusKm: {}}
+	for _, name := range choiceColumns {
+		known[name] = struct{}{}
+	}
+	for name := range index {
+		if _, ok := known[name]; !ok {
+			violations = append(violations, apperror.FieldViolation{Field: "content", Description: "未知の列です: " + name})
+		}
+	}
+	for _, name := range append([]string{columnPrompt, columnCorrectOrdinal}, choiceColumns...) {
+		if _, ok := index[name]; !ok {
+			violations = append(violations, apperror.FieldViolation{Field: "content", Description: "列 " + name + " が必要です"})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, apperror.InvalidArgument("CSV のヘッダが不正です", violations...)
+	}
+
+	cell := func(record []string, name string) string {
+		i, ok := index[name]
+		if !ok {
+			return ""
+		}
+		return record[i]
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, apperror.InvalidArgument("CSV の読み取りに失敗しました", apperror.FieldViolation{Field: "content", Description: err.Error()})
+		}
+		line, _ := reader.FieldPos(0)
+
+		row := Row{Line: line}
+		row.Draft.Kind = domain.QuestionKind(strings.TrimSpace(cell(record, columnKind)))
+		locationOnly := row.Draft.Kind == domain.QuestionKindLocation
+		row.Draft.Prompt = cell(record, columnPrompt)
+		for _, name := range choiceColumns {
+			row.Draft.Choices = append(row.Draft.Choices, cell(record, name))
+		}
+		// 地図でだけ答える問題は選択肢の列を空にする（空の4列は「選択肢なし」として読む）。
+		if locationOnly && allBlank(row.Draft.Choices) {
+			row.Draft.Choices = nil
+		}
+		row.Draft.Explanation = cell(record, columnExplanation)
+		row.Draft.AcceptedAnswers = splitList(cell(record, columnAcceptedAnswers))
+		row.Draft.Tags = splitList(cell(record, columnTags))
+		if raw := strings.TrimSpace(cell(record, columnCitations)); raw != "" {
+			var citations []jsonCitation
+			decoder := json.NewDecoder(strings.NewReader(raw))
+			decoder.DisallowUnknownFields()
+			if err := decoder.Decode(&citations); err != nil {
+				row.Violations = append(row.Violations, apperror.FieldViolation{Field: "draft.citations", Description: "出典の JSON 配列として読み取れません: " + err.Error()})
+			}
+			row.Draft.Citations = fromJSONCitations(citations)
+		}
+		location, ok := parseCSVLocation(cell(record, columnLatitude), cell(record, columnLongitude), cell(record, columnRadiusKm))
+		if !ok {
+			row.Violations = append(row.Violations, apperror.FieldViolation{Field: "draft.location", Description: "latitude/longitude/radius_km は3列とも数値で指定してください（地点が無い場合は3列とも空）"})
+		}
+		row.Draft.Location = location
+
+		rawOrdinal := strings.TrimSpace(cell(record, columnCorrectOrdinal))
+		if !locationOnly || rawOrdinal != "" {
+			ordinal, err := strconv.ParseInt(rawOrdinal, 10, 32)
+			if err != nil {
+				row.Violations = append(row.Violations, apperror.FieldViolation{Field: "draft.correct_ordinal", Description: "0..3 の整数で指定してください"})
+			}
+			row.Draft.CorrectOrdinal = int32(ordinal)
+		}
+
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func parseJSON(r io.Reader) ([]Row, error) {
+	var raws []json.RawMessage
+	if err := json.NewDecoder(StripBOM(r)).Decode(&raws); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, apperror.InvalidArgument("JSON の読み取りに失敗しました", apperror.FieldViolation{Field: "content", Description: "問題オブジェクトの配列を指定してください"})
+	}
+
+	rows := make([]Row, 0, len(raws))
+	for i, raw := range raws {
+		row := Row{Line: i + 1}
+
+		// 型の不正は行エラーとして返し、他の行の検証は続ける。
+		var v jsonRow
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&v); err != nil {
+			row.Violations = append(row.Violations, apperror.FieldViolation{Field: "draft", Description: "問題オブジェクトとして読み取れません: " + err.Error()})
+			rows = append(rows, row)
+			continue
+		}
+
+		row.Draft = domain.QuestionDraft{
+			Kind:            domain.QuestionKind(v.Kind),
+			Prompt:          v.Prompt,
+			Choices:         v.Choices,
+			Explanation:     v.Explanation,
+			AcceptedAnswers: v.AcceptedAnswers,
+			Tags:            v.Tags,
+		}
+		row.Draft.Citations = fromJSONCitations(v.Citations)
+		if v.Location != nil {
+			row.Draft.Location = &domain.QuestionLocation{
+				Point:    geo.Point{Lat: v.Location.Latitude, Lng: v.Location.Longitude},
+				RadiusKm: v.Location.RadiusKm,
+			}
+		}
+		switch {
+		case v.CorrectOrdinal != nil:
+			row.Draft.CorrectOrdinal = *v.CorrectOrdinal
+		case row.Draft.Kind == domain.QuestionKindLocation:
+			// 地図でだけ答える問題は正解の選択肢を持たない。
+		default:
+			// 0 は有効な値なので、省略とは区別する。
+			row.Violations = append(row.Violations, apperror.FieldViolation{Field: "draft.correct_ordinal", Description: "必須です"})
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseCSVLocation は CSV の3列から正解の地点を読む。3列とも空なら地点なし（nil, true）。
+// NOTE: 値の範囲（緯度 -90..90 など）は ValidateDraft で検証する。
+func parseCSVLocation(latitude, longitude, radiusKm string) (*domain.QuestionLocation, bool) {
+	values := []string{strings.TrimSpace(latitude), strings.TrimSpace(longitude), strings.TrimSpace(radiusKm)}
+	if values[0] == "" && values[1] == "" && values[2] == "" {
+		return nil, true
+	}
+	parsed := make([]float64, len(values))
+	for i, v := range values {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, false
+		}
+		parsed[i] = f
+	}
+	return &domain.QuestionLocation{Point: geo.Point{Lat: parsed[0], Lng: parsed[1]}, RadiusKm: parsed[2]}, true
+}
+
+func fromJSONCitations(citations []jsonCitation) []domain.Citation {
+	var out []domain.Citation
+	for _, c := range citations {
+		out = append(out, domain.Citation{
+			Kind:    domain.CitationKind(c.Kind),
+			Title:   c.Title,
+			Author:  c.Author,
+			Locator: c.Locator,
+			URL:     c.URL,
+		})
+	}
+	return out
+}
+
+// allBlank は values がすべて空白だけかを返す。
+func allBlank(values []string) bool {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// splitList は "|" 区切りの値を分割する（空要素は無視する）。
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ListSeparator) {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+// StripBOM は先頭の UTF-8 BOM を読み飛ばす（Excel で保存した CSV 対策）。
+func StripBOM(r io.Reader) io.Reader {
+	buf := make([]byte, 3)
+	n, _ := io.ReadFull(r, buf)
+	if n == 3 && bytes.Equal(buf, []byte{0xEF, 0xBB, 0xBF}) {
+		return r
+	}
+	return io.MultiReader(bytes.NewReader(buf[:n]), r)
+}
@@ -0,0 +1,180 @@
+package questionfile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestParse_CSV(t *testing.T) {
+	t.Parallel()
+
+	content := "\ufeffprompt,choice_1,choice_2,choice_3,choice_4,correct_ordinal,explanation,accepted_answers\n" +
+		"喜望峰を回ってインドに到達したのは？,コロンブス,ヴァスコ・ダ・ガマ,マゼラン,カブラル,1,1498年に到達。,ヴァスコダガマ|Vasco da Gama\n" +
+		"\"改行を含む\n問題文\",a,b,c,d,x,,\n"
+
+	rows, err := Parse(FormatCSV, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("2行を期待しました: got=%d", len(rows))
+	}
+
+	first := rows[0]
+	if first.Line != 2 || len(first.Violations) != 0 {
+		t.Fatalf("1行目が期待と異なります: %+v", first)
+	}
+	if first.Draft.Choices[1] != "ヴァスコ・ダ・ガマ" || first.Draft.CorrectOrdinal != 1 {
+		t.Fatalf("draft が期待と異なります: %+v", first.Draft)
+	}
+	if len(first.Draft.AcceptedAnswers) != 2 || first.Draft.AcceptedAnswers[1] != "Vasco da Gama" {
+		t.Fatalf("別表記が期待と異なります: %+v", first.Draft.AcceptedAnswers)
+	}
+
+	second := rows[1]
+	if second.Line != 3 {
+		t.Fatalf("改行を含む行の行番号は開始行を期待しました: got=%d", second.Line)
+	}
+	if len(second.Violations) != 1 || second.Violations[0].Field != "draft.correct_ordinal" {
+		t.Fatalf("correct_ordinal の型エラーを期待しました: %+v", second.Violations)
+	}
+}
+
+func TestParse_CSVInvalidCitations(t *testing.T) {
+	t.Parallel()
+
+	content := "prompt,choice_1,choice_2,choice_3,choice_4,correct_ordinal,citations\n" +
+		"Q,a,b,c,d,0,\"[{\"\"kind\"\":\"\"book\"\",\"\"title\"\":\"\"T\"\"}]\"\n" +
+		"Q,a,b,c,d,0,山田太郎『T』\n"
+
+	rows, err := Parse(FormatCSV, strings.NewReader(content))
+	if err != nil || len(rows) != 2 {
+		t.Fatalf("2行を期待しました: rows=%+v err=%v", rows, err)
+	}
+	if len(rows[0].Violations) != 0 || len(rows[0].Draft.Citations) != 1 || rows[0].Draft.Citations[0].Title != "T" {
+		t.Fatalf("出典の JSON 配列を読み取る想定です: %+v", rows[0])
+	}
+	if len(rows[1].Violations) != 1 || rows[1].Violations[0].Field != "draft.citations" {
+		t.Fatalf("citations の型エラーを期待しました: %+v", rows[1].Violations)
+	}
+}
+
+func TestParse_CSVLocation(t *testing.T) {
+	t.Parallel()
+
+	content := "prompt,choice_1,choice_2,choice_3,choice_4,correct_ordinal,latitude,longitude,radius_km\n" +
+		"Q,a,b,c,d,0,36.8529,10.3233,50\n" +
+		"Q,a,b,c,d,0,,,\n" +
+		"Q,a,b,c,d,0,36.8529,,50\n"
+
+	rows, err := Parse(FormatCSV, strings.NewReader(content))
+	if err != nil || len(rows) != 3 {
+		t.Fatalf("3行を期待しました: rows=%+v err=%v", rows, err)
+	}
+	if loc := rows[0].Draft.Location; len(rows[0].Violations) != 0 || loc == nil || loc.Point.Lat != 36.8529 || loc.Point.Lng != 10.3233 || loc.RadiusKm != 50 {
+		t.Fatalf("正解の地点を読み取る想定です: %+v", rows[0])
+	}
+	if len(rows[1].Violations) != 0 || rows[1].Draft.Location != nil {
+		t.Fatalf("3列とも空なら地点なしの想定です: %+v", rows[1])
+	}
+	if len(rows[2].Violations) != 1 || rows[2].Violations[0].Field != "draft.location" {
+		t.Fatalf("一部の列だけの地点は location の型エラーを期待しました: %+v", rows[2].Violations)
+	}
+}
+
+func TestParse_LocationOnlyKind(t *testing.T) {
+	t.Parallel()
+
+	csvContent := "kind,prompt,choice_1,choice_2,choice_3,choice_4,correct_ordinal,latitude,longitude,radius_km\n" +
+		"location,カルタゴはどこ？,,,,,,36.8529,10.3233,50\n" +
+		",Q,a,b,c,d,,,,\n"
+	rows, err := Parse(FormatCSV, strings.NewReader(csvContent))
+	if err != nil || len(rows) != 2 {
+		t.Fatalf("2行を期待しました: rows=%+v err=%v", rows, err)
+	}
+	if d := rows[0].Draft; len(rows[0].Violations) != 0 || d.Kind != domain.QuestionKindLocation || d.Choices != nil || d.Location == nil {
+		t.Fatalf("地図でだけ答える問題は選択肢と correct_ordinal を空にできる想定です: %+v", rows[0])
+	}
+	if len(rows[1].Violations) != 1 || rows[1].Violations[0].Field != "draft.correct_ordinal" {
+		t.Fatalf("4択の問題では correct_ordinal が必須の想定です: %+v", rows[1].Violations)
+	}
+
+	jsonContent := `[{"kind": "location", "prompt": "カルタゴはどこ？", "location": {"latitude": 36.8529, "longitude": 10.3233, "radius_km": 50}}]`
+	rows, err = Parse(FormatJSON, strings.NewReader(jsonContent))
+	if err != nil || len(rows) != 1 {
+		t.Fatalf("1行を期待しました: rows=%+v err=%v", rows, err)
+	}
+	if d := rows[0].Draft; len(rows[0].Violations) != 0 || d.Kind != domain.QuestionKindLocation || d.Location == nil {
+		t.Fatalf("地図でだけ答える問題は correct_ordinal を省略できる想定です: %+v", rows[0])
+	}
+}
+
+func TestParse_CSVHeaderErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "必須列の欠落", content: "prompt,choice_1,choice_2,choice_3,choice_4\nQ,a,b,c,d\n"},
+		{name: "未知の列", content: "prompt,choice_1,choice_2,choice_3,choice_4,correct_ordinal,answer\nQ,a,b,c,d,0,a\n"},
+		{name: "列数の不一致", content: "prompt,choice_1,choice_2,choice_3,choice_4,correct_ordinal\nQ,a,b,c,d\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Parse(FormatCSV, strings.NewReader(tt.content))
+			if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+		})
+	}
+}
+
+func TestParse_JSON(t *testing.T) {
+	t.Parallel()
+
+	content := `[
+	  {"prompt": "Q1", "choices": ["a", "b", "c", "d"], "correct_ordinal": 0, "accepted_answers": ["A"]},
+	  {"prompt": "Q2", "choices": ["a", "b", "c", "d"]},
+	  {"prompt": "Q3", "choices": "a,b,c,d", "correct_ordinal": 1},
+	  {"prompt": "Q4", "choices": ["a", "b", "c", "d"], "correct_ordinal": 2, "answer": "c"}
+	]`
+
+	rows, err := Parse(FormatJSON, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("4行を期待しました: got=%d", len(rows))
+	}
+	if len(rows[0].Violations) != 0 || rows[0].Draft.CorrectOrdinal != 0 || rows[0].Draft.AcceptedAnswers[0] != "A" {
+		t.Fatalf("1件目が期待と異なります: %+v", rows[0])
+	}
+	if len(rows[1].Violations) != 1 || rows[1].Violations[0].Field != "draft.correct_ordinal" {
+		t.Fatalf("correct_ordinal の省略はエラーを期待しました: %+v", rows[1].Violations)
+	}
+	for _, row := range rows[2:] {
+		if len(row.Violations) != 1 || row.Violations[0].Field != "draft" {
+			t.Fatalf("型不正/未知のキーは行エラーを期待しました: %+v", row)
+		}
+	}
+	if rows[3].Line != 4 {
+		t.Fatalf("JSON の行番号は 1 始まりの位置を期待しました: got=%d", rows[3].Line)
+	}
+}
+
+func TestParse_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Parse(FormatJSON, strings.NewReader(`{"prompt": "Q"}`)); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("配列でない JSON は INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+	if _, err := Parse(Format("xlsx"), strings.NewReader("")); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("未知の形式は INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
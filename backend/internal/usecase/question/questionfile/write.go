@@ -0,0 +1,267 @@
+package questionfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// csvHeader は書き出す CSV のヘッダ（Parse がそのまま読める列名）。
+var csvHeader = append(append([]string{columnKind, columnPrompt}, choiceColumns...), columnCorrectOrdinal, columnExplanation, columnAcceptedAnswers, columnTags, columnCitations, columnLatitude, columnLongitude, columnRadiusKm)
+
+// Writer は問題を1問ずつファイル形式に書き出す。
+// 書き出した CSV/JSON は Parse でそのまま取り込める（Anki 形式は書き出し専用）。
+type Writer struct {
+	format Format
+	w      io.Writer
+	csv    *csv.Writer
+	count  int
+}
+
+// NewWriter は format 用の Writer を作り、ヘッダ（CSV/Anki）や配列の開始（JSON）を書き出す。
+func NewWriter(format Format, w io.Writer) (*Writer, error) {
+	writer := &Writer{format: format, w: w}
+	switch format {
+	case FormatCSV:
+		writer.csv = csv.NewWriter(w)
+		if err := writer.csv.Write(csvHeader); err != nil {
+			return nil, err
+		}
+	case FormatJSON:
+		if _, err := io.WriteString(w, "["); err != nil {
+			return nil, err
+		}
+	case FormatAnkiTSV:
+		// Anki 2.1.55+ のファイルヘッダ。列は 表面 / 裏面 / タグ。
+		if _, err := io.WriteString(w, "#separator:tab\n#html:true\n#columns:Front\tBack\tTags\n#tags column:3\n"); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, apperror.InvalidArgument("format が不正です", apperror.FieldViolation{Field: "format", Description: "CSV/JSON/Anki のいずれかを指定してください"})
+	}
+	return writer, nil
+}
+
+// Write は1問を書き出す。
+func (w *Writer) Write(q domain.QuestionDetail) error {
+	draft := DraftOf(q)
+	w.count++
+
+	switch w.format {
+	case FormatCSV:
+		citations, err := csvCitations(draft.Citations)
+		if err != nil {
+			return err
+		}
+		record := []string{string(draft.Kind), draft.Prompt}
+		for i := range choiceColumns {
+			label := ""
+			if i < len(draft.Choices) {
+				label = draft.Choices[i]
+			}
+			record = append(record, label)
+		}
+		record = append(record,
+			csvCorrectOrdinal(draft),
+			draft.Explanation,
+			strings.Join(draft.AcceptedAnswers, ListSeparator),
+			strings.Join(draft.Tags, ListSeparator),
+			citations,
+		)
+		record = append(record, csvLocation(draft.Location)...)
+		return w.csv.Write(record)
+	case FormatJSON:
+		// 地図でだけ答える問題は正解の選択肢を持たないため correct_ordinal を書かない。
+		var ordinal *int32
+		if draft.Kind != domain.QuestionKindLocation {
+			ordinal = &draft.CorrectOrdinal
+		}
+		encoded, err := marshalJSON(jsonRow{
+			Kind:            string(draft.Kind),
+			Prompt:          draft.Prompt,
+			Choices:         draft.Choices,
+			CorrectOrdinal:  ordinal,
+			Explanation:     draft.Explanation,
+			AcceptedAnswers: draft.AcceptedAnswers,
+			Tags:            draft.Tags,
+			Citations:       toJSONCitations(draft.Citations),
+			Location:        toJSONLocation(draft.Location),
+		})
+		if err != nil {
+			return err
+		}
+		separator := "\n  "
+		if w.count > 1 {
+			separator = ",\n  "
+		}
+		_, err = io.WriteString(w.w, separator+string(encoded))
+		return err
+	default:
+		_, err := io.WriteString(w.w, ankiField(ankiFront(draft))+"\t"+ankiField(ankiBack(draft))+"\t"+ankiTags(draft.Tags)+"\n")
+		return err
+	}
+}
+
+// Close は末尾（JSON の配列の終端など）を書き出し、バッファを flush する。
+// NOTE: 下層の io.Writer は閉じない。
+func (w *Writer) Close() error {
+	switch w.format {
+	case FormatCSV:
+		w.csv.Flush()
+		return w.csv.Error()
+	case FormatJSON:
+		end := "]\n"
+		if w.count > 0 {
+			end = "\n]\n"
+		}
+		_, err := io.WriteString(w.w, end)
+		return err
+	default:
+		return nil
+	}
+}
+
+// DraftOf は問題の詳細を作問入力の形に戻す（正解は choice_id から ordinal に変換する）。
+func DraftOf(q domain.QuestionDetail) domain.QuestionDraft {
+	draft := domain.QuestionDraft{
+		Kind:            q.Kind,
+		Prompt:          q.Prompt,
+		Explanation:     q.Explanation,
+		AcceptedAnswers: q.AcceptedAnswers,
+		Tags:            q.Tags,
+		Citations:       q.Citations,
+		Location:        q.Location,
+	}
+	for _, c := range q.Choices {
+		draft.Choices = append(draft.Choices, c.Label)
+		if c.ID == q.CorrectChoiceID {
+			draft.CorrectOrdinal = c.Ordinal
+		}
+	}
+	return draft
+}
+
+func toJSONLocation(location *domain.QuestionLocation) *jsonLocation {
+	if location == nil {
+		return nil
+	}
+	return &jsonLocation{Latitude: location.Point.Lat, Longitude: location.Point.Lng, RadiusKm: location.RadiusKm}
+}
+
+func toJSONCitations(citations []domain.Citation) []jsonCitation {
+	var out []jsonCitation
+	for _, c := range citations {
+		out = append(out, jsonCitation{Kind: string(c.Kind), Title: c.Title, Author: c.Author, Locator: c.Locator, URL: c.URL})
+	}
+	return out
+}
+
+// csvCorrectOrdinal は CSV の correct_ordinal 列の値（地図でだけ答える問題は空）にする。
+func csvCorrectOrdinal(draft domain.QuestionDraft) string {
+	if draft.Kind == domain.QuestionKindLocation {
+		return ""
+	}
+	return strconv.Itoa(int(draft.CorrectOrdinal))
+}
+
+// csvCitations は出典を CSV の citations 列の値（JSON 配列。出典が無ければ空）にする。
+func csvCitations(citations []domain.Citation) (string, error) {
+	if len(citations) == 0 {
+		return "", nil
+	}
+	encoded, err := marshalJSON(toJSONCitations(citations))
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// csvLocation は正解の地点を CSV の latitude/longitude/radius_km 列の値にする（地点が無ければ3列とも空）。
+func csvLocation(location *domain.QuestionLocation) []string {
+	if location == nil {
+		return []string{"", "", ""}
+	}
+	return []string{
+		strconv.FormatFloat(location.Point.Lat, 'f', -1, 64),
+		strconv.FormatFloat(location.Point.Lng, 'f', -1, 64),
+		strconv.FormatFloat(location.RadiusKm, 'f', -1, 64),
+	}
+}
+
+// marshalJSON は HTML エスケープせずに JSON を作る（"<" を "\u003c" にしない）。
+func marshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// choiceMarks は Anki のカードで選択肢に付ける記号。
+var choiceMarks = []string{"A", "B", "C", "D"}
+
+func ankiFront(draft domain.QuestionDraft) string {
+	var b strings.Builder
+	b.WriteString(ankiHTML(draft.Prompt))
+	for i, label := range draft.Choices {
+		if i == 0 {
+			b.WriteString("<br><br>")
+		} else {
+			b.WriteString("<br>")
+		}
+		b.WriteString(choiceMark(i) + ". " + ankiHTML(label))
+	}
+	return b.String()
+}
+
+func ankiBack(draft domain.QuestionDraft) string {
+	back := ""
+	switch {
+	case draft.Kind == domain.QuestionKindLocation && draft.Location != nil:
+		// 地図でだけ答える問題は正解の地点（緯度, 経度）を裏面に出す。
+		back = ankiHTML(strconv.FormatFloat(draft.Location.Point.Lat, 'f', -1, 64) + ", " + strconv.FormatFloat(draft.Location.Point.Lng, 'f', -1, 64))
+	case int(draft.CorrectOrdinal) < len(draft.Choices):
+		back = choiceMark(int(draft.CorrectOrdinal)) + ". " + ankiHTML(draft.Choices[draft.CorrectOrdinal])
+	}
+	if draft.Explanation != "" {
+		back += "<br><br>" + ankiHTML(draft.Explanation)
+	}
+	return back
+}
+
+func choiceMark(i int) string {
+	if i < len(choiceMarks) {
+		return choiceMarks[i]
+	}
+	return strconv.Itoa(i + 1)
+}
+
+// ankiHTML は本文を HTML としてエスケープし、改行を <br> にする（#html:true で取り込むため）。
+func ankiHTML(s string) string {
+	s = html.EscapeString(s)
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// ankiField はタブ区切りのフィールドとして安全な形にする（タブは空白に置き換える）。
+func ankiField(s string) string {
+	return strings.ReplaceAll(s, "\t", " ")
+}
+
+// ankiTags は Anki のタグ列を作る。Anki のタグは空白区切りのため、タグ内の空白は "_" に置き換える。
+func ankiTags(tags []string) string {
+	converted := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		converted = append(converted, strings.Join(strings.Fields(tag), "_"))
+	}
+	return strings.Join(converted, " ")
+}
@@ -0,0 +1,251 @@
+package questionfile
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
+)
+
+func exportFixtures() []domain.QuestionDetail {
+	return []domain.QuestionDetail{
+		{
+			ID:     "q1",
+			Prompt: "喜望峰を回ってインドに到達したのは？",
+			Choices: []domain.Choice{
+				{ID: "c1", Label: "コロンブス", Ordinal: 0},
+				{ID: "c2", Label: "ヴァスコ・ダ・ガマ", Ordinal: 1},
+				{ID: "c3", Label: "マゼラン", Ordinal: 2},
+				{ID: "c4", Label: "カブラル", Ordinal: 3},
+			},
+			CorrectChoiceID: "c2",
+			Explanation:     "1498年にカリカットへ到達。\n\"インド航路\" の開拓。",
+			AcceptedAnswers: []string{"ヴァスコダガマ", "Vasco da Gama"},
+			Tags:            []string{"大航海時代", "ポルトガル"},
+		},
+		{
+			ID:     "q2",
+			Prompt: "A, B <C>",
+			Choices: []domain.Choice{
+				{ID: "d1", Label: "a", Ordinal: 0},
+				{ID: "d2", Label: "b", Ordinal: 1},
+				{ID: "d3", Label: "c", Ordinal: 2},
+				{ID: "d4", Label: "d", Ordinal: 3},
+			},
+			CorrectChoiceID: "d1",
+		},
+	}
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []Format{FormatCSV, FormatJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			w, err := NewWriter(format, &buf)
+			if err != nil {
+				t.Fatalf("NewWriter: %v", err)
+			}
+			for _, q := range exportFixtures() {
+				if err := w.Write(q); err != nil {
+					t.Fatalf("Write: %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			rows, err := Parse(format, &buf)
+			if err != nil {
+				t.Fatalf("書き出した内容を Parse できる想定です: %v", err)
+			}
+			fixtures := exportFixtures()
+			if len(rows) != len(fixtures) {
+				t.Fatalf("%d 行を期待しました: got=%d", len(fixtures), len(rows))
+			}
+			for i, row := range rows {
+				if len(row.Violations) != 0 {
+					t.Fatalf("行エラーは無い想定です: %+v", row.Violations)
+				}
+				want := DraftOf(fixtures[i])
+				if !reflect.DeepEqual(row.Draft, want) {
+					t.Fatalf("往復で内容が変わりました:\n got=%+v\nwant=%+v", row.Draft, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWriter_RoundTripKeepsCitations(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []Format{FormatCSV, FormatJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			q := exportFixtures()[0]
+			q.Citations = []domain.Citation{
+				{Kind: domain.CitationKindBook, Title: "大航海時代の世界史", Author: "山田太郎", Locator: "p.42"},
+				{Kind: domain.CitationKindWeb, Title: "Vasco da Gama, \"India\"", URL: "https://example.com/gama"},
+			}
+
+			var buf bytes.Buffer
+			w, err := NewWriter(format, &buf)
+			if err != nil {
+				t.Fatalf("NewWriter: %v", err)
+			}
+			if err := w.Write(q); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			rows, err := Parse(format, &buf)
+			if err != nil || len(rows) != 1 || len(rows[0].Violations) != 0 {
+				t.Fatalf("行エラーの無い 1 行を期待しました: rows=%+v err=%v", rows, err)
+			}
+			if !reflect.DeepEqual(rows[0].Draft.Citations, q.Citations) {
+				t.Fatalf("出典が往復で変わりました:\n got=%+v\nwant=%+v", rows[0].Draft.Citations, q.Citations)
+			}
+		})
+	}
+}
+
+func TestWriter_RoundTripKeepsLocation(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []Format{FormatCSV, FormatJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			q := exportFixtures()[0]
+			q.Location = &domain.QuestionLocation{Point: geo.Point{Lat: 11.2588, Lng: -75.7804}, RadiusKm: 30}
+
+			var buf bytes.Buffer
+			w, err := NewWriter(format, &buf)
+			if err != nil {
+				t.Fatalf("NewWriter: %v", err)
+			}
+			if err := w.Write(q); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			rows, err := Parse(format, &buf)
+			if err != nil || len(rows) != 1 || len(rows[0].Violations) != 0 {
+				t.Fatalf("行エラーの無い 1 行を期待しました: rows=%+v err=%v", rows, err)
+			}
+			if !reflect.DeepEqual(rows[0].Draft.Location, q.Location) {
+				t.Fatalf("正解の地点が往復で変わりました:\n got=%+v\nwant=%+v", rows[0].Draft.Location, q.Location)
+			}
+		})
+	}
+}
+
+func TestWriter_RoundTripLocationOnlyQuestion(t *testing.T) {
+	t.Parallel()
+
+	q := domain.QuestionDetail{
+		ID:       "q1",
+		Kind:     domain.QuestionKindLocation,
+		Prompt:   "カルタゴはどこ？",
+		Location: &domain.QuestionLocation{Point: geo.Point{Lat: 36.8529, Lng: 10.3233}, RadiusKm: 50},
+	}
+	for _, format := range []Format{FormatCSV, FormatJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			w, err := NewWriter(format, &buf)
+			if err != nil {
+				t.Fatalf("NewWriter: %v", err)
+			}
+			if err := w.Write(q); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			rows, err := Parse(format, &buf)
+			if err != nil || len(rows) != 1 || len(rows[0].Violations) != 0 {
+				t.Fatalf("行エラーの無い 1 行を期待しました: rows=%+v err=%v", rows, err)
+			}
+			if want := DraftOf(q); !reflect.DeepEqual(rows[0].Draft, want) {
+				t.Fatalf("往復で内容が変わりました:\n got=%+v\nwant=%+v", rows[0].Draft, want)
+			}
+		})
+	}
+}
+
+func TestWriter_EmptyJSONIsValidArray(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatJSON, &buf)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	rows, err := Parse(FormatJSON, &buf)
+	if err != nil || len(rows) != 0 {
+		t.Fatalf("空配列を期待しました: rows=%+v err=%v", rows, err)
+	}
+}
+
+func TestWriter_AnkiTSV(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatAnkiTSV, &buf)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	for _, q := range exportFixtures() {
+		if err := w.Write(q); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	var cards []string
+	for _, line := range strings.Split(strings.TrimRight(buf.String(), "\n"), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		cards = append(cards, line)
+	}
+	if len(cards) != 2 {
+		t.Fatalf("改行はカード内で <br> になり、1問1行になる想定です: %q", buf.String())
+	}
+
+	fields := strings.Split(cards[0], "\t")
+	if len(fields) != 3 {
+		t.Fatalf("表面/裏面/タグの3列を期待しました: %q", cards[0])
+	}
+	if !strings.Contains(fields[0], "B. ヴァスコ・ダ・ガマ") {
+		t.Fatalf("表面に選択肢を含める想定です: %q", fields[0])
+	}
+	if !strings.HasPrefix(fields[1], "B. ヴァスコ・ダ・ガマ<br><br>1498年") || !strings.Contains(fields[1], "&#34;インド航路&#34;") {
+		t.Fatalf("裏面は正解 + 解説（HTML エスケープ済み）を期待しました: %q", fields[1])
+	}
+	if fields[2] != "大航海時代 ポルトガル" {
+		t.Fatalf("タグは空白区切りを期待しました: %q", fields[2])
+	}
+	if !strings.Contains(cards[1], "A, B &lt;C&gt;") {
+		t.Fatalf("問題文は HTML エスケープする想定です: %q", cards[1])
+	}
+}
@@ -0,0 +1,133 @@
+package questiontemplate
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+)
+
+// Dataset はテンプレートに当てはめる行の一覧。
+type Dataset struct {
+	// Columns は CSV のヘッダの列名（小文字）。JSON の場合は nil（行ごとにキーが違ってよい）。
+	Columns []string
+	Records []Record
+}
+
+// Record はデータセットの1行。
+type Record struct {
+	// Line は CSV の行番号（ヘッダ=1）、JSON の配列の 1 始まりの位置。
+	Line   int
+	Fields map[string]string
+	// Violations は値の型の不正（例: JSON の値がオブジェクト）。
+	Violations []apperror.FieldViolation
+}
+
+// ParseDataset はデータセットを読み取る。
+// CSV はヘッダ行必須（列名は自由）。JSON はオブジェクトの配列で、値は文字列か数値。
+// ヘッダの不正や JSON の構文エラーなど、行単位に割り当てられない不正は INVALID_ARGUMENT を返す。
+func ParseDataset(format questionfile.Format, r io.Reader) (Dataset, error) {
+	switch format {
+	case questionfile.FormatCSV:
+		return parseCSV(r)
+	case questionfile.FormatJSON:
+		return parseJSON(r)
+	default:
+		return Dataset{}, apperror.InvalidArgument("format が不正です", apperror.FieldViolation{Field: "format", Description: "CSV または JSON を指定してください"})
+	}
+}
+
+func parseCSV(r io.Reader) (Dataset, error) {
+	reader := csv.NewReader(questionfile.StripBOM(r))
+	reader.FieldsPerRecord = 0
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return Dataset{}, nil
+	}
+	if err != nil {
+		return Dataset{}, apperror.InvalidArgument("CSV の読み取りに失敗しました", apperror.FieldViolation{Field: "dataset", Description: err.Error()})
+	}
+	columns := make([]string, 0, len(header))
+	seen := map[string]struct{}{}
+	for _, name := range header {
+		name = normalizeName(name)
+		if name == "" {
+			return Dataset{}, apperror.InvalidArgument("CSV のヘッダが不正です", apperror.FieldViolation{Field: "dataset", Description: "列名が空の列があります"})
+		}
+		if _, dup := seen[name]; dup {
+			return Dataset{}, apperror.InvalidArgument("CSV のヘッダが不正です", apperror.FieldViolation{Field: "dataset", Description: "列 " + name + " が重複しています"})
+		}
+		seen[name] = struct{}{}
+		columns = append(columns, name)
+	}
+
+	ds := Dataset{Columns: columns}
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Dataset{}, apperror.InvalidArgument("CSV の読み取りに失敗しました", apperror.FieldViolation{Field: "dataset", Description: err.Error()})
+		}
+		line, _ := reader.FieldPos(0)
+		fields := make(map[string]string, len(columns))
+		for i, name := range columns {
+			fields[name] = values[i]
+		}
+		ds.Records = append(ds.Records, Record{Line: line, Fields: fields})
+	}
+	return ds, nil
+}
+
+func parseJSON(r io.Reader) (Dataset, error) {
+	var raws []json.RawMessage
+	if err := json.NewDecoder(questionfile.StripBOM(r)).Decode(&raws); err != nil {
+		if errors.Is(err, io.EOF) {
+			return Dataset{}, nil
+		}
+		return Dataset{}, apperror.InvalidArgument("JSON の読み取りに失敗しました", apperror.FieldViolation{Field: "dataset", Description: "オブジェクトの配列を指定してください"})
+	}
+
+	ds := Dataset{Records: make([]Record, 0, len(raws))}
+	for i, raw := range raws {
+		record := Record{Line: i + 1, Fields: map[string]string{}}
+
+		// 型の不正は行エラーとして返し、他の行の読み取りは続ける。
+		var obj map[string]any
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		if err := decoder.Decode(&obj); err != nil || obj == nil {
+			record.Violations = append(record.Violations, apperror.FieldViolation{Field: "dataset", Description: "オブジェクトとして読み取れません"})
+			ds.Records = append(ds.Records, record)
+			continue
+		}
+		// 違反の順序を安定させるため、キーの順に読む。
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			name := normalizeName(key)
+			switch v := obj[key].(type) {
+			case string:
+				record.Fields[name] = v
+			case json.Number:
+				record.Fields[name] = v.String()
+			case nil:
+				record.Fields[name] = ""
+			default:
+				record.Violations = append(record.Violations, apperror.FieldViolation{Field: "dataset." + name, Description: "文字列または数値で指定してください"})
+			}
+		}
+		ds.Records = append(ds.Records, record)
+	}
+	return ds, nil
+}
@@ -0,0 +1,198 @@
+package questiontemplate
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/quiz/answermatch"
+)
+
+// distractorCount は1問あたりの誤答の数（選択肢は正解と合わせて4つ）。
+const distractorCount = 3
+
+// Generated はデータセットの1行から生成した問題。
+type Generated struct {
+	// Line はデータセットの行（Record.Line）。
+	Line  int
+	Draft domain.QuestionDraft
+	// Violations は生成できなかった理由（値が空、年が整数でない、誤答が足りない）。空でない場合 Draft は不完全。
+	// NOTE: 作問入力の検証（ValidateDraft/CheckDraft）は含まない。呼び出し側で行う。
+	Violations []apperror.FieldViolation
+}
+
+// row は生成の途中の1行（正解の文字列と年）。
+type row struct {
+	record Record
+	answer string
+	era    int
+	// ok は誤答の候補として使えるか（正解の文字列と年がそろっている）。
+	ok bool
+}
+
+// Generate はデータセットの各行をテンプレートに当てはめて問題を作る。
+// 誤答は、同じデータセットの他の行のうち年が近いものから、正解/他の誤答とほぼ同じでない3つを選ぶ。
+// 選択肢は年の古い順に並べる（正解の位置が行ごとに変わり、年を問う問題でも自然な並びになる）。
+// テンプレートの不正（構文、CSV に無い列）は INVALID_ARGUMENT を返す。行ごとの不正は Generated.Violations に入れる。
+// 混同しやすい点: 同じ入力からは常に同じ問題を作る（プレビューと作成で選択肢が変わらないように、乱数は使わない）。
+func Generate(t Template, ds Dataset) ([]Generated, error) {
+	c, err := compile(t, ds.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]row, len(ds.Records))
+	out := make([]Generated, len(ds.Records))
+	for i, record := range ds.Records {
+		rows[i].record = record
+		out[i].Line = record.Line
+		out[i].Violations = append(out[i].Violations, record.Violations...)
+		if len(record.Violations) > 0 {
+			continue
+		}
+
+		answer, missingAnswer := c.answer.render(record.Fields)
+		era, eraErr := parseEra(record.Fields[c.eraField])
+		if len(missingAnswer) == 0 && eraErr == "" {
+			rows[i].answer = answer
+			rows[i].era = era
+			rows[i].ok = true
+		}
+
+		prompt, missingPrompt := c.prompt.render(record.Fields)
+		explanation, missingExplanation := c.explanation.render(record.Fields)
+		missing := uniqueNames(missingPrompt, missingAnswer, missingExplanation)
+		for _, name := range missing {
+			out[i].Violations = append(out[i].Violations, apperror.FieldViolation{Field: "dataset." + name, Description: "値が空です"})
+		}
+		if eraErr != "" && !contains(missing, c.eraField) {
+			out[i].Violations = append(out[i].Violations, apperror.FieldViolation{Field: "dataset." + c.eraField, Description: eraErr})
+		}
+		out[i].Draft = domain.QuestionDraft{
+			Prompt:      prompt,
+			Explanation: explanation,
+			Tags:        append([]string(nil), c.tags...),
+		}
+	}
+
+	for i := range rows {
+		if len(out[i].Violations) > 0 {
+			continue
+		}
+		distractors := pickDistractors(rows, i)
+		if len(distractors) < distractorCount {
+			out[i].Violations = append(out[i].Violations, apperror.FieldViolation{
+				Field:       "dataset",
+				Description: "誤答を作れる別の行が足りません（正解とほぼ同じでない行が" + strconv.Itoa(distractorCount) + "つ必要です）",
+			})
+			continue
+		}
+		out[i].Draft.Choices, out[i].Draft.CorrectOrdinal = orderChoices(rows[i], distractors)
+	}
+	return out, nil
+}
+
+// pickDistractors は target 以外の行から、年が近い順に誤答を選ぶ（同じ近さなら古い年 → データセットの順）。
+func pickDistractors(rows []row, target int) []row {
+	candidates := make([]row, 0, len(rows))
+	for i, r := range rows {
+		if i != target && r.ok {
+			candidates = append(candidates, r)
+		}
+	}
+	era := rows[target].era
+	sort.SliceStable(candidates, func(a, b int) bool {
+		da, db := abs(candidates[a].era-era), abs(candidates[b].era-era)
+		if da != db {
+			return da < db
+		}
+		return candidates[a].era < candidates[b].era
+	})
+
+	picked := []string{rows[target].answer}
+	var out []row
+	for _, c := range candidates {
+		if len(out) == distractorCount {
+			break
+		}
+		if nearlySameAsAny(c.answer, picked) {
+			continue
+		}
+		picked = append(picked, c.answer)
+		out = append(out, c)
+	}
+	return out
+}
+
+// orderChoices は正解と誤答を年の古い順に並べ、正解の位置を返す（同じ年なら正解を先にする）。
+func orderChoices(correct row, distractors []row) ([]string, int32) {
+	all := append([]row{correct}, distractors...)
+	sort.SliceStable(all, func(a, b int) bool { return all[a].era < all[b].era })
+
+	choices := make([]string, 0, len(all))
+	var ordinal int32
+	for i, r := range all {
+		choices = append(choices, r.answer)
+		if r.record.Line == correct.record.Line {
+			ordinal = int32(i)
+		}
+	}
+	return choices, ordinal
+}
+
+// nearlySameAsAny は s が others のいずれかと同じ/ほぼ同じかを返す（作問入力の重複した選択肢の検証と同じ基準）。
+func nearlySameAsAny(s string, others []string) bool {
+	for _, o := range others {
+		if answermatch.Normalize(s) == answermatch.Normalize(o) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseEra は年の列の値を整数として読む。
+func parseEra(s string) (int, string) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, "値が空です"
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, "年は整数（紀元前は負の数）で指定してください"
+	}
+	return v, ""
+}
+
+// uniqueNames は列名の一覧を重複を除いて順に連結する。
+func uniqueNames(lists ...[]string) []string {
+	seen := map[string]struct{}{}
+	var out []string
+	for _, list := range lists {
+		for _, name := range list {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
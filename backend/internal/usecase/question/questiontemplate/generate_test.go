@@ -0,0 +1,194 @@
+package questiontemplate
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+)
+
+const eventsCSV = `event,year,person
+本能寺の変,1582,織田信長
+桶狭間の戦い,1560,今川義元
+関ヶ原の戦い,1600,徳川家康
+長篠の戦い,1575,武田勝頼
+大坂夏の陣,1615,豊臣秀頼
+応仁の乱,1467,細川勝元
+`
+
+var yearTemplate = Template{
+	Prompt:      "{{event}}が起きたのは何年？",
+	Answer:      "{{year}}年",
+	Explanation: "{{event}}は{{year}}年の出来事です。",
+	EraField:    "year",
+	Tags:        []string{"戦国時代"},
+}
+
+func mustParse(t *testing.T, format questionfile.Format, content string) Dataset {
+	t.Helper()
+	ds, err := ParseDataset(format, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseDataset: %v", err)
+	}
+	return ds
+}
+
+func TestGenerate_PicksNearestErasAndOrdersChronologically(t *testing.T) {
+	t.Parallel()
+
+	got, err := Generate(yearTemplate, mustParse(t, questionfile.FormatCSV, eventsCSV))
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(got) != 6 {
+		t.Fatalf("len = %d, want 6", len(got))
+	}
+
+	first := got[0]
+	if first.Line != 2 || len(first.Violations) != 0 {
+		t.Fatalf("1行目の結果が期待と異なります: %+v", first)
+	}
+	// 1582 に近い順: 1575(7), 1560(22), 1600(18) → 1575, 1600, 1560。年の古い順に並べる。
+	wantChoices := []string{"1560年", "1575年", "1582年", "1600年"}
+	if !reflect.DeepEqual(first.Draft.Choices, wantChoices) || first.Draft.CorrectOrdinal != 2 {
+		t.Fatalf("choices=%v correct=%d, want %v correct=2", first.Draft.Choices, first.Draft.CorrectOrdinal, wantChoices)
+	}
+	if first.Draft.Prompt != "本能寺の変が起きたのは何年？" || first.Draft.Explanation != "本能寺の変は1582年の出来事です。" {
+		t.Fatalf("prompt/explanation が期待と異なります: %+v", first.Draft)
+	}
+	if !reflect.DeepEqual(first.Draft.Tags, []string{"戦国時代"}) {
+		t.Fatalf("tags = %v", first.Draft.Tags)
+	}
+
+	// 最も古い行は、新しい側の近い行から誤答を選ぶ。
+	last := got[5]
+	if !reflect.DeepEqual(last.Draft.Choices, []string{"1467年", "1560年", "1575年", "1582年"}) || last.Draft.CorrectOrdinal != 0 {
+		t.Fatalf("choices=%v correct=%d", last.Draft.Choices, last.Draft.CorrectOrdinal)
+	}
+}
+
+func TestGenerate_PersonAnswersFromJSON(t *testing.T) {
+	t.Parallel()
+
+	ds := mustParse(t, questionfile.FormatJSON, `[
+	  {"event": "本能寺の変", "year": 1582, "person": "明智光秀"},
+	  {"event": "桶狭間の戦い", "year": 1560, "person": "織田信長"},
+	  {"event": "関ヶ原の戦い", "year": 1600, "person": "徳川家康"},
+	  {"event": "長篠の戦い", "year": 1575, "person": "織田信長"},
+	  {"event": "大坂夏の陣", "year": 1615, "person": "徳川家康"}
+	]`)
+	got, err := Generate(Template{Prompt: "{{event}}に関わった人物は？", Answer: "{{person}}", EraField: "year"}, ds)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	// 長篠の戦い（織田信長）: 同じ人物の桶狭間は誤答にしない。1582 明智光秀, 1600 徳川家康, 1615 は家康と重複 → 誤答が2つしかない。
+	if len(got[3].Violations) != 1 || got[3].Violations[0].Field != "dataset" {
+		t.Fatalf("誤答が足りない違反を期待しました: %+v", got[3])
+	}
+	// 本能寺の変（明智光秀）: 1575 織田信長, 1560 は重複, 1600 徳川家康, 1615 は重複 → 2つ。
+	if len(got[0].Violations) != 1 {
+		t.Fatalf("誤答が足りない違反を期待しました: %+v", got[0])
+	}
+}
+
+func TestGenerate_RowViolations(t *testing.T) {
+	t.Parallel()
+
+	ds := mustParse(t, questionfile.FormatCSV, eventsCSV+"壬申の乱,,大海人皇子\n白村江の戦い,六六三,中大兄皇子\n")
+	got, err := Generate(yearTemplate, ds)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	empty := got[6]
+	if len(empty.Violations) != 1 || empty.Violations[0].Field != "dataset.year" || empty.Violations[0].Description != "値が空です" {
+		t.Fatalf("年が空の行の違反が期待と異なります: %+v", empty.Violations)
+	}
+	invalid := got[7]
+	if len(invalid.Violations) != 1 || invalid.Violations[0].Field != "dataset.year" {
+		t.Fatalf("年が整数でない行の違反が期待と異なります: %+v", invalid.Violations)
+	}
+	// 不正な行は他の行の誤答にも使わない。
+	for _, g := range got[:6] {
+		for _, c := range g.Draft.Choices {
+			if c == "年" || strings.Contains(c, "六六三") {
+				t.Fatalf("不正な行が誤答に使われています: %v", g.Draft.Choices)
+			}
+		}
+	}
+}
+
+func TestGenerate_NegativeYears(t *testing.T) {
+	t.Parallel()
+
+	ds := mustParse(t, questionfile.FormatCSV, "event,year\nアクティウムの海戦,-31\nカエサル暗殺,-44\nローマ建国,-753\nミラノ勅令,313\n")
+	got, err := Generate(Template{Prompt: "{{event}}は何年？", Answer: "{{year}}", EraField: "year"}, ds)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if !reflect.DeepEqual(got[0].Draft.Choices, []string{"-753", "-44", "-31", "313"}) || got[0].Draft.CorrectOrdinal != 2 {
+		t.Fatalf("choices=%v correct=%d", got[0].Draft.Choices, got[0].Draft.CorrectOrdinal)
+	}
+}
+
+func TestGenerate_TemplateErrors(t *testing.T) {
+	t.Parallel()
+
+	ds := mustParse(t, questionfile.FormatCSV, eventsCSV)
+	tests := []struct {
+		name      string
+		template  Template
+		wantField string
+	}{
+		{name: "prompt without placeholder", template: Template{Prompt: "何年？", Answer: "{{year}}", EraField: "year"}, wantField: "template.prompt"},
+		{name: "unclosed placeholder", template: Template{Prompt: "{{event が起きたのは？", Answer: "{{year}}", EraField: "year"}, wantField: "template.prompt"},
+		{name: "unknown column", template: Template{Prompt: "{{place}}で起きたのは？", Answer: "{{event}}", EraField: "year"}, wantField: "template.prompt"},
+		{name: "missing answer", template: Template{Prompt: "{{event}}は？", EraField: "year"}, wantField: "template.answer"},
+		{name: "missing era field", template: Template{Prompt: "{{event}}は？", Answer: "{{year}}"}, wantField: "template.era_field"},
+		{name: "unknown era field", template: Template{Prompt: "{{event}}は？", Answer: "{{year}}", EraField: "century"}, wantField: "template.era_field"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Generate(tt.template, ds)
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: %v", err)
+			}
+			if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.wantField {
+				t.Fatalf("%s の違反を期待しました: %+v", tt.wantField, appErr.FieldViolations)
+			}
+		})
+	}
+}
+
+func TestParseDataset_JSONRejectsNestedValues(t *testing.T) {
+	t.Parallel()
+
+	ds := mustParse(t, questionfile.FormatJSON, `[{"Event": "本能寺の変", "year": 1582}, {"event": {"name": "x"}, "year": 1}, 3]`)
+	if len(ds.Records) != 3 {
+		t.Fatalf("len = %d, want 3", len(ds.Records))
+	}
+	if ds.Records[0].Fields["event"] != "本能寺の変" || ds.Records[0].Fields["year"] != "1582" {
+		t.Fatalf("fields = %+v", ds.Records[0].Fields)
+	}
+	if len(ds.Records[1].Violations) != 1 || ds.Records[1].Violations[0].Field != "dataset.event" {
+		t.Fatalf("violations = %+v", ds.Records[1].Violations)
+	}
+	if len(ds.Records[2].Violations) != 1 || ds.Records[2].Violations[0].Field != "dataset" {
+		t.Fatalf("violations = %+v", ds.Records[2].Violations)
+	}
+}
+
+func TestParseDataset_CSVHeaderErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, content := range []string{"event,Event\nx,y\n", "event,,year\nx,y,1\n"} {
+		if _, err := ParseDataset(questionfile.FormatCSV, strings.NewReader(content)); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+			t.Fatalf("INVALID_ARGUMENT を期待しました: content=%q err=%v", content, err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Package questiontemplate は、テンプレートとデータセット（出来事/人物/年の一覧）から問題を生成する。
+//
+// 例: テンプレート "{{event}}が起きたのは何年？" / 正解 "{{year}}年" / 年の列 "year" と、
+// event,year の CSV から「本能寺の変が起きたのは何年？」（1582年 と、年が近い別の行の3つ）を作る。
+package questiontemplate
+
+import (
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// Template は問題のテンプレート。{{列名}} をデータセットの各行の値で置き換える。
+// 列名は大文字小文字を区別しない（CSV のヘッダと同じく小文字にそろえる）。
+type Template struct {
+	Prompt string
+	// Answer は正解の選択肢。誤答も同じテンプレートで、年が近い別の行から作る。
+	Answer      string
+	Explanation string
+	// EraField は年（整数。紀元前は負の数）の列。誤答の選び方と選択肢の並び順に使う。
+	EraField string
+	Tags     []string
+}
+
+// segment はテンプレートの文字列の一部（文字列そのもの、または列の値）。
+type segment struct {
+	literal string
+	field   string
+}
+
+// text は {{列名}} を解析済みのテンプレートの文字列。
+type text []segment
+
+// parseText は "{{列名}}" を含む文字列を解析する。閉じていない "{{" や空の列名は誤り。
+func parseText(s string) (text, string) {
+	var out text
+	for {
+		open := strings.Index(s, "{{")
+		if open < 0 {
+			if s != "" {
+				out = append(out, segment{literal: s})
+			}
+			return out, ""
+		}
+		if open > 0 {
+			out = append(out, segment{literal: s[:open]})
+		}
+		rest := s[open+2:]
+		closeAt := strings.Index(rest, "}}")
+		if closeAt < 0 {
+			return nil, "{{ が閉じられていません"
+		}
+		name := normalizeName(rest[:closeAt])
+		if name == "" {
+			return nil, "{{}} に列名を指定してください"
+		}
+		out = append(out, segment{field: name})
+		s = rest[closeAt+2:]
+	}
+}
+
+// fields はテンプレートが参照する列名を返す。
+func (t text) fields() []string {
+	var names []string
+	for _, seg := range t {
+		if seg.field != "" {
+			names = append(names, seg.field)
+		}
+	}
+	return names
+}
+
+// render は列の値で置き換えた文字列を返す。値が無い/空の列は missing として返す。
+func (t text) render(fields map[string]string) (string, []string) {
+	var b strings.Builder
+	var missing []string
+	for _, seg := range t {
+		if seg.field == "" {
+			b.WriteString(seg.literal)
+			continue
+		}
+		v := strings.TrimSpace(fields[seg.field])
+		if v == "" {
+			missing = append(missing, seg.field)
+			continue
+		}
+		b.WriteString(v)
+	}
+	return b.String(), missing
+}
+
+// compiled は検証済みのテンプレート。
+type compiled struct {
+	prompt      text
+	answer      text
+	explanation text
+	eraField    string
+	tags        []string
+}
+
+// compile はテンプレートを検証する。columns が nil でない場合（CSV）は、参照する列がすべてあることも確認する。
+func compile(t Template, columns []string) (compiled, error) {
+	var violations []apperror.FieldViolation
+	parse := func(field string, s string, required bool) text {
+		if strings.TrimSpace(s) == "" {
+			if required {
+				violations = append(violations, apperror.FieldViolation{Field: field, Description: "必須です"})
+			}
+			return nil
+		}
+		parsed, problem := parseText(s)
+		if problem != "" {
+			violations = append(violations, apperror.FieldViolation{Field: field, Description: problem})
+			return nil
+		}
+		// 列を参照しない問題文/正解は、すべての行で同じ問題になるため受け付けない。
+		if required && len(parsed.fields()) == 0 {
+			violations = append(violations, apperror.FieldViolation{Field: field, Description: "{{列名}} を1つ以上含めてください"})
+		}
+		return parsed
+	}
+
+	c := compiled{
+		prompt:      parse("template.prompt", t.Prompt, true),
+		answer:      parse("template.answer", t.Answer, true),
+		explanation: parse("template.explanation", t.Explanation, false),
+		eraField:    normalizeName(t.EraField),
+		tags:        t.Tags,
+	}
+	if c.eraField == "" {
+		violations = append(violations, apperror.FieldViolation{Field: "template.era_field", Description: "必須です"})
+	}
+
+	if columns != nil {
+		known := map[string]struct{}{}
+		for _, name := range columns {
+			known[name] = struct{}{}
+		}
+		check := func(field string, names []string) {
+			for _, name := range names {
+				if _, ok := known[name]; !ok {
+					violations = append(violations, apperror.FieldViolation{Field: field, Description: "データセットに列 " + name + " がありません"})
+				}
+			}
+		}
+		check("template.prompt", c.prompt.fields())
+		check("template.answer", c.answer.fields())
+		check("template.explanation", c.explanation.fields())
+		if c.eraField != "" {
+			check("template.era_field", []string{c.eraField})
+		}
+	}
+
+	if len(violations) > 0 {
+		return compiled{}, apperror.InvalidArgument("テンプレートが不正です", violations...)
+	}
+	return c, nil
+}
+
+func normalizeName(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
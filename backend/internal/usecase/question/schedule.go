@@ -0,0 +1,62 @@
+package question
+
+import (
+	"context"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// ScheduleInput は UpdateQuestion で指定する公開/公開終了の予約（RFC3339。空文字は予約なし）。
+type ScheduleInput struct {
+	PublishAt   string
+	UnpublishAt string
+}
+
+// 予約の適用（ApplyDueSchedules）の1回あたりの件数。
+const (
+	// scheduleBatchSize は1回の問い合わせで適用する上限（公開/公開終了それぞれ）。
+	scheduleBatchSize = 100
+	// maxScheduleBatches は1回の実行で繰り返す上限（残りは次の実行に回す）。
+	maxScheduleBatches = 10
+)
+
+// ApplyDueSchedules は now までに時刻が来た公開/公開終了の予約を適用し、変更した件数を返す。
+// サーバーの定期処理から呼ぶ。記念日などに多数の予約が重なっても1回で処理できるよう、残りが無くなるまで繰り返す。
+func (u *Usecase) ApplyDueSchedules(ctx context.Context, now time.Time) (int, error) {
+	applied := 0
+	for i := 0; i < maxScheduleBatches; i++ {
+		changes, err := u.questionRepo.ApplyDueQuestionSchedules(ctx, now, scheduleBatchSize)
+		if err != nil {
+			return applied, err
+		}
+		applied += len(changes)
+		if len(changes) == 0 {
+			break
+		}
+	}
+	return applied, nil
+}
+
+// parseSchedule は予約の入力を検証して domain.QuestionSchedule に変換する。
+// 混同しやすい点: 過去の時刻は受け付けない（すぐに公開されてしまうため）。すぐに公開したい場合は PublishQuestion を使う。
+func parseSchedule(in ScheduleInput, now time.Time) (domain.QuestionSchedule, []apperror.FieldViolation) {
+	var violations []apperror.FieldViolation
+	publishAt, ok := parseOptionalTime(in.PublishAt)
+	if !ok {
+		violations = append(violations, apperror.FieldViolation{Field: "schedule.publish_at", Description: "RFC3339 形式で指定してください"})
+	} else if !publishAt.IsZero() && !publishAt.After(now) {
+		violations = append(violations, apperror.FieldViolation{Field: "schedule.publish_at", Description: "現在より後の日時を指定してください"})
+	}
+	unpublishAt, ok := parseOptionalTime(in.UnpublishAt)
+	if !ok {
+		violations = append(violations, apperror.FieldViolation{Field: "schedule.unpublish_at", Description: "RFC3339 形式で指定してください"})
+	} else if !unpublishAt.IsZero() && !unpublishAt.After(now) {
+		violations = append(violations, apperror.FieldViolation{Field: "schedule.unpublish_at", Description: "現在より後の日時を指定してください"})
+	}
+	if len(violations) == 0 && !publishAt.IsZero() && !unpublishAt.IsZero() && !unpublishAt.After(publishAt) {
+		violations = append(violations, apperror.FieldViolation{Field: "schedule.unpublish_at", Description: "publish_at より後の日時を指定してください"})
+	}
+	return domain.QuestionSchedule{PublishAt: publishAt, UnpublishAt: unpublishAt}, violations
+}
@@ -0,0 +1,166 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestUsecase_UpdateQuestion_Schedule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	draft := domain.QuestionDraft{Prompt: "Q", Choices: []string{"a", "b", "c", "d"}}
+
+	tests := []struct {
+		name         string
+		schedule     *ScheduleInput
+		wantField    string
+		wantSchedule *domain.QuestionSchedule
+	}{
+		{
+			name:         "nil keeps the current schedule",
+			schedule:     nil,
+			wantSchedule: nil,
+		},
+		{
+			name:     "both times",
+			schedule: &ScheduleInput{PublishAt: "2026-10-21T00:00:00+09:00", UnpublishAt: "2026-10-28T00:00:00Z"},
+			wantSchedule: &domain.QuestionSchedule{
+				PublishAt:   time.Date(2026, 10, 20, 15, 0, 0, 0, time.UTC),
+				UnpublishAt: time.Date(2026, 10, 28, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:         "empty strings clear the schedule",
+			schedule:     &ScheduleInput{},
+			wantSchedule: &domain.QuestionSchedule{},
+		},
+		{
+			name:      "invalid format",
+			schedule:  &ScheduleInput{PublishAt: "2026/10/21"},
+			wantField: "schedule.publish_at",
+		},
+		{
+			name:      "publish_at in the past",
+			schedule:  &ScheduleInput{PublishAt: "2026-10-20T08:59:59Z"},
+			wantField: "schedule.publish_at",
+		},
+		{
+			name:      "unpublish_at in the past",
+			schedule:  &ScheduleInput{UnpublishAt: "2026-10-20T09:00:00Z"},
+			wantField: "schedule.unpublish_at",
+		},
+		{
+			name:      "unpublish_at before publish_at",
+			schedule:  &ScheduleInput{PublishAt: "2026-10-22T00:00:00Z", UnpublishAt: "2026-10-21T00:00:00Z"},
+			wantField: "schedule.unpublish_at",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userID, questionID := mustUUID(t), mustUUID(t)
+			var gotSchedule *domain.QuestionSchedule
+			updated := false
+			u := NewUsecase(
+				&fakeQuestionRepo{
+					getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+						return userID, false, nil
+					},
+					updateQuestionFn: func(_ context.Context, _ string, _ string, _ int64, _ domain.QuestionDraft, schedule *domain.QuestionSchedule) (domain.QuestionDetail, error) {
+						updated = true
+						gotSchedule = schedule
+						return domain.QuestionDetail{ID: questionID}, nil
+					},
+				},
+				&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+				testPageTokens,
+				testDraftRules,
+			)
+
+			_, _, err := u.UpdateQuestion(context.Background(), userID, questionID, 1, draft, tt.schedule, now)
+			if tt.wantField != "" {
+				var appErr *apperror.Error
+				if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+					t.Fatalf("INVALID_ARGUMENT を期待しました: %v", err)
+				}
+				if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.wantField {
+					t.Fatalf("%s の FieldViolation を期待しました: %+v", tt.wantField, appErr.FieldViolations)
+				}
+				if updated {
+					t.Fatal("検証エラーの場合、UpdateQuestion は呼ばれない想定です")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err は nil を期待しました: %v", err)
+			}
+			if (gotSchedule == nil) != (tt.wantSchedule == nil) {
+				t.Fatalf("schedule = %+v, want %+v", gotSchedule, tt.wantSchedule)
+			}
+			if gotSchedule != nil && (!gotSchedule.PublishAt.Equal(tt.wantSchedule.PublishAt) || !gotSchedule.UnpublishAt.Equal(tt.wantSchedule.UnpublishAt)) {
+				t.Fatalf("schedule = %+v, want %+v", *gotSchedule, *tt.wantSchedule)
+			}
+		})
+	}
+}
+
+func TestUsecase_ApplyDueSchedules_RepeatsUntilNoneLeft(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	batches := [][]domain.QuestionStatusChange{
+		make([]domain.QuestionStatusChange, scheduleBatchSize),
+		make([]domain.QuestionStatusChange, 3),
+		nil,
+	}
+	calls := 0
+	u := NewUsecase(&fakeQuestionRepo{
+		applySchedulesFn: func(_ context.Context, gotNow time.Time, limit int32) ([]domain.QuestionStatusChange, error) {
+			if !gotNow.Equal(now) || limit != scheduleBatchSize {
+				t.Fatalf("ApplyDueQuestionSchedules の引数が期待と異なります: now=%s limit=%d", gotNow, limit)
+			}
+			calls++
+			return batches[calls-1], nil
+		},
+	}, &fakeUserRepo{}, testPageTokens, testDraftRules)
+
+	applied, err := u.ApplyDueSchedules(context.Background(), now)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if applied != scheduleBatchSize+3 || calls != 3 {
+		t.Fatalf("applied=%d calls=%d, want applied=%d calls=3", applied, calls, scheduleBatchSize+3)
+	}
+}
+
+func TestUsecase_ApplyDueSchedules_ReturnsPartialCountOnError(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	u := NewUsecase(&fakeQuestionRepo{
+		applySchedulesFn: func(context.Context, time.Time, int32) ([]domain.QuestionStatusChange, error) {
+			calls++
+			if calls == 1 {
+				return make([]domain.QuestionStatusChange, 2), nil
+			}
+			return nil, apperror.Internal("予約の適用に失敗しました", errors.New("db down"))
+		},
+	}, &fakeUserRepo{}, testPageTokens, testDraftRules)
+
+	applied, err := u.ApplyDueSchedules(context.Background(), time.Now())
+	if !apperror.IsCode(err, apperror.CodeInternal) {
+		t.Fatalf("INTERNAL を期待しました: %v", err)
+	}
+	if applied != 2 {
+		t.Fatalf("applied = %d, want 2", applied)
+	}
+}
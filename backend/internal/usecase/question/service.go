@@ -2,11 +2,21 @@ package question
 
 import (
 	"context"
+	"math"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
 	"github.com/history-quiz/historyquiz/internal/domain"
 	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
+	"github.com/history-quiz/historyquiz/internal/domain/richtext"
+	"github.com/history-quiz/historyquiz/internal/domain/similarity"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/draftrule"
 	"github.com/history-quiz/historyquiz/internal/repository"
 )
 
@@ -14,62 +24,102 @@ import (
 type Usecase struct {
 	questionRepo repository.QuestionRepository
 	userRepo     repository.UserRepository
+	pageTokens   pagetoken.Codec
+	draftRules   *draftrule.Pipeline
 }
 
 // NewUsecase は QuestionUsecase を生成する。
-func NewUsecase(questionRepo repository.QuestionRepository, userRepo repository.UserRepository) *Usecase {
+// pageTokens は一覧の page_token の署名に使う。draftRules は作問入力の内容の検証ルール（デプロイごとの設定）。
+func NewUsecase(questionRepo repository.QuestionRepository, userRepo repository.UserRepository, pageTokens pagetoken.Codec, draftRules *draftrule.Pipeline) *Usecase {
 	return &Usecase{
 		questionRepo: questionRepo,
 		userRepo:     userRepo,
+		pageTokens:   pageTokens,
+		draftRules:   draftRules,
 	}
 }
 
 // CreateQuestion は問題を作成して詳細を返す。
-func (u *Usecase) CreateQuestion(ctx context.Context, userID string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
+// 問題文がよく似た既存の問題があれば警告として合わせて返し、ほぼ同じ問題がある場合は ALREADY_EXISTS で作成しない。
+func (u *Usecase) CreateQuestion(ctx context.Context, userID string, draft domain.QuestionDraft) (domain.QuestionDetail, []domain.SimilarQuestion, error) {
 	if userID == "" {
-		return domain.QuestionDetail{}, apperror.Unauthenticated("認証が必要です")
+		return domain.QuestionDetail{}, nil, apperror.Unauthenticated("認証が必要です")
 	}
-	if err := validateDraft(draft); err != nil {
-		return domain.QuestionDetail{}, err
+	draft, err := CheckDraft(u.draftRules, draft)
+	if err != nil {
+		return domain.QuestionDetail{}, nil, err
+	}
+	draft = NormalizeDraft(draft)
+
+	similar, err := u.findSimilarQuestions(ctx, userID, "", draft.Prompt)
+	if err != nil {
+		return domain.QuestionDetail{}, nil, err
 	}
+	if len(similar) > 0 && similar[0].Similarity >= duplicateBlockThreshold {
+		return domain.QuestionDetail{}, nil, apperror.AlreadyExists("ほぼ同じ問題が既にあります（question_id=" + similar[0].QuestionID + "）")
+	}
+
 	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
-		return domain.QuestionDetail{}, err
+		return domain.QuestionDetail{}, nil, err
+	}
+	created, err := u.questionRepo.CreateQuestion(ctx, userID, draft)
+	if err != nil {
+		return domain.QuestionDetail{}, nil, err
 	}
-	return u.questionRepo.CreateQuestion(ctx, userID, draft)
+	return created, similar, nil
 }
 
 // UpdateQuestion は問題を更新して詳細を返す（所有者チェック含む）。
-func (u *Usecase) UpdateQuestion(ctx context.Context, userID string, questionID string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
+// 問題文がよく似た他の問題があれば警告として合わせて返す。
+// 混同しやすい点: 作成と違い、ほぼ同じ問題があっても拒否しない（既に重複している問題の修正を妨げないため）。
+// expectedVersion は編集を始めた時点の版（必須）。別の編集が先に保存されていた場合は ABORTED（最新の問題付き）を返す。
+// schedule が nil の場合は公開/公開終了の予約を変えない。nil でない場合は置き換える（空文字は予約の取り消し）。now は予約の検証に使う。
+func (u *Usecase) UpdateQuestion(ctx context.Context, userID string, questionID string, expectedVersion int64, draft domain.QuestionDraft, schedule *ScheduleInput, now time.Time) (domain.QuestionDetail, []domain.SimilarQuestion, error) {
 	if userID == "" {
-		return domain.QuestionDetail{}, apperror.Unauthenticated("認証が必要です")
+		return domain.QuestionDetail{}, nil, apperror.Unauthenticated("認証が必要です")
 	}
 	if questionID == "" {
-		return domain.QuestionDetail{}, apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+		return domain.QuestionDetail{}, nil, apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
 	}
 	if _, err := uuid.Parse(questionID); err != nil {
-		return domain.QuestionDetail{}, apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+		return domain.QuestionDetail{}, nil, apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
 	}
-	if err := validateDraft(draft); err != nil {
-		return domain.QuestionDetail{}, err
+	// 混同しやすい点: 0 は「版を確認しない」の意味になるため、利用者からの更新では受け付けない。
+	if expectedVersion <= 0 {
+		return domain.QuestionDetail{}, nil, apperror.InvalidArgument("expected_version が不正です", apperror.FieldViolation{Field: "expected_version", Description: "編集前に取得した version を指定してください"})
 	}
-
-	authorUserID, deleted, err := u.questionRepo.GetQuestionAuthor(ctx, questionID)
+	draft, err := CheckDraft(u.draftRules, draft)
 	if err != nil {
-		return domain.QuestionDetail{}, err
+		return domain.QuestionDetail{}, nil, err
 	}
-	if deleted {
-		return domain.QuestionDetail{}, apperror.NotFound("問題が見つかりません")
+	draft = NormalizeDraft(draft)
+	var newSchedule *domain.QuestionSchedule
+	if schedule != nil {
+		parsed, violations := parseSchedule(*schedule, now)
+		if len(violations) > 0 {
+			return domain.QuestionDetail{}, nil, apperror.InvalidArgument("予約の指定が不正です", violations...)
+		}
+		newSchedule = &parsed
 	}
-	if authorUserID != userID {
-		return domain.QuestionDetail{}, apperror.PermissionDenied("権限がありません")
+
+	if err := u.authorizeOwner(ctx, userID, questionID); err != nil {
+		return domain.QuestionDetail{}, nil, err
 	}
 
 	// 更新時も users が存在する前提に揃える（外部キーの一貫性）。
 	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
-		return domain.QuestionDetail{}, err
+		return domain.QuestionDetail{}, nil, err
 	}
 
-	return u.questionRepo.UpdateQuestion(ctx, userID, questionID, draft)
+	updated, err := u.questionRepo.UpdateQuestion(ctx, userID, questionID, expectedVersion, draft, newSchedule)
+	if err != nil {
+		return domain.QuestionDetail{}, nil, err
+	}
+	similar, err := u.findSimilarQuestions(ctx, userID, questionID, updated.Prompt)
+	if err != nil {
+		return domain.QuestionDetail{}, nil, err
+	}
+	return updated, similar, nil
 }
 
 // GetMyQuestion は自分の問題の詳細を返す（論理削除は除外）。
@@ -86,30 +136,235 @@ func (u *Usecase) GetMyQuestion(ctx context.Context, userID string, questionID s
 	return u.questionRepo.GetMyQuestion(ctx, userID, questionID)
 }
 
-// ListMyQuestions は自分の問題一覧を返す（論理削除は除外）。
-func (u *Usecase) ListMyQuestions(ctx context.Context, userID string, pageSize int32) ([]domain.QuestionSummary, string, error) {
+// DeleteQuestion は自分の問題を論理削除する（所有者チェック含む）。
+// その問題だけが使っていた添付も削除扱いになり、保持期間の経過後に本体ごと消える。
+func (u *Usecase) DeleteQuestion(ctx context.Context, userID string, questionID string) error {
 	if userID == "" {
-		return nil, "", apperror.Unauthenticated("認証が必要です")
+		return apperror.Unauthenticated("認証が必要です")
+	}
+	if questionID == "" {
+		return apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(questionID); err != nil {
+		return apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+	}
+	if err := u.authorizeOwner(ctx, userID, questionID); err != nil {
+		return err
 	}
+	return u.questionRepo.SoftDeleteQuestion(ctx, userID, questionID)
+}
+
+// PublishQuestion は問題を公開し、出題候補に含める（所有者チェック含む）。
+func (u *Usecase) PublishQuestion(ctx context.Context, userID string, questionID string) (domain.QuestionDetail, error) {
+	return u.transitionStatus(ctx, userID, questionID, domain.QuestionStatusPublished)
+}
+
+// UnpublishQuestion は公開中の問題を限定公開/アーカイブへ移し、出題候補から外す（所有者チェック含む）。
+// targetStatus が空の場合は unlisted として扱う。
+func (u *Usecase) UnpublishQuestion(ctx context.Context, userID string, questionID string, targetStatus domain.QuestionStatus) (domain.QuestionDetail, error) {
+	if targetStatus == "" {
+		targetStatus = domain.QuestionStatusUnlisted
+	}
+	if targetStatus != domain.QuestionStatusUnlisted && targetStatus != domain.QuestionStatusArchived {
+		return domain.QuestionDetail{}, apperror.InvalidArgument("target_status が不正です", apperror.FieldViolation{Field: "target_status", Description: "UNLISTED または ARCHIVED を指定してください"})
+	}
+	return u.transitionStatus(ctx, userID, questionID, targetStatus)
+}
 
-	limit := normalizePageSize(pageSize)
-	questions, err := u.questionRepo.ListMyQuestions(ctx, userID, limit)
+// transitionStatus は公開状態の遷移（所有者チェック + 遷移ルール検証）を共通化する。
+func (u *Usecase) transitionStatus(ctx context.Context, userID string, questionID string, to domain.QuestionStatus) (domain.QuestionDetail, error) {
+	if userID == "" {
+		return domain.QuestionDetail{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if questionID == "" {
+		return domain.QuestionDetail{}, apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(questionID); err != nil {
+		return domain.QuestionDetail{}, apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+	}
+	if err := u.authorizeOwner(ctx, userID, questionID); err != nil {
+		return domain.QuestionDetail{}, err
+	}
+
+	current, err := u.questionRepo.GetMyQuestion(ctx, userID, questionID)
 	if err != nil {
-		return nil, "", err
+		return domain.QuestionDetail{}, err
+	}
+	if current.Status == to {
+		// 同じ状態への遷移は冪等に成功扱いにする（二重送信対策）。
+		return current, nil
 	}
+	if !current.Status.CanTransitionTo(to) {
+		return domain.QuestionDetail{}, apperror.FailedPrecondition("この状態からは変更できません（" + string(current.Status) + " → " + string(to) + "）")
+	}
+	return u.questionRepo.UpdateQuestionStatus(ctx, userID, questionID, current.Status, to)
+}
 
-	// Phase1 では page_token を未実装（next_page_token は空）。
-	return questions, "", nil
+// findSimilarQuestions は問題文がよく似た問題（警告の対象）を類似度の高い順に返す。
+func (u *Usecase) findSimilarQuestions(ctx context.Context, userID string, excludeQuestionID string, prompt string) ([]domain.SimilarQuestion, error) {
+	return u.questionRepo.FindSimilarQuestions(ctx, userID, excludeQuestionID, similarity.Shingles(prompt), similarWarnThreshold, maxSimilarQuestions)
 }
 
-// validateDraft は作問入力のバリデーションを行う。
-func validateDraft(draft domain.QuestionDraft) error {
+// authorizeOwner は問題の所有者であることを確認する（論理削除済みは NOT_FOUND）。
+func (u *Usecase) authorizeOwner(ctx context.Context, userID string, questionID string) error {
+	authorUserID, deleted, err := u.questionRepo.GetQuestionAuthor(ctx, questionID)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		return apperror.NotFound("問題が見つかりません")
+	}
+	if authorUserID != userID {
+		return apperror.PermissionDenied("権限がありません")
+	}
+	return nil
+}
+
+// 作問時の類似問題チェック（類似度は問題文の文字 bigram の Jaccard 係数）。
+// 例: "古代ローマの首都はどこ？" と "古代ローマの首都はどこですか？" は約 0.77。
+const (
+	// similarWarnThreshold 以上の問題を警告として返す。
+	similarWarnThreshold = 0.5
+	// duplicateBlockThreshold 以上の問題がある場合は作成しない（表記揺れ程度の違いしかない）。
+	duplicateBlockThreshold = 0.9
+	// maxSimilarQuestions は警告として返す類似問題の上限。
+	maxSimilarQuestions = 5
+)
+
+// maxAcceptedAnswers は記述式の別表記の登録上限。
+const maxAcceptedAnswers = 10
+
+// タグの上限。
+const (
+	maxTags      = 10
+	maxTagRunes  = 30
+	// listSeparator は CSV の accepted_answers/tags 列の区切り文字（値そのものには使えない）。
+	// NOTE: questionfile.ListSeparator と同じ値。書き出した CSV を取り込み直せるように禁止している。
+	listSeparator = "|"
+)
+
+// 添付の上限。
+const (
+	maxAttachments        = 4
+	maxAttachmentAltRunes = 200
+)
+
+// 出典の上限。
+const (
+	maxCitations          = 10
+	maxCitationTitleRunes = 200
+	maxCitationFieldRunes = 100
+	maxCitationURLBytes   = 2000
+)
+
+// CheckDraft は作問入力から制御文字を取り除き、構造（ValidateDraft）と内容（rules）をまとめて検証する。
+// 違反はすべて INVALID_ARGUMENT の FieldViolation として返す。戻り値の作問入力は制御文字を取り除いた後の値。
+// NOTE: モデレーション（管理者による修正）や一括取り込みでも同じ検証を使うため公開している。
+func CheckDraft(rules *draftrule.Pipeline, draft domain.QuestionDraft) (domain.QuestionDraft, error) {
+	draft = draftrule.StripControlChars(draft)
+
+	var violations []apperror.FieldViolation
+	if err := ValidateDraft(draft); err != nil {
+		violations = append(violations, fieldViolationsOf(err)...)
+	}
+	violations = append(violations, rules.Check(draft)...)
+	if len(violations) > 0 {
+		return domain.QuestionDraft{}, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+	return draft, nil
+}
+
+// ValidateDraft は作問入力の構造（必須項目、問題文/解説の書式、選択肢/別表記/タグ/添付/出典の件数と形式）を検証する。
+// 地図でだけ答える問題（QuestionKindLocation）は選択肢の代わりに地点を必須にする。
+// 混同しやすい点: 文字数や禁止語などの内容の検証は含まない（CheckDraft で draftrule のルールと合わせて行う）。
+func ValidateDraft(draft domain.QuestionDraft) error {
 	var violations []apperror.FieldViolation
 
 	if strings.TrimSpace(draft.Prompt) == "" {
 		violations = append(violations, apperror.FieldViolation{Field: "draft.prompt", Description: "必須です"})
 	}
+	violations = append(violations, markupViolations("draft.prompt", draft.Prompt)...)
+	violations = append(violations, markupViolations("draft.explanation", draft.Explanation)...)
+
+	switch draft.Kind {
+	case "", domain.QuestionKindChoice:
+		violations = append(violations, choiceViolations(draft)...)
+	case domain.QuestionKindLocation:
+		violations = append(violations, locationOnlyViolations(draft)...)
+	default:
+		violations = append(violations, apperror.FieldViolation{Field: "draft.kind", Description: "CHOICE / LOCATION のいずれかを指定してください"})
+	}
+
+	if len(draft.AcceptedAnswers) > maxAcceptedAnswers {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.accepted_answers", Description: "別表記は10件以内で指定してください"})
+	} else {
+		for i, a := range draft.AcceptedAnswers {
+			field := "draft.accepted_answers[" + strconv.Itoa(i) + "]"
+			switch {
+			case strings.TrimSpace(a) == "":
+				violations = append(violations, apperror.FieldViolation{Field: field, Description: "必須です"})
+			case strings.Contains(a, listSeparator):
+				violations = append(violations, apperror.FieldViolation{Field: field, Description: "\"|\" は使えません"})
+			}
+		}
+	}
+
+	if len(draft.Tags) > maxTags {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.tags", Description: "タグは10件以内で指定してください"})
+	} else {
+		for i, tag := range draft.Tags {
+			field := "draft.tags[" + strconv.Itoa(i) + "]"
+			tag = strings.TrimSpace(tag)
+			switch {
+			case tag == "":
+				violations = append(violations, apperror.FieldViolation{Field: field, Description: "必須です"})
+			case utf8.RuneCountInString(tag) > maxTagRunes:
+				violations = append(violations, apperror.FieldViolation{Field: field, Description: "30文字以内で指定してください"})
+			case strings.Contains(tag, listSeparator):
+				violations = append(violations, apperror.FieldViolation{Field: field, Description: "\"|\" は使えません"})
+			}
+		}
+	}
+
+	if len(draft.Attachments) > maxAttachments {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.attachments", Description: "添付は4件以内で指定してください"})
+	} else {
+		seen := make(map[string]struct{}, len(draft.Attachments))
+		for i, ref := range draft.Attachments {
+			field := "draft.attachments[" + strconv.Itoa(i) + "]"
+			if _, err := uuid.Parse(ref.AttachmentID); err != nil {
+				violations = append(violations, apperror.FieldViolation{Field: field + ".attachment_id", Description: "UUID 形式で指定してください"})
+			} else if _, dup := seen[ref.AttachmentID]; dup {
+				violations = append(violations, apperror.FieldViolation{Field: field + ".attachment_id", Description: "同じ添付が重複しています"})
+			}
+			seen[ref.AttachmentID] = struct{}{}
+			if utf8.RuneCountInString(strings.TrimSpace(ref.AltText)) > maxAttachmentAltRunes {
+				violations = append(violations, apperror.FieldViolation{Field: field + ".alt_text", Description: "200文字以内で指定してください"})
+			}
+		}
+	}
+
+	if len(draft.Citations) > maxCitations {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.citations", Description: "出典は10件以内で指定してください"})
+	} else {
+		for i, c := range draft.Citations {
+			violations = append(violations, validateCitation("draft.citations["+strconv.Itoa(i)+"]", c)...)
+		}
+	}
 
+	if draft.Location != nil {
+		violations = append(violations, validateLocation("draft.location", *draft.Location)...)
+	}
+
+	if len(violations) > 0 {
+		return apperror.InvalidArgument("入力が不正です", violations...)
+	}
+	return nil
+}
+
+// choiceViolations は4択の問題の選択肢と正解を検証する。
+func choiceViolations(draft domain.QuestionDraft) []apperror.FieldViolation {
+	var violations []apperror.FieldViolation
 	if len(draft.Choices) != 4 {
 		violations = append(violations, apperror.FieldViolation{Field: "draft.choices", Description: "選択肢は4件である必要があります"})
 	} else {
@@ -123,11 +378,172 @@ func validateDraft(draft domain.QuestionDraft) error {
 	if draft.CorrectOrdinal < 0 || draft.CorrectOrdinal > 3 {
 		violations = append(violations, apperror.FieldViolation{Field: "draft.correct_ordinal", Description: "0..3 の範囲で指定してください"})
 	}
+	return violations
+}
 
-	if len(violations) > 0 {
-		return apperror.InvalidArgument("入力が不正です", violations...)
+// locationOnlyViolations は地図でだけ答える問題を検証する（地点は必須、選択肢/別表記は指定できない）。
+// 混同しやすい点: correct_ordinal は使わないため 0 以外でも違反にしない。
+func locationOnlyViolations(draft domain.QuestionDraft) []apperror.FieldViolation {
+	var violations []apperror.FieldViolation
+	if draft.Location == nil {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.location", Description: "地図で答える問題では必須です"})
 	}
-	return nil
+	if len(draft.Choices) > 0 {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.choices", Description: "地図で答える問題では指定できません"})
+	}
+	if len(draft.AcceptedAnswers) > 0 {
+		violations = append(violations, apperror.FieldViolation{Field: "draft.accepted_answers", Description: "地図で答える問題では指定できません"})
+	}
+	return violations
+}
+
+// validateCitation は出典1件を検証する。
+func validateCitation(field string, c domain.Citation) []apperror.FieldViolation {
+	var violations []apperror.FieldViolation
+	switch c.Kind {
+	case domain.CitationKindBook, domain.CitationKindWeb, domain.CitationKindPrimarySource:
+	default:
+		violations = append(violations, apperror.FieldViolation{Field: field + ".kind", Description: "BOOK / WEB / PRIMARY_SOURCE のいずれかを指定してください"})
+	}
+
+	title := strings.TrimSpace(c.Title)
+	switch {
+	case title == "":
+		violations = append(violations, apperror.FieldViolation{Field: field + ".title", Description: "必須です"})
+	case utf8.RuneCountInString(title) > maxCitationTitleRunes:
+		violations = append(violations, apperror.FieldViolation{Field: field + ".title", Description: "200文字以内で指定してください"})
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(c.Author)) > maxCitationFieldRunes {
+		violations = append(violations, apperror.FieldViolation{Field: field + ".author", Description: "100文字以内で指定してください"})
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(c.Locator)) > maxCitationFieldRunes {
+		violations = append(violations, apperror.FieldViolation{Field: field + ".locator", Description: "100文字以内で指定してください"})
+	}
+
+	rawURL := strings.TrimSpace(c.URL)
+	switch {
+	case rawURL == "":
+		if c.Kind == domain.CitationKindWeb {
+			violations = append(violations, apperror.FieldViolation{Field: field + ".url", Description: "Web の出典では必須です"})
+		}
+	case len(rawURL) > maxCitationURLBytes:
+		violations = append(violations, apperror.FieldViolation{Field: field + ".url", Description: "2000文字以内で指定してください"})
+	case !isHTTPURL(rawURL):
+		violations = append(violations, apperror.FieldViolation{Field: field + ".url", Description: "http:// または https:// で始まる URL を指定してください"})
+	}
+	return violations
+}
+
+// validateLocation は地図で答える問題の正解の地点を検証する。
+func validateLocation(field string, l domain.QuestionLocation) []apperror.FieldViolation {
+	var violations []apperror.FieldViolation
+	if math.IsNaN(l.Point.Lat) || l.Point.Lat < -90 || l.Point.Lat > 90 {
+		violations = append(violations, apperror.FieldViolation{Field: field + ".latitude", Description: "-90..90 の範囲で指定してください"})
+	}
+	if math.IsNaN(l.Point.Lng) || l.Point.Lng < -180 || l.Point.Lng > 180 {
+		violations = append(violations, apperror.FieldViolation{Field: field + ".longitude", Description: "-180..180 の範囲で指定してください"})
+	}
+	if math.IsNaN(l.RadiusKm) || l.RadiusKm < geo.MinRadiusKm || l.RadiusKm > geo.MaxRadiusKm {
+		violations = append(violations, apperror.FieldViolation{Field: field + ".radius_km", Description: "1..2000 の範囲で指定してください"})
+	}
+	return violations
+}
+
+// markupViolations は問題文/解説の書式（richtext）の誤りを FieldViolation にする。
+// 混同しやすい点: 保存するのは入力の書式そのもので、構文木は応答のたびに作る（誤りの無い書式だけを保存する）。
+func markupViolations(field string, markup string) []apperror.FieldViolation {
+	_, problems := richtext.Parse(markup)
+	violations := make([]apperror.FieldViolation, 0, len(problems))
+	for _, p := range problems {
+		violations = append(violations, apperror.FieldViolation{Field: field, Description: p.String()})
+	}
+	return violations
+}
+
+// isHTTPURL は s がホスト付きの http/https の絶対 URL かを返す。
+// 混同しやすい点: 回答画面でリンクとして表示するため、javascript: などのスキームは受け付けない。
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && u.User == nil
+}
+
+// NormalizeDraft は検証済みの作問入力を保存用に整える（種類の既定値、別表記/タグの前後空白除去、タグの重複除去）。
+// NOTE: モデレーションの修正や一括取り込みでも、作成/更新と同じ形で保存するために公開している。
+func NormalizeDraft(draft domain.QuestionDraft) domain.QuestionDraft {
+	if draft.Kind == "" {
+		draft.Kind = domain.QuestionKindChoice
+	}
+	if draft.Kind == domain.QuestionKindLocation {
+		draft.CorrectOrdinal = 0
+	}
+	draft.AcceptedAnswers = trimAcceptedAnswers(draft.AcceptedAnswers)
+	draft.Tags = normalizeTags(draft.Tags)
+	draft.Attachments = trimAttachmentAltTexts(draft.Attachments)
+	draft.Citations = trimCitations(draft.Citations)
+	return draft
+}
+
+// trimCitations は出典の各項目の前後空白を除去する（元の slice は書き換えない）。
+func trimCitations(citations []domain.Citation) []domain.Citation {
+	if len(citations) == 0 {
+		return nil
+	}
+	trimmed := make([]domain.Citation, 0, len(citations))
+	for _, c := range citations {
+		trimmed = append(trimmed, domain.Citation{
+			Kind:    c.Kind,
+			Title:   strings.TrimSpace(c.Title),
+			Author:  strings.TrimSpace(c.Author),
+			Locator: strings.TrimSpace(c.Locator),
+			URL:     strings.TrimSpace(c.URL),
+		})
+	}
+	return trimmed
+}
+
+// trimAttachmentAltTexts は添付の代替テキストの前後空白を除去する（元の slice は書き換えない）。
+func trimAttachmentAltTexts(refs []domain.AttachmentRef) []domain.AttachmentRef {
+	if len(refs) == 0 {
+		return nil
+	}
+	trimmed := make([]domain.AttachmentRef, 0, len(refs))
+	for _, ref := range refs {
+		trimmed = append(trimmed, domain.AttachmentRef{AttachmentID: ref.AttachmentID, AltText: strings.TrimSpace(ref.AltText)})
+	}
+	return trimmed
+}
+
+// normalizeTags はタグの前後空白を除去し、重複を取り除く（先に出現したものを残す）。
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if _, dup := seen[tag]; dup {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// trimAcceptedAnswers は別表記の前後空白を除去する（照合は正規化して行うため、保存は入力表記を尊重する）。
+func trimAcceptedAnswers(answers []string) []string {
+	if len(answers) == 0 {
+		return nil
+	}
+	trimmed := make([]string, 0, len(answers))
+	for _, a := range answers {
+		trimmed = append(trimmed, strings.TrimSpace(a))
+	}
+	return trimmed
 }
 
 // normalizePageSize は pageSize のデフォルト/上限を統一する。
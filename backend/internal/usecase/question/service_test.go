@@ -2,39 +2,100 @@ package question
 
 import (
 	"context"
+	"errors"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
 	"github.com/history-quiz/historyquiz/internal/domain"
 	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/draftrule"
 )
 
 // fakeQuestionRepo は question.Usecase のユニットテスト用のリポジトリ差し替え。
 // NOTE: 実装は関数フィールドで差し替え、各テストで「呼ばれてよい/よくない」を明示する。
 type fakeQuestionRepo struct {
 	createQuestionFn    func(ctx context.Context, authorUserID string, draft domain.QuestionDraft) (domain.QuestionDetail, error)
-	updateQuestionFn    func(ctx context.Context, userID string, questionID string, draft domain.QuestionDraft) (domain.QuestionDetail, error)
+	createQuestionsFn   func(ctx context.Context, authorUserID string, drafts []domain.QuestionDraft) ([]domain.QuestionDetail, error)
+	updateQuestionFn    func(ctx context.Context, userID string, questionID string, expectedVersion int64, draft domain.QuestionDraft, schedule *domain.QuestionSchedule) (domain.QuestionDetail, error)
 	getMyQuestionFn     func(ctx context.Context, userID string, questionID string) (domain.QuestionDetail, error)
-	listMyQuestionsFn   func(ctx context.Context, userID string, limit int32) ([]domain.QuestionSummary, error)
+	softDeleteFn        func(ctx context.Context, userID string, questionID string) error
+	listMyQuestionsFn   func(ctx context.Context, userID string, query domain.MyQuestionsQuery) ([]domain.QuestionSummary, error)
+	listMyDetailsFn     func(ctx context.Context, userID string, afterQuestionID string, limit int32) ([]domain.QuestionDetail, error)
 	getQuestionAuthorFn func(ctx context.Context, questionID string) (authorUserID string, deleted bool, err error)
+	updateStatusFn      func(ctx context.Context, userID string, questionID string, from domain.QuestionStatus, to domain.QuestionStatus) (domain.QuestionDetail, error)
+	findSimilarFn       func(ctx context.Context, userID string, excludeQuestionID string, shingles []string, minSimilarity float64, limit int32) ([]domain.SimilarQuestion, error)
+	upsertTranslationFn func(ctx context.Context, questionID string, translation domain.QuestionTranslation) (domain.QuestionTranslation, error)
+	deleteTranslationFn func(ctx context.Context, questionID string, locale string) error
+	listTranslationsFn  func(ctx context.Context, questionID string) ([]domain.QuestionTranslation, error)
+	attemptStatsFn      func(ctx context.Context, questionID string, trendSince time.Time, strong domain.StrongPlayerCriteria) (domain.QuestionStats, error)
+	forkQuestionFn      func(ctx context.Context, userID string, sourceQuestionID string) (domain.QuestionDetail, error)
+	applySchedulesFn    func(ctx context.Context, now time.Time, limit int32) ([]domain.QuestionStatusChange, error)
+	distractorsFn       func(ctx context.Context, query domain.DistractorQuery) ([]domain.DistractorOccurrence, error)
 }
 
 func (f *fakeQuestionRepo) CreateQuestion(ctx context.Context, authorUserID string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
 	return f.createQuestionFn(ctx, authorUserID, draft)
 }
-func (f *fakeQuestionRepo) UpdateQuestion(ctx context.Context, userID string, questionID string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
-	return f.updateQuestionFn(ctx, userID, questionID, draft)
+func (f *fakeQuestionRepo) CreateQuestions(ctx context.Context, authorUserID string, drafts []domain.QuestionDraft) ([]domain.QuestionDetail, error) {
+	return f.createQuestionsFn(ctx, authorUserID, drafts)
+}
+func (f *fakeQuestionRepo) UpdateQuestion(ctx context.Context, userID string, questionID string, expectedVersion int64, draft domain.QuestionDraft, schedule *domain.QuestionSchedule) (domain.QuestionDetail, error) {
+	return f.updateQuestionFn(ctx, userID, questionID, expectedVersion, draft, schedule)
 }
 func (f *fakeQuestionRepo) GetMyQuestion(ctx context.Context, userID string, questionID string) (domain.QuestionDetail, error) {
 	return f.getMyQuestionFn(ctx, userID, questionID)
 }
-func (f *fakeQuestionRepo) ListMyQuestions(ctx context.Context, userID string, limit int32) ([]domain.QuestionSummary, error) {
-	return f.listMyQuestionsFn(ctx, userID, limit)
+func (f *fakeQuestionRepo) SoftDeleteQuestion(ctx context.Context, userID string, questionID string) error {
+	return f.softDeleteFn(ctx, userID, questionID)
+}
+func (f *fakeQuestionRepo) ListMyQuestions(ctx context.Context, userID string, query domain.MyQuestionsQuery) ([]domain.QuestionSummary, error) {
+	return f.listMyQuestionsFn(ctx, userID, query)
+}
+func (f *fakeQuestionRepo) ListMyQuestionDetails(ctx context.Context, userID string, afterQuestionID string, limit int32) ([]domain.QuestionDetail, error) {
+	return f.listMyDetailsFn(ctx, userID, afterQuestionID, limit)
 }
 func (f *fakeQuestionRepo) GetQuestionAuthor(ctx context.Context, questionID string) (string, bool, error) {
 	return f.getQuestionAuthorFn(ctx, questionID)
 }
+func (f *fakeQuestionRepo) UpdateQuestionStatus(ctx context.Context, userID string, questionID string, from domain.QuestionStatus, to domain.QuestionStatus) (domain.QuestionDetail, error) {
+	return f.updateStatusFn(ctx, userID, questionID, from, to)
+}
+
+func (f *fakeQuestionRepo) UpsertQuestionTranslation(ctx context.Context, questionID string, translation domain.QuestionTranslation) (domain.QuestionTranslation, error) {
+	return f.upsertTranslationFn(ctx, questionID, translation)
+}
+func (f *fakeQuestionRepo) DeleteQuestionTranslation(ctx context.Context, questionID string, locale string) error {
+	return f.deleteTranslationFn(ctx, questionID, locale)
+}
+func (f *fakeQuestionRepo) ListQuestionTranslations(ctx context.Context, questionID string) ([]domain.QuestionTranslation, error) {
+	return f.listTranslationsFn(ctx, questionID)
+}
+
+func (f *fakeQuestionRepo) GetQuestionAttemptStats(ctx context.Context, questionID string, trendSince time.Time, strong domain.StrongPlayerCriteria) (domain.QuestionStats, error) {
+	return f.attemptStatsFn(ctx, questionID, trendSince, strong)
+}
+func (f *fakeQuestionRepo) ListDistractorOccurrences(ctx context.Context, query domain.DistractorQuery) ([]domain.DistractorOccurrence, error) {
+	return f.distractorsFn(ctx, query)
+}
+func (f *fakeQuestionRepo) ForkQuestion(ctx context.Context, userID string, sourceQuestionID string) (domain.QuestionDetail, error) {
+	return f.forkQuestionFn(ctx, userID, sourceQuestionID)
+}
+func (f *fakeQuestionRepo) ApplyDueQuestionSchedules(ctx context.Context, now time.Time, limit int32) ([]domain.QuestionStatusChange, error) {
+	return f.applySchedulesFn(ctx, now, limit)
+}
+
+// FindSimilarQuestions は findSimilarFn が未設定の場合「類似問題なし」として扱う（作成/更新のテストで毎回設定しなくてよいように）。
+func (f *fakeQuestionRepo) FindSimilarQuestions(ctx context.Context, userID string, excludeQuestionID string, shingles []string, minSimilarity float64, limit int32) ([]domain.SimilarQuestion, error) {
+	if f.findSimilarFn == nil {
+		return nil, nil
+	}
+	return f.findSimilarFn(ctx, userID, excludeQuestionID, shingles, minSimilarity, limit)
+}
 
 // quiz 側でしか使わないメソッドは、誤って呼ばれたらテストを落とす。
 func (*fakeQuestionRepo) ListQuizCandidateQuestionIDs(context.Context, string) ([]string, error) {
@@ -46,6 +107,15 @@ func (*fakeQuestionRepo) ListQuizCandidateSystemQuestionIDs(context.Context, str
 func (*fakeQuestionRepo) ListQuizCandidateNonSystemQuestionIDs(context.Context, string) ([]string, error) {
 	panic("not used in question usecase tests")
 }
+func (*fakeQuestionRepo) ListQuizCandidateDeckQuestionIDs(context.Context, string) ([]string, error) {
+	panic("not used in question usecase tests")
+}
+func (*fakeQuestionRepo) ListQuizCandidateEntityQuestionIDs(context.Context, string, string) ([]string, error) {
+	panic("not used in question usecase tests")
+}
+func (*fakeQuestionRepo) ListQuestionEntities(context.Context, string) ([]domain.EntityRef, error) {
+	panic("not used in question usecase tests")
+}
 func (*fakeQuestionRepo) GetQuizQuestion(context.Context, string) (domain.Question, error) {
 	panic("not used in question usecase tests")
 }
@@ -55,6 +125,24 @@ func (*fakeQuestionRepo) GetCorrectChoiceID(context.Context, string) (string, er
 func (*fakeQuestionRepo) ChoiceBelongsToQuestion(context.Context, string, string) (bool, error) {
 	panic("not used in question usecase tests")
 }
+func (*fakeQuestionRepo) ListAcceptedAnswers(context.Context, string) ([]string, error) {
+	panic("not used in question usecase tests")
+}
+func (*fakeQuestionRepo) ListSimilarQuestionPairs(context.Context, float64, int32) ([]domain.SimilarQuestionPair, error) {
+	panic("not used in question usecase tests")
+}
+func (*fakeQuestionRepo) ListCitations(context.Context, string) ([]domain.Citation, error) {
+	panic("not used in question usecase tests")
+}
+func (*fakeQuestionRepo) GetQuestionLocation(context.Context, string) (*domain.QuestionLocation, error) {
+	panic("not used in question usecase tests")
+}
+func (*fakeQuestionRepo) ListPassageQuestionIDs(context.Context, string) ([]string, error) {
+	panic("not used in question usecase tests")
+}
+func (*fakeQuestionRepo) ListUncitedQuestions(context.Context, string, int32) ([]domain.UncitedQuestion, error) {
+	panic("not used in question usecase tests")
+}
 
 type fakeUserRepo struct {
 	ensureUserExistsFn func(ctx context.Context, userID string) error
@@ -64,6 +152,20 @@ func (f *fakeUserRepo) EnsureUserExists(ctx context.Context, userID string) erro
 	return f.ensureUserExistsFn(ctx, userID)
 }
 
+// testPageTokens はテスト用の page_token の署名鍵。
+var testPageTokens = pagetoken.NewCodec([]byte("test"))
+
+// testDraftRules はテスト用の作問入力の検証ルール（デフォルト設定）。
+var testDraftRules = mustDraftRules(draftrule.DefaultConfig())
+
+func mustDraftRules(cfg draftrule.Config) *draftrule.Pipeline {
+	p, err := draftrule.New(cfg)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
 // mustUUID はテストで UUID を生成するヘルパー。
 func mustUUID(t *testing.T) string {
 	t.Helper()
@@ -84,9 +186,11 @@ func TestUsecase_CreateQuestion_Unauthenticated(t *testing.T) {
 			t.Fatal("未認証の場合、EnsureUserExists は呼ばれない想定です")
 			return nil
 		}},
+		testPageTokens,
+		testDraftRules,
 	)
 
-	_, err := u.CreateQuestion(context.Background(), "", domain.QuestionDraft{})
+	_, _, err := u.CreateQuestion(context.Background(), "", domain.QuestionDraft{})
 	if !apperror.IsCode(err, apperror.CodeUnauthenticated) {
 		t.Fatalf("UNAUTHENTICATED を期待しました: err=%v", err)
 	}
@@ -106,9 +210,11 @@ func TestUsecase_CreateQuestion_InvalidDraft(t *testing.T) {
 			t.Fatal("入力不正の場合、EnsureUserExists は呼ばれない想定です")
 			return nil
 		}},
+		testPageTokens,
+		testDraftRules,
 	)
 
-	_, err := u.CreateQuestion(context.Background(), mustUUID(t), domain.QuestionDraft{
+	_, _, err := u.CreateQuestion(context.Background(), mustUUID(t), domain.QuestionDraft{
 		Prompt:         "  ",
 		Choices:        []string{"a", "b"},
 		CorrectOrdinal: 10,
@@ -160,9 +266,11 @@ func TestUsecase_CreateQuestion_Success(t *testing.T) {
 				}
 				return nil
 			}},
+			testPageTokens,
+			testDraftRules,
 		)
 
-		got, err := u.CreateQuestion(context.Background(), userID, draft)
+		got, _, err := u.CreateQuestion(context.Background(), userID, draft)
 		if err != nil {
 			t.Fatalf("err は nil を期待しました: %v", err)
 		}
@@ -186,7 +294,7 @@ func TestUsecase_UpdateQuestion_PermissionDenied(t *testing.T) {
 			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
 				return authorUserID, false, nil
 			},
-			updateQuestionFn: func(context.Context, string, string, domain.QuestionDraft) (domain.QuestionDetail, error) {
+			updateQuestionFn: func(context.Context, string, string, int64, domain.QuestionDraft, *domain.QuestionSchedule) (domain.QuestionDetail, error) {
 				t.Fatal("権限がない場合、UpdateQuestion は呼ばれない想定です")
 				return domain.QuestionDetail{}, nil
 			},
@@ -195,13 +303,15 @@ func TestUsecase_UpdateQuestion_PermissionDenied(t *testing.T) {
 			t.Fatal("権限がない場合、EnsureUserExists は呼ばれない想定です")
 			return nil
 		}},
+		testPageTokens,
+		testDraftRules,
 	)
 
-	_, err := u.UpdateQuestion(context.Background(), userID, questionID, domain.QuestionDraft{
+	_, _, err := u.UpdateQuestion(context.Background(), userID, questionID, 1, domain.QuestionDraft{
 		Prompt:         "Q",
 		Choices:        []string{"a", "b", "c", "d"},
 		CorrectOrdinal: 0,
-	})
+	}, nil, time.Now())
 	if !apperror.IsCode(err, apperror.CodePermissionDenied) {
 		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
 	}
@@ -218,7 +328,7 @@ func TestUsecase_UpdateQuestion_DeletedIsNotFound(t *testing.T) {
 			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
 				return userID, true, nil
 			},
-			updateQuestionFn: func(context.Context, string, string, domain.QuestionDraft) (domain.QuestionDetail, error) {
+			updateQuestionFn: func(context.Context, string, string, int64, domain.QuestionDraft, *domain.QuestionSchedule) (domain.QuestionDetail, error) {
 				t.Fatal("deleted の場合、UpdateQuestion は呼ばれない想定です")
 				return domain.QuestionDetail{}, nil
 			},
@@ -227,18 +337,74 @@ func TestUsecase_UpdateQuestion_DeletedIsNotFound(t *testing.T) {
 			t.Fatal("deleted の場合、EnsureUserExists は呼ばれない想定です")
 			return nil
 		}},
+		testPageTokens,
+		testDraftRules,
 	)
 
-	_, err := u.UpdateQuestion(context.Background(), userID, questionID, domain.QuestionDraft{
+	_, _, err := u.UpdateQuestion(context.Background(), userID, questionID, 1, domain.QuestionDraft{
 		Prompt:         "Q",
 		Choices:        []string{"a", "b", "c", "d"},
 		CorrectOrdinal: 0,
-	})
+	}, nil, time.Now())
 	if !apperror.IsCode(err, apperror.CodeNotFound) {
 		t.Fatalf("NOT_FOUND を期待しました: err=%v", err)
 	}
 }
 
+func TestUsecase_UpdateQuestion_RequiresExpectedVersion(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeQuestionRepo{}, &fakeUserRepo{}, testPageTokens, testDraftRules)
+
+	_, _, err := u.UpdateQuestion(context.Background(), mustUUID(t), mustUUID(t), 0, domain.QuestionDraft{
+		Prompt:  "Q",
+		Choices: []string{"a", "b", "c", "d"},
+	}, nil, time.Now())
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) || len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != "expected_version" {
+		t.Fatalf("expected_version の FieldViolation を期待しました: err=%+v", err)
+	}
+}
+
+func TestUsecase_UpdateQuestion_VersionConflictReturnsCurrent(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	questionID := mustUUID(t)
+	current := domain.QuestionDetail{ID: questionID, Prompt: "別の画面で保存した問題文", Version: 5}
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) { return userID, false, nil },
+			updateQuestionFn: func(context.Context, string, string, int64, domain.QuestionDraft, *domain.QuestionSchedule) (domain.QuestionDetail, error) {
+				return domain.QuestionDetail{}, apperror.Aborted("他の編集で問題が更新されています", current)
+			},
+			findSimilarFn: func(context.Context, string, string, []string, float64, int32) ([]domain.SimilarQuestion, error) {
+				t.Fatal("競合した場合、類似問題は探さない想定です")
+				return nil, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	_, _, err := u.UpdateQuestion(context.Background(), userID, questionID, 4, domain.QuestionDraft{
+		Prompt:  "Q",
+		Choices: []string{"a", "b", "c", "d"},
+	}, nil, time.Now())
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) || appErr.Code != apperror.CodeAborted {
+		t.Fatalf("ABORTED を期待しました: err=%v", err)
+	}
+	if got, ok := appErr.Current.(domain.QuestionDetail); !ok || got.Version != 5 || got.Prompt != current.Prompt {
+		t.Fatalf("最新の問題を返す想定です: current=%+v", appErr.Current)
+	}
+}
+
 func TestUsecase_UpdateQuestion_Success(t *testing.T) {
 	t.Parallel()
 
@@ -261,10 +427,10 @@ func TestUsecase_UpdateQuestion_Success(t *testing.T) {
 			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
 				return userID, false, nil
 			},
-			updateQuestionFn: func(ctx context.Context, gotUserID string, gotQuestionID string, gotDraft domain.QuestionDraft) (domain.QuestionDetail, error) {
+			updateQuestionFn: func(ctx context.Context, gotUserID string, gotQuestionID string, gotExpectedVersion int64, gotDraft domain.QuestionDraft, _ *domain.QuestionSchedule) (domain.QuestionDetail, error) {
 				updateCalled++
-				if gotUserID != userID || gotQuestionID != questionID {
-					t.Fatalf("UpdateQuestion の引数が期待と異なります: user=%s q=%s", gotUserID, gotQuestionID)
+				if gotUserID != userID || gotQuestionID != questionID || gotExpectedVersion != 3 {
+					t.Fatalf("UpdateQuestion の引数が期待と異なります: user=%s q=%s version=%d", gotUserID, gotQuestionID, gotExpectedVersion)
 				}
 				if gotDraft.CorrectOrdinal != 1 {
 					t.Fatalf("UpdateQuestion の draft が期待と異なります: %+v", gotDraft)
@@ -279,9 +445,11 @@ func TestUsecase_UpdateQuestion_Success(t *testing.T) {
 			}
 			return nil
 		}},
+		testPageTokens,
+		testDraftRules,
 	)
 
-	got, err := u.UpdateQuestion(context.Background(), userID, questionID, draft)
+	got, _, err := u.UpdateQuestion(context.Background(), userID, questionID, 3, draft, nil, time.Now())
 	if err != nil {
 		t.Fatalf("err は nil を期待しました: %v", err)
 	}
@@ -293,6 +461,142 @@ func TestUsecase_UpdateQuestion_Success(t *testing.T) {
 	}
 }
 
+// 4択の問題を地図だけで答える問題に変える更新では、選択肢を持たない draft がそのまま保存へ渡る
+// （選択肢の件数はトランザクション終端のトリガーが kind に応じて確かめる）。
+func TestUsecase_UpdateQuestion_ChoiceToLocation(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	questionID := mustUUID(t)
+	draft := domain.QuestionDraft{
+		Kind:     domain.QuestionKindLocation,
+		Prompt:   "カルタゴはどこ？",
+		Location: &domain.QuestionLocation{Point: geo.Point{Lat: 36.8529, Lng: 10.3233}, RadiusKm: 50},
+		// 4択だったときの正解の位置が残っていても、地図だけで答える問題では使わない。
+		CorrectOrdinal: 2,
+	}
+
+	updateCalled := 0
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return userID, false, nil
+			},
+			updateQuestionFn: func(_ context.Context, _ string, _ string, _ int64, gotDraft domain.QuestionDraft, _ *domain.QuestionSchedule) (domain.QuestionDetail, error) {
+				updateCalled++
+				if gotDraft.Kind != domain.QuestionKindLocation || len(gotDraft.Choices) != 0 || gotDraft.CorrectOrdinal != 0 || gotDraft.Location == nil {
+					t.Fatalf("地図だけで答える問題の draft を期待しました: %+v", gotDraft)
+				}
+				return domain.QuestionDetail{ID: questionID, Kind: gotDraft.Kind, Location: gotDraft.Location}, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, _, err := u.UpdateQuestion(context.Background(), userID, questionID, 1, draft, nil, time.Now())
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if updateCalled != 1 || got.Kind != domain.QuestionKindLocation {
+		t.Fatalf("UpdateQuestion=1 と種類 location を期待: update=%d got=%+v", updateCalled, got)
+	}
+}
+
+func TestUsecase_CreateQuestion_SimilarQuestions(t *testing.T) {
+	t.Parallel()
+
+	draft := domain.QuestionDraft{Prompt: "古代ローマの首都はどこ？", Choices: []string{"a", "b", "c", "d"}}
+
+	tests := []struct {
+		name        string
+		similarity  float64
+		wantBlocked bool
+	}{
+		{name: "似ている問題は警告として返す", similarity: 0.77},
+		{name: "ほぼ同じ問題がある場合は作成しない", similarity: 0.95, wantBlocked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userID := mustUUID(t)
+			similar := []domain.SimilarQuestion{{QuestionID: mustUUID(t), Prompt: "古代ローマの首都はどこですか？", Similarity: tt.similarity}}
+			createCalled := 0
+
+			u := NewUsecase(
+				&fakeQuestionRepo{
+					findSimilarFn: func(_ context.Context, gotUserID string, excludeQuestionID string, shingles []string, _ float64, _ int32) ([]domain.SimilarQuestion, error) {
+						if gotUserID != userID || excludeQuestionID != "" || len(shingles) == 0 {
+							t.Fatalf("FindSimilarQuestions の引数が期待と異なります: user=%s exclude=%s shingles=%q", gotUserID, excludeQuestionID, shingles)
+						}
+						return similar, nil
+					},
+					createQuestionFn: func(context.Context, string, domain.QuestionDraft) (domain.QuestionDetail, error) {
+						createCalled++
+						return domain.QuestionDetail{ID: mustUUID(t)}, nil
+					},
+				},
+				&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+				testPageTokens,
+				testDraftRules,
+			)
+
+			_, gotSimilar, err := u.CreateQuestion(context.Background(), userID, draft)
+			if tt.wantBlocked {
+				if !apperror.IsCode(err, apperror.CodeAlreadyExists) || createCalled != 0 {
+					t.Fatalf("ALREADY_EXISTS で作成しないことを期待しました: err=%v create=%d", err, createCalled)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err は nil を期待しました: %v", err)
+			}
+			if createCalled != 1 || len(gotSimilar) != 1 || gotSimilar[0].QuestionID != similar[0].QuestionID {
+				t.Fatalf("作成した上で類似問題を返す想定です: create=%d similar=%+v", createCalled, gotSimilar)
+			}
+		})
+	}
+}
+
+func TestUsecase_UpdateQuestion_SimilarQuestionsDoNotBlock(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	questionID := mustUUID(t)
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) { return userID, false, nil },
+			updateQuestionFn: func(_ context.Context, _ string, _ string, _ int64, draft domain.QuestionDraft, _ *domain.QuestionSchedule) (domain.QuestionDetail, error) {
+				return domain.QuestionDetail{ID: questionID, Prompt: draft.Prompt}, nil
+			},
+			findSimilarFn: func(_ context.Context, _ string, excludeQuestionID string, _ []string, _ float64, _ int32) ([]domain.SimilarQuestion, error) {
+				if excludeQuestionID != questionID {
+					t.Fatalf("更新中の問題自身は除外する想定です: exclude=%s", excludeQuestionID)
+				}
+				return []domain.SimilarQuestion{{QuestionID: mustUUID(t), Similarity: 1}}, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, similar, err := u.UpdateQuestion(context.Background(), userID, questionID, 1, domain.QuestionDraft{
+		Prompt:  "古代ローマの首都はどこ？",
+		Choices: []string{"a", "b", "c", "d"},
+	}, nil, time.Now())
+	if err != nil {
+		t.Fatalf("更新ではほぼ同じ問題があっても拒否しない想定です: %v", err)
+	}
+	if got.ID != questionID || len(similar) != 1 {
+		t.Fatalf("更新結果と類似問題を期待しました: got=%+v similar=%+v", got, similar)
+	}
+}
+
 func TestUsecase_ListMyQuestions_NormalizesPageSize(t *testing.T) {
 	t.Parallel()
 
@@ -301,31 +605,559 @@ func TestUsecase_ListMyQuestions_NormalizesPageSize(t *testing.T) {
 
 	u := NewUsecase(
 		&fakeQuestionRepo{
-			listMyQuestionsFn: func(ctx context.Context, gotUserID string, limit int32) ([]domain.QuestionSummary, error) {
+			listMyQuestionsFn: func(ctx context.Context, gotUserID string, query domain.MyQuestionsQuery) ([]domain.QuestionSummary, error) {
 				if gotUserID != userID {
 					t.Fatalf("ListMyQuestions の userID が一致しません: got=%s want=%s", gotUserID, userID)
 				}
-				gotLimit = limit
+				gotLimit = query.Limit
 				return nil, nil
 			},
 		},
 		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+		testPageTokens,
+		testDraftRules,
 	)
 
-	_, _, err := u.ListMyQuestions(context.Background(), userID, 0)
+	// 次のページの有無を判定するため、repo には1件多く要求する。
+	_, _, err := u.ListMyQuestions(context.Background(), userID, ListQuery{PageSize: 0})
 	if err != nil {
 		t.Fatalf("err は nil を期待しました: %v", err)
 	}
-	if gotLimit != 20 {
-		t.Fatalf("pageSize<=0 は 20 を期待: got=%d", gotLimit)
+	if gotLimit != 21 {
+		t.Fatalf("pageSize<=0 は 20（+1）を期待: got=%d", gotLimit)
 	}
 
 	gotLimit = -1
-	_, _, err = u.ListMyQuestions(context.Background(), userID, 999)
+	_, _, err = u.ListMyQuestions(context.Background(), userID, ListQuery{PageSize: 999})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if gotLimit != 101 {
+		t.Fatalf("pageSize>100 は 100（+1）を期待: got=%d", gotLimit)
+	}
+}
+
+func TestUsecase_CreateQuestion_InvalidAcceptedAnswers(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			createQuestionFn: func(context.Context, string, domain.QuestionDraft) (domain.QuestionDetail, error) {
+				t.Fatal("入力不正の場合、repo は呼ばれない想定です")
+				return domain.QuestionDetail{}, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			t.Fatal("入力不正の場合、EnsureUserExists は呼ばれない想定です")
+			return nil
+		}},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	_, _, err := u.CreateQuestion(context.Background(), mustUUID(t), domain.QuestionDraft{
+		Prompt:          "Q",
+		Choices:         []string{"a", "b", "c", "d"},
+		CorrectOrdinal:  0,
+		AcceptedAnswers: []string{"alias", " "},
+	})
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_CreateQuestion_TrimsAcceptedAnswers(t *testing.T) {
+	t.Parallel()
+
+	var gotAliases []string
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			createQuestionFn: func(_ context.Context, _ string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
+				gotAliases = draft.AcceptedAnswers
+				return domain.QuestionDetail{}, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	_, _, err := u.CreateQuestion(context.Background(), mustUUID(t), domain.QuestionDraft{
+		Prompt:          "Q",
+		Choices:         []string{"a", "b", "c", "d"},
+		CorrectOrdinal:  0,
+		AcceptedAnswers: []string{"  ヴァスコダガマ "},
+	})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(gotAliases) != 1 || gotAliases[0] != "ヴァスコダガマ" {
+		t.Fatalf("別表記は前後空白を除去して保存する想定です: got=%q", gotAliases)
+	}
+}
+
+func TestUsecase_CreateQuestion_Tags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		tags     []string
+		wantErr  bool
+		wantTags []string
+	}{
+		{name: "前後空白を除去して重複を取り除く", tags: []string{" 大航海時代 ", "ポルトガル", "大航海時代"}, wantTags: []string{"大航海時代", "ポルトガル"}},
+		{name: "空のタグは不正", tags: []string{" "}, wantErr: true},
+		{name: "区切り文字を含むタグは不正", tags: []string{"中世|近世"}, wantErr: true},
+		{name: "31文字以上は不正", tags: []string{"あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほま"}, wantErr: true},
+		{name: "11件以上は不正", tags: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotTags []string
+			u := NewUsecase(
+				&fakeQuestionRepo{
+					createQuestionFn: func(_ context.Context, _ string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
+						gotTags = draft.Tags
+						return domain.QuestionDetail{}, nil
+					},
+				},
+				&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+				testPageTokens,
+				testDraftRules,
+			)
+
+			_, _, err := u.CreateQuestion(context.Background(), mustUUID(t), domain.QuestionDraft{
+				Prompt:         "Q",
+				Choices:        []string{"a", "b", "c", "d"},
+				CorrectOrdinal: 0,
+				Tags:           tt.tags,
+			})
+			if tt.wantErr {
+				if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+					t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err は nil を期待しました: %v", err)
+			}
+			if len(gotTags) != len(tt.wantTags) {
+				t.Fatalf("タグが期待と異なります: got=%q want=%q", gotTags, tt.wantTags)
+			}
+			for i := range gotTags {
+				if gotTags[i] != tt.wantTags[i] {
+					t.Fatalf("タグが期待と異なります: got=%q want=%q", gotTags, tt.wantTags)
+				}
+			}
+		})
+	}
+}
+
+func TestUsecase_PublishQuestion_PermissionDenied(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return mustUUID(t), false, nil
+			},
+			updateStatusFn: func(context.Context, string, string, domain.QuestionStatus, domain.QuestionStatus) (domain.QuestionDetail, error) {
+				t.Fatal("権限がない場合、UpdateQuestionStatus は呼ばれない想定です")
+				return domain.QuestionDetail{}, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	_, err := u.PublishQuestion(context.Background(), mustUUID(t), mustUUID(t))
+	if !apperror.IsCode(err, apperror.CodePermissionDenied) {
+		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_PublishQuestion_FromDraft(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	questionID := mustUUID(t)
+	updateCalled := 0
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) { return userID, false, nil },
+			getMyQuestionFn: func(context.Context, string, string) (domain.QuestionDetail, error) {
+				return domain.QuestionDetail{ID: questionID, Status: domain.QuestionStatusDraft}, nil
+			},
+			updateStatusFn: func(_ context.Context, gotUserID string, gotQuestionID string, from domain.QuestionStatus, to domain.QuestionStatus) (domain.QuestionDetail, error) {
+				updateCalled++
+				if gotUserID != userID || gotQuestionID != questionID {
+					t.Fatalf("UpdateQuestionStatus の引数が期待と異なります: user=%s q=%s", gotUserID, gotQuestionID)
+				}
+				if from != domain.QuestionStatusDraft || to != domain.QuestionStatusPublished {
+					t.Fatalf("draft → published を期待: from=%s to=%s", from, to)
+				}
+				return domain.QuestionDetail{ID: questionID, Status: to}, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, err := u.PublishQuestion(context.Background(), userID, questionID)
 	if err != nil {
 		t.Fatalf("err は nil を期待しました: %v", err)
 	}
-	if gotLimit != 100 {
-		t.Fatalf("pageSize>100 は 100 を期待: got=%d", gotLimit)
+	if got.Status != domain.QuestionStatusPublished || updateCalled != 1 {
+		t.Fatalf("published への更新を期待: status=%s called=%d", got.Status, updateCalled)
+	}
+}
+
+func TestUsecase_UnpublishQuestion_InvalidTransition(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) { return userID, false, nil },
+			getMyQuestionFn: func(context.Context, string, string) (domain.QuestionDetail, error) {
+				// draft は公開前なので、unlisted/archived には直接遷移できない。
+				return domain.QuestionDetail{Status: domain.QuestionStatusDraft}, nil
+			},
+			updateStatusFn: func(context.Context, string, string, domain.QuestionStatus, domain.QuestionStatus) (domain.QuestionDetail, error) {
+				t.Fatal("不正な遷移の場合、UpdateQuestionStatus は呼ばれない想定です")
+				return domain.QuestionDetail{}, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	_, err := u.UnpublishQuestion(context.Background(), userID, mustUUID(t), "")
+	if !apperror.IsCode(err, apperror.CodeFailedPrecondition) {
+		t.Fatalf("FAILED_PRECONDITION を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_UnpublishQuestion_InvalidTargetStatus(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeQuestionRepo{}, &fakeUserRepo{}, testPageTokens, testDraftRules)
+
+	_, err := u.UnpublishQuestion(context.Background(), mustUUID(t), mustUUID(t), domain.QuestionStatusPublished)
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_DeleteQuestion_PermissionDenied(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return mustUUID(t), false, nil
+			},
+			softDeleteFn: func(context.Context, string, string) error {
+				t.Fatal("権限がない場合、SoftDeleteQuestion は呼ばれない想定です")
+				return nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	err := u.DeleteQuestion(context.Background(), mustUUID(t), mustUUID(t))
+	if !apperror.IsCode(err, apperror.CodePermissionDenied) {
+		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_DeleteQuestion_Success(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	questionID := mustUUID(t)
+	called := false
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return userID, false, nil
+			},
+			softDeleteFn: func(_ context.Context, gotUserID string, gotQuestionID string) error {
+				called = true
+				if gotUserID != userID || gotQuestionID != questionID {
+					t.Fatalf("SoftDeleteQuestion の引数が期待と異なります: user=%s question=%s", gotUserID, gotQuestionID)
+				}
+				return nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	if err := u.DeleteQuestion(context.Background(), userID, questionID); err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if !called {
+		t.Fatal("SoftDeleteQuestion が呼ばれる想定です")
+	}
+}
+
+func TestValidateDraft_Attachments(t *testing.T) {
+	t.Parallel()
+
+	attachmentID := mustUUID(t)
+	base := domain.QuestionDraft{
+		Prompt:         "Q",
+		Choices:        []string{"a", "b", "c", "d"},
+		CorrectOrdinal: 0,
+	}
+
+	tests := []struct {
+		name        string
+		attachments []domain.AttachmentRef
+		wantErr     bool
+	}{
+		{name: "添付なし", attachments: nil, wantErr: false},
+		{name: "代替テキスト付き", attachments: []domain.AttachmentRef{{AttachmentID: attachmentID, AltText: "古地図"}}, wantErr: false},
+		{name: "UUID でない", attachments: []domain.AttachmentRef{{AttachmentID: "x"}}, wantErr: true},
+		{name: "同じ添付を重複指定", attachments: []domain.AttachmentRef{{AttachmentID: attachmentID}, {AttachmentID: attachmentID}}, wantErr: true},
+		{name: "代替テキストが長すぎる", attachments: []domain.AttachmentRef{{AttachmentID: attachmentID, AltText: strings.Repeat("あ", 201)}}, wantErr: true},
+		{name: "件数超過", attachments: []domain.AttachmentRef{{AttachmentID: mustUUID(t)}, {AttachmentID: mustUUID(t)}, {AttachmentID: mustUUID(t)}, {AttachmentID: mustUUID(t)}, {AttachmentID: mustUUID(t)}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			draft := base
+			draft.Attachments = tt.attachments
+			err := ValidateDraft(draft)
+			if tt.wantErr != apperror.IsCode(err, apperror.CodeInvalidArgument) {
+				t.Fatalf("wantErr=%v ですが err=%v でした", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateDraft_Citations(t *testing.T) {
+	t.Parallel()
+
+	base := domain.QuestionDraft{
+		Prompt:         "Q",
+		Choices:        []string{"a", "b", "c", "d"},
+		CorrectOrdinal: 0,
+	}
+
+	tests := []struct {
+		name      string
+		citations []domain.Citation
+		wantField string
+	}{
+		{name: "書籍（URL なし）", citations: []domain.Citation{{Kind: domain.CitationKindBook, Title: "日本史史料集", Locator: "p.12"}}},
+		{name: "Web", citations: []domain.Citation{{Kind: domain.CitationKindWeb, Title: "国立国会図書館", URL: "https://www.ndl.go.jp/"}}},
+		{name: "種類が未指定", citations: []domain.Citation{{Title: "T"}}, wantField: "draft.citations[0].kind"},
+		{name: "題名が空白だけ", citations: []domain.Citation{{Kind: domain.CitationKindBook, Title: "  "}}, wantField: "draft.citations[0].title"},
+		{name: "Web で URL なし", citations: []domain.Citation{{Kind: domain.CitationKindWeb, Title: "T"}}, wantField: "draft.citations[0].url"},
+		{name: "相対 URL", citations: []domain.Citation{{Kind: domain.CitationKindBook, Title: "T", URL: "/books/1"}}, wantField: "draft.citations[0].url"},
+		{name: "javascript スキーム", citations: []domain.Citation{{Kind: domain.CitationKindWeb, Title: "T", URL: "javascript:alert(1)"}}, wantField: "draft.citations[0].url"},
+		{name: "2件目が不正", citations: []domain.Citation{{Kind: domain.CitationKindBook, Title: "T"}, {Kind: domain.CitationKindPrimarySource, Title: "T", Author: strings.Repeat("a", 101)}}, wantField: "draft.citations[1].author"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			draft := base
+			draft.Citations = tt.citations
+			err := ValidateDraft(draft)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("err は nil を期待しました: %v", err)
+				}
+				return
+			}
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+			if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.wantField {
+				t.Fatalf("%s の FieldViolation を期待しました: %+v", tt.wantField, appErr.FieldViolations)
+			}
+		})
+	}
+}
+
+func TestValidateDraft_Location(t *testing.T) {
+	t.Parallel()
+
+	base := domain.QuestionDraft{
+		Prompt:         "カルタゴはどこ？",
+		Choices:        []string{"チュニジア", "エジプト", "シリア", "スペイン"},
+		CorrectOrdinal: 0,
+	}
+	carthage := geo.Point{Lat: 36.8529, Lng: 10.3233}
+
+	tests := []struct {
+		name      string
+		location  *domain.QuestionLocation
+		wantField string
+	}{
+		{name: "地点なし", location: nil},
+		{name: "カルタゴ（半径 50 km）", location: &domain.QuestionLocation{Point: carthage, RadiusKm: 50}},
+		{name: "日付変更線上", location: &domain.QuestionLocation{Point: geo.Point{Lat: -17.7, Lng: 180}, RadiusKm: 1}},
+		{name: "緯度が範囲外", location: &domain.QuestionLocation{Point: geo.Point{Lat: 91, Lng: 0}, RadiusKm: 50}, wantField: "draft.location.latitude"},
+		{name: "経度が範囲外", location: &domain.QuestionLocation{Point: geo.Point{Lat: 0, Lng: -181}, RadiusKm: 50}, wantField: "draft.location.longitude"},
+		{name: "半径が 0", location: &domain.QuestionLocation{Point: carthage}, wantField: "draft.location.radius_km"},
+		{name: "半径が大きすぎる", location: &domain.QuestionLocation{Point: carthage, RadiusKm: 2001}, wantField: "draft.location.radius_km"},
+		{name: "NaN", location: &domain.QuestionLocation{Point: geo.Point{Lat: math.NaN(), Lng: 0}, RadiusKm: 50}, wantField: "draft.location.latitude"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			draft := base
+			draft.Location = tt.location
+			err := ValidateDraft(draft)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("err は nil を期待しました: %v", err)
+				}
+				return
+			}
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+			if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.wantField {
+				t.Fatalf("%s の FieldViolation を期待しました: %+v", tt.wantField, appErr.FieldViolations)
+			}
+		})
+	}
+}
+
+func TestValidateDraft_LocationOnlyKind(t *testing.T) {
+	t.Parallel()
+
+	carthage := &domain.QuestionLocation{Point: geo.Point{Lat: 36.8529, Lng: 10.3233}, RadiusKm: 50}
+	base := domain.QuestionDraft{
+		Kind:     domain.QuestionKindLocation,
+		Prompt:   "カルタゴはどこ？",
+		Location: carthage,
+	}
+
+	tests := []struct {
+		name      string
+		mutate    func(d *domain.QuestionDraft)
+		wantField string
+	}{
+		{name: "選択肢なしで地点あり", mutate: func(*domain.QuestionDraft) {}},
+		{name: "地点なし", mutate: func(d *domain.QuestionDraft) { d.Location = nil }, wantField: "draft.location"},
+		{name: "選択肢あり", mutate: func(d *domain.QuestionDraft) { d.Choices = []string{"a", "b", "c", "d"} }, wantField: "draft.choices"},
+		{name: "別表記あり", mutate: func(d *domain.QuestionDraft) { d.AcceptedAnswers = []string{"カルタゴ"} }, wantField: "draft.accepted_answers"},
+		{name: "不明な種類", mutate: func(d *domain.QuestionDraft) { d.Kind = "timeline" }, wantField: "draft.kind"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			draft := base
+			tt.mutate(&draft)
+			err := ValidateDraft(draft)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("err は nil を期待しました: %v", err)
+				}
+				return
+			}
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+			if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.wantField {
+				t.Fatalf("%s の FieldViolation を期待しました: %+v", tt.wantField, appErr.FieldViolations)
+			}
+		})
+	}
+
+	// 4択の問題（種類の指定なしを含む）は今までどおり選択肢が必須。
+	if err := ValidateDraft(domain.QuestionDraft{Prompt: "カルタゴはどこ？", Location: carthage}); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("選択肢の無い4択の問題は INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestCheckDraft(t *testing.T) {
+	t.Parallel()
+
+	got, err := CheckDraft(testDraftRules, domain.QuestionDraft{
+		Prompt:         "鎌倉幕府を\x00開いたのは？",
+		Choices:        []string{"源頼朝", "足利尊氏", "徳川家康", "平清盛"},
+		CorrectOrdinal: 0,
+	})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.Prompt != "鎌倉幕府を開いたのは？" {
+		t.Fatalf("制御文字を取り除いた値を返す想定です: %q", got.Prompt)
+	}
+
+	// 構造の違反（選択肢が空）と内容の違反（同じ選択肢）をまとめて返す。
+	_, err = CheckDraft(testDraftRules, domain.QuestionDraft{
+		Prompt:         "鎌倉幕府を開いたのは？",
+		Choices:        []string{"源頼朝", "\x07", "源頼朝", "平清盛"},
+		CorrectOrdinal: 0,
+	})
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) || len(appErr.FieldViolations) != 2 ||
+		appErr.FieldViolations[0].Field != "draft.choices[1]" || appErr.FieldViolations[1].Field != "draft.choices[2]" {
+		t.Fatalf("選択肢 1 と 2 の違反を期待しました: err=%v", err)
+	}
+}
+
+func TestValidateDraft_Markup(t *testing.T) {
+	t.Parallel()
+
+	base := domain.QuestionDraft{
+		Prompt:         "元寇の時の執権 北条時宗《ほうじょうときむね》 が**退けた**国は？",
+		Choices:        []string{"元", "明", "宋", "清"},
+		CorrectOrdinal: 0,
+		Explanation:    "文永の役と弘安の役。\n[参考](https://www.ndl.go.jp/)",
+	}
+	if err := ValidateDraft(base); err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		modify    func(d *domain.QuestionDraft)
+		wantField string
+	}{
+		{name: "閉じていない強調", modify: func(d *domain.QuestionDraft) { d.Prompt = "**元寇" }, wantField: "draft.prompt"},
+		{name: "空のルビ", modify: func(d *domain.QuestionDraft) { d.Prompt = "時宗《》" }, wantField: "draft.prompt"},
+		{name: "http 以外のリンク", modify: func(d *domain.QuestionDraft) { d.Explanation = "[押す](javascript:void)" }, wantField: "draft.explanation"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := base
+			tt.modify(&d)
+			var appErr *apperror.Error
+			if err := ValidateDraft(d); !errors.As(err, &appErr) || len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.wantField {
+				t.Fatalf("%s の違反を期待しました: err=%v", tt.wantField, err)
+			}
+		})
 	}
 }
@@ -0,0 +1,109 @@
+package question
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// 作者向けの回答統計（GetQuestionStats）の設定。
+const (
+	// statsTrendWeeks は推移として返す週の数（今週を含む）。
+	statsTrendWeeks = 12
+
+	// unpickedDistractorMaxRate 未満しか選ばれない誤答の選択肢を「機能していない」とみなす。
+	unpickedDistractorMaxRate = 0.02
+	// minChoiceAttemptsForFlag は選択肢の注意点を出すのに必要な選択式の回答数（少ないと偶然の偏りで誤検知するため）。
+	minChoiceAttemptsForFlag = 50
+
+	// strongPlayersMissMaxAccuracy 未満しか、よくできるプレイヤーが正解できない問題は正解の設定を疑う。
+	strongPlayersMissMaxAccuracy = 0.5
+	// minStrongAttemptsForFlag は上の注意点を出すのに必要な、よくできるプレイヤーの回答数。
+	minStrongAttemptsForFlag = 10
+)
+
+// strongPlayerCriteria は「よくできるプレイヤー」の条件（この問題以外に 30 回以上回答し、8 割以上正解している）。
+var strongPlayerCriteria = domain.StrongPlayerCriteria{MinAttempts: 30, MinAccuracy: 0.8}
+
+// GetQuestionStats は自分の問題の回答統計を返す（所有者チェック含む）。
+// now は推移の最終週を決めるための現在時刻。
+func (u *Usecase) GetQuestionStats(ctx context.Context, userID string, questionID string, now time.Time) (domain.QuestionStats, error) {
+	if userID == "" {
+		return domain.QuestionStats{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if questionID == "" {
+		return domain.QuestionStats{}, apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(questionID); err != nil {
+		return domain.QuestionStats{}, apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+	}
+
+	if err := u.authorizeOwner(ctx, userID, questionID); err != nil {
+		return domain.QuestionStats{}, err
+	}
+
+	firstWeek := weekStart(now).AddDate(0, 0, -7*(statsTrendWeeks-1))
+	stats, err := u.questionRepo.GetQuestionAttemptStats(ctx, questionID, firstWeek, strongPlayerCriteria)
+	if err != nil {
+		return domain.QuestionStats{}, err
+	}
+	return summarizeStats(stats, firstWeek), nil
+}
+
+// summarizeStats は集計値から率を求め、推移の空き週を埋め、注意点を付ける。
+func summarizeStats(stats domain.QuestionStats, firstWeek time.Time) domain.QuestionStats {
+	stats.Accuracy = ratio(stats.CorrectAttempts, stats.TotalAttempts)
+	stats.StrongPlayerAccuracy = ratio(stats.StrongPlayerCorrect, stats.StrongPlayerAttempts)
+
+	choiceAttempts := stats.TotalAttempts - stats.TextAttempts - stats.LocationAttempts
+	for i := range stats.Choices {
+		stats.Choices[i].PickRate = ratio(stats.Choices[i].Picks, choiceAttempts)
+	}
+
+	byStart := make(map[time.Time]domain.StatsBucket, len(stats.Trend))
+	for _, b := range stats.Trend {
+		byStart[b.Start] = b
+	}
+	trend := make([]domain.StatsBucket, 0, statsTrendWeeks)
+	for i := 0; i < statsTrendWeeks; i++ {
+		start := firstWeek.AddDate(0, 0, 7*i)
+		b, ok := byStart[start]
+		if !ok {
+			b = domain.StatsBucket{Start: start}
+		}
+		b.Accuracy = ratio(b.CorrectAttempts, b.Attempts)
+		trend = append(trend, b)
+	}
+	stats.Trend = trend
+
+	stats.Flags = nil
+	if choiceAttempts >= minChoiceAttemptsForFlag {
+		for _, c := range stats.Choices {
+			if !c.IsCorrect && c.PickRate < unpickedDistractorMaxRate {
+				stats.Flags = append(stats.Flags, domain.QuestionQualityFlag{Kind: domain.QualityFlagUnpickedDistractor, ChoiceID: c.ChoiceID})
+			}
+		}
+	}
+	if stats.StrongPlayerAttempts >= minStrongAttemptsForFlag && stats.StrongPlayerAccuracy < strongPlayersMissMaxAccuracy {
+		stats.Flags = append(stats.Flags, domain.QuestionQualityFlag{Kind: domain.QualityFlagStrongPlayersMiss})
+	}
+	return stats
+}
+
+// weekStart は t を含む週の開始（UTC の月曜 0 時。Postgres の date_trunc('week', ...) と同じ）を返す。
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7 // 月曜 = 0
+	return day.AddDate(0, 0, -offset)
+}
+
+func ratio(n int64, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
@@ -0,0 +1,149 @@
+package question
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestUsecase_GetQuestionStats_PermissionDenied(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return mustUUID(t), false, nil
+			},
+			attemptStatsFn: func(context.Context, string, time.Time, domain.StrongPlayerCriteria) (domain.QuestionStats, error) {
+				t.Fatal("権限がない場合、GetQuestionAttemptStats は呼ばれない想定です")
+				return domain.QuestionStats{}, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	_, err := u.GetQuestionStats(context.Background(), mustUUID(t), mustUUID(t), time.Now())
+	if !apperror.IsCode(err, apperror.CodePermissionDenied) {
+		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_GetQuestionStats_Summarizes(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	// 2026-10-21 は水曜日。今週は 10-19（月）から。
+	now := time.Date(2026, 10, 21, 15, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	thisWeek := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	firstWeek := thisWeek.AddDate(0, 0, -7*(statsTrendWeeks-1))
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return userID, false, nil
+			},
+			attemptStatsFn: func(_ context.Context, _ string, trendSince time.Time, strong domain.StrongPlayerCriteria) (domain.QuestionStats, error) {
+				if !trendSince.Equal(firstWeek) {
+					t.Fatalf("推移の開始週が期待と異なります: got=%s want=%s", trendSince, firstWeek)
+				}
+				if strong != strongPlayerCriteria {
+					t.Fatalf("よくできるプレイヤーの条件が期待と異なります: %+v", strong)
+				}
+				return domain.QuestionStats{
+					TotalAttempts:   110,
+					CorrectAttempts: 33,
+					TextAttempts:    10,
+					Choices: []domain.ChoiceStats{
+						{ChoiceID: "c0", Ordinal: 0, IsCorrect: true, Picks: 30},
+						{ChoiceID: "c1", Ordinal: 1, Picks: 69},
+						{ChoiceID: "c2", Ordinal: 2, Picks: 1},
+						{ChoiceID: "c3", Ordinal: 3, Picks: 0},
+					},
+					Trend: []domain.StatsBucket{
+						{Start: thisWeek, Attempts: 4, CorrectAttempts: 1},
+					},
+					StrongPlayerAttempts: 12,
+					StrongPlayerCorrect:  3,
+				}, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, err := u.GetQuestionStats(context.Background(), userID, mustUUID(t), now)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+
+	if got.Accuracy != 0.3 {
+		t.Fatalf("正答率が期待と異なります: %v", got.Accuracy)
+	}
+	// 選択率の分母は記述式を除いた 100 件。
+	if got.Choices[1].PickRate != 0.69 || got.Choices[2].PickRate != 0.01 {
+		t.Fatalf("選択率が期待と異なります: %+v", got.Choices)
+	}
+
+	if len(got.Trend) != statsTrendWeeks || !got.Trend[0].Start.Equal(firstWeek) {
+		t.Fatalf("推移は %d 週を古い順に返す想定です: %+v", statsTrendWeeks, got.Trend)
+	}
+	last := got.Trend[statsTrendWeeks-1]
+	if !last.Start.Equal(thisWeek) || last.Attempts != 4 || last.Accuracy != 0.25 || got.Trend[0].Attempts != 0 {
+		t.Fatalf("回答の無い週は 0 件で埋める想定です: %+v", got.Trend)
+	}
+
+	wantFlags := []domain.QuestionQualityFlag{
+		{Kind: domain.QualityFlagUnpickedDistractor, ChoiceID: "c2"},
+		{Kind: domain.QualityFlagUnpickedDistractor, ChoiceID: "c3"},
+		{Kind: domain.QualityFlagStrongPlayersMiss},
+	}
+	if len(got.Flags) != len(wantFlags) {
+		t.Fatalf("注意点が期待と異なります: got=%+v want=%+v", got.Flags, wantFlags)
+	}
+	for i := range wantFlags {
+		if got.Flags[i] != wantFlags[i] {
+			t.Fatalf("注意点が期待と異なります: got=%+v want=%+v", got.Flags, wantFlags)
+		}
+	}
+}
+
+func TestSummarizeStats_NoFlagsWithFewAttempts(t *testing.T) {
+	t.Parallel()
+
+	// 回答が少ないうちは、偶然の偏りで注意点を出さない。
+	got := summarizeStats(domain.QuestionStats{
+		TotalAttempts: 5,
+		Choices: []domain.ChoiceStats{
+			{ChoiceID: "c0", IsCorrect: true, Picks: 5},
+			{ChoiceID: "c1"},
+		},
+		StrongPlayerAttempts: 3,
+	}, weekStart(time.Now()))
+	if len(got.Flags) != 0 {
+		t.Fatalf("注意点は出さない想定です: %+v", got.Flags)
+	}
+}
+
+func TestSummarizeStats_PickRateExcludesTextAndLocation(t *testing.T) {
+	t.Parallel()
+
+	// 選択率の分母は、記述式（10件）と地図（20件）を除いた 50 件。
+	got := summarizeStats(domain.QuestionStats{
+		TotalAttempts:    80,
+		TextAttempts:     10,
+		LocationAttempts: 20,
+		Choices: []domain.ChoiceStats{
+			{ChoiceID: "c0", IsCorrect: true, Picks: 40},
+			{ChoiceID: "c1", Picks: 10},
+		},
+	}, weekStart(time.Now()))
+	if got.Choices[0].PickRate != 0.8 || got.Choices[1].PickRate != 0.2 {
+		t.Fatalf("選択率が期待と異なります: %+v", got.Choices)
+	}
+}
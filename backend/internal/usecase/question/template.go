@@ -0,0 +1,108 @@
+package question
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questiontemplate"
+)
+
+// TemplatePreview はテンプレートから生成した問題（作成前）。
+type TemplatePreview struct {
+	// Row はデータセットの行（CSV の行番号、JSON の 1 始まりの位置）。
+	Row   int
+	Draft domain.QuestionDraft
+}
+
+// TemplateResult は GenerateQuestionsFromTemplate の結果。
+type TemplateResult struct {
+	TotalRows int
+	// RowErrors が空でない場合は何も作成していない。
+	RowErrors []ImportRowError
+	// Previews は生成した問題（正規化済み）。RowErrors がある場合は、不正でない行の分だけ入る。
+	Previews []TemplatePreview
+	// Created は作成した問題（dryRun または RowErrors がある場合は空）。
+	Created []domain.QuestionDetail
+	// Skipped はほぼ同じ問題が既にあるため作成しなかった行。
+	Skipped []ImportRowError
+}
+
+// GenerateQuestionsFromTemplate はテンプレートとデータセット（CSV/JSON）から問題を生成し、1問ずつ作成する。
+// 生成した問題は CreateQuestion と同じルールで検証し、1行でも不正があれば何も作成しない。dryRun の場合はプレビューのみ返す。
+// 混同しやすい点: ImportQuestions と違い、ほぼ同じ問題が既にある行は作成せず Skipped に入れる（他の行は作成する）。
+// そのため、途中で失敗しても同じ入力でやり直せば、作成済みの行は Skipped になり残りだけが作成される。
+func (u *Usecase) GenerateQuestionsFromTemplate(ctx context.Context, userID string, tmpl questiontemplate.Template, format questionfile.Format, dataset []byte, dryRun bool) (TemplateResult, error) {
+	if userID == "" {
+		return TemplateResult{}, apperror.Unauthenticated("認証が必要です")
+	}
+	if len(dataset) == 0 {
+		return TemplateResult{}, apperror.InvalidArgument("dataset が空です", apperror.FieldViolation{Field: "dataset", Description: "必須です"})
+	}
+	if len(dataset) > maxImportBytes {
+		return TemplateResult{}, apperror.InvalidArgument("dataset が大きすぎます", apperror.FieldViolation{Field: "dataset", Description: "1MiB 以内で指定してください"})
+	}
+
+	ds, err := questiontemplate.ParseDataset(format, bytes.NewReader(dataset))
+	if err != nil {
+		return TemplateResult{}, err
+	}
+	if len(ds.Records) == 0 {
+		return TemplateResult{}, apperror.InvalidArgument("データが含まれていません", apperror.FieldViolation{Field: "dataset", Description: "1行以上含めてください"})
+	}
+	if len(ds.Records) > maxImportRows {
+		return TemplateResult{}, apperror.InvalidArgument("データが多すぎます", apperror.FieldViolation{Field: "dataset", Description: strconv.Itoa(maxImportRows) + "行以内に分割してください"})
+	}
+
+	generated, err := questiontemplate.Generate(tmpl, ds)
+	if err != nil {
+		return TemplateResult{}, err
+	}
+
+	result := TemplateResult{TotalRows: len(generated)}
+	for _, g := range generated {
+		violations := g.Violations
+		if len(violations) == 0 {
+			draft, err := CheckDraft(u.draftRules, g.Draft)
+			if err != nil {
+				violations = fieldViolationsOf(err)
+			} else {
+				g.Draft = NormalizeDraft(draft)
+			}
+		}
+		if len(violations) > 0 {
+			result.RowErrors = append(result.RowErrors, ImportRowError{Row: g.Line, Violations: violations})
+			continue
+		}
+		result.Previews = append(result.Previews, TemplatePreview{Row: g.Line, Draft: g.Draft})
+	}
+	if len(result.RowErrors) > 0 || dryRun {
+		return result, nil
+	}
+
+	if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+		return TemplateResult{}, err
+	}
+	for _, p := range result.Previews {
+		similar, err := u.findSimilarQuestions(ctx, userID, "", p.Draft.Prompt)
+		if err != nil {
+			return TemplateResult{}, err
+		}
+		if len(similar) > 0 && similar[0].Similarity >= duplicateBlockThreshold {
+			result.Skipped = append(result.Skipped, ImportRowError{Row: p.Row, Violations: []apperror.FieldViolation{{
+				Field:       "draft.prompt",
+				Description: "ほぼ同じ問題が既にあります（question_id=" + similar[0].QuestionID + "）",
+			}}})
+			continue
+		}
+		created, err := u.questionRepo.CreateQuestion(ctx, userID, p.Draft)
+		if err != nil {
+			return TemplateResult{}, err
+		}
+		result.Created = append(result.Created, created)
+	}
+	return result, nil
+}
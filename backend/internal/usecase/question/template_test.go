@@ -0,0 +1,136 @@
+package question
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questionfile"
+	"github.com/history-quiz/historyquiz/internal/usecase/question/questiontemplate"
+)
+
+const templateCSV = "event,year\n本能寺の変,1582\n桶狭間の戦い,1560\n関ヶ原の戦い,1600\n長篠の戦い,1575\n"
+
+var testTemplate = questiontemplate.Template{
+	Prompt:   "{{event}}が起きたのは何年？",
+	Answer:   "{{year}}年",
+	EraField: "year",
+}
+
+func TestUsecase_GenerateQuestionsFromTemplate_DryRun(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{createQuestionFn: func(context.Context, string, domain.QuestionDraft) (domain.QuestionDetail, error) {
+			t.Fatal("dry-run の場合、CreateQuestion は呼ばれない想定です")
+			return domain.QuestionDetail{}, nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			t.Fatal("dry-run の場合、EnsureUserExists は呼ばれない想定です")
+			return nil
+		}},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, err := u.GenerateQuestionsFromTemplate(context.Background(), mustUUID(t), testTemplate, questionfile.FormatCSV, []byte(templateCSV), true)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.TotalRows != 4 || len(got.RowErrors) != 0 || len(got.Previews) != 4 || len(got.Created) != 0 {
+		t.Fatalf("結果が期待と異なります: %+v", got)
+	}
+	p := got.Previews[0]
+	if p.Row != 2 || p.Draft.Prompt != "本能寺の変が起きたのは何年？" || p.Draft.Choices[p.Draft.CorrectOrdinal] != "1582年" {
+		t.Fatalf("プレビューが期待と異なります: %+v", p)
+	}
+}
+
+func TestUsecase_GenerateQuestionsFromTemplate_RowErrorsCreateNothing(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{createQuestionFn: func(context.Context, string, domain.QuestionDraft) (domain.QuestionDetail, error) {
+			t.Fatal("不正な行がある場合、CreateQuestion は呼ばれない想定です")
+			return domain.QuestionDetail{}, nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			t.Fatal("不正な行がある場合、EnsureUserExists は呼ばれない想定です")
+			return nil
+		}},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	// 6行目は問題文が長すぎ（作問入力の検証）、7行目は年が空（生成時の検証）。
+	dataset := templateCSV + strings.Repeat("あ", 1000) + ",1590\n壬申の乱,\n"
+	got, err := u.GenerateQuestionsFromTemplate(context.Background(), mustUUID(t), testTemplate, questionfile.FormatCSV, []byte(dataset), false)
+	if err != nil {
+		t.Fatalf("行エラーは err ではなく結果で返す想定です: %v", err)
+	}
+	if len(got.RowErrors) != 2 || got.RowErrors[0].Row != 6 || got.RowErrors[1].Row != 7 {
+		t.Fatalf("6行目と7行目のエラーを期待しました: %+v", got.RowErrors)
+	}
+	if got.RowErrors[0].Violations[0].Field != "draft.prompt" || got.RowErrors[1].Violations[0].Field != "dataset.year" {
+		t.Fatalf("違反の項目が期待と異なります: %+v", got.RowErrors)
+	}
+	if len(got.Previews) != 4 || len(got.Created) != 0 {
+		t.Fatalf("不正でない行のプレビューのみ返す想定です: %+v", got)
+	}
+}
+
+func TestUsecase_GenerateQuestionsFromTemplate_SkipsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	var created []string
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			createQuestionFn: func(_ context.Context, _ string, draft domain.QuestionDraft) (domain.QuestionDetail, error) {
+				created = append(created, draft.Prompt)
+				return domain.QuestionDetail{Prompt: draft.Prompt}, nil
+			},
+			findSimilarFn: func(_ context.Context, _ string, _ string, shingles []string, _ float64, _ int32) ([]domain.SimilarQuestion, error) {
+				// 「関ヶ原」を含む問題文だけ既存の問題とほぼ同じとみなす。
+				for _, s := range shingles {
+					if strings.HasPrefix(s, "関") {
+						return []domain.SimilarQuestion{{QuestionID: "existing", Similarity: 0.95}}, nil
+					}
+				}
+				return nil, nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, err := u.GenerateQuestionsFromTemplate(context.Background(), mustUUID(t), testTemplate, questionfile.FormatCSV, []byte(templateCSV), false)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if len(got.Created) != 3 || len(created) != 3 {
+		t.Fatalf("3問の作成を期待しました: created=%v", created)
+	}
+	if len(got.Skipped) != 1 || got.Skipped[0].Row != 4 || !strings.Contains(got.Skipped[0].Violations[0].Description, "existing") {
+		t.Fatalf("4行目のスキップを期待しました: %+v", got.Skipped)
+	}
+}
+
+func TestUsecase_GenerateQuestionsFromTemplate_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeQuestionRepo{}, &fakeUserRepo{}, testPageTokens, testDraftRules)
+
+	if _, err := u.GenerateQuestionsFromTemplate(context.Background(), "", testTemplate, questionfile.FormatCSV, []byte(templateCSV), true); !apperror.IsCode(err, apperror.CodeUnauthenticated) {
+		t.Fatalf("UNAUTHENTICATED を期待しました: %v", err)
+	}
+	if _, err := u.GenerateQuestionsFromTemplate(context.Background(), mustUUID(t), testTemplate, questionfile.FormatCSV, nil, true); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: %v", err)
+	}
+	bad := questiontemplate.Template{Prompt: "{{place}}は？", Answer: "{{year}}", EraField: "year"}
+	if _, err := u.GenerateQuestionsFromTemplate(context.Background(), mustUUID(t), bad, questionfile.FormatCSV, []byte(templateCSV), true); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+package question
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/locale"
+)
+
+// UpsertQuestionTranslation は自分の問題の翻訳を作成/置き換えする（所有者チェック含む）。
+// NOTE: 正解は原文と共有するため、選択肢は原文と同じ順（ordinal）で4件指定してもらう（地図でだけ答える問題は0件）。
+func (u *Usecase) UpsertQuestionTranslation(ctx context.Context, userID string, questionID string, translation domain.QuestionTranslation) (domain.QuestionTranslation, error) {
+	if err := validateTranslationTarget(userID, questionID); err != nil {
+		return domain.QuestionTranslation{}, err
+	}
+	normalized, err := validateTranslation(translation)
+	if err != nil {
+		return domain.QuestionTranslation{}, err
+	}
+
+	if err := u.authorizeOwner(ctx, userID, questionID); err != nil {
+		return domain.QuestionTranslation{}, err
+	}
+	source, err := u.questionRepo.GetMyQuestion(ctx, userID, questionID)
+	if err != nil {
+		return domain.QuestionTranslation{}, err
+	}
+	if len(normalized.Choices) != len(source.Choices) {
+		return domain.QuestionTranslation{}, apperror.InvalidArgument("入力が不正です", apperror.FieldViolation{Field: "translation.choices", Description: "選択肢は原文と同じ順で同じ数だけ指定してください"})
+	}
+	return u.questionRepo.UpsertQuestionTranslation(ctx, questionID, normalized)
+}
+
+// DeleteQuestionTranslation は自分の問題の翻訳を削除する（所有者チェック含む）。
+func (u *Usecase) DeleteQuestionTranslation(ctx context.Context, userID string, questionID string, localeTag string) error {
+	if err := validateTranslationTarget(userID, questionID); err != nil {
+		return err
+	}
+	normalizedLocale, ok := locale.Normalize(localeTag)
+	if !ok {
+		return apperror.InvalidArgument("locale が不正です", apperror.FieldViolation{Field: "locale", Description: "BCP 47 の言語タグ（例: en）を指定してください"})
+	}
+
+	if err := u.authorizeOwner(ctx, userID, questionID); err != nil {
+		return err
+	}
+	return u.questionRepo.DeleteQuestionTranslation(ctx, questionID, normalizedLocale)
+}
+
+// ListQuestionTranslations は自分の問題の翻訳一覧を返す（所有者チェック含む）。
+// 原文の更新後に直していない翻訳は Stale=true で返す（出題には使われない）。
+func (u *Usecase) ListQuestionTranslations(ctx context.Context, userID string, questionID string) ([]domain.QuestionTranslation, error) {
+	if err := validateTranslationTarget(userID, questionID); err != nil {
+		return nil, err
+	}
+	if err := u.authorizeOwner(ctx, userID, questionID); err != nil {
+		return nil, err
+	}
+	return u.questionRepo.ListQuestionTranslations(ctx, questionID)
+}
+
+func validateTranslationTarget(userID string, questionID string) error {
+	if userID == "" {
+		return apperror.Unauthenticated("認証が必要です")
+	}
+	if questionID == "" {
+		return apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(questionID); err != nil {
+		return apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+	}
+	return nil
+}
+
+// validateTranslation は翻訳を検証し、保存用に整えたもの（言語タグの正規化、前後空白の除去）を返す。
+func validateTranslation(t domain.QuestionTranslation) (domain.QuestionTranslation, error) {
+	var violations []apperror.FieldViolation
+
+	normalizedLocale, ok := locale.Normalize(t.Locale)
+	switch {
+	case !ok:
+		violations = append(violations, apperror.FieldViolation{Field: "translation.locale", Description: "BCP 47 の言語タグ（例: en）を指定してください"})
+	case locale.IsCanonical(normalizedLocale):
+		violations = append(violations, apperror.FieldViolation{Field: "translation.locale", Description: "原文と同じ言語の翻訳は登録できません"})
+	}
+
+	if strings.TrimSpace(t.Prompt) == "" {
+		violations = append(violations, apperror.FieldViolation{Field: "translation.prompt", Description: "必須です"})
+	}
+	violations = append(violations, markupViolations("translation.prompt", t.Prompt)...)
+	violations = append(violations, markupViolations("translation.explanation", t.Explanation)...)
+
+	// 混同しやすい点: 0件は地図でだけ答える問題の翻訳。原文の選択肢の数との一致は所有者チェックの後に確かめる。
+	if len(t.Choices) != 0 && len(t.Choices) != 4 {
+		violations = append(violations, apperror.FieldViolation{Field: "translation.choices", Description: "選択肢は原文と同じ順で4件指定してください"})
+	} else {
+		for i, c := range t.Choices {
+			if strings.TrimSpace(c) == "" {
+				violations = append(violations, apperror.FieldViolation{Field: "translation.choices[" + strconv.Itoa(i) + "]", Description: "必須です"})
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return domain.QuestionTranslation{}, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	choices := make([]string, 0, len(t.Choices))
+	for _, c := range t.Choices {
+		choices = append(choices, strings.TrimSpace(c))
+	}
+	return domain.QuestionTranslation{
+		Locale:      normalizedLocale,
+		Prompt:      strings.TrimSpace(t.Prompt),
+		Choices:     choices,
+		Explanation: strings.TrimSpace(t.Explanation),
+	}, nil
+}
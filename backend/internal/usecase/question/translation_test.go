@@ -0,0 +1,190 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+func TestUsecase_UpsertQuestionTranslation_Validation(t *testing.T) {
+	t.Parallel()
+
+	valid := domain.QuestionTranslation{Locale: "en", Prompt: "Q", Choices: []string{"a", "b", "c", "d"}}
+
+	tests := []struct {
+		name   string
+		mutate func(tr *domain.QuestionTranslation)
+		field  string
+	}{
+		{name: "言語タグが不正", mutate: func(tr *domain.QuestionTranslation) { tr.Locale = "not a tag" }, field: "translation.locale"},
+		{name: "原文と同じ言語", mutate: func(tr *domain.QuestionTranslation) { tr.Locale = "ja-JP" }, field: "translation.locale"},
+		{name: "問題文が空", mutate: func(tr *domain.QuestionTranslation) { tr.Prompt = " " }, field: "translation.prompt"},
+		{name: "選択肢の数が原文と違う", mutate: func(tr *domain.QuestionTranslation) { tr.Choices = []string{"a", "b"} }, field: "translation.choices"},
+		{name: "選択肢が空", mutate: func(tr *domain.QuestionTranslation) { tr.Choices = []string{"a", "", "c", "d"} }, field: "translation.choices[1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := valid
+			tr.Choices = append([]string(nil), valid.Choices...)
+			tt.mutate(&tr)
+
+			u := NewUsecase(
+				&fakeQuestionRepo{
+					getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+						t.Fatal("入力が不正な場合、所有者チェックは行わない想定です")
+						return "", false, nil
+					},
+				},
+				&fakeUserRepo{},
+				testPageTokens,
+				testDraftRules,
+			)
+
+			_, err := u.UpsertQuestionTranslation(context.Background(), mustUUID(t), mustUUID(t), tr)
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+			if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != tt.field {
+				t.Fatalf("%s の FieldViolation を期待しました: %+v", tt.field, appErr.FieldViolations)
+			}
+		})
+	}
+}
+
+func TestUsecase_UpsertQuestionTranslation_PermissionDenied(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return mustUUID(t), false, nil
+			},
+			upsertTranslationFn: func(context.Context, string, domain.QuestionTranslation) (domain.QuestionTranslation, error) {
+				t.Fatal("権限がない場合、UpsertQuestionTranslation は呼ばれない想定です")
+				return domain.QuestionTranslation{}, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	_, err := u.UpsertQuestionTranslation(context.Background(), mustUUID(t), mustUUID(t), domain.QuestionTranslation{
+		Locale: "en", Prompt: "Q", Choices: []string{"a", "b", "c", "d"},
+	})
+	if !apperror.IsCode(err, apperror.CodePermissionDenied) {
+		t.Fatalf("PERMISSION_DENIED を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_UpsertQuestionTranslation_Normalizes(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	questionID := mustUUID(t)
+
+	u := NewUsecase(
+		&fakeQuestionRepo{
+			getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+				return userID, false, nil
+			},
+			getMyQuestionFn: func(context.Context, string, string) (domain.QuestionDetail, error) {
+				return domain.QuestionDetail{ID: questionID, Choices: make([]domain.Choice, 4)}, nil
+			},
+			upsertTranslationFn: func(_ context.Context, gotQuestionID string, tr domain.QuestionTranslation) (domain.QuestionTranslation, error) {
+				if gotQuestionID != questionID {
+					t.Fatalf("question_id mismatch: got=%s want=%s", gotQuestionID, questionID)
+				}
+				// 言語タグは正規化し、各項目は前後空白を除いて保存する。
+				if tr.Locale != "zh-Hant" || tr.Prompt != "Q" || tr.Choices[0] != "a" || tr.Explanation != "e" {
+					t.Fatalf("正規化した翻訳を保存する想定です: %+v", tr)
+				}
+				tr.SourceVersion = 3
+				return tr, nil
+			},
+		},
+		&fakeUserRepo{},
+		testPageTokens,
+		testDraftRules,
+	)
+
+	got, err := u.UpsertQuestionTranslation(context.Background(), userID, questionID, domain.QuestionTranslation{
+		Locale: "zh-hant", Prompt: " Q ", Choices: []string{" a", "b", "c", "d"}, Explanation: "e ",
+	})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if got.SourceVersion != 3 {
+		t.Fatalf("リポジトリの結果を返す想定です: %+v", got)
+	}
+}
+
+func TestUsecase_UpsertQuestionTranslation_ChoiceCountFollowsSource(t *testing.T) {
+	t.Parallel()
+
+	fourChoices := []string{"a", "b", "c", "d"}
+	tests := []struct {
+		name          string
+		sourceChoices int
+		choices       []string
+		wantErr       bool
+	}{
+		{name: "4択の問題に4件", sourceChoices: 4, choices: fourChoices},
+		{name: "地図でだけ答える問題に0件", sourceChoices: 0, choices: nil},
+		{name: "4択の問題に0件", sourceChoices: 4, choices: nil, wantErr: true},
+		{name: "地図でだけ答える問題に4件", sourceChoices: 0, choices: fourChoices, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userID := mustUUID(t)
+			upserted := false
+			u := NewUsecase(
+				&fakeQuestionRepo{
+					getQuestionAuthorFn: func(context.Context, string) (string, bool, error) {
+						return userID, false, nil
+					},
+					getMyQuestionFn: func(context.Context, string, string) (domain.QuestionDetail, error) {
+						return domain.QuestionDetail{Choices: make([]domain.Choice, tt.sourceChoices)}, nil
+					},
+					upsertTranslationFn: func(_ context.Context, _ string, tr domain.QuestionTranslation) (domain.QuestionTranslation, error) {
+						upserted = true
+						return tr, nil
+					},
+				},
+				&fakeUserRepo{},
+				testPageTokens,
+				testDraftRules,
+			)
+
+			_, err := u.UpsertQuestionTranslation(context.Background(), userID, mustUUID(t), domain.QuestionTranslation{
+				Locale: "en", Prompt: "Q", Choices: tt.choices,
+			})
+			if tt.wantErr {
+				var appErr *apperror.Error
+				if !errors.As(err, &appErr) || appErr.Code != apperror.CodeInvalidArgument {
+					t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+				}
+				if len(appErr.FieldViolations) != 1 || appErr.FieldViolations[0].Field != "translation.choices" {
+					t.Fatalf("translation.choices の FieldViolation を期待しました: %+v", appErr.FieldViolations)
+				}
+				if upserted {
+					t.Fatal("選択肢の数が原文と違う場合、保存しない想定です")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err は nil を期待しました: %v", err)
+			}
+		})
+	}
+}
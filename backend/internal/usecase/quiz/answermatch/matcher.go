@@ -0,0 +1,96 @@
+package answermatch
+
+import "unicode"
+
+// Result は記述式回答の照合結果。
+type Result struct {
+	Matched bool
+	// Answer は一致した正解/別表記（登録時の表記のまま返す）。不一致の場合は空。
+	Answer string
+	// Distance は正規化後の編集距離（完全一致は 0）。
+	Distance int
+}
+
+// Match は入力文字列を正解候補（正解ラベル + 別表記）と照合する。
+// 候補は先頭ほど優先し、編集距離が最小のものを採用する（同距離なら先に登録されたもの）。
+func Match(input string, accepted []string) Result {
+	normalizedInput := Normalize(input)
+	if normalizedInput == "" {
+		return Result{}
+	}
+
+	best := Result{}
+	for _, candidate := range accepted {
+		normalizedCandidate := Normalize(candidate)
+		if normalizedCandidate == "" {
+			continue
+		}
+
+		distance := editDistance(normalizedInput, normalizedCandidate)
+		if distance > allowedDistance(normalizedCandidate) {
+			continue
+		}
+		// 年号などの数字は1文字違いでも別の答えになるため、数字列は完全一致を要求する。
+		if distance > 0 && digitsOf(normalizedInput) != digitsOf(normalizedCandidate) {
+			continue
+		}
+
+		if !best.Matched || distance < best.Distance {
+			best = Result{Matched: true, Answer: candidate, Distance: distance}
+		}
+		if distance == 0 {
+			break
+		}
+	}
+	return best
+}
+
+// allowedDistance は正解候補の長さに応じて許容するタイプミスの数を返す。
+// 短い語は1文字違いで別の語になりやすいため、完全一致のみ許可する。
+func allowedDistance(normalizedCandidate string) int {
+	n := len([]rune(normalizedCandidate))
+	switch {
+	case n <= 3:
+		return 0
+	case n <= 7:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// digitsOf は文字列中の数字だけを連結して返す。
+func digitsOf(s string) string {
+	var digits []rune
+	for _, r := range s {
+		if unicode.IsDigit(r) {
+			digits = append(digits, r)
+		}
+	}
+	return string(digits)
+}
+
+// editDistance は rune 単位のレーベンシュタイン距離を返す。
+func editDistance(a, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,98 @@
+package answermatch
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "カタカナはひらがなへ寄せる", input: "コロンブス", want: "ころんぶす"},
+		{name: "中黒を除去する", input: "ヴァスコ・ダ・ガマ", want: "ゔぁすこだがま"},
+		{name: "半角カタカナと半角中黒", input: "ｳﾞｧｽｺ･ﾀﾞ･ｶﾞﾏ", want: "ゔぁすこだがま"},
+		{name: "全角英数は半角小文字へ", input: "ＶＡＳＣＯ　ｄａ　Ｇａｍａ", want: "vascodagama"},
+		{name: "前後と途中の空白を除去する", input: "  カール  マルテル ", want: "かーるまるてる"},
+		{name: "二重ハイフン相当の等号を除去する", input: "カール＝マルテル", want: "かーるまるてる"},
+		{name: "長音記号は保持する", input: "クーデター", want: "くーでたー"},
+		{name: "全角数字は半角へ", input: "１４９８年", want: "1498年"},
+		{name: "空文字", input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Normalize(tt.input); got != tt.want {
+				t.Fatalf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	accepted := []string{"ヴァスコ・ダ・ガマ", "Vasco da Gama"}
+
+	tests := []struct {
+		name         string
+		input        string
+		accepted     []string
+		wantMatched  bool
+		wantAnswer   string
+		wantDistance int
+	}{
+		{name: "完全一致", input: "ヴァスコ・ダ・ガマ", accepted: accepted, wantMatched: true, wantAnswer: "ヴァスコ・ダ・ガマ"},
+		{name: "中黒なし", input: "ヴァスコダガマ", accepted: accepted, wantMatched: true, wantAnswer: "ヴァスコ・ダ・ガマ"},
+		{name: "ひらがな入力", input: "ゔぁすこだがま", accepted: accepted, wantMatched: true, wantAnswer: "ヴァスコ・ダ・ガマ"},
+		{name: "別表記（英語）に一致", input: "vasco da gama", accepted: accepted, wantMatched: true, wantAnswer: "Vasco da Gama"},
+		{name: "1文字のタイプミス", input: "ヴァスコダガム", accepted: accepted, wantMatched: true, wantAnswer: "ヴァスコ・ダ・ガマ", wantDistance: 1},
+		{name: "長い語は2文字の揺れを許容", input: "コンスタンチノープル", accepted: []string{"コンスタンティノープル"}, wantMatched: true, wantAnswer: "コンスタンティノープル", wantDistance: 2},
+		{name: "別人は不一致", input: "コロンブス", accepted: accepted, wantMatched: false},
+		{name: "短い語はタイプミスを許容しない", input: "ロマ", accepted: []string{"ローマ"}, wantMatched: false},
+		{name: "短い語の完全一致", input: "ろーま", accepted: []string{"ローマ"}, wantMatched: true, wantAnswer: "ローマ"},
+		{name: "年号の数字違いは不一致", input: "1499年", accepted: []string{"1498年"}, wantMatched: false},
+		{name: "年号の全角入力は一致", input: "１４９８年", accepted: []string{"1498年"}, wantMatched: true, wantAnswer: "1498年"},
+		{name: "空入力は不一致", input: "  ", accepted: accepted, wantMatched: false},
+		{name: "候補なしは不一致", input: "ローマ", accepted: nil, wantMatched: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Match(tt.input, tt.accepted)
+			if got.Matched != tt.wantMatched {
+				t.Fatalf("Match(%q).Matched = %v, want %v (result=%+v)", tt.input, got.Matched, tt.wantMatched, got)
+			}
+			if !tt.wantMatched {
+				return
+			}
+			if got.Answer != tt.wantAnswer || got.Distance != tt.wantDistance {
+				t.Fatalf("Match(%q) = %+v, want answer=%q distance=%d", tt.input, got, tt.wantAnswer, tt.wantDistance)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "abc", b: "", want: 3},
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "がま", b: "がむ", want: 1},
+		{a: "ころんぶす", b: "ころんぶす", want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Fatalf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
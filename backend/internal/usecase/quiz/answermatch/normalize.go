@@ -0,0 +1,47 @@
+package answermatch
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/history-quiz/historyquiz/internal/domain/similarity"
+)
+
+// separatorRunes は表記揺れとして無視する区切り文字。
+// 例: "ヴァスコ・ダ・ガマ" と "ヴァスコダガマ"、"Vasco da Gama" と "vascodagama" を同一視する。
+var separatorRunes = map[rune]struct{}{
+	'・':  {}, // 中黒（NFKC 後は半角中黒もこれに寄る）
+	'·':  {}, // ラテン文字圏の中点
+	'‧':  {},
+	'=':  {}, // "カール＝マルテル" の二重ハイフン代わり
+	'゠':  {},
+	'-':  {},
+	'‐':  {},
+	'.':  {},
+	',':  {},
+	'、':  {},
+	'。':  {},
+	'\'': {},
+}
+
+// Normalize は記述式回答の比較用に文字列を正規化する。
+// 1) NFKC で全角/半角を統一 2) 小文字化 3) カタカナをひらがなへ寄せる 4) 空白と区切り文字を除去する。
+// 混同しやすい点: 長音記号（ー）は読みの一部なので除去しない。
+func Normalize(s string) string {
+	s = norm.NFKC.String(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		if _, ok := separatorRunes[r]; ok {
+			continue
+		}
+		b.WriteRune(similarity.KatakanaToHiragana(unicode.ToLower(r)))
+	}
+	return b.String()
+}
@@ -46,3 +46,37 @@ var defaultCorrectChoiceIDByQuestionID = map[string]string{
 	"00000000-0000-0000-0000-000000000003": "00000000-0000-0000-0000-000000003003",
 }
 
+
+// defaultAnswerAliasesByQuestionID は既定問題セットの記述式用の別表記（migrations の seed と揃える）。
+var defaultAnswerAliasesByQuestionID = map[string][]string{
+	"00000000-0000-0000-0000-000000000003": {"Vasco da Gama"},
+}
+
+// defaultAcceptedAnswers は既定問題の正解表記（正解ラベル + 別表記）を返す。
+func defaultAcceptedAnswers(questionID string) ([]string, bool) {
+	correctChoiceID, ok := defaultCorrectChoiceIDByQuestionID[questionID]
+	if !ok {
+		return nil, false
+	}
+	for _, q := range defaultQuestions {
+		if q.ID != questionID {
+			continue
+		}
+		for _, c := range q.Choices {
+			if c.ID == correctChoiceID {
+				return append([]string{c.Label}, defaultAnswerAliasesByQuestionID[questionID]...), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// isDefaultQuestionID は既定問題セットの問題かを返す（既定問題は DB に無いため、翻訳や出典も無い）。
+func isDefaultQuestionID(questionID string) bool {
+	for _, q := range defaultQuestions {
+		if q.ID == questionID {
+			return true
+		}
+	}
+	return false
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/history-quiz/historyquiz/internal/domain"
 	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
+	"github.com/history-quiz/historyquiz/internal/domain/locale"
 	"github.com/history-quiz/historyquiz/internal/repository"
+	"github.com/history-quiz/historyquiz/internal/usecase/quiz/answermatch"
 )
 
 // Usecase は Quiz のユースケース（出題/判定）を提供する。
@@ -33,11 +38,136 @@ type SubmitAnswerResult struct {
 	IsCorrect       bool
 	CorrectChoiceID string
 	AttemptID       string
+	// MatchedAnswer は記述式で一致した正解/別表記（選択式・不一致の場合は空）。
+	MatchedAnswer string
+	// Citations は問題の出典（表示順）。
+	// 混同しやすい点: 出典の題名が答えそのものになりうるため、出題時ではなく回答後にだけ返す。
+	Citations []domain.Citation
+	// Entities は問題に付いた人物/出来事/場所/王朝（「もっと知る」の導線）。出典と同じ理由で回答後にだけ返す。
+	Entities []domain.EntityRef
+	// Location は地図で回答した場合の距離と得点（選択式・記述式の場合は nil）。
+	Location *LocationResult
 }
 
+// LocationResult は地図での回答の採点結果。
+type LocationResult struct {
+	// DistanceKm は回答した地点から正解の地点までの大円距離（km）。
+	DistanceKm float64
+	Band       geo.Band
+	// Score は距離の帯による得点（0..100）。許容半径以内（BandWithin）なら 100 で、その場合だけ正解とする。
+	Score int32
+	// Correct は正解の地点と許容半径（回答後にだけ返す）。
+	Correct domain.QuestionLocation
+}
+
+// maxAnswerTextRunes は記述式回答の入力上限（極端に長い入力で照合コストが膨らむのを防ぐ）。
+const maxAnswerTextRunes = 100
+
 // GetQuestion は「次の問題」を返す。
 // previousQuestionID が渡された場合、可能な限り直前の問題を避ける。
-func (u *Usecase) GetQuestion(ctx context.Context, requestID string, previousQuestionID string) (domain.Question, error) {
+// acceptLanguage（HTTP の Accept-Language と同じ形式）に合う翻訳があればその言語で、無ければ原文で返す。
+func (u *Usecase) GetQuestion(ctx context.Context, requestID string, previousQuestionID string, acceptLanguage string) (domain.Question, error) {
+	q, err := u.selectQuestion(ctx, requestID, previousQuestionID)
+	if err != nil {
+		return domain.Question{}, err
+	}
+	return u.localizeQuestion(ctx, q, acceptLanguage)
+}
+
+// GetDeckQuestion はデッキの問題を並び順に出題する。
+// previousQuestionID の次の問題を返し、最後の問題（またはデッキに無い問題）の次は先頭に戻る。
+// 混同しやすい点: デッキに出題できる問題が無い場合は、既定問題セットへフォールバックせず FAILED_PRECONDITION を返す。
+func (u *Usecase) GetDeckQuestion(ctx context.Context, deckID string, previousQuestionID string, acceptLanguage string) (domain.Question, error) {
+	if _, err := uuid.Parse(deckID); err != nil {
+		return domain.Question{}, apperror.InvalidArgument("deck_id が不正です", apperror.FieldViolation{Field: "deck_id", Description: "UUID 形式で指定してください"})
+	}
+	if previousQuestionID != "" {
+		if _, err := uuid.Parse(previousQuestionID); err != nil {
+			return domain.Question{}, apperror.InvalidArgument("previous_question_id が不正です", apperror.FieldViolation{Field: "previous_question_id", Description: "UUID 形式で指定してください"})
+		}
+	}
+
+	candidateIDs, err := u.questionRepo.ListQuizCandidateDeckQuestionIDs(ctx, deckID)
+	if err != nil {
+		return domain.Question{}, err
+	}
+	if len(candidateIDs) == 0 {
+		return domain.Question{}, apperror.FailedPrecondition("デッキに出題できる問題がありません")
+	}
+
+	q, err := u.questionRepo.GetQuizQuestion(ctx, nextInDeck(candidateIDs, previousQuestionID))
+	if err != nil {
+		return domain.Question{}, err
+	}
+	return u.localizeQuestion(ctx, q, acceptLanguage)
+}
+
+// GetEntityQuestion はエンティティ（人物/出来事/場所/王朝）が付いた問題から1問を出題する。
+// previousQuestionID は可能な限り避ける（その問題しか無い場合は同じ問題を返す）。
+// 混同しやすい点: 出題できる問題が無い場合は、既定問題セットへフォールバックせず FAILED_PRECONDITION を返す。
+func (u *Usecase) GetEntityQuestion(ctx context.Context, requestID string, entityID string, previousQuestionID string, acceptLanguage string) (domain.Question, error) {
+	if _, err := uuid.Parse(entityID); err != nil {
+		return domain.Question{}, apperror.InvalidArgument("entity_id が不正です", apperror.FieldViolation{Field: "entity_id", Description: "UUID 形式で指定してください"})
+	}
+	if previousQuestionID != "" {
+		if _, err := uuid.Parse(previousQuestionID); err != nil {
+			return domain.Question{}, apperror.InvalidArgument("previous_question_id が不正です", apperror.FieldViolation{Field: "previous_question_id", Description: "UUID 形式で指定してください"})
+		}
+	}
+
+	candidateIDs, err := u.questionRepo.ListQuizCandidateEntityQuestionIDs(ctx, entityID, previousQuestionID)
+	if err != nil {
+		return domain.Question{}, err
+	}
+	if len(candidateIDs) == 0 && previousQuestionID != "" {
+		candidateIDs, err = u.questionRepo.ListQuizCandidateEntityQuestionIDs(ctx, entityID, "")
+		if err != nil {
+			return domain.Question{}, err
+		}
+	}
+	if len(candidateIDs) == 0 {
+		return domain.Question{}, apperror.FailedPrecondition("このエンティティに出題できる問題がありません")
+	}
+
+	q, err := u.questionRepo.GetQuizQuestion(ctx, pickDeterministically(requestID, candidateIDs))
+	if err != nil {
+		return domain.Question{}, err
+	}
+	return u.localizeQuestion(ctx, q, acceptLanguage)
+}
+
+// nextInDeck は出題順に並んだ candidateIDs から previousQuestionID の次の問題を返す。
+func nextInDeck(candidateIDs []string, previousQuestionID string) string {
+	for i, id := range candidateIDs {
+		if id == previousQuestionID {
+			return candidateIDs[(i+1)%len(candidateIDs)]
+		}
+	}
+	return candidateIDs[0]
+}
+
+// nextInPassage は previousQuestionID と同じ史料を共有する問題のうち、出題順で次の問題を返す。
+// 史料の最後の問題、または史料を共有しない問題の場合は ok=false（通常の抽選に戻る）。
+func (u *Usecase) nextInPassage(ctx context.Context, previousQuestionID string) (q domain.Question, ok bool, err error) {
+	ids, err := u.questionRepo.ListPassageQuestionIDs(ctx, previousQuestionID)
+	if err != nil {
+		return domain.Question{}, false, err
+	}
+	for i, id := range ids {
+		if id != previousQuestionID || i+1 >= len(ids) {
+			continue
+		}
+		q, err := u.questionRepo.GetQuizQuestion(ctx, ids[i+1])
+		if err != nil {
+			return domain.Question{}, false, err
+		}
+		return q, true, nil
+	}
+	return domain.Question{}, false, nil
+}
+
+// selectQuestion は出題する問題を原文で選ぶ。
+func (u *Usecase) selectQuestion(ctx context.Context, requestID string, previousQuestionID string) (domain.Question, error) {
 	// previous_question_id は任意だが、入っているなら UUID として妥当かをチェックする。
 	if previousQuestionID != "" {
 		if _, err := uuid.Parse(previousQuestionID); err != nil {
@@ -45,8 +175,20 @@ func (u *Usecase) GetQuestion(ctx context.Context, requestID string, previousQue
 		}
 	}
 
+	// 史料を共有する問題の途中なら、抽選せずに同じ史料の次の問題を続けて出題する。
+	if previousQuestionID != "" {
+		next, ok, err := u.nextInPassage(ctx, previousQuestionID)
+		if err != nil {
+			return domain.Question{}, err
+		}
+		if ok {
+			return next, nil
+		}
+	}
+
 	// 保存済みの問題（= DBの questions）を優先し、無い場合は既定セットへフォールバックする。
 	// ここでは「ユーザー作成が1件でもあるなら、system も含めた全体から抽選する」方針にする。
+	// 混同しやすい点: 史料を共有する問題は、出題できる子の先頭だけが抽選の候補になる（2問目以降は nextInPassage で出題する）。
 	nonSystemIDs, err := u.questionRepo.ListQuizCandidateNonSystemQuestionIDs(ctx, previousQuestionID)
 	if err != nil {
 		return domain.Question{}, err
@@ -91,7 +233,50 @@ func (u *Usecase) GetQuestion(ctx context.Context, requestID string, previousQue
 	return q, nil
 }
 
+// localizeQuestion は希望の言語の翻訳があれば、問題文/選択肢/解説を差し替える。
+// 混同しやすい点: 選択肢の ID と正解は原文のまま使い、ラベルだけを ordinal で対応付けて差し替える（判定は言語に依らない）。
+// 原文の更新後に直されていない翻訳は、選択肢の意味がずれている可能性があるため使わない。
+func (u *Usecase) localizeQuestion(ctx context.Context, q domain.Question, acceptLanguage string) (domain.Question, error) {
+	q.Locale = domain.CanonicalLocale
+	// 既定問題セット（DB に無い問題）には翻訳が無い。翻訳の希望が無い場合は DB も引かない。
+	if acceptLanguage == "" || isDefaultQuestionID(q.ID) {
+		return q, nil
+	}
+
+	translations, err := u.questionRepo.ListQuestionTranslations(ctx, q.ID)
+	if err != nil {
+		return domain.Question{}, err
+	}
+	usable := make(map[string]domain.QuestionTranslation, len(translations))
+	available := make([]string, 0, len(translations))
+	for _, t := range translations {
+		if t.Stale || len(t.Choices) != len(q.Choices) {
+			continue
+		}
+		usable[t.Locale] = t
+		available = append(available, t.Locale)
+	}
+
+	t, ok := usable[locale.Negotiate(acceptLanguage, available)]
+	if !ok {
+		return q, nil
+	}
+	localized := q
+	localized.Locale = t.Locale
+	localized.Prompt = t.Prompt
+	localized.Explanation = t.Explanation
+	localized.Choices = make([]domain.Choice, 0, len(q.Choices))
+	for _, c := range q.Choices {
+		if c.Ordinal >= 0 && int(c.Ordinal) < len(t.Choices) {
+			c.Label = t.Choices[c.Ordinal]
+		}
+		localized.Choices = append(localized.Choices, c)
+	}
+	return localized, nil
+}
+
 // SubmitAnswer は回答を判定し、（認証済みなら）attempt を保存して結果を返す。
+// 混同しやすい点: 地図でだけ答える問題には SubmitLocationAnswer で答えてもらう（FAILED_PRECONDITION）。
 func (u *Usecase) SubmitAnswer(ctx context.Context, userID string, questionID string, selectedChoiceID string) (SubmitAnswerResult, error) {
 	if questionID == "" {
 		return SubmitAnswerResult{}, apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
@@ -116,6 +301,10 @@ func (u *Usecase) SubmitAnswer(ctx context.Context, userID string, questionID st
 		}
 		return SubmitAnswerResult{}, err
 	}
+	if correctChoiceID == "" {
+		// 地図でだけ答える問題は正解の選択肢を持たない。
+		return SubmitAnswerResult{}, apperror.FailedPrecondition("この問題は地図で答えてください")
+	}
 
 	belongs, err := u.questionRepo.ChoiceBelongsToQuestion(ctx, questionID, selectedChoiceID)
 	if err != nil {
@@ -128,6 +317,15 @@ func (u *Usecase) SubmitAnswer(ctx context.Context, userID string, questionID st
 	isCorrect := selectedChoiceID == correctChoiceID
 	attemptID := ""
 
+	citations, err := u.questionRepo.ListCitations(ctx, questionID)
+	if err != nil {
+		return SubmitAnswerResult{}, err
+	}
+	entities, err := u.questionRepo.ListQuestionEntities(ctx, questionID)
+	if err != nil {
+		return SubmitAnswerResult{}, err
+	}
+
 	// 未ログインでもクイズは遊べるが、履歴（attempt）はログイン後のみ保存する。
 	if userID != "" {
 		if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
@@ -143,6 +341,153 @@ func (u *Usecase) SubmitAnswer(ctx context.Context, userID string, questionID st
 		IsCorrect:       isCorrect,
 		CorrectChoiceID: correctChoiceID,
 		AttemptID:       attemptID,
+		Citations:       citations,
+		Entities:        entities,
+	}, nil
+}
+
+// SubmitTextAnswer は記述式の回答を正解表記（正解ラベル + 別表記）と照合し、（認証済みなら）attempt を保存する。
+// 表記揺れ（ひらがな/カタカナ、全角/半角、中黒）と軽微なタイプミスは answermatch で吸収する。
+// 地図でだけ答える問題は記述式では答えられない（FAILED_PRECONDITION）。
+func (u *Usecase) SubmitTextAnswer(ctx context.Context, userID string, questionID string, answerText string) (SubmitAnswerResult, error) {
+	if questionID == "" {
+		return SubmitAnswerResult{}, apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(questionID); err != nil {
+		return SubmitAnswerResult{}, apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+	}
+	answerText = strings.TrimSpace(answerText)
+	if answerText == "" {
+		return SubmitAnswerResult{}, apperror.InvalidArgument("answer_text が空です", apperror.FieldViolation{Field: "answer_text", Description: "必須です"})
+	}
+	if utf8.RuneCountInString(answerText) > maxAnswerTextRunes {
+		return SubmitAnswerResult{}, apperror.InvalidArgument("answer_text が長すぎます", apperror.FieldViolation{Field: "answer_text", Description: "100文字以内で入力してください"})
+	}
+
+	accepted, err := u.questionRepo.ListAcceptedAnswers(ctx, questionID)
+	if err != nil {
+		// DBに存在しない場合は既定問題セットも見る（DBが空のケース）。
+		if apperror.IsCode(err, apperror.CodeNotFound) {
+			if defaultAccepted, ok := defaultAcceptedAnswers(questionID); ok {
+				match := answermatch.Match(answerText, defaultAccepted)
+				return SubmitAnswerResult{
+					IsCorrect:       match.Matched,
+					CorrectChoiceID: defaultCorrectChoiceIDByQuestionID[questionID],
+					MatchedAnswer:   match.Answer,
+				}, nil
+			}
+		}
+		return SubmitAnswerResult{}, err
+	}
+
+	correctChoiceID, err := u.questionRepo.GetCorrectChoiceID(ctx, questionID)
+	if err != nil {
+		return SubmitAnswerResult{}, err
+	}
+	if correctChoiceID == "" {
+		// 地図でだけ答える問題は正解の選択肢を持たない。
+		return SubmitAnswerResult{}, apperror.FailedPrecondition("この問題は地図で答えてください")
+	}
+
+	citations, err := u.questionRepo.ListCitations(ctx, questionID)
+	if err != nil {
+		return SubmitAnswerResult{}, err
+	}
+	entities, err := u.questionRepo.ListQuestionEntities(ctx, questionID)
+	if err != nil {
+		return SubmitAnswerResult{}, err
+	}
+
+	match := answermatch.Match(answerText, accepted)
+	attemptID := ""
+
+	if userID != "" {
+		if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+			return SubmitAnswerResult{}, err
+		}
+		attemptID, err = u.attemptRepo.CreateTextAttempt(ctx, userID, questionID, answerText, match.Matched)
+		if err != nil {
+			return SubmitAnswerResult{}, err
+		}
+	}
+
+	return SubmitAnswerResult{
+		IsCorrect:       match.Matched,
+		CorrectChoiceID: correctChoiceID,
+		AttemptID:       attemptID,
+		MatchedAnswer:   match.Answer,
+		Citations:       citations,
+		Entities:        entities,
+	}, nil
+}
+
+// SubmitLocationAnswer は地図で回答した地点を正解の地点と比べ、距離の帯で採点する（認証済みなら attempt を保存する）。
+// 許容半径以内を正解とし、それより遠い場合も距離に応じた部分点（LocationResult.Score）を返す。
+// 混同しやすい点: 正解の地点が無い問題（既定問題セットを含む）は地図では答えられないため FAILED_PRECONDITION。
+func (u *Usecase) SubmitLocationAnswer(ctx context.Context, userID string, questionID string, answer geo.Point) (SubmitAnswerResult, error) {
+	if questionID == "" {
+		return SubmitAnswerResult{}, apperror.InvalidArgument("question_id が空です", apperror.FieldViolation{Field: "question_id", Description: "必須です"})
+	}
+	if _, err := uuid.Parse(questionID); err != nil {
+		return SubmitAnswerResult{}, apperror.InvalidArgument("question_id が不正です", apperror.FieldViolation{Field: "question_id", Description: "UUID 形式で指定してください"})
+	}
+	if !answer.Valid() {
+		return SubmitAnswerResult{}, apperror.InvalidArgument("location が不正です", apperror.FieldViolation{Field: "location", Description: "緯度は -90..90、経度は -180..180 の範囲で指定してください"})
+	}
+
+	// 混同しやすい点: 出題できない問題（下書き/非表示など）の地点を返さないよう、先に GetCorrectChoiceID で確かめる。
+	correctChoiceID, err := u.questionRepo.GetCorrectChoiceID(ctx, questionID)
+	if err != nil {
+		if apperror.IsCode(err, apperror.CodeNotFound) {
+			if _, ok := defaultCorrectChoiceIDByQuestionID[questionID]; ok {
+				return SubmitAnswerResult{}, apperror.FailedPrecondition("この問題は地図では答えられません")
+			}
+		}
+		return SubmitAnswerResult{}, err
+	}
+	location, err := u.questionRepo.GetQuestionLocation(ctx, questionID)
+	if err != nil {
+		return SubmitAnswerResult{}, err
+	}
+	if location == nil {
+		return SubmitAnswerResult{}, apperror.FailedPrecondition("この問題は地図では答えられません")
+	}
+	citations, err := u.questionRepo.ListCitations(ctx, questionID)
+	if err != nil {
+		return SubmitAnswerResult{}, err
+	}
+	entities, err := u.questionRepo.ListQuestionEntities(ctx, questionID)
+	if err != nil {
+		return SubmitAnswerResult{}, err
+	}
+
+	distanceKm := geo.DistanceKm(answer, location.Point)
+	band, score := geo.Score(distanceKm, location.RadiusKm)
+	isCorrect := band == geo.BandWithin
+	attemptID := ""
+
+	if userID != "" {
+		if err := u.userRepo.EnsureUserExists(ctx, userID); err != nil {
+			return SubmitAnswerResult{}, err
+		}
+		attemptID, err = u.attemptRepo.CreateLocationAttempt(ctx, userID, questionID, answer, distanceKm, isCorrect)
+		if err != nil {
+			return SubmitAnswerResult{}, err
+		}
+	}
+
+	return SubmitAnswerResult{
+		IsCorrect:       isCorrect,
+		CorrectChoiceID: correctChoiceID,
+		AttemptID:       attemptID,
+		Citations:       citations,
+		Entities:        entities,
+		Location: &LocationResult{
+			DistanceKm: distanceKm,
+			Band:       band,
+			Score:      score,
+			Correct:    *location,
+		},
 	}, nil
 }
 
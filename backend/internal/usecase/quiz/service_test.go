@@ -3,10 +3,12 @@ package quiz
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/history-quiz/historyquiz/internal/domain"
 	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
 )
 
 // fakeQuizQuestionRepo は quiz.Usecase のテスト用に、QuestionRepository の必要メソッドだけを差し替える。
@@ -15,9 +17,17 @@ type fakeQuizQuestionRepo struct {
 	listCandidateQuestionIDsFn        func(ctx context.Context, previousQuestionID string) ([]string, error)
 	listCandidateSystemQuestionIDsFn  func(ctx context.Context, previousQuestionID string) ([]string, error)
 	listCandidateNonSystemQuestionIDs func(ctx context.Context, previousQuestionID string) ([]string, error)
+	listCandidateDeckQuestionIDsFn    func(ctx context.Context, deckID string) ([]string, error)
+	listCandidateEntityQuestionIDsFn  func(ctx context.Context, entityID string, previousQuestionID string) ([]string, error)
 	getQuizQuestionFn                 func(ctx context.Context, questionID string) (domain.Question, error)
 	getCorrectChoiceIDFn              func(ctx context.Context, questionID string) (string, error)
 	choiceBelongsToQuestionFn         func(ctx context.Context, questionID string, choiceID string) (bool, error)
+	listAcceptedAnswersFn             func(ctx context.Context, questionID string) ([]string, error)
+	listCitationsFn                   func(ctx context.Context, questionID string) ([]domain.Citation, error)
+	getQuestionLocationFn             func(ctx context.Context, questionID string) (*domain.QuestionLocation, error)
+	listPassageQuestionIDsFn          func(ctx context.Context, questionID string) ([]string, error)
+	listQuestionEntitiesFn            func(ctx context.Context, questionID string) ([]domain.EntityRef, error)
+	listTranslationsFn                func(ctx context.Context, questionID string) ([]domain.QuestionTranslation, error)
 }
 
 func (f *fakeQuizQuestionRepo) ListQuizCandidateQuestionIDs(ctx context.Context, previousQuestionID string) ([]string, error) {
@@ -29,6 +39,12 @@ func (f *fakeQuizQuestionRepo) ListQuizCandidateSystemQuestionIDs(ctx context.Co
 func (f *fakeQuizQuestionRepo) ListQuizCandidateNonSystemQuestionIDs(ctx context.Context, previousQuestionID string) ([]string, error) {
 	return f.listCandidateNonSystemQuestionIDs(ctx, previousQuestionID)
 }
+func (f *fakeQuizQuestionRepo) ListQuizCandidateDeckQuestionIDs(ctx context.Context, deckID string) ([]string, error) {
+	return f.listCandidateDeckQuestionIDsFn(ctx, deckID)
+}
+func (f *fakeQuizQuestionRepo) ListQuizCandidateEntityQuestionIDs(ctx context.Context, entityID string, previousQuestionID string) ([]string, error) {
+	return f.listCandidateEntityQuestionIDsFn(ctx, entityID, previousQuestionID)
+}
 func (f *fakeQuizQuestionRepo) GetQuizQuestion(ctx context.Context, questionID string) (domain.Question, error) {
 	return f.getQuizQuestionFn(ctx, questionID)
 }
@@ -38,33 +54,123 @@ func (f *fakeQuizQuestionRepo) GetCorrectChoiceID(ctx context.Context, questionI
 func (f *fakeQuizQuestionRepo) ChoiceBelongsToQuestion(ctx context.Context, questionID string, choiceID string) (bool, error) {
 	return f.choiceBelongsToQuestionFn(ctx, questionID, choiceID)
 }
+func (f *fakeQuizQuestionRepo) ListAcceptedAnswers(ctx context.Context, questionID string) ([]string, error) {
+	return f.listAcceptedAnswersFn(ctx, questionID)
+}
+
+// ListCitations は listCitationsFn が未設定の場合「出典なし」として扱う（回答のテストで毎回設定しなくてよいように）。
+func (f *fakeQuizQuestionRepo) ListCitations(ctx context.Context, questionID string) ([]domain.Citation, error) {
+	if f.listCitationsFn == nil {
+		return nil, nil
+	}
+	return f.listCitationsFn(ctx, questionID)
+}
+
+// GetQuestionLocation は getQuestionLocationFn が未設定の場合「地点なし」として扱う。
+func (f *fakeQuizQuestionRepo) GetQuestionLocation(ctx context.Context, questionID string) (*domain.QuestionLocation, error) {
+	if f.getQuestionLocationFn == nil {
+		return nil, nil
+	}
+	return f.getQuestionLocationFn(ctx, questionID)
+}
+
+// ListPassageQuestionIDs は listPassageQuestionIDsFn が未設定の場合「史料なし」として扱う。
+func (f *fakeQuizQuestionRepo) ListPassageQuestionIDs(ctx context.Context, questionID string) ([]string, error) {
+	if f.listPassageQuestionIDsFn == nil {
+		return nil, nil
+	}
+	return f.listPassageQuestionIDsFn(ctx, questionID)
+}
+
+// ListQuestionEntities は listQuestionEntitiesFn が未設定の場合「エンティティなし」として扱う。
+func (f *fakeQuizQuestionRepo) ListQuestionEntities(ctx context.Context, questionID string) ([]domain.EntityRef, error) {
+	if f.listQuestionEntitiesFn == nil {
+		return nil, nil
+	}
+	return f.listQuestionEntitiesFn(ctx, questionID)
+}
+
+// ListQuestionTranslations は listTranslationsFn が未設定の場合「翻訳なし」として扱う。
+func (f *fakeQuizQuestionRepo) ListQuestionTranslations(ctx context.Context, questionID string) ([]domain.QuestionTranslation, error) {
+	if f.listTranslationsFn == nil {
+		return nil, nil
+	}
+	return f.listTranslationsFn(ctx, questionID)
+}
 
 // 以降の QuestionRepository メソッドは quiz.Usecase のテストでは不要のため、panic させる。
 // NOTE: テストが意図せず別メソッドに依存した場合に、早期に気付けるようにする。
 func (*fakeQuizQuestionRepo) CreateQuestion(context.Context, string, domain.QuestionDraft) (domain.QuestionDetail, error) {
 	panic("not used in quiz usecase tests")
 }
-func (*fakeQuizQuestionRepo) UpdateQuestion(context.Context, string, string, domain.QuestionDraft) (domain.QuestionDetail, error) {
+func (*fakeQuizQuestionRepo) CreateQuestions(context.Context, string, []domain.QuestionDraft) ([]domain.QuestionDetail, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) UpdateQuestion(context.Context, string, string, int64, domain.QuestionDraft, *domain.QuestionSchedule) (domain.QuestionDetail, error) {
 	panic("not used in quiz usecase tests")
 }
 func (*fakeQuizQuestionRepo) GetMyQuestion(context.Context, string, string) (domain.QuestionDetail, error) {
 	panic("not used in quiz usecase tests")
 }
-func (*fakeQuizQuestionRepo) ListMyQuestions(context.Context, string, int32) ([]domain.QuestionSummary, error) {
+func (*fakeQuizQuestionRepo) SoftDeleteQuestion(context.Context, string, string) error {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) ListMyQuestions(context.Context, string, domain.MyQuestionsQuery) ([]domain.QuestionSummary, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) ListMyQuestionDetails(context.Context, string, string, int32) ([]domain.QuestionDetail, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) FindSimilarQuestions(context.Context, string, string, []string, float64, int32) ([]domain.SimilarQuestion, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) ListDistractorOccurrences(context.Context, domain.DistractorQuery) ([]domain.DistractorOccurrence, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) ListSimilarQuestionPairs(context.Context, float64, int32) ([]domain.SimilarQuestionPair, error) {
 	panic("not used in quiz usecase tests")
 }
 func (*fakeQuizQuestionRepo) GetQuestionAuthor(context.Context, string) (string, bool, error) {
 	panic("not used in quiz usecase tests")
 }
+func (*fakeQuizQuestionRepo) ListUncitedQuestions(context.Context, string, int32) ([]domain.UncitedQuestion, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) UpsertQuestionTranslation(context.Context, string, domain.QuestionTranslation) (domain.QuestionTranslation, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) DeleteQuestionTranslation(context.Context, string, string) error {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) GetQuestionAttemptStats(context.Context, string, time.Time, domain.StrongPlayerCriteria) (domain.QuestionStats, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) ForkQuestion(context.Context, string, string) (domain.QuestionDetail, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) UpdateQuestionStatus(context.Context, string, string, domain.QuestionStatus, domain.QuestionStatus) (domain.QuestionDetail, error) {
+	panic("not used in quiz usecase tests")
+}
+func (*fakeQuizQuestionRepo) ApplyDueQuestionSchedules(context.Context, time.Time, int32) ([]domain.QuestionStatusChange, error) {
+	panic("not used in quiz usecase tests")
+}
 
 type fakeAttemptRepo struct {
-	createAttemptFn func(ctx context.Context, userID, questionID, selectedChoiceID string, isCorrect bool) (string, error)
+	createAttemptFn         func(ctx context.Context, userID, questionID, selectedChoiceID string, isCorrect bool) (string, error)
+	createTextAttemptFn     func(ctx context.Context, userID, questionID, answerText string, isCorrect bool) (string, error)
+	createLocationAttemptFn func(ctx context.Context, userID, questionID string, answer geo.Point, distanceKm float64, isCorrect bool) (string, error)
 }
 
 func (f *fakeAttemptRepo) CreateAttempt(ctx context.Context, userID string, questionID string, selectedChoiceID string, isCorrect bool) (string, error) {
 	return f.createAttemptFn(ctx, userID, questionID, selectedChoiceID, isCorrect)
 }
-func (*fakeAttemptRepo) ListMyAttempts(context.Context, string, int32) ([]domain.Attempt, error) {
+func (f *fakeAttemptRepo) CreateTextAttempt(ctx context.Context, userID string, questionID string, answerText string, isCorrect bool) (string, error) {
+	return f.createTextAttemptFn(ctx, userID, questionID, answerText, isCorrect)
+}
+func (f *fakeAttemptRepo) CreateLocationAttempt(ctx context.Context, userID string, questionID string, answer geo.Point, distanceKm float64, isCorrect bool) (string, error) {
+	return f.createLocationAttemptFn(ctx, userID, questionID, answer, distanceKm, isCorrect)
+}
+func (*fakeAttemptRepo) ListMyAttempts(context.Context, string, *domain.AttemptListCursor, int32) ([]domain.Attempt, error) {
 	panic("not used in quiz usecase tests")
 }
 func (*fakeAttemptRepo) GetMyStats(context.Context, string) (domain.Stats, error) {
@@ -105,7 +211,7 @@ func TestUsecase_GetQuestion_InvalidPreviousQuestionID(t *testing.T) {
 		}},
 	)
 
-	_, err := u.GetQuestion(context.Background(), "req-1", "not-a-uuid")
+	_, err := u.GetQuestion(context.Background(), "req-1", "not-a-uuid", "")
 	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
 		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
 	}
@@ -143,7 +249,7 @@ func TestUsecase_GetQuestion_FallbackToDefaultWhenDBEmpty(t *testing.T) {
 		}},
 	)
 
-	q, err := u.GetQuestion(context.Background(), "req-1", "")
+	q, err := u.GetQuestion(context.Background(), "req-1", "", "")
 	if err != nil {
 		t.Fatalf("err should be nil: %v", err)
 	}
@@ -191,7 +297,7 @@ func TestUsecase_GetQuestion_ExcludePreviousButGuaranteeOne(t *testing.T) {
 		}},
 	)
 
-	q, err := u.GetQuestion(context.Background(), "req-1", previousID)
+	q, err := u.GetQuestion(context.Background(), "req-1", previousID, "")
 	if err != nil {
 		t.Fatalf("err should be nil: %v", err)
 	}
@@ -203,6 +309,67 @@ func TestUsecase_GetQuestion_ExcludePreviousButGuaranteeOne(t *testing.T) {
 	}
 }
 
+func TestUsecase_GetQuestion_ContinuesPassageInOrder(t *testing.T) {
+	t.Parallel()
+
+	firstID := mustUUID(t)
+	secondID := mustUUID(t)
+	thirdID := mustUUID(t)
+	drawnID := mustUUID(t)
+
+	tests := []struct {
+		name       string
+		previousID string
+		wantID     string
+	}{
+		{name: "史料の途中は次の問題を続けて出題する", previousID: firstID, wantID: secondID},
+		{name: "史料の2問目の次は3問目", previousID: secondID, wantID: thirdID},
+		{name: "史料の最後の問題の次は抽選に戻る", previousID: thirdID, wantID: drawnID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := NewUsecase(
+				&fakeQuizQuestionRepo{
+					listPassageQuestionIDsFn: func(_ context.Context, gotQuestionID string) ([]string, error) {
+						if gotQuestionID != tt.previousID {
+							t.Fatalf("ListPassageQuestionIDs args mismatch: got=%s want=%s", gotQuestionID, tt.previousID)
+						}
+						return []string{firstID, secondID, thirdID}, nil
+					},
+					listCandidateNonSystemQuestionIDs: func(context.Context, string) ([]string, error) {
+						if tt.wantID != drawnID {
+							t.Fatal("史料の途中では抽選しない想定です")
+						}
+						return []string{drawnID}, nil
+					},
+					listCandidateQuestionIDsFn: func(context.Context, string) ([]string, error) {
+						return []string{drawnID}, nil
+					},
+					listCandidateSystemQuestionIDsFn: func(context.Context, string) ([]string, error) {
+						t.Fatal("nonSystemIDs が空でない場合は system 候補を使わない想定です")
+						return nil, nil
+					},
+					getQuizQuestionFn: func(_ context.Context, questionID string) (domain.Question, error) {
+						return domain.Question{ID: questionID, Prompt: "p", Choices: []domain.Choice{}}, nil
+					},
+				},
+				&fakeAttemptRepo{},
+				&fakeUserRepo{},
+			)
+
+			q, err := u.GetQuestion(context.Background(), "req-1", tt.previousID, "")
+			if err != nil {
+				t.Fatalf("err should be nil: %v", err)
+			}
+			if q.ID != tt.wantID {
+				t.Fatalf("q.ID = %s, want %s", q.ID, tt.wantID)
+			}
+		})
+	}
+}
+
 func TestUsecase_GetQuestion_FallbackToDefaultWhenSelectedNotFound(t *testing.T) {
 	t.Parallel()
 
@@ -228,7 +395,7 @@ func TestUsecase_GetQuestion_FallbackToDefaultWhenSelectedNotFound(t *testing.T)
 		}},
 	)
 
-	q, err := u.GetQuestion(context.Background(), "req-1", "")
+	q, err := u.GetQuestion(context.Background(), "req-1", "", "")
 	if err != nil {
 		t.Fatalf("err should be nil: %v", err)
 	}
@@ -240,6 +407,209 @@ func TestUsecase_GetQuestion_FallbackToDefaultWhenSelectedNotFound(t *testing.T)
 	}
 }
 
+func TestUsecase_GetQuestion_LocalizesByAcceptLanguage(t *testing.T) {
+	t.Parallel()
+
+	questionID := mustUUID(t)
+	original := domain.Question{
+		ID:     questionID,
+		Prompt: "ローマ帝国の首都は？",
+		Choices: []domain.Choice{
+			{ID: "c1", Label: "ローマ", Ordinal: 0},
+			{ID: "c2", Label: "アテネ", Ordinal: 1},
+		},
+		Explanation: "解説",
+	}
+	translations := []domain.QuestionTranslation{
+		{Locale: "en", Prompt: "Capital of the Roman Empire?", Choices: []string{"Rome", "Athens"}, Explanation: "explanation"},
+		// 原文の更新後に直されていない翻訳は使わない。
+		{Locale: "fr", Prompt: "Capitale ?", Choices: []string{"Rome", "Athènes"}, Stale: true},
+	}
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		wantLocale     string
+		wantPrompt     string
+		wantLabel      string
+	}{
+		{name: "一致する翻訳を使う", acceptLanguage: "en-US,en;q=0.9", wantLocale: "en", wantPrompt: "Capital of the Roman Empire?", wantLabel: "Athens"},
+		{name: "古い翻訳しか無ければ原文", acceptLanguage: "fr", wantLocale: domain.CanonicalLocale, wantPrompt: original.Prompt, wantLabel: "アテネ"},
+		{name: "日本語を優先するなら原文", acceptLanguage: "ja,en;q=0.5", wantLocale: domain.CanonicalLocale, wantPrompt: original.Prompt, wantLabel: "アテネ"},
+		{name: "指定なしは翻訳を引かずに原文", acceptLanguage: "", wantLocale: domain.CanonicalLocale, wantPrompt: original.Prompt, wantLabel: "アテネ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := NewUsecase(
+				&fakeQuizQuestionRepo{
+					listCandidateNonSystemQuestionIDs: func(context.Context, string) ([]string, error) { return []string{questionID}, nil },
+					listCandidateQuestionIDsFn:        func(context.Context, string) ([]string, error) { return []string{questionID}, nil },
+					getQuizQuestionFn: func(context.Context, string) (domain.Question, error) {
+						q := original
+						q.Choices = append([]domain.Choice(nil), original.Choices...)
+						return q, nil
+					},
+					listTranslationsFn: func(context.Context, string) ([]domain.QuestionTranslation, error) {
+						if tt.acceptLanguage == "" {
+							t.Fatal("言語の指定が無い場合、翻訳は引かない想定です")
+						}
+						return translations, nil
+					},
+				},
+				&fakeAttemptRepo{},
+				&fakeUserRepo{},
+			)
+
+			q, err := u.GetQuestion(context.Background(), "req-1", "", tt.acceptLanguage)
+			if err != nil {
+				t.Fatalf("err should be nil: %v", err)
+			}
+			if q.Locale != tt.wantLocale || q.Prompt != tt.wantPrompt {
+				t.Fatalf("locale/prompt mismatch: got=(%s, %s) want=(%s, %s)", q.Locale, q.Prompt, tt.wantLocale, tt.wantPrompt)
+			}
+			// 選択肢の ID は言語に依らず原文のまま。
+			if q.Choices[1].ID != "c2" || q.Choices[1].Label != tt.wantLabel {
+				t.Fatalf("choice mismatch: got=%+v want label=%s", q.Choices[1], tt.wantLabel)
+			}
+		})
+	}
+}
+
+func TestUsecase_GetDeckQuestion_FollowsDeckOrder(t *testing.T) {
+	t.Parallel()
+
+	deckID := mustUUID(t)
+	q1, q2, q3 := mustUUID(t), mustUUID(t), mustUUID(t)
+
+	tests := []struct {
+		name       string
+		previousID string
+		wantID     string
+	}{
+		{name: "最初は先頭", previousID: "", wantID: q1},
+		{name: "直前の次", previousID: q2, wantID: q3},
+		{name: "最後の次は先頭に戻る", previousID: q3, wantID: q1},
+		{name: "デッキに無い問題の次は先頭", previousID: mustUUID(t), wantID: q1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := NewUsecase(
+				&fakeQuizQuestionRepo{
+					listCandidateDeckQuestionIDsFn: func(_ context.Context, gotDeckID string) ([]string, error) {
+						if gotDeckID != deckID {
+							t.Fatalf("deck_id mismatch: got=%s want=%s", gotDeckID, deckID)
+						}
+						return []string{q1, q2, q3}, nil
+					},
+					getQuizQuestionFn: func(_ context.Context, questionID string) (domain.Question, error) {
+						return domain.Question{ID: questionID, Prompt: "p"}, nil
+					},
+				},
+				&fakeAttemptRepo{},
+				&fakeUserRepo{},
+			)
+
+			q, err := u.GetDeckQuestion(context.Background(), deckID, tt.previousID, "")
+			if err != nil {
+				t.Fatalf("err should be nil: %v", err)
+			}
+			if q.ID != tt.wantID {
+				t.Fatalf("q.ID mismatch: got=%s want=%s", q.ID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestUsecase_GetDeckQuestion_NoPlayableQuestions(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuizQuestionRepo{
+			listCandidateDeckQuestionIDsFn: func(context.Context, string) ([]string, error) { return nil, nil },
+			getQuizQuestionFn: func(context.Context, string) (domain.Question, error) {
+				t.Fatal("候補が空の場合、GetQuizQuestion は呼ばれない想定です")
+				return domain.Question{}, nil
+			},
+		},
+		&fakeAttemptRepo{},
+		&fakeUserRepo{},
+	)
+
+	// デッキで遊んでいる場合は、既定問題セットへフォールバックしない。
+	_, err := u.GetDeckQuestion(context.Background(), mustUUID(t), "", "")
+	if !apperror.IsCode(err, apperror.CodeFailedPrecondition) {
+		t.Fatalf("FAILED_PRECONDITION を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_GetEntityQuestion_AvoidsPreviousButGuaranteesOne(t *testing.T) {
+	t.Parallel()
+
+	entityID := mustUUID(t)
+	only := mustUUID(t)
+
+	var calls []string
+	u := NewUsecase(
+		&fakeQuizQuestionRepo{
+			listCandidateEntityQuestionIDsFn: func(_ context.Context, gotEntityID string, previousQuestionID string) ([]string, error) {
+				if gotEntityID != entityID {
+					t.Fatalf("entity_id mismatch: got=%s want=%s", gotEntityID, entityID)
+				}
+				calls = append(calls, previousQuestionID)
+				if previousQuestionID == only {
+					return nil, nil
+				}
+				return []string{only}, nil
+			},
+			getQuizQuestionFn: func(_ context.Context, questionID string) (domain.Question, error) {
+				return domain.Question{ID: questionID, Prompt: "p"}, nil
+			},
+		},
+		&fakeAttemptRepo{},
+		&fakeUserRepo{},
+	)
+
+	q, err := u.GetEntityQuestion(context.Background(), "req-1", entityID, only, "")
+	if err != nil {
+		t.Fatalf("err should be nil: %v", err)
+	}
+	if q.ID != only || len(calls) != 2 || calls[1] != "" {
+		t.Fatalf("直前の問題しか無い場合は同じ問題を返すことを期待しました: q=%s calls=%v", q.ID, calls)
+	}
+
+	if _, err := u.GetEntityQuestion(context.Background(), "req-1", "not-uuid", "", ""); !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_GetEntityQuestion_NoPlayableQuestions(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuizQuestionRepo{
+			listCandidateEntityQuestionIDsFn: func(context.Context, string, string) ([]string, error) { return nil, nil },
+			getQuizQuestionFn: func(context.Context, string) (domain.Question, error) {
+				t.Fatal("候補が空の場合、GetQuizQuestion は呼ばれない想定です")
+				return domain.Question{}, nil
+			},
+		},
+		&fakeAttemptRepo{},
+		&fakeUserRepo{},
+	)
+
+	// エンティティで絞っている場合も、既定問題セットへフォールバックしない。
+	_, err := u.GetEntityQuestion(context.Background(), "req-1", mustUUID(t), "", "")
+	if !apperror.IsCode(err, apperror.CodeFailedPrecondition) {
+		t.Fatalf("FAILED_PRECONDITION を期待しました: err=%v", err)
+	}
+}
+
 func TestUsecase_SubmitAnswer_SavesAttemptWhenLoggedIn(t *testing.T) {
 	t.Parallel()
 
@@ -292,6 +662,43 @@ func TestUsecase_SubmitAnswer_SavesAttemptWhenLoggedIn(t *testing.T) {
 	}
 }
 
+func TestUsecase_SubmitAnswer_ReturnsCitationsAndEntities(t *testing.T) {
+	t.Parallel()
+
+	questionID := mustUUID(t)
+	correctChoiceID := mustUUID(t)
+	citations := []domain.Citation{{Kind: domain.CitationKindPrimarySource, Title: "御成敗式目"}}
+	entities := []domain.EntityRef{{ID: mustUUID(t), Kind: domain.EntityKindPerson, Name: "北条泰時"}}
+
+	u := NewUsecase(
+		&fakeQuizQuestionRepo{
+			getCorrectChoiceIDFn:      func(context.Context, string) (string, error) { return correctChoiceID, nil },
+			choiceBelongsToQuestionFn: func(context.Context, string, string) (bool, error) { return true, nil },
+			listCitationsFn: func(_ context.Context, gotQuestionID string) ([]domain.Citation, error) {
+				if gotQuestionID != questionID {
+					t.Fatalf("ListCitations args mismatch: got=%s want=%s", gotQuestionID, questionID)
+				}
+				return citations, nil
+			},
+			listQuestionEntitiesFn: func(context.Context, string) ([]domain.EntityRef, error) { return entities, nil },
+		},
+		&fakeAttemptRepo{},
+		&fakeUserRepo{},
+	)
+
+	// 未ログインでも回答後は出典と関連エンティティを返す。
+	res, err := u.SubmitAnswer(context.Background(), "", questionID, correctChoiceID)
+	if err != nil {
+		t.Fatalf("err should be nil: %v", err)
+	}
+	if len(res.Citations) != 1 || res.Citations[0].Title != "御成敗式目" {
+		t.Fatalf("出典を返す想定です: %+v", res.Citations)
+	}
+	if len(res.Entities) != 1 || res.Entities[0].Name != "北条泰時" {
+		t.Fatalf("関連エンティティを返す想定です: %+v", res.Entities)
+	}
+}
+
 func TestUsecase_SubmitAnswer_DefaultQuestion_DoesNotCreateAttemptEvenWhenLoggedIn(t *testing.T) {
 	t.Parallel()
 
@@ -373,3 +780,291 @@ func TestUsecase_SubmitAnswer_InvalidChoiceRelation(t *testing.T) {
 	}
 }
 
+func TestUsecase_SubmitAnswer_LocationOnlyQuestion(t *testing.T) {
+	t.Parallel()
+
+	// 地図でだけ答える問題は正解の選択肢を持たない（GetCorrectChoiceID が空を返す）。
+	newUsecase := func(t *testing.T) *Usecase {
+		return NewUsecase(
+			&fakeQuizQuestionRepo{
+				getCorrectChoiceIDFn:  func(context.Context, string) (string, error) { return "", nil },
+				listAcceptedAnswersFn: func(context.Context, string) ([]string, error) { return []string{}, nil },
+				choiceBelongsToQuestionFn: func(context.Context, string, string) (bool, error) {
+					t.Fatal("地図でだけ答える問題では選択肢を確かめない想定です")
+					return false, nil
+				},
+			},
+			&fakeAttemptRepo{},
+			&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+				t.Fatal("not used")
+				return nil
+			}},
+		)
+	}
+
+	t.Run("選択肢で答える", func(t *testing.T) {
+		t.Parallel()
+		_, err := newUsecase(t).SubmitAnswer(context.Background(), mustUUID(t), mustUUID(t), mustUUID(t))
+		if !apperror.IsCode(err, apperror.CodeFailedPrecondition) {
+			t.Fatalf("FAILED_PRECONDITION を期待しました: err=%v", err)
+		}
+	})
+	t.Run("記述式で答える", func(t *testing.T) {
+		t.Parallel()
+		_, err := newUsecase(t).SubmitTextAnswer(context.Background(), mustUUID(t), mustUUID(t), "カルタゴ")
+		if !apperror.IsCode(err, apperror.CodeFailedPrecondition) {
+			t.Fatalf("FAILED_PRECONDITION を期待しました: err=%v", err)
+		}
+	})
+}
+
+func TestUsecase_SubmitTextAnswer_MatchesAliasAndSavesTextAttempt(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	questionID := mustUUID(t)
+	correctChoiceID := mustUUID(t)
+
+	createCalled := 0
+
+	u := NewUsecase(
+		&fakeQuizQuestionRepo{
+			listAcceptedAnswersFn: func(context.Context, string) ([]string, error) {
+				return []string{"ヴァスコ・ダ・ガマ", "Vasco da Gama"}, nil
+			},
+			getCorrectChoiceIDFn: func(context.Context, string) (string, error) { return correctChoiceID, nil },
+			choiceBelongsToQuestionFn: func(context.Context, string, string) (bool, error) {
+				t.Fatal("記述式では ChoiceBelongsToQuestion を呼ばない想定です")
+				return false, nil
+			},
+		},
+		&fakeAttemptRepo{
+			createAttemptFn: func(context.Context, string, string, string, bool) (string, error) {
+				t.Fatal("記述式では CreateAttempt ではなく CreateTextAttempt を使う想定です")
+				return "", nil
+			},
+			createTextAttemptFn: func(ctx context.Context, gotUserID, gotQuestionID, gotAnswerText string, isCorrect bool) (string, error) {
+				createCalled++
+				if gotUserID != userID || gotQuestionID != questionID || gotAnswerText != "vasco da gama" {
+					t.Fatalf("CreateTextAttempt args mismatch: user=%s q=%s text=%s", gotUserID, gotQuestionID, gotAnswerText)
+				}
+				if !isCorrect {
+					t.Fatalf("別表記に一致するので isCorrect=true を期待")
+				}
+				return "attempt-1", nil
+			},
+		},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+	)
+
+	res, err := u.SubmitTextAnswer(context.Background(), userID, questionID, "  vasco da gama ")
+	if err != nil {
+		t.Fatalf("err should be nil: %v", err)
+	}
+	if !res.IsCorrect || res.MatchedAnswer != "Vasco da Gama" || res.CorrectChoiceID != correctChoiceID || res.AttemptID != "attempt-1" {
+		t.Fatalf("result mismatch: %+v", res)
+	}
+	if createCalled != 1 {
+		t.Fatalf("CreateTextAttempt=1 を期待: got=%d", createCalled)
+	}
+}
+
+func TestUsecase_SubmitTextAnswer_DefaultQuestionFallback(t *testing.T) {
+	t.Parallel()
+
+	// defaultQuestions の Q3（ヴァスコ・ダ・ガマ）を使う。
+	questionID := defaultQuestions[2].ID
+
+	u := NewUsecase(
+		&fakeQuizQuestionRepo{
+			listAcceptedAnswersFn: func(context.Context, string) ([]string, error) {
+				return nil, apperror.NotFound("not found")
+			},
+			getCorrectChoiceIDFn: func(context.Context, string) (string, error) {
+				t.Fatal("default の場合は GetCorrectChoiceID を呼ばない想定です")
+				return "", nil
+			},
+		},
+		&fakeAttemptRepo{createTextAttemptFn: func(context.Context, string, string, string, bool) (string, error) {
+			t.Fatal("default の場合は attempt を作らない想定です")
+			return "", nil
+		}},
+		&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error {
+			t.Fatal("default の場合は EnsureUserExists を呼ばない想定です")
+			return nil
+		}},
+	)
+
+	res, err := u.SubmitTextAnswer(context.Background(), mustUUID(t), questionID, "ヴァスコダガマ")
+	if err != nil {
+		t.Fatalf("err should be nil: %v", err)
+	}
+	if !res.IsCorrect || res.MatchedAnswer != "ヴァスコ・ダ・ガマ" || res.CorrectChoiceID != defaultCorrectChoiceIDByQuestionID[questionID] {
+		t.Fatalf("result mismatch: %+v", res)
+	}
+
+	res, err = u.SubmitTextAnswer(context.Background(), "", questionID, "コロンブス")
+	if err != nil {
+		t.Fatalf("err should be nil: %v", err)
+	}
+	if res.IsCorrect || res.MatchedAnswer != "" {
+		t.Fatalf("不正解の場合は IsCorrect=false / MatchedAnswer 空を期待: %+v", res)
+	}
+}
+
+func TestUsecase_SubmitTextAnswer_EmptyAnswerText(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(
+		&fakeQuizQuestionRepo{
+			listAcceptedAnswersFn: func(context.Context, string) ([]string, error) {
+				t.Fatal("入力不正の場合、repo は呼ばれない想定です")
+				return nil, nil
+			},
+		},
+		&fakeAttemptRepo{},
+		&fakeUserRepo{},
+	)
+
+	_, err := u.SubmitTextAnswer(context.Background(), "", mustUUID(t), "   ")
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_SubmitLocationAnswer_ScoresByDistanceAndSavesAttempt(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	questionID := mustUUID(t)
+	correctChoiceID := mustUUID(t)
+	carthage := domain.QuestionLocation{Point: geo.Point{Lat: 36.8529, Lng: 10.3233}, RadiusKm: 50}
+
+	tests := []struct {
+		name      string
+		answer    geo.Point
+		wantBand  geo.Band
+		wantScore int32
+	}{
+		{name: "チュニス（半径以内）", answer: geo.Point{Lat: 36.8065, Lng: 10.1815}, wantBand: geo.BandWithin, wantScore: 100},
+		{name: "ビゼルト（2倍以内）", answer: geo.Point{Lat: 37.2744, Lng: 9.8739}, wantBand: geo.BandNear, wantScore: 70},
+		{name: "マルサーラ（5倍以内）", answer: geo.Point{Lat: 37.7990, Lng: 12.4360}, wantBand: geo.BandRegion, wantScore: 40},
+		{name: "パレルモ（10倍以内）", answer: geo.Point{Lat: 38.1157, Lng: 13.3615}, wantBand: geo.BandFar, wantScore: 10},
+		{name: "ローマ（10倍より遠い）", answer: geo.Point{Lat: 41.8925, Lng: 12.4853}, wantBand: geo.BandMiss, wantScore: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var savedDistance float64
+			u := NewUsecase(
+				&fakeQuizQuestionRepo{
+					getQuestionLocationFn: func(_ context.Context, gotQuestionID string) (*domain.QuestionLocation, error) {
+						if gotQuestionID != questionID {
+							t.Fatalf("GetQuestionLocation args mismatch: got=%s want=%s", gotQuestionID, questionID)
+						}
+						location := carthage
+						return &location, nil
+					},
+					getCorrectChoiceIDFn: func(context.Context, string) (string, error) { return correctChoiceID, nil },
+				},
+				&fakeAttemptRepo{
+					createLocationAttemptFn: func(_ context.Context, gotUserID, gotQuestionID string, answer geo.Point, distanceKm float64, isCorrect bool) (string, error) {
+						if gotUserID != userID || gotQuestionID != questionID || answer != tt.answer {
+							t.Fatalf("CreateLocationAttempt args mismatch: user=%s q=%s answer=%+v", gotUserID, gotQuestionID, answer)
+						}
+						if isCorrect != (tt.wantBand == geo.BandWithin) {
+							t.Fatalf("許容半径以内だけを正解とする想定です: isCorrect=%v", isCorrect)
+						}
+						savedDistance = distanceKm
+						return "attempt-1", nil
+					},
+				},
+				&fakeUserRepo{ensureUserExistsFn: func(context.Context, string) error { return nil }},
+			)
+
+			res, err := u.SubmitLocationAnswer(context.Background(), userID, questionID, tt.answer)
+			if err != nil {
+				t.Fatalf("err は nil を期待しました: %v", err)
+			}
+			if res.Location == nil || res.Location.Band != tt.wantBand || res.Location.Score != tt.wantScore {
+				t.Fatalf("採点が期待と異なります: %+v", res.Location)
+			}
+			if res.Location.DistanceKm != savedDistance || res.Location.Correct != carthage {
+				t.Fatalf("距離と正解の地点を返す想定です: %+v (saved=%v)", res.Location, savedDistance)
+			}
+			if res.CorrectChoiceID != correctChoiceID || res.AttemptID != "attempt-1" {
+				t.Fatalf("result mismatch: %+v", res)
+			}
+		})
+	}
+}
+
+func TestUsecase_SubmitLocationAnswer_LocationOnlyQuestion(t *testing.T) {
+	t.Parallel()
+
+	carthage := domain.QuestionLocation{Point: geo.Point{Lat: 36.8529, Lng: 10.3233}, RadiusKm: 50}
+	u := NewUsecase(
+		&fakeQuizQuestionRepo{
+			getCorrectChoiceIDFn: func(context.Context, string) (string, error) { return "", nil },
+			getQuestionLocationFn: func(context.Context, string) (*domain.QuestionLocation, error) {
+				location := carthage
+				return &location, nil
+			},
+		},
+		&fakeAttemptRepo{},
+		&fakeUserRepo{},
+	)
+
+	res, err := u.SubmitLocationAnswer(context.Background(), "", mustUUID(t), geo.Point{Lat: 36.8065, Lng: 10.1815})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if !res.IsCorrect || res.CorrectChoiceID != "" || res.Location == nil || res.Location.Band != geo.BandWithin {
+		t.Fatalf("地図でだけ答える問題も距離で採点する想定です: %+v", res)
+	}
+}
+
+func TestUsecase_SubmitLocationAnswer_Rejects(t *testing.T) {
+	t.Parallel()
+
+	questionID := mustUUID(t)
+	tests := []struct {
+		name       string
+		questionID string
+		answer     geo.Point
+		location   *domain.QuestionLocation
+		lookupErr  error
+		want       apperror.Code
+	}{
+		{name: "緯度が範囲外", questionID: questionID, answer: geo.Point{Lat: 91}, want: apperror.CodeInvalidArgument},
+		{name: "question_id が UUID でない", questionID: "q1", answer: geo.Point{}, want: apperror.CodeInvalidArgument},
+		{name: "地点が無い問題", questionID: questionID, answer: geo.Point{}, want: apperror.CodeFailedPrecondition},
+		{name: "既定問題セット", questionID: defaultQuestions[0].ID, answer: geo.Point{}, lookupErr: apperror.NotFound("not found"), want: apperror.CodeFailedPrecondition},
+		{name: "存在しない/出題できない問題", questionID: questionID, answer: geo.Point{}, lookupErr: apperror.NotFound("not found"), want: apperror.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := NewUsecase(
+				&fakeQuizQuestionRepo{
+					getCorrectChoiceIDFn: func(context.Context, string) (string, error) {
+						return mustUUID(t), tt.lookupErr
+					},
+					getQuestionLocationFn: func(context.Context, string) (*domain.QuestionLocation, error) {
+						if tt.lookupErr != nil {
+							t.Fatal("出題できない問題の地点は読まない想定です")
+						}
+						return tt.location, nil
+					},
+				},
+				&fakeAttemptRepo{},
+				&fakeUserRepo{},
+			)
+			if _, err := u.SubmitLocationAnswer(context.Background(), mustUUID(t), tt.questionID, tt.answer); !apperror.IsCode(err, tt.want) {
+				t.Fatalf("%s を期待しました: err=%v", tt.want, err)
+			}
+		})
+	}
+}
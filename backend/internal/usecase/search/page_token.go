@@ -0,0 +1,36 @@
+package search
+
+import (
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// searchPageTokenKind は問題の検索結果のページトークンの種類（他の一覧のトークンを弾く）。
+const searchPageTokenKind = "question_search"
+
+// encodePageToken は検索結果のページング位置を署名付きの不透明な文字列にする。
+func encodePageToken(codec pagetoken.Codec, c domain.QuestionSearchCursor) string {
+	return codec.Encode(searchPageTokenKind, strconv.FormatInt(int64(c.Score), 10), c.QuestionID)
+}
+
+// decodePageToken はページトークンを検証して復元する（空の場合は nil = 先頭から）。
+func decodePageToken(codec pagetoken.Codec, token string) (*domain.QuestionSearchCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	fields, err := codec.Decode(searchPageTokenKind, token, 2)
+	if err != nil {
+		return nil, err
+	}
+	score, err := strconv.ParseInt(fields[0], 10, 32)
+	if err != nil || score < 0 {
+		return nil, pagetoken.ErrInvalid
+	}
+	if _, err := uuid.Parse(fields[1]); err != nil {
+		return nil, pagetoken.ErrInvalid
+	}
+	return &domain.QuestionSearchCursor{Score: int32(score), QuestionID: fields[1]}, nil
+}
@@ -0,0 +1,175 @@
+package search
+
+import (
+	"context"
+	"strings"
+
+	"github.com/history-quiz/historyquiz/internal/app/authz"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/searchtext"
+	"github.com/history-quiz/historyquiz/internal/repository"
+)
+
+// snippetRunes はスニペット1件の最大文字数。
+const snippetRunes = 60
+
+// Usecase は問題の全文検索のユースケースを提供する。
+// 作者は自分の問題のみ、管理者は全体を検索できる。
+type Usecase struct {
+	searchRepo repository.QuestionSearchRepository
+	admins     authz.AdminSet
+	pageTokens pagetoken.Codec
+}
+
+// NewUsecase は SearchUsecase を生成する。
+// pageTokens は検索結果の page_token の署名に使う。
+func NewUsecase(searchRepo repository.QuestionSearchRepository, admins authz.AdminSet, pageTokens pagetoken.Codec) *Usecase {
+	return &Usecase{
+		searchRepo: searchRepo,
+		admins:     admins,
+		pageTokens: pageTokens,
+	}
+}
+
+// Query は検索条件（入力そのまま）。
+type Query struct {
+	Text string
+	// AuthorUserID は作者の絞り込み。管理者以外は空（= 自分）か自分の userId のみ指定できる。
+	AuthorUserID string
+	Tag          string
+	Statuses     []domain.QuestionStatus
+	PageSize     int32
+	PageToken    string
+}
+
+// Field はスニペットを作ったフィールド。
+type Field string
+
+const (
+	FieldPrompt      Field = "prompt"
+	FieldChoice      Field = "choice"
+	FieldExplanation Field = "explanation"
+)
+
+// Snippet は検索語に一致した箇所の抜粋。
+type Snippet struct {
+	Field Field
+	// ChoiceOrdinal は Field が FieldChoice の場合の選択肢の位置（0..3）。
+	ChoiceOrdinal int32
+	Segments      []searchtext.Segment
+}
+
+// Hit は検索結果の1件。
+type Hit struct {
+	Question domain.QuestionSearchHit
+	// Snippets は一致したフィールドの抜粋（問題文 → 選択肢 → 解説の順）。
+	Snippets []Snippet
+}
+
+// SearchQuestions は問題文/選択肢/解説を全文検索し、関連度の高い順に返す。
+// 次のページがある場合は nextPageToken を返す。
+func (u *Usecase) SearchQuestions(ctx context.Context, userID string, q Query) (hits []Hit, nextPageToken string, err error) {
+	if userID == "" {
+		return nil, "", apperror.Unauthenticated("認証が必要です")
+	}
+
+	terms := searchtext.ParseQuery(q.Text)
+	var violations []apperror.FieldViolation
+	if len(terms) == 0 {
+		violations = append(violations, apperror.FieldViolation{Field: "query", Description: "検索語を入力してください"})
+	}
+	for _, term := range terms {
+		if len([]rune(term)) > searchtext.MaxTermRunes {
+			violations = append(violations, apperror.FieldViolation{Field: "query", Description: "検索語は50文字以内で指定してください"})
+			break
+		}
+	}
+	for _, s := range q.Statuses {
+		if !isKnownStatus(s) {
+			violations = append(violations, apperror.FieldViolation{Field: "statuses", Description: "公開状態が不正です"})
+			break
+		}
+	}
+	after, err := decodePageToken(u.pageTokens, q.PageToken)
+	if err != nil {
+		violations = append(violations, apperror.FieldViolation{Field: "pagination.page_token", Description: "不正なページトークンです"})
+	}
+	if len(violations) > 0 {
+		return nil, "", apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	authorUserID := q.AuthorUserID
+	if !u.admins.IsAdmin(userID) {
+		// 管理者以外は自分の問題だけを検索できる（下書きの問題文を他人に見せない）。
+		if authorUserID != "" && authorUserID != userID {
+			return nil, "", apperror.PermissionDenied("権限がありません")
+		}
+		authorUserID = userID
+	}
+
+	limit := normalizePageSize(q.PageSize)
+	found, err := u.searchRepo.SearchQuestions(ctx, domain.QuestionSearchQuery{
+		Terms:        terms,
+		Bigrams:      searchtext.QueryBigrams(terms),
+		AuthorUserID: authorUserID,
+		Tag:          strings.TrimSpace(q.Tag),
+		Statuses:     q.Statuses,
+		After:        after,
+		// 次のページの有無を判定するため1件多く読む。
+		Limit: limit + 1,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if int32(len(found)) > limit {
+		found = found[:limit]
+		last := found[len(found)-1]
+		nextPageToken = encodePageToken(u.pageTokens, domain.QuestionSearchCursor{Score: last.Score, QuestionID: last.QuestionID})
+	}
+
+	hits = make([]Hit, 0, len(found))
+	for _, h := range found {
+		hits = append(hits, Hit{Question: h, Snippets: snippetsOf(h, terms)})
+	}
+	return hits, nextPageToken, nil
+}
+
+// snippetsOf は一致したフィールドごとに抜粋を作る。
+func snippetsOf(h domain.QuestionSearchHit, terms []string) []Snippet {
+	var snippets []Snippet
+	if segments := searchtext.Snippet(h.Prompt, terms, snippetRunes); segments != nil {
+		snippets = append(snippets, Snippet{Field: FieldPrompt, Segments: segments})
+	}
+	for i, c := range h.Choices {
+		if segments := searchtext.Snippet(c, terms, snippetRunes); segments != nil {
+			snippets = append(snippets, Snippet{Field: FieldChoice, ChoiceOrdinal: int32(i), Segments: segments})
+		}
+	}
+	if segments := searchtext.Snippet(h.Explanation, terms, snippetRunes); segments != nil {
+		snippets = append(snippets, Snippet{Field: FieldExplanation, Segments: segments})
+	}
+	return snippets
+}
+
+func isKnownStatus(s domain.QuestionStatus) bool {
+	switch s {
+	case domain.QuestionStatusDraft, domain.QuestionStatusPublished, domain.QuestionStatusUnlisted, domain.QuestionStatusArchived:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizePageSize は pageSize のデフォルト/上限を統一する。
+func normalizePageSize(pageSize int32) int32 {
+	if pageSize <= 0 {
+		return 20
+	}
+	if pageSize > 100 {
+		return 100
+	}
+	return pageSize
+}
@@ -0,0 +1,144 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/authz"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// fakeSearchRepo は search.Usecase のユニットテスト用のリポジトリ差し替え。
+type fakeSearchRepo struct {
+	searchQuestionsFn func(ctx context.Context, query domain.QuestionSearchQuery) ([]domain.QuestionSearchHit, error)
+}
+
+func (f *fakeSearchRepo) SearchQuestions(ctx context.Context, query domain.QuestionSearchQuery) ([]domain.QuestionSearchHit, error) {
+	return f.searchQuestionsFn(ctx, query)
+}
+
+// testPageTokens はテスト用のページトークンの署名。
+var testPageTokens = pagetoken.NewCodec([]byte("test"))
+
+func TestUsecase_SearchQuestions_AuthorScope(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.NewString()
+	adminUserID := uuid.NewString()
+	otherUserID := uuid.NewString()
+
+	tests := []struct {
+		name         string
+		userID       string
+		authorUserID string
+		wantAuthor   string
+		wantCode     apperror.Code
+	}{
+		{name: "作者は省略すると自分の問題のみ", userID: userID, authorUserID: "", wantAuthor: userID},
+		{name: "作者は他人の問題を検索できない", userID: userID, authorUserID: otherUserID, wantCode: apperror.CodePermissionDenied},
+		{name: "管理者は省略すると全体", userID: adminUserID, authorUserID: "", wantAuthor: ""},
+		{name: "管理者は作者で絞り込める", userID: adminUserID, authorUserID: otherUserID, wantAuthor: otherUserID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			u := NewUsecase(&fakeSearchRepo{searchQuestionsFn: func(_ context.Context, query domain.QuestionSearchQuery) ([]domain.QuestionSearchHit, error) {
+				called = true
+				if query.AuthorUserID != tt.wantAuthor {
+					t.Fatalf("作者の絞り込みが期待と異なります: got=%q want=%q", query.AuthorUserID, tt.wantAuthor)
+				}
+				return nil, nil
+			}}, authz.ParseAdminSet(adminUserID), testPageTokens)
+
+			_, _, err := u.SearchQuestions(context.Background(), tt.userID, Query{Text: "ローマ", AuthorUserID: tt.authorUserID})
+			if tt.wantCode != "" {
+				if !apperror.IsCode(err, tt.wantCode) || called {
+					t.Fatalf("%s で検索しないことを期待しました: err=%v called=%v", tt.wantCode, err, called)
+				}
+				return
+			}
+			if err != nil || !called {
+				t.Fatalf("検索することを期待しました: err=%v called=%v", err, called)
+			}
+		})
+	}
+}
+
+func TestUsecase_SearchQuestions_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeSearchRepo{searchQuestionsFn: func(context.Context, domain.QuestionSearchQuery) ([]domain.QuestionSearchHit, error) {
+		t.Fatal("入力不正の場合、repo は呼ばれない想定です")
+		return nil, nil
+	}}, nil, testPageTokens)
+
+	tests := []struct {
+		name  string
+		query Query
+	}{
+		{name: "検索語が空", query: Query{Text: " 　？ "}},
+		{name: "ページトークンが base64 でない", query: Query{Text: "ローマ", PageToken: "!!!"}},
+		{name: "ページトークンの中身が不正", query: Query{Text: "ローマ", PageToken: encodePageToken(testPageTokens, domain.QuestionSearchCursor{Score: 3, QuestionID: "not-a-uuid"})}},
+		{name: "ページトークンの署名が違う", query: Query{Text: "ローマ", PageToken: encodePageToken(pagetoken.NewCodec([]byte("other")), domain.QuestionSearchCursor{Score: 3, QuestionID: uuid.NewString()})}},
+		{name: "別の一覧のページトークン", query: Query{Text: "ローマ", PageToken: testPageTokens.Encode("my_attempts", "3", uuid.NewString())}},
+		{name: "公開状態が不正", query: Query{Text: "ローマ", Statuses: []domain.QuestionStatus{"deleted"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := u.SearchQuestions(context.Background(), uuid.NewString(), tt.query)
+			if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+				t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+			}
+		})
+	}
+}
+
+func TestUsecase_SearchQuestions_PagingAndSnippets(t *testing.T) {
+	t.Parallel()
+
+	lastID := uuid.NewString()
+	var gotQueries []domain.QuestionSearchQuery
+	u := NewUsecase(&fakeSearchRepo{searchQuestionsFn: func(_ context.Context, query domain.QuestionSearchQuery) ([]domain.QuestionSearchHit, error) {
+		gotQueries = append(gotQueries, query)
+		return []domain.QuestionSearchHit{
+			{QuestionID: uuid.NewString(), Prompt: "古代ローマの首都はどこ？", Choices: []string{"ローマ", "アテネ", "カルタゴ", "アレクサンドリア"}, Score: 5},
+			{QuestionID: lastID, Prompt: "ローマ帝国の分裂は？", Score: 3},
+			{QuestionID: uuid.NewString(), Prompt: "（次のページ）", Score: 3},
+		}, nil
+	}}, nil, testPageTokens)
+
+	hits, next, err := u.SearchQuestions(context.Background(), uuid.NewString(), Query{Text: "ﾛｰﾏ", PageSize: 2})
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if gotQueries[0].Limit != 3 || gotQueries[0].Terms[0] != "ろーま" {
+		t.Fatalf("1件多く、正規化した検索語で検索する想定です: %+v", gotQueries[0])
+	}
+	if len(hits) != 2 || next == "" {
+		t.Fatalf("2件と次のページトークンを期待しました: hits=%d next=%q", len(hits), next)
+	}
+
+	snippets := hits[0].Snippets
+	if len(snippets) != 2 || snippets[0].Field != FieldPrompt || snippets[1].Field != FieldChoice || snippets[1].ChoiceOrdinal != 0 {
+		t.Fatalf("問題文と1つ目の選択肢のスニペットを期待しました: %+v", snippets)
+	}
+	if seg := snippets[0].Segments[1]; !seg.Highlighted || seg.Text != "ローマ" {
+		t.Fatalf("一致箇所を元の表記でハイライトする想定です: %+v", snippets[0].Segments)
+	}
+
+	if _, _, err := u.SearchQuestions(context.Background(), uuid.NewString(), Query{Text: "ローマ", PageToken: next}); err != nil {
+		t.Fatalf("次のページトークンで検索できる想定です: %v", err)
+	}
+	after := gotQueries[1].After
+	if after == nil || after.Score != 3 || after.QuestionID != lastID {
+		t.Fatalf("前ページの最後の位置から検索する想定です: %+v", after)
+	}
+}
@@ -0,0 +1,226 @@
+package timeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/repository"
+)
+
+// 「どちらが先か」の出題の設定。
+const (
+	defaultEventCount = 2
+	maxEventCount     = 4
+	// maxPreviousEvents は避ける直前の出来事として受け付ける数。
+	maxPreviousEvents = 20
+	// maxCandidateEvents は出題の候補として読む出来事の上限（requestID から決まる順で選ぶ。年の順ではない）。
+	maxCandidateEvents = 2000
+)
+
+// Usecase は「どちらが先か」（年が分かる出来事のうち、最も早いものを選ぶ）の出題/判定を提供する。
+// NOTE: QuizService の RPC から呼ぶが、問題（questions）ではなく出来事のエンティティから出題するため、quiz とは分けている。
+type Usecase struct {
+	timelineRepo repository.TimelineRepository
+}
+
+// NewUsecase は TimelineUsecase を生成する。
+func NewUsecase(timelineRepo repository.TimelineRepository) *Usecase {
+	return &Usecase{timelineRepo: timelineRepo}
+}
+
+// GetDuel は count 件（0 の場合は 2 件）の出来事を、最も早い出来事が確実に決まる組で選ぶ。
+// 難しさは最も早い出来事と2番目の出来事の年の差で決める（TimelineDifficulty.GapRange）。
+// previousEventIDs（直前に出した出来事）は可能な限り避ける。避けると組が作れない場合は避けずに選ぶ。
+// NOTE: quiz.GetQuestion と同じく、requestID から決まる乱数で選ぶ（同じ requestID なら同じ出題）。
+func (u *Usecase) GetDuel(ctx context.Context, requestID string, difficulty domain.TimelineDifficulty, count int32, previousEventIDs []string) (domain.TimelineDuel, error) {
+	var violations []apperror.FieldViolation
+	switch difficulty {
+	case domain.TimelineDifficultyAny, domain.TimelineDifficultyEasy, domain.TimelineDifficultyNormal, domain.TimelineDifficultyHard:
+	default:
+		violations = append(violations, apperror.FieldViolation{Field: "difficulty", Description: "easy / normal / hard のいずれかを指定してください"})
+	}
+	if count != 0 && (count < 2 || count > maxEventCount) {
+		violations = append(violations, apperror.FieldViolation{Field: "event_count", Description: "2..4 の範囲で指定してください"})
+	}
+	if len(previousEventIDs) > maxPreviousEvents {
+		violations = append(violations, apperror.FieldViolation{Field: "previous_event_ids", Description: "20件以内で指定してください"})
+	}
+	if len(violations) > 0 {
+		return domain.TimelineDuel{}, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+	if count == 0 {
+		count = defaultEventCount
+	}
+
+	events, err := u.timelineRepo.ListTimelineEvents(ctx, seedOf(requestID), maxCandidateEvents)
+	if err != nil {
+		return domain.TimelineDuel{}, err
+	}
+
+	avoid := make(map[string]struct{}, len(previousEventIDs))
+	for _, id := range previousEventIDs {
+		if parsed, err := uuid.Parse(id); err == nil {
+			avoid[parsed.String()] = struct{}{}
+		}
+	}
+	if duel, ok := selectDuel(events, difficulty, int(count), avoid, newRand(requestID)); ok {
+		return duel, nil
+	}
+	if len(avoid) > 0 {
+		if duel, ok := selectDuel(events, difficulty, int(count), nil, newRand(requestID)); ok {
+			return duel, nil
+		}
+	}
+	return domain.TimelineDuel{}, apperror.FailedPrecondition("この条件で出題できる出来事の組がありません")
+}
+
+// SubmitDuel は chosenEventID が eventIDs のうち最も早い出来事かを判定し、年を明かす。
+// 混同しやすい点: 出題を保存しないため、eventIDs は GetDuel の結果をそのまま送る前提（回答は記録しない）。
+func (u *Usecase) SubmitDuel(ctx context.Context, eventIDs []string, chosenEventID string) (domain.TimelineDuelResult, error) {
+	var violations []apperror.FieldViolation
+	ids := make([]string, 0, len(eventIDs))
+	seen := make(map[string]struct{}, len(eventIDs))
+	if len(eventIDs) < 2 || len(eventIDs) > maxEventCount {
+		violations = append(violations, apperror.FieldViolation{Field: "event_ids", Description: "2..4 件で指定してください"})
+	} else {
+		for _, id := range eventIDs {
+			parsed, err := uuid.Parse(id)
+			if err != nil {
+				violations = append(violations, apperror.FieldViolation{Field: "event_ids", Description: "UUID 形式で指定してください"})
+				break
+			}
+			if _, dup := seen[parsed.String()]; dup {
+				violations = append(violations, apperror.FieldViolation{Field: "event_ids", Description: "同じ出来事が重複しています"})
+				break
+			}
+			seen[parsed.String()] = struct{}{}
+			ids = append(ids, parsed.String())
+		}
+	}
+	chosen, err := uuid.Parse(chosenEventID)
+	switch {
+	case err != nil:
+		violations = append(violations, apperror.FieldViolation{Field: "chosen_event_id", Description: "UUID 形式で指定してください"})
+	case len(violations) == 0:
+		if _, ok := seen[chosen.String()]; !ok {
+			violations = append(violations, apperror.FieldViolation{Field: "chosen_event_id", Description: "event_ids のいずれかを指定してください"})
+		}
+	}
+	if len(violations) > 0 {
+		return domain.TimelineDuelResult{}, apperror.InvalidArgument("入力が不正です", violations...)
+	}
+
+	events, err := u.timelineRepo.ListTimelineEventsByIDs(ctx, ids)
+	if err != nil {
+		return domain.TimelineDuelResult{}, err
+	}
+	if len(events) != len(ids) {
+		return domain.TimelineDuelResult{}, apperror.NotFound("出来事が見つかりません（削除されたか、年が不明になった可能性があります）")
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Date.Year != events[j].Date.Year {
+			return events[i].Date.Year < events[j].Date.Year
+		}
+		return events[i].EntityID < events[j].EntityID
+	})
+	earliest := events[0]
+	for _, other := range events[1:] {
+		if earliest.Date.YearsBefore(other.Date) == 0 {
+			// 出題後に年や誤差が直された場合に起こりうる（出題時には確実に前後が決まる組だけを選ぶ）。
+			return domain.TimelineDuelResult{}, apperror.FailedPrecondition("年の前後が確実でない出来事が含まれています")
+		}
+	}
+	return domain.TimelineDuelResult{
+		IsCorrect:       earliest.EntityID == chosen.String(),
+		EarliestEventID: earliest.EntityID,
+		Events:          events,
+		YearGap:         earliest.Date.YearsBetween(events[1].Date),
+	}, nil
+}
+
+// selectDuel は最も早い出来事（基準）と2番目の出来事の年の差が難しさの範囲に入る組を選ぶ。
+//  1. 基準を乱数の順に試す。
+//  2. 基準より確実に後で、差が範囲に入る出来事から2番目を選ぶ（残りの件数が足りるものに限る）。
+//  3. 残り（count-2 件）は、基準との差が2番目以上の出来事から選ぶ（最も早い出来事と難しさが変わらないように）。
+//
+// 表示順は乱数で並べ替える（年の順で返すと答えが分かるため）。
+func selectDuel(events []domain.TimelineEvent, difficulty domain.TimelineDifficulty, count int, avoid map[string]struct{}, rng *rand.Rand) (domain.TimelineDuel, bool) {
+	candidates := make([]domain.TimelineEvent, 0, len(events))
+	for _, e := range events {
+		if _, skip := avoid[e.EntityID]; skip || !e.Date.Known() {
+			continue
+		}
+		candidates = append(candidates, e)
+	}
+	if len(candidates) < count {
+		return domain.TimelineDuel{}, false
+	}
+
+	lo, hi := difficulty.GapRange()
+	for _, a := range rng.Perm(len(candidates)) {
+		anchor := candidates[a]
+
+		// gaps[k] は基準との差（確実に後でない出来事は 0）。2番目の差以上の出来事が count-1 件（2番目を含む）あれば組が作れる。
+		gaps := make([]int32, len(candidates))
+		var after []int32
+		for k, e := range candidates {
+			gaps[k] = anchor.Date.YearsBefore(e.Date)
+			if gaps[k] > 0 {
+				after = append(after, gaps[k])
+			}
+		}
+		sort.Slice(after, func(i, j int) bool { return after[i] > after[j] })
+		atLeast := func(gap int32) int {
+			return sort.Search(len(after), func(i int) bool { return after[i] < gap })
+		}
+
+		var runners []int
+		for j, gap := range gaps {
+			if gap >= lo && (hi == 0 || gap <= hi) && atLeast(gap) >= count-1 {
+				runners = append(runners, j)
+			}
+		}
+		if len(runners) == 0 {
+			continue
+		}
+		r := runners[rng.IntN(len(runners))]
+		gap := gaps[r]
+
+		var others []int
+		for k, g := range gaps {
+			if k != r && g >= gap {
+				others = append(others, k)
+			}
+		}
+		rng.Shuffle(len(others), func(i, j int) { others[i], others[j] = others[j], others[i] })
+
+		picked := []domain.TimelineEvent{anchor, candidates[r]}
+		for _, k := range others[:count-2] {
+			picked = append(picked, candidates[k])
+		}
+		rng.Shuffle(len(picked), func(i, j int) { picked[i], picked[j] = picked[j], picked[i] })
+		return domain.TimelineDuel{Events: picked, Difficulty: domain.DifficultyOfGap(gap)}, true
+	}
+	return domain.TimelineDuel{}, false
+}
+
+// seedOf は requestID から乱数と候補の順を決める文字列を返す（requestID が無い場合も毎回同じ結果になるよう固定文字列にする）。
+func seedOf(requestID string) string {
+	if requestID == "" {
+		return "no-request-id"
+	}
+	return requestID
+}
+
+// newRand は requestID から決まる乱数を返す。
+func newRand(requestID string) *rand.Rand {
+	sum := sha256.Sum256([]byte(seedOf(requestID)))
+	return rand.New(rand.NewPCG(binary.BigEndian.Uint64(sum[:8]), binary.BigEndian.Uint64(sum[8:16])))
+}
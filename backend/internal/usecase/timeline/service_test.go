@@ -0,0 +1,233 @@
+package timeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/history-quiz/historyquiz/internal/domain"
+	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+)
+
+// fakeTimelineRepo は timeline.Usecase のユニットテスト用のリポジトリ差し替え。
+type fakeTimelineRepo struct {
+	events []domain.TimelineEvent
+	// seeds は ListTimelineEvents に渡された seed（呼ばれた順）。
+	seeds []string
+}
+
+func (f *fakeTimelineRepo) ListTimelineEvents(_ context.Context, seed string, _ int32) ([]domain.TimelineEvent, error) {
+	f.seeds = append(f.seeds, seed)
+	return f.events, nil
+}
+
+func (f *fakeTimelineRepo) ListTimelineEventsByIDs(_ context.Context, entityIDs []string) ([]domain.TimelineEvent, error) {
+	var found []domain.TimelineEvent
+	for _, e := range f.events {
+		for _, id := range entityIDs {
+			if e.EntityID == id {
+				found = append(found, e)
+			}
+		}
+	}
+	return found, nil
+}
+
+// 出来事の ID（UUID 形式）。
+const (
+	idMarathon = "00000000-0000-4000-8000-000000000001" // 紀元前490年
+	idSalamis  = "00000000-0000-4000-8000-000000000002" // 紀元前480年
+	idBuddha   = "00000000-0000-4000-8000-000000000003" // 紀元前500年頃（±25）
+	idAugustus = "00000000-0000-4000-8000-000000000004" // 紀元前27年
+	idJesus    = "00000000-0000-4000-8000-000000000005" // 紀元1年
+	idVasco    = "00000000-0000-4000-8000-000000000006" // 1498年
+	idColumbus = "00000000-0000-4000-8000-000000000007" // 1492年
+)
+
+func testEvents() []domain.TimelineEvent {
+	return []domain.TimelineEvent{
+		{EntityID: idBuddha, Name: "仏教の成立", Date: domain.HistoricalYear{Year: -500, Margin: 25}},
+		{EntityID: idMarathon, Name: "マラトンの戦い", Date: domain.HistoricalYear{Year: -490}},
+		{EntityID: idSalamis, Name: "サラミスの海戦", Date: domain.HistoricalYear{Year: -480}},
+		{EntityID: idAugustus, Name: "元首政の開始", Date: domain.HistoricalYear{Year: -27}},
+		{EntityID: idJesus, Name: "紀元1年", Date: domain.HistoricalYear{Year: 1}},
+		{EntityID: idColumbus, Name: "コロンブスの航海", Date: domain.HistoricalYear{Year: 1492}},
+		{EntityID: idVasco, Name: "ヴァスコ・ダ・ガマのインド到達", Date: domain.HistoricalYear{Year: 1498}},
+	}
+}
+
+func TestHistoricalYear_YearsBefore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		a, b  domain.HistoricalYear
+		want  int32
+		apart int32
+	}{
+		{name: "紀元前どうし", a: domain.HistoricalYear{Year: -490}, b: domain.HistoricalYear{Year: -480}, want: 10, apart: 10},
+		{name: "紀元0年は無い", a: domain.HistoricalYear{Year: -1}, b: domain.HistoricalYear{Year: 1}, want: 1, apart: 1},
+		{name: "紀元前をまたぐ", a: domain.HistoricalYear{Year: -27}, b: domain.HistoricalYear{Year: 14}, want: 40, apart: 40},
+		{name: "後の年", a: domain.HistoricalYear{Year: 1498}, b: domain.HistoricalYear{Year: 1492}, want: 0, apart: 6},
+		{name: "同じ年", a: domain.HistoricalYear{Year: 1492}, b: domain.HistoricalYear{Year: 1492}, want: 0, apart: 0},
+		{name: "誤差が重なる", a: domain.HistoricalYear{Year: -500, Margin: 25}, b: domain.HistoricalYear{Year: -490}, want: 0, apart: 10},
+		{name: "誤差の分だけ縮む", a: domain.HistoricalYear{Year: -500, Margin: 5}, b: domain.HistoricalYear{Year: -480, Margin: 5}, want: 10, apart: 20},
+		{name: "年が不明", a: domain.HistoricalYear{}, b: domain.HistoricalYear{Year: 1}, want: 0, apart: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.a.YearsBefore(tt.b); got != tt.want {
+				t.Fatalf("YearsBefore: got=%d want=%d", got, tt.want)
+			}
+			if got := tt.a.YearsBetween(tt.b); got != tt.apart {
+				t.Fatalf("YearsBetween: got=%d want=%d", got, tt.apart)
+			}
+		})
+	}
+}
+
+func TestUsecase_GetDuel_DifficultyBands(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeTimelineRepo{events: testEvents()})
+	for _, difficulty := range []domain.TimelineDifficulty{domain.TimelineDifficultyEasy, domain.TimelineDifficultyNormal, domain.TimelineDifficultyHard} {
+		for _, requestID := range []string{"r1", "r2", "r3", "r4", "r5", ""} {
+			duel, err := u.GetDuel(context.Background(), requestID, difficulty, 2, nil)
+			if err != nil {
+				t.Fatalf("%s/%q: err は nil を期待しました: %v", difficulty, requestID, err)
+			}
+			if len(duel.Events) != 2 || duel.Difficulty != difficulty {
+				t.Fatalf("%s/%q: 出題が期待と異なります: %+v", difficulty, requestID, duel)
+			}
+			a, b := duel.Events[0].Date, duel.Events[1].Date
+			gap := max(a.YearsBefore(b), b.YearsBefore(a))
+			if lo, hi := difficulty.GapRange(); gap < lo || (hi != 0 && gap > hi) {
+				t.Fatalf("%s/%q: 年の差が範囲外です: gap=%d events=%+v", difficulty, requestID, gap, duel.Events)
+			}
+		}
+	}
+}
+
+func TestUsecase_GetDuel_EarliestIsUnambiguous(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeTimelineRepo{events: testEvents()})
+	for _, requestID := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		duel, err := u.GetDuel(context.Background(), requestID, domain.TimelineDifficultyAny, 4, nil)
+		if err != nil {
+			t.Fatalf("%q: err は nil を期待しました: %v", requestID, err)
+		}
+		ids := make([]string, 0, len(duel.Events))
+		for _, e := range duel.Events {
+			ids = append(ids, e.EntityID)
+		}
+		// 出題した組はそのまま判定できる（最も早い出来事が確実に決まる）。
+		result, err := u.SubmitDuel(context.Background(), ids, ids[0])
+		if err != nil {
+			t.Fatalf("%q: 出題した組の判定に失敗しました: %v (events=%+v)", requestID, err, duel.Events)
+		}
+		if len(result.Events) != 4 {
+			t.Fatalf("%q: 4件を期待しました: %+v", requestID, result)
+		}
+	}
+}
+
+func TestUsecase_GetDuel_AvoidsPreviousButGuaranteesOne(t *testing.T) {
+	t.Parallel()
+
+	events := []domain.TimelineEvent{
+		{EntityID: idColumbus, Name: "コロンブスの航海", Date: domain.HistoricalYear{Year: 1492}},
+		{EntityID: idVasco, Name: "ヴァスコ・ダ・ガマのインド到達", Date: domain.HistoricalYear{Year: 1498}},
+	}
+	u := NewUsecase(&fakeTimelineRepo{events: events})
+
+	duel, err := u.GetDuel(context.Background(), "r1", domain.TimelineDifficultyHard, 0, []string{idVasco})
+	if err != nil {
+		t.Fatalf("避けると組が作れない場合は避けずに出題する想定です: %v", err)
+	}
+	if len(duel.Events) != 2 {
+		t.Fatalf("2件（既定）を期待しました: %+v", duel)
+	}
+
+	if _, err := u.GetDuel(context.Background(), "r1", domain.TimelineDifficultyEasy, 0, nil); !apperror.IsCode(err, apperror.CodeFailedPrecondition) {
+		t.Fatalf("組が作れない場合は FAILED_PRECONDITION を期待しました: err=%v", err)
+	}
+}
+
+// 候補は requestID から決まる順で読む（年の古い順に上限まで読むと、新しい出来事が出題されなくなるため）。
+func TestUsecase_GetDuel_CandidatesFollowRequestID(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeTimelineRepo{events: testEvents()}
+	u := NewUsecase(repo)
+	for _, requestID := range []string{"r1", "r1", "r2", ""} {
+		if _, err := u.GetDuel(context.Background(), requestID, domain.TimelineDifficultyAny, 2, nil); err != nil {
+			t.Fatalf("%q: err は nil を期待しました: %v", requestID, err)
+		}
+	}
+	want := []string{"r1", "r1", "r2", "no-request-id"}
+	if len(repo.seeds) != len(want) {
+		t.Fatalf("seed が期待と異なります: %v", repo.seeds)
+	}
+	for i := range want {
+		if repo.seeds[i] != want[i] {
+			t.Fatalf("seed が期待と異なります: got=%v want=%v", repo.seeds, want)
+		}
+	}
+}
+
+func TestUsecase_SubmitDuel(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeTimelineRepo{events: testEvents()})
+
+	result, err := u.SubmitDuel(context.Background(), []string{idJesus, idAugustus}, idAugustus)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if !result.IsCorrect || result.EarliestEventID != idAugustus || result.YearGap != 27 {
+		t.Fatalf("結果が期待と異なります: %+v", result)
+	}
+	if result.Events[0].EntityID != idAugustus || result.Events[1].EntityID != idJesus {
+		t.Fatalf("年の古い順を期待しました: %+v", result.Events)
+	}
+
+	wrong, err := u.SubmitDuel(context.Background(), []string{idVasco, idColumbus}, idVasco)
+	if err != nil {
+		t.Fatalf("err は nil を期待しました: %v", err)
+	}
+	if wrong.IsCorrect || wrong.EarliestEventID != idColumbus {
+		t.Fatalf("不正解を期待しました: %+v", wrong)
+	}
+
+	if _, err := u.SubmitDuel(context.Background(), []string{idBuddha, idMarathon}, idBuddha); !apperror.IsCode(err, apperror.CodeFailedPrecondition) {
+		t.Fatalf("誤差が重なる組は FAILED_PRECONDITION を期待しました: err=%v", err)
+	}
+}
+
+func TestUsecase_SubmitDuel_Rejects(t *testing.T) {
+	t.Parallel()
+
+	missing := "00000000-0000-4000-8000-0000000000ff"
+	tests := []struct {
+		name     string
+		eventIDs []string
+		chosen   string
+		want     apperror.Code
+	}{
+		{name: "1件だけ", eventIDs: []string{idVasco}, chosen: idVasco, want: apperror.CodeInvalidArgument},
+		{name: "UUID でない", eventIDs: []string{idVasco, "x"}, chosen: idVasco, want: apperror.CodeInvalidArgument},
+		{name: "重複", eventIDs: []string{idVasco, idVasco}, chosen: idVasco, want: apperror.CodeInvalidArgument},
+		{name: "選んだ出来事が出題に無い", eventIDs: []string{idVasco, idColumbus}, chosen: idJesus, want: apperror.CodeInvalidArgument},
+		{name: "存在しない出来事", eventIDs: []string{idVasco, missing}, chosen: idVasco, want: apperror.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			u := NewUsecase(&fakeTimelineRepo{events: testEvents()})
+			if _, err := u.SubmitDuel(context.Background(), tt.eventIDs, tt.chosen); !apperror.IsCode(err, tt.want) {
+				t.Fatalf("%s を期待しました: err=%v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
+	"github.com/history-quiz/historyquiz/internal/domain"
+)
+
+// myAttemptsPageTokenKind は解答履歴のページトークンの種類（他の一覧のトークンを弾く）。
+const myAttemptsPageTokenKind = "my_attempts"
+
+// encodeMyAttemptsPageToken は解答履歴のページング位置を署名付きの不透明な文字列にする。
+// NOTE: answered_at は Postgres の精度（マイクロ秒）に合わせて UnixMicro で持つ。
+func encodeMyAttemptsPageToken(codec pagetoken.Codec, c domain.AttemptListCursor) string {
+	return codec.Encode(myAttemptsPageTokenKind, strconv.FormatInt(c.AnsweredAt.UnixMicro(), 10), c.AttemptID)
+}
+
+// decodeMyAttemptsPageToken はページトークンを検証して復元する（空の場合は nil = 先頭から）。
+func decodeMyAttemptsPageToken(codec pagetoken.Codec, token string) (*domain.AttemptListCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	fields, err := codec.Decode(myAttemptsPageTokenKind, token, 2)
+	if err != nil {
+		return nil, err
+	}
+	micros, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, pagetoken.ErrInvalid
+	}
+	if _, err := uuid.Parse(fields[1]); err != nil {
+		return nil, pagetoken.ErrInvalid
+	}
+	return &domain.AttemptListCursor{AnsweredAt: time.UnixMicro(micros).UTC(), AttemptID: fields[1]}, nil
+}
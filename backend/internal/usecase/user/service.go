@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
 	"github.com/history-quiz/historyquiz/internal/domain"
 	"github.com/history-quiz/historyquiz/internal/domain/apperror"
 	"github.com/history-quiz/historyquiz/internal/repository"
@@ -11,26 +12,40 @@ import (
 // Usecase はマイページ向け（履歴/統計）のユースケースを提供する。
 type Usecase struct {
 	attemptRepo repository.AttemptRepository
+	pageTokens  pagetoken.Codec
 }
 
 // NewUsecase は UserUsecase を生成する。
-func NewUsecase(attemptRepo repository.AttemptRepository) *Usecase {
-	return &Usecase{attemptRepo: attemptRepo}
+// pageTokens は解答履歴の page_token の署名に使う。
+func NewUsecase(attemptRepo repository.AttemptRepository, pageTokens pagetoken.Codec) *Usecase {
+	return &Usecase{attemptRepo: attemptRepo, pageTokens: pageTokens}
 }
 
-// ListMyAttempts は自分の解答履歴を返す。
-func (u *Usecase) ListMyAttempts(ctx context.Context, userID string, pageSize int32) ([]domain.Attempt, string, error) {
+// ListMyAttempts は自分の解答履歴を新しい順に返す。
+// pageToken には前のページの nextPageToken を渡す。次のページが無い場合、nextPageToken は空。
+func (u *Usecase) ListMyAttempts(ctx context.Context, userID string, pageToken string, pageSize int32) ([]domain.Attempt, string, error) {
 	if userID == "" {
 		return nil, "", apperror.Unauthenticated("認証が必要です")
 	}
+	after, err := decodeMyAttemptsPageToken(u.pageTokens, pageToken)
+	if err != nil {
+		return nil, "", apperror.InvalidArgument("page_token が不正です", apperror.FieldViolation{Field: "pagination.page_token", Description: "前のページの next_page_token を指定してください"})
+	}
 	limit := normalizePageSize(pageSize)
 
-	attempts, err := u.attemptRepo.ListMyAttempts(ctx, userID, limit)
+	// 次のページの有無を判定するため1件多く読む。
+	attempts, err := u.attemptRepo.ListMyAttempts(ctx, userID, after, limit+1)
 	if err != nil {
 		return nil, "", err
 	}
-	// Phase1 では page_token を未実装（next_page_token は空）。
-	return attempts, "", nil
+
+	nextPageToken := ""
+	if int32(len(attempts)) > limit {
+		attempts = attempts[:limit]
+		last := attempts[len(attempts)-1]
+		nextPageToken = encodeMyAttemptsPageToken(u.pageTokens, domain.AttemptListCursor{AnsweredAt: last.AnsweredAt, AttemptID: last.ID})
+	}
+	return attempts, nextPageToken, nil
 }
 
 // GetMyStats は自分の統計（正答率など）を返す。
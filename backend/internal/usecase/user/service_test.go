@@ -6,13 +6,15 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/history-quiz/historyquiz/internal/app/pagetoken"
 	"github.com/history-quiz/historyquiz/internal/domain"
 	"github.com/history-quiz/historyquiz/internal/domain/apperror"
+	"github.com/history-quiz/historyquiz/internal/domain/geo"
 )
 
 // fakeAttemptRepo は user.Usecase のユニットテスト用の AttemptRepository 実装。
 type fakeAttemptRepo struct {
-	listMyAttemptsFn func(ctx context.Context, userID string, limit int32) ([]domain.Attempt, error)
+	listMyAttemptsFn func(ctx context.Context, userID string, after *domain.AttemptListCursor, limit int32) ([]domain.Attempt, error)
 	getMyStatsFn     func(ctx context.Context, userID string) (domain.Stats, error)
 	createAttemptFn  func(ctx context.Context, userID string, questionID string, selectedChoiceID string, isCorrect bool) (string, error)
 }
@@ -20,13 +22,22 @@ type fakeAttemptRepo struct {
 func (f *fakeAttemptRepo) CreateAttempt(ctx context.Context, userID string, questionID string, selectedChoiceID string, isCorrect bool) (string, error) {
 	return f.createAttemptFn(ctx, userID, questionID, selectedChoiceID, isCorrect)
 }
-func (f *fakeAttemptRepo) ListMyAttempts(ctx context.Context, userID string, limit int32) ([]domain.Attempt, error) {
-	return f.listMyAttemptsFn(ctx, userID, limit)
+func (*fakeAttemptRepo) CreateTextAttempt(context.Context, string, string, string, bool) (string, error) {
+	panic("not used in user usecase tests")
+}
+func (*fakeAttemptRepo) CreateLocationAttempt(context.Context, string, string, geo.Point, float64, bool) (string, error) {
+	panic("not used in user usecase tests")
+}
+func (f *fakeAttemptRepo) ListMyAttempts(ctx context.Context, userID string, after *domain.AttemptListCursor, limit int32) ([]domain.Attempt, error) {
+	return f.listMyAttemptsFn(ctx, userID, after, limit)
 }
 func (f *fakeAttemptRepo) GetMyStats(ctx context.Context, userID string) (domain.Stats, error) {
 	return f.getMyStatsFn(ctx, userID)
 }
 
+// testPageTokens はテスト用の page_token の署名鍵。
+var testPageTokens = pagetoken.NewCodec([]byte("test"))
+
 // mustUUID はテスト用に UUID を生成する。
 func mustUUID(t *testing.T) string {
 	t.Helper()
@@ -37,7 +48,7 @@ func TestUsecase_ListMyAttempts_Unauthenticated(t *testing.T) {
 	t.Parallel()
 
 	u := NewUsecase(&fakeAttemptRepo{
-		listMyAttemptsFn: func(context.Context, string, int32) ([]domain.Attempt, error) {
+		listMyAttemptsFn: func(context.Context, string, *domain.AttemptListCursor, int32) ([]domain.Attempt, error) {
 			t.Fatal("未認証の場合、repo は呼ばれない想定です")
 			return nil, nil
 		},
@@ -49,9 +60,9 @@ func TestUsecase_ListMyAttempts_Unauthenticated(t *testing.T) {
 			t.Fatal("not used")
 			return "", nil
 		},
-	})
+	}, testPageTokens)
 
-	_, _, err := u.ListMyAttempts(context.Background(), "", 10)
+	_, _, err := u.ListMyAttempts(context.Background(), "", "", 10)
 	if !apperror.IsCode(err, apperror.CodeUnauthenticated) {
 		t.Fatalf("UNAUTHENTICATED を期待しました: err=%v", err)
 	}
@@ -64,7 +75,7 @@ func TestUsecase_ListMyAttempts_NormalizesPageSize(t *testing.T) {
 	gotLimit := int32(-1)
 
 	u := NewUsecase(&fakeAttemptRepo{
-		listMyAttemptsFn: func(ctx context.Context, gotUserID string, limit int32) ([]domain.Attempt, error) {
+		listMyAttemptsFn: func(ctx context.Context, gotUserID string, _ *domain.AttemptListCursor, limit int32) ([]domain.Attempt, error) {
 			if gotUserID != userID {
 				t.Fatalf("ListMyAttempts user mismatch: got=%s want=%s", gotUserID, userID)
 			}
@@ -81,14 +92,15 @@ func TestUsecase_ListMyAttempts_NormalizesPageSize(t *testing.T) {
 			t.Fatal("not used")
 			return "", nil
 		},
-	})
+	}, testPageTokens)
 
-	_, _, err := u.ListMyAttempts(context.Background(), userID, 999)
+	// 次のページの有無を判定するため、repo には1件多く要求する。
+	_, _, err := u.ListMyAttempts(context.Background(), userID, "", 999)
 	if err != nil {
 		t.Fatalf("err should be nil: %v", err)
 	}
-	if gotLimit != 100 {
-		t.Fatalf("pageSize>100 は 100 を期待: got=%d", gotLimit)
+	if gotLimit != 101 {
+		t.Fatalf("pageSize>100 は 100（+1）を期待: got=%d", gotLimit)
 	}
 }
 
@@ -96,7 +108,7 @@ func TestUsecase_GetMyStats_Unauthenticated(t *testing.T) {
 	t.Parallel()
 
 	u := NewUsecase(&fakeAttemptRepo{
-		listMyAttemptsFn: func(context.Context, string, int32) ([]domain.Attempt, error) {
+		listMyAttemptsFn: func(context.Context, string, *domain.AttemptListCursor, int32) ([]domain.Attempt, error) {
 			t.Fatal("not used")
 			return nil, nil
 		},
@@ -108,7 +120,7 @@ func TestUsecase_GetMyStats_Unauthenticated(t *testing.T) {
 			t.Fatal("not used")
 			return "", nil
 		},
-	})
+	}, testPageTokens)
 
 	_, err := u.GetMyStats(context.Background(), "")
 	if !apperror.IsCode(err, apperror.CodeUnauthenticated) {
@@ -123,7 +135,7 @@ func TestUsecase_GetMyStats_Success(t *testing.T) {
 	expected := domain.Stats{TotalAttempts: 10, CorrectAttempts: 7, Accuracy: 0.7}
 
 	u := NewUsecase(&fakeAttemptRepo{
-		listMyAttemptsFn: func(context.Context, string, int32) ([]domain.Attempt, error) {
+		listMyAttemptsFn: func(context.Context, string, *domain.AttemptListCursor, int32) ([]domain.Attempt, error) {
 			t.Fatal("not used")
 			return nil, nil
 		},
@@ -134,7 +146,7 @@ func TestUsecase_GetMyStats_Success(t *testing.T) {
 			t.Fatal("not used")
 			return "", nil
 		},
-	})
+	}, testPageTokens)
 
 	got, err := u.GetMyStats(context.Background(), userID)
 	if err != nil {
@@ -144,3 +156,60 @@ func TestUsecase_GetMyStats_Success(t *testing.T) {
 		t.Fatalf("result mismatch: got=%+v expected=%+v", got, expected)
 	}
 }
+
+func TestUsecase_ListMyAttempts_Paginates(t *testing.T) {
+	t.Parallel()
+
+	userID := mustUUID(t)
+	answeredAt := time.Date(2026, 10, 19, 12, 0, 0, 123456000, time.UTC)
+	a1, a2 := mustUUID(t), mustUUID(t)
+	var gotAfter *domain.AttemptListCursor
+
+	u := NewUsecase(&fakeAttemptRepo{
+		listMyAttemptsFn: func(_ context.Context, _ string, after *domain.AttemptListCursor, limit int32) ([]domain.Attempt, error) {
+			gotAfter = after
+			if after != nil {
+				return nil, nil
+			}
+			// limit=1+1 件を返す = 次のページがある。
+			return []domain.Attempt{{ID: a1, AnsweredAt: answeredAt}, {ID: a2, AnsweredAt: answeredAt}}[:limit], nil
+		},
+	}, testPageTokens)
+
+	got, next, err := u.ListMyAttempts(context.Background(), userID, "", 1)
+	if err != nil {
+		t.Fatalf("err should be nil: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != a1 || next == "" {
+		t.Fatalf("1件と next_page_token を期待しました: got=%+v next=%q", got, next)
+	}
+
+	_, last, err := u.ListMyAttempts(context.Background(), userID, next, 1)
+	if err != nil {
+		t.Fatalf("err should be nil: %v", err)
+	}
+	if gotAfter == nil || gotAfter.AttemptID != a1 || !gotAfter.AnsweredAt.Equal(answeredAt) {
+		t.Fatalf("前のページの最後の位置から読む想定です: %+v", gotAfter)
+	}
+	if last != "" {
+		t.Fatalf("最後のページでは next_page_token は空の想定です: %q", last)
+	}
+}
+
+func TestUsecase_ListMyAttempts_InvalidPageToken(t *testing.T) {
+	t.Parallel()
+
+	u := NewUsecase(&fakeAttemptRepo{
+		listMyAttemptsFn: func(context.Context, string, *domain.AttemptListCursor, int32) ([]domain.Attempt, error) {
+			t.Fatal("page_token が不正な場合、repo は呼ばれない想定です")
+			return nil, nil
+		},
+	}, testPageTokens)
+
+	// 自分の問題一覧のトークンを解答履歴に使うことはできない。
+	token := testPageTokens.Encode("my_questions", "0", mustUUID(t))
+	_, _, err := u.ListMyAttempts(context.Background(), mustUUID(t), token, 10)
+	if !apperror.IsCode(err, apperror.CodeInvalidArgument) {
+		t.Fatalf("INVALID_ARGUMENT を期待しました: err=%v", err)
+	}
+}
@@ -0,0 +1,594 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: historyquiz/attachment/v1/attachment_service.proto
+
+package attachmentv1
+
+import (
+	v1 "github.com/history-quiz/historyquiz/proto/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 添付の種類（表示の仕方を変えるために使う。中身はどちらも画像）。
+type AttachmentKind int32
+
+const (
+	AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED AttachmentKind = 0
+	AttachmentKind_ATTACHMENT_KIND_IMAGE       AttachmentKind = 1 // 肖像画や史料の写真など
+	AttachmentKind_ATTACHMENT_KIND_MAP         AttachmentKind = 2
+)
+
+// Enum value maps for AttachmentKind.
+var (
+	AttachmentKind_name = map[int32]string{
+		0: "ATTACHMENT_KIND_UNSPECIFIED",
+		1: "ATTACHMENT_KIND_IMAGE",
+		2: "ATTACHMENT_KIND_MAP",
+	}
+	AttachmentKind_value = map[string]int32{
+		"ATTACHMENT_KIND_UNSPECIFIED": 0,
+		"ATTACHMENT_KIND_IMAGE":       1,
+		"ATTACHMENT_KIND_MAP":         2,
+	}
+)
+
+func (x AttachmentKind) Enum() *AttachmentKind {
+	p := new(AttachmentKind)
+	*p = x
+	return p
+}
+
+func (x AttachmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_attachment_v1_attachment_service_proto_enumTypes[0].Descriptor()
+}
+
+func (AttachmentKind) Type() protoreflect.EnumType {
+	return &file_historyquiz_attachment_v1_attachment_service_proto_enumTypes[0]
+}
+
+func (x AttachmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentKind.Descriptor instead.
+func (AttachmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_attachment_v1_attachment_service_proto_rawDescGZIP(), []int{0}
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          AttachmentKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=historyquiz.attachment.v1.AttachmentKind" json:"kind,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Sha256        string                 `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`                        // 16進
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_historyquiz_attachment_v1_attachment_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 問題に付いた添付（QuestionDetail / quiz の Question で共通）。
+type QuestionAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	Kind          AttachmentKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=historyquiz.attachment.v1.AttachmentKind" json:"kind,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	AltText       string                 `protobuf:"bytes,5,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"` // 画像を表示できない場合の代替テキスト（空の場合あり）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionAttachment) Reset() {
+	*x = QuestionAttachment{}
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionAttachment) ProtoMessage() {}
+
+func (x *QuestionAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionAttachment.ProtoReflect.Descriptor instead.
+func (*QuestionAttachment) Descriptor() ([]byte, []int) {
+	return file_historyquiz_attachment_v1_attachment_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuestionAttachment) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *QuestionAttachment) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *QuestionAttachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *QuestionAttachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *QuestionAttachment) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+type UploadAttachmentRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Kind    AttachmentKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=historyquiz.attachment.v1.AttachmentKind" json:"kind,omitempty"`
+	// 宣言した content type と、中身から判定した形式が一致しない場合は INVALID_ARGUMENT。
+	ContentType   string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_attachment_v1_attachment_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UploadAttachmentRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *UploadAttachmentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadAttachmentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Attachment    *Attachment            `protobuf:"bytes,2,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_attachment_v1_attachment_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UploadAttachmentResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type GetAttachmentContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	AttachmentId  string                 `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentContentRequest) Reset() {
+	*x = GetAttachmentContentRequest{}
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentContentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentContentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentContentRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_attachment_v1_attachment_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAttachmentContentRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetAttachmentContentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type GetAttachmentContentResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Chunk   []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// 最初のメッセージにだけ設定する。
+	ContentType   string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentContentResponse) Reset() {
+	*x = GetAttachmentContentResponse{}
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentContentResponse) ProtoMessage() {}
+
+func (x *GetAttachmentContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_attachment_v1_attachment_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentContentResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentContentResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_attachment_v1_attachment_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAttachmentContentResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetAttachmentContentResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *GetAttachmentContentResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetAttachmentContentResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+var File_historyquiz_attachment_v1_attachment_service_proto protoreflect.FileDescriptor
+
+const file_historyquiz_attachment_v1_attachment_service_proto_rawDesc = "" +
+	"\n" +
+	"2historyquiz/attachment/v1/attachment_service.proto\x12\x19historyquiz.attachment.v1\x1a\"historyquiz/common/v1/common.proto\"\xd4\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\x04kind\x18\x02 \x01(\x0e2).historyquiz.attachment.v1.AttachmentKindR\x04kind\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\xd5\x01\n" +
+	"\x12QuestionAttachment\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\x12=\n" +
+	"\x04kind\x18\x02 \x01(\x0e2).historyquiz.attachment.v1.AttachmentKindR\x04kind\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x19\n" +
+	"\balt_text\x18\x05 \x01(\tR\aaltText\"\xd6\x01\n" +
+	"\x17UploadAttachmentRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12=\n" +
+	"\x04kind\x18\x02 \x01(\x0e2).historyquiz.attachment.v1.AttachmentKindR\x04kind\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\"\xa2\x01\n" +
+	"\x18UploadAttachmentResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12E\n" +
+	"\n" +
+	"attachment\x18\x02 \x01(\v2%.historyquiz.attachment.v1.AttachmentR\n" +
+	"attachment\"\x83\x01\n" +
+	"\x1bGetAttachmentContentRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12#\n" +
+	"\rattachment_id\x18\x02 \x01(\tR\fattachmentId\"\xb7\x01\n" +
+	"\x1cGetAttachmentContentResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes*e\n" +
+	"\x0eAttachmentKind\x12\x1f\n" +
+	"\x1bATTACHMENT_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTACHMENT_KIND_IMAGE\x10\x01\x12\x17\n" +
+	"\x13ATTACHMENT_KIND_MAP\x10\x022\x9c\x02\n" +
+	"\x11AttachmentService\x12{\n" +
+	"\x10UploadAttachment\x122.historyquiz.attachment.v1.UploadAttachmentRequest\x1a3.historyquiz.attachment.v1.UploadAttachmentResponse\x12\x89\x01\n" +
+	"\x14GetAttachmentContent\x126.historyquiz.attachment.v1.GetAttachmentContentRequest\x1a7.historyquiz.attachment.v1.GetAttachmentContentResponse0\x01BFZDgithub.com/history-quiz/historyquiz/proto/attachment/v1;attachmentv1b\x06proto3"
+
+var (
+	file_historyquiz_attachment_v1_attachment_service_proto_rawDescOnce sync.Once
+	file_historyquiz_attachment_v1_attachment_service_proto_rawDescData []byte
+)
+
+func file_historyquiz_attachment_v1_attachment_service_proto_rawDescGZIP() []byte {
+	file_historyquiz_attachment_v1_attachment_service_proto_rawDescOnce.Do(func() {
+		file_historyquiz_attachment_v1_attachment_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_historyquiz_attachment_v1_attachment_service_proto_rawDesc), len(file_historyquiz_attachment_v1_attachment_service_proto_rawDesc)))
+	})
+	return file_historyquiz_attachment_v1_attachment_service_proto_rawDescData
+}
+
+var file_historyquiz_attachment_v1_attachment_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_historyquiz_attachment_v1_attachment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_historyquiz_attachment_v1_attachment_service_proto_goTypes = []any{
+	(AttachmentKind)(0),                  // 0: historyquiz.attachment.v1.AttachmentKind
+	(*Attachment)(nil),                   // 1: historyquiz.attachment.v1.Attachment
+	(*QuestionAttachment)(nil),           // 2: historyquiz.attachment.v1.QuestionAttachment
+	(*UploadAttachmentRequest)(nil),      // 3: historyquiz.attachment.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),     // 4: historyquiz.attachment.v1.UploadAttachmentResponse
+	(*GetAttachmentContentRequest)(nil),  // 5: historyquiz.attachment.v1.GetAttachmentContentRequest
+	(*GetAttachmentContentResponse)(nil), // 6: historyquiz.attachment.v1.GetAttachmentContentResponse
+	(*v1.RequestContext)(nil),            // 7: historyquiz.common.v1.RequestContext
+}
+var file_historyquiz_attachment_v1_attachment_service_proto_depIdxs = []int32{
+	0,  // 0: historyquiz.attachment.v1.Attachment.kind:type_name -> historyquiz.attachment.v1.AttachmentKind
+	0,  // 1: historyquiz.attachment.v1.QuestionAttachment.kind:type_name -> historyquiz.attachment.v1.AttachmentKind
+	7,  // 2: historyquiz.attachment.v1.UploadAttachmentRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	0,  // 3: historyquiz.attachment.v1.UploadAttachmentRequest.kind:type_name -> historyquiz.attachment.v1.AttachmentKind
+	7,  // 4: historyquiz.attachment.v1.UploadAttachmentResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	1,  // 5: historyquiz.attachment.v1.UploadAttachmentResponse.attachment:type_name -> historyquiz.attachment.v1.Attachment
+	7,  // 6: historyquiz.attachment.v1.GetAttachmentContentRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	7,  // 7: historyquiz.attachment.v1.GetAttachmentContentResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	3,  // 8: historyquiz.attachment.v1.AttachmentService.UploadAttachment:input_type -> historyquiz.attachment.v1.UploadAttachmentRequest
+	5,  // 9: historyquiz.attachment.v1.AttachmentService.GetAttachmentContent:input_type -> historyquiz.attachment.v1.GetAttachmentContentRequest
+	4,  // 10: historyquiz.attachment.v1.AttachmentService.UploadAttachment:output_type -> historyquiz.attachment.v1.UploadAttachmentResponse
+	6,  // 11: historyquiz.attachment.v1.AttachmentService.GetAttachmentContent:output_type -> historyquiz.attachment.v1.GetAttachmentContentResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_historyquiz_attachment_v1_attachment_service_proto_init() }
+func file_historyquiz_attachment_v1_attachment_service_proto_init() {
+	if File_historyquiz_attachment_v1_attachment_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_historyquiz_attachment_v1_attachment_service_proto_rawDesc), len(file_historyquiz_attachment_v1_attachment_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_historyquiz_attachment_v1_attachment_service_proto_goTypes,
+		DependencyIndexes: file_historyquiz_attachment_v1_attachment_service_proto_depIdxs,
+		EnumInfos:         file_historyquiz_attachment_v1_attachment_service_proto_enumTypes,
+		MessageInfos:      file_historyquiz_attachment_v1_attachment_service_proto_msgTypes,
+	}.Build()
+	File_historyquiz_attachment_v1_attachment_service_proto = out.File
+	file_historyquiz_attachment_v1_attachment_service_proto_goTypes = nil
+	file_historyquiz_attachment_v1_attachment_service_proto_depIdxs = nil
+}
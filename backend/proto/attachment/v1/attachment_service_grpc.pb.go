@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: historyquiz/attachment/v1/attachment_service.proto
+
+package attachmentv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AttachmentService_UploadAttachment_FullMethodName     = "/historyquiz.attachment.v1.AttachmentService/UploadAttachment"
+	AttachmentService_GetAttachmentContent_FullMethodName = "/historyquiz.attachment.v1.AttachmentService/GetAttachmentContent"
+)
+
+// AttachmentServiceClient is the client API for AttachmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 問題に付ける画像/地図（肖像画、地図、史料の写真など）を扱うサービス。
+type AttachmentServiceClient interface {
+	// 画像をアップロードする（PNG/JPEG/WebP/GIF、2MiB まで）。
+	// 問題に付けるには、返った attachment.id を QuestionDraft.attachments で指定する。
+	// NOTE: どの問題にも付けられないまま一定時間が過ぎた添付は削除される。
+	UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error)
+	// 添付の本体を chunk に分けて順に返す（連結すると1ファイルになる）。
+	// 公開中の問題の添付は未ログインでも取得できる。それ以外はアップロードした本人のみ。
+	GetAttachmentContent(ctx context.Context, in *GetAttachmentContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetAttachmentContentResponse], error)
+}
+
+type attachmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttachmentServiceClient(cc grpc.ClientConnInterface) AttachmentServiceClient {
+	return &attachmentServiceClient{cc}
+}
+
+func (c *attachmentServiceClient) UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadAttachmentResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_UploadAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) GetAttachmentContent(ctx context.Context, in *GetAttachmentContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetAttachmentContentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[0], AttachmentService_GetAttachmentContent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetAttachmentContentRequest, GetAttachmentContentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_GetAttachmentContentClient = grpc.ServerStreamingClient[GetAttachmentContentResponse]
+
+// AttachmentServiceServer is the server API for AttachmentService service.
+// All implementations must embed UnimplementedAttachmentServiceServer
+// for forward compatibility.
+//
+// 問題に付ける画像/地図（肖像画、地図、史料の写真など）を扱うサービス。
+type AttachmentServiceServer interface {
+	// 画像をアップロードする（PNG/JPEG/WebP/GIF、2MiB まで）。
+	// 問題に付けるには、返った attachment.id を QuestionDraft.attachments で指定する。
+	// NOTE: どの問題にも付けられないまま一定時間が過ぎた添付は削除される。
+	UploadAttachment(context.Context, *UploadAttachmentRequest) (*UploadAttachmentResponse, error)
+	// 添付の本体を chunk に分けて順に返す（連結すると1ファイルになる）。
+	// 公開中の問題の添付は未ログインでも取得できる。それ以外はアップロードした本人のみ。
+	GetAttachmentContent(*GetAttachmentContentRequest, grpc.ServerStreamingServer[GetAttachmentContentResponse]) error
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+// UnimplementedAttachmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttachmentServiceServer struct{}
+
+func (UnimplementedAttachmentServiceServer) UploadAttachment(context.Context, *UploadAttachmentRequest) (*UploadAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) GetAttachmentContent(*GetAttachmentContentRequest, grpc.ServerStreamingServer[GetAttachmentContentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetAttachmentContent not implemented")
+}
+func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
+func (UnimplementedAttachmentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttachmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentServiceServer will
+// result in compilation errors.
+type UnsafeAttachmentServiceServer interface {
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+func RegisterAttachmentServiceServer(s grpc.ServiceRegistrar, srv AttachmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttachmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttachmentService_ServiceDesc, srv)
+}
+
+func _AttachmentService_UploadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).UploadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_UploadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).UploadAttachment(ctx, req.(*UploadAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_GetAttachmentContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAttachmentContentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttachmentServiceServer).GetAttachmentContent(m, &grpc.GenericServerStream[GetAttachmentContentRequest, GetAttachmentContentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_GetAttachmentContentServer = grpc.ServerStreamingServer[GetAttachmentContentResponse]
+
+// AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttachmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "historyquiz.attachment.v1.AttachmentService",
+	HandlerType: (*AttachmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadAttachment",
+			Handler:    _AttachmentService_UploadAttachment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetAttachmentContent",
+			Handler:       _AttachmentService_GetAttachmentContent_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "historyquiz/attachment/v1/attachment_service.proto",
+}
@@ -21,6 +21,65 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 書式付きテキスト（問題文/解説）の構文木の種類。
+type RichTextNodeKind int32
+
+const (
+	RichTextNodeKind_RICH_TEXT_NODE_KIND_UNSPECIFIED RichTextNodeKind = 0
+	RichTextNodeKind_RICH_TEXT_NODE_KIND_TEXT        RichTextNodeKind = 1
+	RichTextNodeKind_RICH_TEXT_NODE_KIND_RUBY        RichTextNodeKind = 2 // 北条時宗《ほうじょうときむね》
+	RichTextNodeKind_RICH_TEXT_NODE_KIND_EMPHASIS    RichTextNodeKind = 3 // **強調**
+	RichTextNodeKind_RICH_TEXT_NODE_KIND_LINE_BREAK  RichTextNodeKind = 4
+	RichTextNodeKind_RICH_TEXT_NODE_KIND_LINK        RichTextNodeKind = 5 // [文字列](https://...)
+)
+
+// Enum value maps for RichTextNodeKind.
+var (
+	RichTextNodeKind_name = map[int32]string{
+		0: "RICH_TEXT_NODE_KIND_UNSPECIFIED",
+		1: "RICH_TEXT_NODE_KIND_TEXT",
+		2: "RICH_TEXT_NODE_KIND_RUBY",
+		3: "RICH_TEXT_NODE_KIND_EMPHASIS",
+		4: "RICH_TEXT_NODE_KIND_LINE_BREAK",
+		5: "RICH_TEXT_NODE_KIND_LINK",
+	}
+	RichTextNodeKind_value = map[string]int32{
+		"RICH_TEXT_NODE_KIND_UNSPECIFIED": 0,
+		"RICH_TEXT_NODE_KIND_TEXT":        1,
+		"RICH_TEXT_NODE_KIND_RUBY":        2,
+		"RICH_TEXT_NODE_KIND_EMPHASIS":    3,
+		"RICH_TEXT_NODE_KIND_LINE_BREAK":  4,
+		"RICH_TEXT_NODE_KIND_LINK":        5,
+	}
+)
+
+func (x RichTextNodeKind) Enum() *RichTextNodeKind {
+	p := new(RichTextNodeKind)
+	*p = x
+	return p
+}
+
+func (x RichTextNodeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RichTextNodeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_common_v1_common_proto_enumTypes[0].Descriptor()
+}
+
+func (RichTextNodeKind) Type() protoreflect.EnumType {
+	return &file_historyquiz_common_v1_common_proto_enumTypes[0]
+}
+
+func (x RichTextNodeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RichTextNodeKind.Descriptor instead.
+func (RichTextNodeKind) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_common_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
 // リクエストを横断して追跡するためのコンテキスト。
 // 実際の伝播は gRPC metadata（例: x-request-id）を主とするが、デバッグ用途で message 側にも持てるようにする。
 type RequestContext struct {
@@ -317,6 +376,181 @@ func (x *ErrorDetail) GetMetadata() map[string]string {
 	return nil
 }
 
+// 地球上の地点（度）。緯度は北緯が正（-90..90）、経度は東経が正（-180..180）。
+type LatLng struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatLng) Reset() {
+	*x = LatLng{}
+	mi := &file_historyquiz_common_v1_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatLng) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatLng) ProtoMessage() {}
+
+func (x *LatLng) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_common_v1_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatLng.ProtoReflect.Descriptor instead.
+func (*LatLng) Descriptor() ([]byte, []int) {
+	return file_historyquiz_common_v1_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LatLng) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *LatLng) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type RichTextNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          RichTextNodeKind       `protobuf:"varint,1,opt,name=kind,proto3,enum=historyquiz.common.v1.RichTextNodeKind" json:"kind,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`         // TEXT の文字列、RUBY の親文字
+	Reading       string                 `protobuf:"bytes,3,opt,name=reading,proto3" json:"reading,omitempty"`   // RUBY の読み
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`           // LINK のリンク先（http/https のみ）
+	Children      []*RichTextNode        `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"` // EMPHASIS / LINK の中身
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RichTextNode) Reset() {
+	*x = RichTextNode{}
+	mi := &file_historyquiz_common_v1_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RichTextNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RichTextNode) ProtoMessage() {}
+
+func (x *RichTextNode) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_common_v1_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RichTextNode.ProtoReflect.Descriptor instead.
+func (*RichTextNode) Descriptor() ([]byte, []int) {
+	return file_historyquiz_common_v1_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RichTextNode) GetKind() RichTextNodeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RichTextNodeKind_RICH_TEXT_NODE_KIND_UNSPECIFIED
+}
+
+func (x *RichTextNode) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RichTextNode) GetReading() string {
+	if x != nil {
+		return x.Reading
+	}
+	return ""
+}
+
+func (x *RichTextNode) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RichTextNode) GetChildren() []*RichTextNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// 書式付きテキストの構文木。
+// NOTE: クライアントは構文木を描画し、文字列を HTML として解釈しない（text はそのまま文字として表示する）。
+type RichText struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*RichTextNode        `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RichText) Reset() {
+	*x = RichText{}
+	mi := &file_historyquiz_common_v1_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RichText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RichText) ProtoMessage() {}
+
+func (x *RichText) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_common_v1_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RichText.ProtoReflect.Descriptor instead.
+func (*RichText) Descriptor() ([]byte, []int) {
+	return file_historyquiz_common_v1_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RichText) GetNodes() []*RichTextNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
 var File_historyquiz_common_v1_common_proto protoreflect.FileDescriptor
 
 const file_historyquiz_common_v1_common_proto_rawDesc = "" +
@@ -342,7 +576,25 @@ const file_historyquiz_common_v1_common_proto_rawDesc = "" +
 	"\bmetadata\x18\x02 \x03(\v20.historyquiz.common.v1.ErrorDetail.MetadataEntryR\bmetadata\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B>Z<github.com/history-quiz/historyquiz/proto/common/v1;commonv1b\x06proto3"
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"B\n" +
+	"\x06LatLng\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xcc\x01\n" +
+	"\fRichTextNode\x12;\n" +
+	"\x04kind\x18\x01 \x01(\x0e2'.historyquiz.common.v1.RichTextNodeKindR\x04kind\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x18\n" +
+	"\areading\x18\x03 \x01(\tR\areading\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12?\n" +
+	"\bchildren\x18\x05 \x03(\v2#.historyquiz.common.v1.RichTextNodeR\bchildren\"E\n" +
+	"\bRichText\x129\n" +
+	"\x05nodes\x18\x01 \x03(\v2#.historyquiz.common.v1.RichTextNodeR\x05nodes*\xd7\x01\n" +
+	"\x10RichTextNodeKind\x12#\n" +
+	"\x1fRICH_TEXT_NODE_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RICH_TEXT_NODE_KIND_TEXT\x10\x01\x12\x1c\n" +
+	"\x18RICH_TEXT_NODE_KIND_RUBY\x10\x02\x12 \n" +
+	"\x1cRICH_TEXT_NODE_KIND_EMPHASIS\x10\x03\x12\"\n" +
+	"\x1eRICH_TEXT_NODE_KIND_LINE_BREAK\x10\x04\x12\x1c\n" +
+	"\x18RICH_TEXT_NODE_KIND_LINK\x10\x05B>Z<github.com/history-quiz/historyquiz/proto/common/v1;commonv1b\x06proto3"
 
 var (
 	file_historyquiz_common_v1_common_proto_rawDescOnce sync.Once
@@ -356,24 +608,32 @@ func file_historyquiz_common_v1_common_proto_rawDescGZIP() []byte {
 	return file_historyquiz_common_v1_common_proto_rawDescData
 }
 
-var file_historyquiz_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_historyquiz_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_historyquiz_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_historyquiz_common_v1_common_proto_goTypes = []any{
-	(*RequestContext)(nil), // 0: historyquiz.common.v1.RequestContext
-	(*UserContext)(nil),    // 1: historyquiz.common.v1.UserContext
-	(*Pagination)(nil),     // 2: historyquiz.common.v1.Pagination
-	(*PageInfo)(nil),       // 3: historyquiz.common.v1.PageInfo
-	(*FieldViolation)(nil), // 4: historyquiz.common.v1.FieldViolation
-	(*ErrorDetail)(nil),    // 5: historyquiz.common.v1.ErrorDetail
-	nil,                    // 6: historyquiz.common.v1.ErrorDetail.MetadataEntry
+	(RichTextNodeKind)(0),  // 0: historyquiz.common.v1.RichTextNodeKind
+	(*RequestContext)(nil), // 1: historyquiz.common.v1.RequestContext
+	(*UserContext)(nil),    // 2: historyquiz.common.v1.UserContext
+	(*Pagination)(nil),     // 3: historyquiz.common.v1.Pagination
+	(*PageInfo)(nil),       // 4: historyquiz.common.v1.PageInfo
+	(*FieldViolation)(nil), // 5: historyquiz.common.v1.FieldViolation
+	(*ErrorDetail)(nil),    // 6: historyquiz.common.v1.ErrorDetail
+	(*LatLng)(nil),         // 7: historyquiz.common.v1.LatLng
+	(*RichTextNode)(nil),   // 8: historyquiz.common.v1.RichTextNode
+	(*RichText)(nil),       // 9: historyquiz.common.v1.RichText
+	nil,                    // 10: historyquiz.common.v1.ErrorDetail.MetadataEntry
 }
 var file_historyquiz_common_v1_common_proto_depIdxs = []int32{
-	4, // 0: historyquiz.common.v1.ErrorDetail.field_violations:type_name -> historyquiz.common.v1.FieldViolation
-	6, // 1: historyquiz.common.v1.ErrorDetail.metadata:type_name -> historyquiz.common.v1.ErrorDetail.MetadataEntry
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5,  // 0: historyquiz.common.v1.ErrorDetail.field_violations:type_name -> historyquiz.common.v1.FieldViolation
+	10, // 1: historyquiz.common.v1.ErrorDetail.metadata:type_name -> historyquiz.common.v1.ErrorDetail.MetadataEntry
+	0,  // 2: historyquiz.common.v1.RichTextNode.kind:type_name -> historyquiz.common.v1.RichTextNodeKind
+	8,  // 3: historyquiz.common.v1.RichTextNode.children:type_name -> historyquiz.common.v1.RichTextNode
+	8,  // 4: historyquiz.common.v1.RichText.nodes:type_name -> historyquiz.common.v1.RichTextNode
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_historyquiz_common_v1_common_proto_init() }
@@ -386,13 +646,14 @@ func file_historyquiz_common_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_historyquiz_common_v1_common_proto_rawDesc), len(file_historyquiz_common_v1_common_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_historyquiz_common_v1_common_proto_goTypes,
 		DependencyIndexes: file_historyquiz_common_v1_common_proto_depIdxs,
+		EnumInfos:         file_historyquiz_common_v1_common_proto_enumTypes,
 		MessageInfos:      file_historyquiz_common_v1_common_proto_msgTypes,
 	}.Build()
 	File_historyquiz_common_v1_common_proto = out.File
@@ -0,0 +1,997 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: historyquiz/deck/v1/deck_service.proto
+
+package deckv1
+
+import (
+	v1 "github.com/history-quiz/historyquiz/proto/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Deck struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerUserId string                 `protobuf:"bytes,2,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// 共有用文字列（出力専用）。作成時に生成する。
+	ShareSlug string `protobuf:"bytes,5,opt,name=share_slug,json=shareSlug,proto3" json:"share_slug,omitempty"`
+	// 出題順の問題 ID。GetMyDeck/CreateDeck/UpdateDeck でだけ返す。
+	QuestionIds []string `protobuf:"bytes,6,rep,name=question_ids,json=questionIds,proto3" json:"question_ids,omitempty"`
+	// 今出題できる（公開中で非表示でない）問題の数（出力専用）。
+	PlayableCount int32  `protobuf:"varint,7,opt,name=playable_count,json=playableCount,proto3" json:"playable_count,omitempty"`
+	CreatedAt     string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	UpdatedAt     string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deck) Reset() {
+	*x = Deck{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deck) ProtoMessage() {}
+
+func (x *Deck) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deck.ProtoReflect.Descriptor instead.
+func (*Deck) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Deck) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Deck) GetOwnerUserId() string {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return ""
+}
+
+func (x *Deck) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Deck) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Deck) GetShareSlug() string {
+	if x != nil {
+		return x.ShareSlug
+	}
+	return ""
+}
+
+func (x *Deck) GetQuestionIds() []string {
+	if x != nil {
+		return x.QuestionIds
+	}
+	return nil
+}
+
+func (x *Deck) GetPlayableCount() int32 {
+	if x != nil {
+		return x.PlayableCount
+	}
+	return 0
+}
+
+func (x *Deck) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Deck) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// デッキの作成/更新の入力。question_ids は出題順（100 件まで、重複不可）。
+type DeckDraft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`             // 100 文字まで
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // 1000 文字まで
+	QuestionIds   []string               `protobuf:"bytes,3,rep,name=question_ids,json=questionIds,proto3" json:"question_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckDraft) Reset() {
+	*x = DeckDraft{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckDraft) ProtoMessage() {}
+
+func (x *DeckDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckDraft.ProtoReflect.Descriptor instead.
+func (*DeckDraft) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeckDraft) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DeckDraft) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DeckDraft) GetQuestionIds() []string {
+	if x != nil {
+		return x.QuestionIds
+	}
+	return nil
+}
+
+type CreateDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Draft         *DeckDraft             `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeckRequest) Reset() {
+	*x = CreateDeckRequest{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeckRequest) ProtoMessage() {}
+
+func (x *CreateDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeckRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeckRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateDeckRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CreateDeckRequest) GetDraft() *DeckDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type CreateDeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Deck          *Deck                  `protobuf:"bytes,2,opt,name=deck,proto3" json:"deck,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeckResponse) Reset() {
+	*x = CreateDeckResponse{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeckResponse) ProtoMessage() {}
+
+func (x *CreateDeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeckResponse.ProtoReflect.Descriptor instead.
+func (*CreateDeckResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateDeckResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CreateDeckResponse) GetDeck() *Deck {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+type UpdateDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	Draft         *DeckDraft             `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeckRequest) Reset() {
+	*x = UpdateDeckRequest{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeckRequest) ProtoMessage() {}
+
+func (x *UpdateDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeckRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeckRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateDeckRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *UpdateDeckRequest) GetDraft() *DeckDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type UpdateDeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Deck          *Deck                  `protobuf:"bytes,2,opt,name=deck,proto3" json:"deck,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeckResponse) Reset() {
+	*x = UpdateDeckResponse{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeckResponse) ProtoMessage() {}
+
+func (x *UpdateDeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeckResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeckResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateDeckResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateDeckResponse) GetDeck() *Deck {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+type DeleteDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeckRequest) Reset() {
+	*x = DeleteDeckRequest{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeckRequest) ProtoMessage() {}
+
+func (x *DeleteDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeckRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeckRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteDeckRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *DeleteDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type DeleteDeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeckResponse) Reset() {
+	*x = DeleteDeckResponse{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeckResponse) ProtoMessage() {}
+
+func (x *DeleteDeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeckResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDeckResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteDeckResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type GetMyDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyDeckRequest) Reset() {
+	*x = GetMyDeckRequest{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyDeckRequest) ProtoMessage() {}
+
+func (x *GetMyDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyDeckRequest.ProtoReflect.Descriptor instead.
+func (*GetMyDeckRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMyDeckRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetMyDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type GetMyDeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Deck          *Deck                  `protobuf:"bytes,2,opt,name=deck,proto3" json:"deck,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyDeckResponse) Reset() {
+	*x = GetMyDeckResponse{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyDeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyDeckResponse) ProtoMessage() {}
+
+func (x *GetMyDeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyDeckResponse.ProtoReflect.Descriptor instead.
+func (*GetMyDeckResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMyDeckResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetMyDeckResponse) GetDeck() *Deck {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+type ListMyDecksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Pagination    *v1.Pagination         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyDecksRequest) Reset() {
+	*x = ListMyDecksRequest{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyDecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyDecksRequest) ProtoMessage() {}
+
+func (x *ListMyDecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyDecksRequest.ProtoReflect.Descriptor instead.
+func (*ListMyDecksRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMyDecksRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListMyDecksRequest) GetPagination() *v1.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListMyDecksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Decks         []*Deck                `protobuf:"bytes,2,rep,name=decks,proto3" json:"decks,omitempty"` // question_ids は空
+	PageInfo      *v1.PageInfo           `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyDecksResponse) Reset() {
+	*x = ListMyDecksResponse{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyDecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyDecksResponse) ProtoMessage() {}
+
+func (x *ListMyDecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyDecksResponse.ProtoReflect.Descriptor instead.
+func (*ListMyDecksResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMyDecksResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListMyDecksResponse) GetDecks() []*Deck {
+	if x != nil {
+		return x.Decks
+	}
+	return nil
+}
+
+func (x *ListMyDecksResponse) GetPageInfo() *v1.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type GetSharedDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	ShareSlug     string                 `protobuf:"bytes,2,opt,name=share_slug,json=shareSlug,proto3" json:"share_slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedDeckRequest) Reset() {
+	*x = GetSharedDeckRequest{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedDeckRequest) ProtoMessage() {}
+
+func (x *GetSharedDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedDeckRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedDeckRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSharedDeckRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetSharedDeckRequest) GetShareSlug() string {
+	if x != nil {
+		return x.ShareSlug
+	}
+	return ""
+}
+
+type GetSharedDeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Deck          *Deck                  `protobuf:"bytes,2,opt,name=deck,proto3" json:"deck,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedDeckResponse) Reset() {
+	*x = GetSharedDeckResponse{}
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedDeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedDeckResponse) ProtoMessage() {}
+
+func (x *GetSharedDeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_deck_v1_deck_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedDeckResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedDeckResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSharedDeckResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetSharedDeckResponse) GetDeck() *Deck {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+var File_historyquiz_deck_v1_deck_service_proto protoreflect.FileDescriptor
+
+const file_historyquiz_deck_v1_deck_service_proto_rawDesc = "" +
+	"\n" +
+	"&historyquiz/deck/v1/deck_service.proto\x12\x13historyquiz.deck.v1\x1a\"historyquiz/common/v1/common.proto\"\x99\x02\n" +
+	"\x04Deck\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rowner_user_id\x18\x02 \x01(\tR\vownerUserId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"share_slug\x18\x05 \x01(\tR\tshareSlug\x12!\n" +
+	"\fquestion_ids\x18\x06 \x03(\tR\vquestionIds\x12%\n" +
+	"\x0eplayable_count\x18\a \x01(\x05R\rplayableCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"f\n" +
+	"\tDeckDraft\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12!\n" +
+	"\fquestion_ids\x18\x03 \x03(\tR\vquestionIds\"\x8a\x01\n" +
+	"\x11CreateDeckRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x124\n" +
+	"\x05draft\x18\x02 \x01(\v2\x1e.historyquiz.deck.v1.DeckDraftR\x05draft\"\x84\x01\n" +
+	"\x12CreateDeckResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12-\n" +
+	"\x04deck\x18\x02 \x01(\v2\x19.historyquiz.deck.v1.DeckR\x04deck\"\xa3\x01\n" +
+	"\x11UpdateDeckRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\x124\n" +
+	"\x05draft\x18\x03 \x01(\v2\x1e.historyquiz.deck.v1.DeckDraftR\x05draft\"\x84\x01\n" +
+	"\x12UpdateDeckResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12-\n" +
+	"\x04deck\x18\x02 \x01(\v2\x19.historyquiz.deck.v1.DeckR\x04deck\"m\n" +
+	"\x11DeleteDeckRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\"U\n" +
+	"\x12DeleteDeckResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\"l\n" +
+	"\x10GetMyDeckRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\"\x83\x01\n" +
+	"\x11GetMyDeckResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12-\n" +
+	"\x04deck\x18\x02 \x01(\v2\x19.historyquiz.deck.v1.DeckR\x04deck\"\x98\x01\n" +
+	"\x12ListMyDecksRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12A\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2!.historyquiz.common.v1.PaginationR\n" +
+	"pagination\"\xc5\x01\n" +
+	"\x13ListMyDecksResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12/\n" +
+	"\x05decks\x18\x02 \x03(\v2\x19.historyquiz.deck.v1.DeckR\x05decks\x12<\n" +
+	"\tpage_info\x18\x03 \x01(\v2\x1f.historyquiz.common.v1.PageInfoR\bpageInfo\"v\n" +
+	"\x14GetSharedDeckRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1d\n" +
+	"\n" +
+	"share_slug\x18\x02 \x01(\tR\tshareSlug\"\x87\x01\n" +
+	"\x15GetSharedDeckResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12-\n" +
+	"\x04deck\x18\x02 \x01(\v2\x19.historyquiz.deck.v1.DeckR\x04deck2\xd0\x04\n" +
+	"\vDeckService\x12]\n" +
+	"\n" +
+	"CreateDeck\x12&.historyquiz.deck.v1.CreateDeckRequest\x1a'.historyquiz.deck.v1.CreateDeckResponse\x12]\n" +
+	"\n" +
+	"UpdateDeck\x12&.historyquiz.deck.v1.UpdateDeckRequest\x1a'.historyquiz.deck.v1.UpdateDeckResponse\x12]\n" +
+	"\n" +
+	"DeleteDeck\x12&.historyquiz.deck.v1.DeleteDeckRequest\x1a'.historyquiz.deck.v1.DeleteDeckResponse\x12Z\n" +
+	"\tGetMyDeck\x12%.historyquiz.deck.v1.GetMyDeckRequest\x1a&.historyquiz.deck.v1.GetMyDeckResponse\x12`\n" +
+	"\vListMyDecks\x12'.historyquiz.deck.v1.ListMyDecksRequest\x1a(.historyquiz.deck.v1.ListMyDecksResponse\x12f\n" +
+	"\rGetSharedDeck\x12).historyquiz.deck.v1.GetSharedDeckRequest\x1a*.historyquiz.deck.v1.GetSharedDeckResponseB:Z8github.com/history-quiz/historyquiz/proto/deck/v1;deckv1b\x06proto3"
+
+var (
+	file_historyquiz_deck_v1_deck_service_proto_rawDescOnce sync.Once
+	file_historyquiz_deck_v1_deck_service_proto_rawDescData []byte
+)
+
+func file_historyquiz_deck_v1_deck_service_proto_rawDescGZIP() []byte {
+	file_historyquiz_deck_v1_deck_service_proto_rawDescOnce.Do(func() {
+		file_historyquiz_deck_v1_deck_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_historyquiz_deck_v1_deck_service_proto_rawDesc), len(file_historyquiz_deck_v1_deck_service_proto_rawDesc)))
+	})
+	return file_historyquiz_deck_v1_deck_service_proto_rawDescData
+}
+
+var file_historyquiz_deck_v1_deck_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_historyquiz_deck_v1_deck_service_proto_goTypes = []any{
+	(*Deck)(nil),                  // 0: historyquiz.deck.v1.Deck
+	(*DeckDraft)(nil),             // 1: historyquiz.deck.v1.DeckDraft
+	(*CreateDeckRequest)(nil),     // 2: historyquiz.deck.v1.CreateDeckRequest
+	(*CreateDeckResponse)(nil),    // 3: historyquiz.deck.v1.CreateDeckResponse
+	(*UpdateDeckRequest)(nil),     // 4: historyquiz.deck.v1.UpdateDeckRequest
+	(*UpdateDeckResponse)(nil),    // 5: historyquiz.deck.v1.UpdateDeckResponse
+	(*DeleteDeckRequest)(nil),     // 6: historyquiz.deck.v1.DeleteDeckRequest
+	(*DeleteDeckResponse)(nil),    // 7: historyquiz.deck.v1.DeleteDeckResponse
+	(*GetMyDeckRequest)(nil),      // 8: historyquiz.deck.v1.GetMyDeckRequest
+	(*GetMyDeckResponse)(nil),     // 9: historyquiz.deck.v1.GetMyDeckResponse
+	(*ListMyDecksRequest)(nil),    // 10: historyquiz.deck.v1.ListMyDecksRequest
+	(*ListMyDecksResponse)(nil),   // 11: historyquiz.deck.v1.ListMyDecksResponse
+	(*GetSharedDeckRequest)(nil),  // 12: historyquiz.deck.v1.GetSharedDeckRequest
+	(*GetSharedDeckResponse)(nil), // 13: historyquiz.deck.v1.GetSharedDeckResponse
+	(*v1.RequestContext)(nil),     // 14: historyquiz.common.v1.RequestContext
+	(*v1.Pagination)(nil),         // 15: historyquiz.common.v1.Pagination
+	(*v1.PageInfo)(nil),           // 16: historyquiz.common.v1.PageInfo
+}
+var file_historyquiz_deck_v1_deck_service_proto_depIdxs = []int32{
+	14, // 0: historyquiz.deck.v1.CreateDeckRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	1,  // 1: historyquiz.deck.v1.CreateDeckRequest.draft:type_name -> historyquiz.deck.v1.DeckDraft
+	14, // 2: historyquiz.deck.v1.CreateDeckResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	0,  // 3: historyquiz.deck.v1.CreateDeckResponse.deck:type_name -> historyquiz.deck.v1.Deck
+	14, // 4: historyquiz.deck.v1.UpdateDeckRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	1,  // 5: historyquiz.deck.v1.UpdateDeckRequest.draft:type_name -> historyquiz.deck.v1.DeckDraft
+	14, // 6: historyquiz.deck.v1.UpdateDeckResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	0,  // 7: historyquiz.deck.v1.UpdateDeckResponse.deck:type_name -> historyquiz.deck.v1.Deck
+	14, // 8: historyquiz.deck.v1.DeleteDeckRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	14, // 9: historyquiz.deck.v1.DeleteDeckResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	14, // 10: historyquiz.deck.v1.GetMyDeckRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	14, // 11: historyquiz.deck.v1.GetMyDeckResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	0,  // 12: historyquiz.deck.v1.GetMyDeckResponse.deck:type_name -> historyquiz.deck.v1.Deck
+	14, // 13: historyquiz.deck.v1.ListMyDecksRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	15, // 14: historyquiz.deck.v1.ListMyDecksRequest.pagination:type_name -> historyquiz.common.v1.Pagination
+	14, // 15: historyquiz.deck.v1.ListMyDecksResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	0,  // 16: historyquiz.deck.v1.ListMyDecksResponse.decks:type_name -> historyquiz.deck.v1.Deck
+	16, // 17: historyquiz.deck.v1.ListMyDecksResponse.page_info:type_name -> historyquiz.common.v1.PageInfo
+	14, // 18: historyquiz.deck.v1.GetSharedDeckRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	14, // 19: historyquiz.deck.v1.GetSharedDeckResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	0,  // 20: historyquiz.deck.v1.GetSharedDeckResponse.deck:type_name -> historyquiz.deck.v1.Deck
+	2,  // 21: historyquiz.deck.v1.DeckService.CreateDeck:input_type -> historyquiz.deck.v1.CreateDeckRequest
+	4,  // 22: historyquiz.deck.v1.DeckService.UpdateDeck:input_type -> historyquiz.deck.v1.UpdateDeckRequest
+	6,  // 23: historyquiz.deck.v1.DeckService.DeleteDeck:input_type -> historyquiz.deck.v1.DeleteDeckRequest
+	8,  // 24: historyquiz.deck.v1.DeckService.GetMyDeck:input_type -> historyquiz.deck.v1.GetMyDeckRequest
+	10, // 25: historyquiz.deck.v1.DeckService.ListMyDecks:input_type -> historyquiz.deck.v1.ListMyDecksRequest
+	12, // 26: historyquiz.deck.v1.DeckService.GetSharedDeck:input_type -> historyquiz.deck.v1.GetSharedDeckRequest
+	3,  // 27: historyquiz.deck.v1.DeckService.CreateDeck:output_type -> historyquiz.deck.v1.CreateDeckResponse
+	5,  // 28: historyquiz.deck.v1.DeckService.UpdateDeck:output_type -> historyquiz.deck.v1.UpdateDeckResponse
+	7,  // 29: historyquiz.deck.v1.DeckService.DeleteDeck:output_type -> historyquiz.deck.v1.DeleteDeckResponse
+	9,  // 30: historyquiz.deck.v1.DeckService.GetMyDeck:output_type -> historyquiz.deck.v1.GetMyDeckResponse
+	11, // 31: historyquiz.deck.v1.DeckService.ListMyDecks:output_type -> historyquiz.deck.v1.ListMyDecksResponse
+	13, // 32: historyquiz.deck.v1.DeckService.GetSharedDeck:output_type -> historyquiz.deck.v1.GetSharedDeckResponse
+	27, // [27:33] is the sub-list for method output_type
+	21, // [21:27] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_historyquiz_deck_v1_deck_service_proto_init() }
+func file_historyquiz_deck_v1_deck_service_proto_init() {
+	if File_historyquiz_deck_v1_deck_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_historyquiz_deck_v1_deck_service_proto_rawDesc), len(file_historyquiz_deck_v1_deck_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_historyquiz_deck_v1_deck_service_proto_goTypes,
+		DependencyIndexes: file_historyquiz_deck_v1_deck_service_proto_depIdxs,
+		MessageInfos:      file_historyquiz_deck_v1_deck_service_proto_msgTypes,
+	}.Build()
+	File_historyquiz_deck_v1_deck_service_proto = out.File
+	file_historyquiz_deck_v1_deck_service_proto_goTypes = nil
+	file_historyquiz_deck_v1_deck_service_proto_depIdxs = nil
+}
@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: historyquiz/deck/v1/deck_service.proto
+
+package deckv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DeckService_CreateDeck_FullMethodName    = "/historyquiz.deck.v1.DeckService/CreateDeck"
+	DeckService_UpdateDeck_FullMethodName    = "/historyquiz.deck.v1.DeckService/UpdateDeck"
+	DeckService_DeleteDeck_FullMethodName    = "/historyquiz.deck.v1.DeckService/DeleteDeck"
+	DeckService_GetMyDeck_FullMethodName     = "/historyquiz.deck.v1.DeckService/GetMyDeck"
+	DeckService_ListMyDecks_FullMethodName   = "/historyquiz.deck.v1.DeckService/ListMyDecks"
+	DeckService_GetSharedDeck_FullMethodName = "/historyquiz.deck.v1.DeckService/GetSharedDeck"
+)
+
+// DeckServiceClient is the client API for DeckService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// デッキ（ユーザーが作る問題集。例: 「幕末10問」）を扱うサービス。
+// デッキで遊ぶには、QuizService.GetQuestion の deck_id にデッキの ID を指定する。
+type DeckServiceClient interface {
+	// デッキを作成する。問題は自分の問題か、他人の公開中の問題を指定できる。
+	CreateDeck(ctx context.Context, in *CreateDeckRequest, opts ...grpc.CallOption) (*CreateDeckResponse, error)
+	// 自分のデッキの題名/説明/問題の並びを置き換える（所有者のみ）。
+	UpdateDeck(ctx context.Context, in *UpdateDeckRequest, opts ...grpc.CallOption) (*UpdateDeckResponse, error)
+	// 自分のデッキを論理削除する（所有者のみ）。共有リンクからも開けなくなる。
+	DeleteDeck(ctx context.Context, in *DeleteDeckRequest, opts ...grpc.CallOption) (*DeleteDeckResponse, error)
+	// 自分のデッキを問題の並び付きで取得する（所有者のみ）。
+	GetMyDeck(ctx context.Context, in *GetMyDeckRequest, opts ...grpc.CallOption) (*GetMyDeckResponse, error)
+	// 自分のデッキ一覧（作成の新しい順）。
+	ListMyDecks(ctx context.Context, in *ListMyDecksRequest, opts ...grpc.CallOption) (*ListMyDecksResponse, error)
+	// 共有用文字列からデッキを取得する（未ログインでも呼べる）。question_ids は返さない。
+	GetSharedDeck(ctx context.Context, in *GetSharedDeckRequest, opts ...grpc.CallOption) (*GetSharedDeckResponse, error)
+}
+
+type deckServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeckServiceClient(cc grpc.ClientConnInterface) DeckServiceClient {
+	return &deckServiceClient{cc}
+}
+
+func (c *deckServiceClient) CreateDeck(ctx context.Context, in *CreateDeckRequest, opts ...grpc.CallOption) (*CreateDeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_CreateDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) UpdateDeck(ctx context.Context, in *UpdateDeckRequest, opts ...grpc.CallOption) (*UpdateDeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_UpdateDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) DeleteDeck(ctx context.Context, in *DeleteDeckRequest, opts ...grpc.CallOption) (*DeleteDeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_DeleteDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) GetMyDeck(ctx context.Context, in *GetMyDeckRequest, opts ...grpc.CallOption) (*GetMyDeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyDeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_GetMyDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) ListMyDecks(ctx context.Context, in *ListMyDecksRequest, opts ...grpc.CallOption) (*ListMyDecksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyDecksResponse)
+	err := c.cc.Invoke(ctx, DeckService_ListMyDecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) GetSharedDeck(ctx context.Context, in *GetSharedDeckRequest, opts ...grpc.CallOption) (*GetSharedDeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedDeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_GetSharedDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeckServiceServer is the server API for DeckService service.
+// All implementations must embed UnimplementedDeckServiceServer
+// for forward compatibility.
+//
+// デッキ（ユーザーが作る問題集。例: 「幕末10問」）を扱うサービス。
+// デッキで遊ぶには、QuizService.GetQuestion の deck_id にデッキの ID を指定する。
+type DeckServiceServer interface {
+	// デッキを作成する。問題は自分の問題か、他人の公開中の問題を指定できる。
+	CreateDeck(context.Context, *CreateDeckRequest) (*CreateDeckResponse, error)
+	// 自分のデッキの題名/説明/問題の並びを置き換える（所有者のみ）。
+	UpdateDeck(context.Context, *UpdateDeckRequest) (*UpdateDeckResponse, error)
+	// 自分のデッキを論理削除する（所有者のみ）。共有リンクからも開けなくなる。
+	DeleteDeck(context.Context, *DeleteDeckRequest) (*DeleteDeckResponse, error)
+	// 自分のデッキを問題の並び付きで取得する（所有者のみ）。
+	GetMyDeck(context.Context, *GetMyDeckRequest) (*GetMyDeckResponse, error)
+	// 自分のデッキ一覧（作成の新しい順）。
+	ListMyDecks(context.Context, *ListMyDecksRequest) (*ListMyDecksResponse, error)
+	// 共有用文字列からデッキを取得する（未ログインでも呼べる）。question_ids は返さない。
+	GetSharedDeck(context.Context, *GetSharedDeckRequest) (*GetSharedDeckResponse, error)
+	mustEmbedUnimplementedDeckServiceServer()
+}
+
+// UnimplementedDeckServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeckServiceServer struct{}
+
+func (UnimplementedDeckServiceServer) CreateDeck(context.Context, *CreateDeckRequest) (*CreateDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) UpdateDeck(context.Context, *UpdateDeckRequest) (*UpdateDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) DeleteDeck(context.Context, *DeleteDeckRequest) (*DeleteDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) GetMyDeck(context.Context, *GetMyDeckRequest) (*GetMyDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) ListMyDecks(context.Context, *ListMyDecksRequest) (*ListMyDecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyDecks not implemented")
+}
+func (UnimplementedDeckServiceServer) GetSharedDeck(context.Context, *GetSharedDeckRequest) (*GetSharedDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) mustEmbedUnimplementedDeckServiceServer() {}
+func (UnimplementedDeckServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeDeckServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeckServiceServer will
+// result in compilation errors.
+type UnsafeDeckServiceServer interface {
+	mustEmbedUnimplementedDeckServiceServer()
+}
+
+func RegisterDeckServiceServer(s grpc.ServiceRegistrar, srv DeckServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDeckServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DeckService_ServiceDesc, srv)
+}
+
+func _DeckService_CreateDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).CreateDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_CreateDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).CreateDeck(ctx, req.(*CreateDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_UpdateDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).UpdateDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_UpdateDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).UpdateDeck(ctx, req.(*UpdateDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_DeleteDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).DeleteDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_DeleteDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).DeleteDeck(ctx, req.(*DeleteDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_GetMyDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).GetMyDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_GetMyDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).GetMyDeck(ctx, req.(*GetMyDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_ListMyDecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyDecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).ListMyDecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_ListMyDecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).ListMyDecks(ctx, req.(*ListMyDecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_GetSharedDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).GetSharedDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_GetSharedDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).GetSharedDeck(ctx, req.(*GetSharedDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeckService_ServiceDesc is the grpc.ServiceDesc for DeckService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeckService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "historyquiz.deck.v1.DeckService",
+	HandlerType: (*DeckServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDeck",
+			Handler:    _DeckService_CreateDeck_Handler,
+		},
+		{
+			MethodName: "UpdateDeck",
+			Handler:    _DeckService_UpdateDeck_Handler,
+		},
+		{
+			MethodName: "DeleteDeck",
+			Handler:    _DeckService_DeleteDeck_Handler,
+		},
+		{
+			MethodName: "GetMyDeck",
+			Handler:    _DeckService_GetMyDeck_Handler,
+		},
+		{
+			MethodName: "ListMyDecks",
+			Handler:    _DeckService_ListMyDecks_Handler,
+		},
+		{
+			MethodName: "GetSharedDeck",
+			Handler:    _DeckService_GetSharedDeck_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "historyquiz/deck/v1/deck_service.proto",
+}
@@ -0,0 +1,2005 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: historyquiz/entity/v1/entity_service.proto
+
+package entityv1
+
+import (
+	v1 "github.com/history-quiz/historyquiz/proto/common/v1"
+	v11 "github.com/history-quiz/historyquiz/proto/question/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EntityKind int32
+
+const (
+	EntityKind_ENTITY_KIND_UNSPECIFIED EntityKind = 0
+	EntityKind_ENTITY_KIND_PERSON      EntityKind = 1
+	EntityKind_ENTITY_KIND_EVENT       EntityKind = 2
+	EntityKind_ENTITY_KIND_PLACE       EntityKind = 3 // 都市/地域/国
+	EntityKind_ENTITY_KIND_DYNASTY     EntityKind = 4 // 王朝/政権
+)
+
+// Enum value maps for EntityKind.
+var (
+	EntityKind_name = map[int32]string{
+		0: "ENTITY_KIND_UNSPECIFIED",
+		1: "ENTITY_KIND_PERSON",
+		2: "ENTITY_KIND_EVENT",
+		3: "ENTITY_KIND_PLACE",
+		4: "ENTITY_KIND_DYNASTY",
+	}
+	EntityKind_value = map[string]int32{
+		"ENTITY_KIND_UNSPECIFIED": 0,
+		"ENTITY_KIND_PERSON":      1,
+		"ENTITY_KIND_EVENT":       2,
+		"ENTITY_KIND_PLACE":       3,
+		"ENTITY_KIND_DYNASTY":     4,
+	}
+)
+
+func (x EntityKind) Enum() *EntityKind {
+	p := new(EntityKind)
+	*p = x
+	return p
+}
+
+func (x EntityKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_entity_v1_entity_service_proto_enumTypes[0].Descriptor()
+}
+
+func (EntityKind) Type() protoreflect.EnumType {
+	return &file_historyquiz_entity_v1_entity_service_proto_enumTypes[0]
+}
+
+func (x EntityKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityKind.Descriptor instead.
+func (EntityKind) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{0}
+}
+
+// 関係の種類（subject → object の向き）。
+type EntityRelationKind int32
+
+const (
+	EntityRelationKind_ENTITY_RELATION_KIND_UNSPECIFIED EntityRelationKind = 0
+	// 人物 → 出来事
+	EntityRelationKind_ENTITY_RELATION_KIND_PARTICIPATED_IN EntityRelationKind = 1
+	// 出来事/場所 → 場所
+	EntityRelationKind_ENTITY_RELATION_KIND_LOCATED_IN EntityRelationKind = 2
+	// 人物 → 王朝
+	EntityRelationKind_ENTITY_RELATION_KIND_MEMBER_OF EntityRelationKind = 3
+)
+
+// Enum value maps for EntityRelationKind.
+var (
+	EntityRelationKind_name = map[int32]string{
+		0: "ENTITY_RELATION_KIND_UNSPECIFIED",
+		1: "ENTITY_RELATION_KIND_PARTICIPATED_IN",
+		2: "ENTITY_RELATION_KIND_LOCATED_IN",
+		3: "ENTITY_RELATION_KIND_MEMBER_OF",
+	}
+	EntityRelationKind_value = map[string]int32{
+		"ENTITY_RELATION_KIND_UNSPECIFIED":     0,
+		"ENTITY_RELATION_KIND_PARTICIPATED_IN": 1,
+		"ENTITY_RELATION_KIND_LOCATED_IN":      2,
+		"ENTITY_RELATION_KIND_MEMBER_OF":       3,
+	}
+)
+
+func (x EntityRelationKind) Enum() *EntityRelationKind {
+	p := new(EntityRelationKind)
+	*p = x
+	return p
+}
+
+func (x EntityRelationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityRelationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_entity_v1_entity_service_proto_enumTypes[1].Descriptor()
+}
+
+func (EntityRelationKind) Type() protoreflect.EnumType {
+	return &file_historyquiz_entity_v1_entity_service_proto_enumTypes[1]
+}
+
+func (x EntityRelationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityRelationKind.Descriptor instead.
+func (EntityRelationKind) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{1}
+}
+
+type EntityFileFormat int32
+
+const (
+	EntityFileFormat_ENTITY_FILE_FORMAT_UNSPECIFIED EntityFileFormat = 0
+	// ヘッダ行必須。列: key, kind, name, description, start_year, end_year, year_margin,
+	// participated_in/located_in/member_of（関係の相手の key を "|" 区切り）
+	EntityFileFormat_ENTITY_FILE_FORMAT_CSV EntityFileFormat = 1
+	// JSON-LD（{"@graph": [...]} またはノードの配列）。"@id" が key、"@type" が kind。
+	// プロパティは接頭辞を除いた名前で照合する（name, description, startYear/startDate/birthDate,
+	// endYear/endDate/deathDate, yearMargin, participatedIn, locatedIn, memberOf）。@context は読まない。
+	EntityFileFormat_ENTITY_FILE_FORMAT_JSON_LD EntityFileFormat = 2
+)
+
+// Enum value maps for EntityFileFormat.
+var (
+	EntityFileFormat_name = map[int32]string{
+		0: "ENTITY_FILE_FORMAT_UNSPECIFIED",
+		1: "ENTITY_FILE_FORMAT_CSV",
+		2: "ENTITY_FILE_FORMAT_JSON_LD",
+	}
+	EntityFileFormat_value = map[string]int32{
+		"ENTITY_FILE_FORMAT_UNSPECIFIED": 0,
+		"ENTITY_FILE_FORMAT_CSV":         1,
+		"ENTITY_FILE_FORMAT_JSON_LD":     2,
+	}
+)
+
+func (x EntityFileFormat) Enum() *EntityFileFormat {
+	p := new(EntityFileFormat)
+	*p = x
+	return p
+}
+
+func (x EntityFileFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityFileFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_entity_v1_entity_service_proto_enumTypes[2].Descriptor()
+}
+
+func (EntityFileFormat) Type() protoreflect.EnumType {
+	return &file_historyquiz_entity_v1_entity_service_proto_enumTypes[2]
+}
+
+func (x EntityFileFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityFileFormat.Descriptor instead.
+func (EntityFileFormat) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{2}
+}
+
+type Entity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 取り込みファイルで使う外部キー（例: "vasco-da-gama"）。
+	Key         string     `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Kind        EntityKind `protobuf:"varint,3,opt,name=kind,proto3,enum=historyquiz.entity.v1.EntityKind" json:"kind,omitempty"`
+	Name        string     `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description string     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// 年（紀元前は負の数）。0 は不明。人物は生没年、出来事は開始/終了年、王朝は存続期間。
+	StartYear int32  `protobuf:"varint,6,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	EndYear   int32  `protobuf:"varint,7,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	UpdatedAt string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	// 年の誤差（±年）。0 は正確な年、それ以外は「頃」（例: 前500年頃は start_year = -500, year_margin = 25）。
+	YearMargin    int32 `protobuf:"varint,10,opt,name=year_margin,json=yearMargin,proto3" json:"year_margin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Entity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Entity) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Entity) GetKind() EntityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EntityKind_ENTITY_KIND_UNSPECIFIED
+}
+
+func (x *Entity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Entity) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Entity) GetStartYear() int32 {
+	if x != nil {
+		return x.StartYear
+	}
+	return 0
+}
+
+func (x *Entity) GetEndYear() int32 {
+	if x != nil {
+		return x.EndYear
+	}
+	return 0
+}
+
+func (x *Entity) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Entity) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Entity) GetYearMargin() int32 {
+	if x != nil {
+		return x.YearMargin
+	}
+	return 0
+}
+
+// エンティティの作成/更新の入力。
+type EntityDraft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                          // 200 バイトまで、空白不可
+	Kind          EntityKind             `protobuf:"varint,2,opt,name=kind,proto3,enum=historyquiz.entity.v1.EntityKind" json:"kind,omitempty"` // 更新では変えられない
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                        // 200 文字まで
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                          // 2000 文字まで
+	StartYear     int32                  `protobuf:"varint,5,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`            // -9999..9999、0 は不明
+	EndYear       int32                  `protobuf:"varint,6,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	YearMargin    int32                  `protobuf:"varint,7,opt,name=year_margin,json=yearMargin,proto3" json:"year_margin,omitempty"` // 0..500。年が不明（start_year/end_year とも 0）の場合は指定できない
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityDraft) Reset() {
+	*x = EntityDraft{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityDraft) ProtoMessage() {}
+
+func (x *EntityDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityDraft.ProtoReflect.Descriptor instead.
+func (*EntityDraft) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EntityDraft) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EntityDraft) GetKind() EntityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EntityKind_ENTITY_KIND_UNSPECIFIED
+}
+
+func (x *EntityDraft) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EntityDraft) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EntityDraft) GetStartYear() int32 {
+	if x != nil {
+		return x.StartYear
+	}
+	return 0
+}
+
+func (x *EntityDraft) GetEndYear() int32 {
+	if x != nil {
+		return x.EndYear
+	}
+	return 0
+}
+
+func (x *EntityDraft) GetYearMargin() int32 {
+	if x != nil {
+		return x.YearMargin
+	}
+	return 0
+}
+
+// 一覧や関係の相手として返すエンティティの要約。
+type EntityRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          EntityKind             `protobuf:"varint,2,opt,name=kind,proto3,enum=historyquiz.entity.v1.EntityKind" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityRef) Reset() {
+	*x = EntityRef{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRef) ProtoMessage() {}
+
+func (x *EntityRef) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRef.ProtoReflect.Descriptor instead.
+func (*EntityRef) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EntityRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntityRef) GetKind() EntityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EntityKind_ENTITY_KIND_UNSPECIFIED
+}
+
+func (x *EntityRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EntityRelation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SubjectEntityId string                 `protobuf:"bytes,1,opt,name=subject_entity_id,json=subjectEntityId,proto3" json:"subject_entity_id,omitempty"`
+	Kind            EntityRelationKind     `protobuf:"varint,2,opt,name=kind,proto3,enum=historyquiz.entity.v1.EntityRelationKind" json:"kind,omitempty"`
+	ObjectEntityId  string                 `protobuf:"bytes,3,opt,name=object_entity_id,json=objectEntityId,proto3" json:"object_entity_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EntityRelation) Reset() {
+	*x = EntityRelation{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRelation) ProtoMessage() {}
+
+func (x *EntityRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRelation.ProtoReflect.Descriptor instead.
+func (*EntityRelation) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EntityRelation) GetSubjectEntityId() string {
+	if x != nil {
+		return x.SubjectEntityId
+	}
+	return ""
+}
+
+func (x *EntityRelation) GetKind() EntityRelationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EntityRelationKind_ENTITY_RELATION_KIND_UNSPECIFIED
+}
+
+func (x *EntityRelation) GetObjectEntityId() string {
+	if x != nil {
+		return x.ObjectEntityId
+	}
+	return ""
+}
+
+// あるエンティティから見た関係。
+type EntityRelationView struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  EntityRelationKind     `protobuf:"varint,1,opt,name=kind,proto3,enum=historyquiz.entity.v1.EntityRelationKind" json:"kind,omitempty"`
+	// true はこのエンティティが subject（例: 人物から見た participated_in）、false は object（例: 出来事から見た参加者）。
+	Outgoing      bool       `protobuf:"varint,2,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	Other         *EntityRef `protobuf:"bytes,3,opt,name=other,proto3" json:"other,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityRelationView) Reset() {
+	*x = EntityRelationView{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityRelationView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRelationView) ProtoMessage() {}
+
+func (x *EntityRelationView) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRelationView.ProtoReflect.Descriptor instead.
+func (*EntityRelationView) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EntityRelationView) GetKind() EntityRelationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EntityRelationKind_ENTITY_RELATION_KIND_UNSPECIFIED
+}
+
+func (x *EntityRelationView) GetOutgoing() bool {
+	if x != nil {
+		return x.Outgoing
+	}
+	return false
+}
+
+func (x *EntityRelationView) GetOther() *EntityRef {
+	if x != nil {
+		return x.Other
+	}
+	return nil
+}
+
+type CreateEntityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Draft         *EntityDraft           `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEntityRequest) Reset() {
+	*x = CreateEntityRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntityRequest) ProtoMessage() {}
+
+func (x *CreateEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntityRequest.ProtoReflect.Descriptor instead.
+func (*CreateEntityRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateEntityRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CreateEntityRequest) GetDraft() *EntityDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type CreateEntityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Entity        *Entity                `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEntityResponse) Reset() {
+	*x = CreateEntityResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntityResponse) ProtoMessage() {}
+
+func (x *CreateEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntityResponse.ProtoReflect.Descriptor instead.
+func (*CreateEntityResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateEntityResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CreateEntityResponse) GetEntity() *Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+type UpdateEntityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Draft         *EntityDraft           `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEntityRequest) Reset() {
+	*x = UpdateEntityRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEntityRequest) ProtoMessage() {}
+
+func (x *UpdateEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEntityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEntityRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateEntityRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateEntityRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *UpdateEntityRequest) GetDraft() *EntityDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type UpdateEntityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Entity        *Entity                `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEntityResponse) Reset() {
+	*x = UpdateEntityResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEntityResponse) ProtoMessage() {}
+
+func (x *UpdateEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEntityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEntityResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateEntityResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateEntityResponse) GetEntity() *Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+type DeleteEntityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEntityRequest) Reset() {
+	*x = DeleteEntityRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntityRequest) ProtoMessage() {}
+
+func (x *DeleteEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntityRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteEntityRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *DeleteEntityRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type DeleteEntityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEntityResponse) Reset() {
+	*x = DeleteEntityResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntityResponse) ProtoMessage() {}
+
+func (x *DeleteEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntityResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEntityResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteEntityResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type GetEntityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntityRequest) Reset() {
+	*x = GetEntityRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityRequest) ProtoMessage() {}
+
+func (x *GetEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetEntityRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetEntityRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type GetEntityResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Context   *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Entity    *Entity                `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Relations []*EntityRelationView  `protobuf:"bytes,3,rep,name=relations,proto3" json:"relations,omitempty"`
+	// このエンティティが付いた問題のうち、今出題できる（公開中で非表示でない）問題の数。
+	PlayableQuestionCount int32 `protobuf:"varint,4,opt,name=playable_question_count,json=playableQuestionCount,proto3" json:"playable_question_count,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetEntityResponse) Reset() {
+	*x = GetEntityResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityResponse) ProtoMessage() {}
+
+func (x *GetEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityResponse.ProtoReflect.Descriptor instead.
+func (*GetEntityResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEntityResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetEntityResponse) GetEntity() *Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *GetEntityResponse) GetRelations() []*EntityRelationView {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *GetEntityResponse) GetPlayableQuestionCount() int32 {
+	if x != nil {
+		return x.PlayableQuestionCount
+	}
+	return 0
+}
+
+type ListEntitiesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// page_token には前回の next_page_token をそのまま渡す。
+	Pagination *v1.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// 省略（UNSPECIFIED）の場合はすべての種類。
+	Kind EntityKind `protobuf:"varint,3,opt,name=kind,proto3,enum=historyquiz.entity.v1.EntityKind" json:"kind,omitempty"`
+	// 名前の部分一致（100 文字まで）。
+	NameQuery     string `protobuf:"bytes,4,opt,name=name_query,json=nameQuery,proto3" json:"name_query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntitiesRequest) Reset() {
+	*x = ListEntitiesRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntitiesRequest) ProtoMessage() {}
+
+func (x *ListEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListEntitiesRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListEntitiesRequest) GetPagination() *v1.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListEntitiesRequest) GetKind() EntityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EntityKind_ENTITY_KIND_UNSPECIFIED
+}
+
+func (x *ListEntitiesRequest) GetNameQuery() string {
+	if x != nil {
+		return x.NameQuery
+	}
+	return ""
+}
+
+type ListEntitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Entities      []*Entity              `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	PageInfo      *v1.PageInfo           `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntitiesResponse) Reset() {
+	*x = ListEntitiesResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntitiesResponse) ProtoMessage() {}
+
+func (x *ListEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListEntitiesResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListEntitiesResponse) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *ListEntitiesResponse) GetPageInfo() *v1.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type AddEntityRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Relation      *EntityRelation        `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEntityRelationRequest) Reset() {
+	*x = AddEntityRelationRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEntityRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEntityRelationRequest) ProtoMessage() {}
+
+func (x *AddEntityRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEntityRelationRequest.ProtoReflect.Descriptor instead.
+func (*AddEntityRelationRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddEntityRelationRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *AddEntityRelationRequest) GetRelation() *EntityRelation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type AddEntityRelationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEntityRelationResponse) Reset() {
+	*x = AddEntityRelationResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEntityRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEntityRelationResponse) ProtoMessage() {}
+
+func (x *AddEntityRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEntityRelationResponse.ProtoReflect.Descriptor instead.
+func (*AddEntityRelationResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddEntityRelationResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type RemoveEntityRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Relation      *EntityRelation        `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEntityRelationRequest) Reset() {
+	*x = RemoveEntityRelationRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEntityRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEntityRelationRequest) ProtoMessage() {}
+
+func (x *RemoveEntityRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEntityRelationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEntityRelationRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveEntityRelationRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *RemoveEntityRelationRequest) GetRelation() *EntityRelation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type RemoveEntityRelationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEntityRelationResponse) Reset() {
+	*x = RemoveEntityRelationResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEntityRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEntityRelationResponse) ProtoMessage() {}
+
+func (x *RemoveEntityRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEntityRelationResponse.ProtoReflect.Descriptor instead.
+func (*RemoveEntityRelationResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveEntityRelationResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type SetQuestionEntitiesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Context    *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// 空の場合はすべて外す。
+	EntityIds     []string `protobuf:"bytes,3,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuestionEntitiesRequest) Reset() {
+	*x = SetQuestionEntitiesRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuestionEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuestionEntitiesRequest) ProtoMessage() {}
+
+func (x *SetQuestionEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuestionEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*SetQuestionEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetQuestionEntitiesRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SetQuestionEntitiesRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *SetQuestionEntitiesRequest) GetEntityIds() []string {
+	if x != nil {
+		return x.EntityIds
+	}
+	return nil
+}
+
+type SetQuestionEntitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Entities      []*EntityRef           `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuestionEntitiesResponse) Reset() {
+	*x = SetQuestionEntitiesResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuestionEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuestionEntitiesResponse) ProtoMessage() {}
+
+func (x *SetQuestionEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuestionEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*SetQuestionEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetQuestionEntitiesResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SetQuestionEntitiesResponse) GetEntities() []*EntityRef {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type ListQuestionEntitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionEntitiesRequest) Reset() {
+	*x = ListQuestionEntitiesRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionEntitiesRequest) ProtoMessage() {}
+
+func (x *ListQuestionEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuestionEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListQuestionEntitiesRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListQuestionEntitiesRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+type ListQuestionEntitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Entities      []*EntityRef           `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionEntitiesResponse) Reset() {
+	*x = ListQuestionEntitiesResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionEntitiesResponse) ProtoMessage() {}
+
+func (x *ListQuestionEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListQuestionEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListQuestionEntitiesResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListQuestionEntitiesResponse) GetEntities() []*EntityRef {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// NOTE: 全件を1トランザクションで反映するため、1リクエストでファイル全体を送る（上限 1MiB / 500件）。
+type ImportEntitiesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Format  EntityFileFormat       `protobuf:"varint,2,opt,name=format,proto3,enum=historyquiz.entity.v1.EntityFileFormat" json:"format,omitempty"`
+	Content []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// true の場合は検証のみ行い、変更しない。
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEntitiesRequest) Reset() {
+	*x = ImportEntitiesRequest{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEntitiesRequest) ProtoMessage() {}
+
+func (x *ImportEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ImportEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportEntitiesRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ImportEntitiesRequest) GetFormat() EntityFileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return EntityFileFormat_ENTITY_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportEntitiesRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportEntitiesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportEntitiesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Context   *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	TotalRows int32                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	// row_errors が空でない場合は何も変更していない。
+	RowErrors []*v11.ImportRowError `protobuf:"bytes,3,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
+	// 反映した件数（dry_run または row_errors がある場合は 0）。
+	CreatedCount        int32 `protobuf:"varint,4,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	UpdatedCount        int32 `protobuf:"varint,5,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	RelationsAddedCount int32 `protobuf:"varint,6,opt,name=relations_added_count,json=relationsAddedCount,proto3" json:"relations_added_count,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ImportEntitiesResponse) Reset() {
+	*x = ImportEntitiesResponse{}
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEntitiesResponse) ProtoMessage() {}
+
+func (x *ImportEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_entity_v1_entity_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ImportEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportEntitiesResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ImportEntitiesResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportEntitiesResponse) GetRowErrors() []*v11.ImportRowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+func (x *ImportEntitiesResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportEntitiesResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *ImportEntitiesResponse) GetRelationsAddedCount() int32 {
+	if x != nil {
+		return x.RelationsAddedCount
+	}
+	return 0
+}
+
+var File_historyquiz_entity_v1_entity_service_proto protoreflect.FileDescriptor
+
+const file_historyquiz_entity_v1_entity_service_proto_rawDesc = "" +
+	"\n" +
+	"*historyquiz/entity/v1/entity_service.proto\x12\x15historyquiz.entity.v1\x1a\"historyquiz/common/v1/common.proto\x1a.historyquiz/question/v1/question_service.proto\"\xb0\x02\n" +
+	"\x06Entity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x125\n" +
+	"\x04kind\x18\x03 \x01(\x0e2!.historyquiz.entity.v1.EntityKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"start_year\x18\x06 \x01(\x05R\tstartYear\x12\x19\n" +
+	"\bend_year\x18\a \x01(\x05R\aendYear\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vyear_margin\x18\n" +
+	" \x01(\x05R\n" +
+	"yearMargin\"\xe7\x01\n" +
+	"\vEntityDraft\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2!.historyquiz.entity.v1.EntityKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"start_year\x18\x05 \x01(\x05R\tstartYear\x12\x19\n" +
+	"\bend_year\x18\x06 \x01(\x05R\aendYear\x12\x1f\n" +
+	"\vyear_margin\x18\a \x01(\x05R\n" +
+	"yearMargin\"f\n" +
+	"\tEntityRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2!.historyquiz.entity.v1.EntityKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\xa5\x01\n" +
+	"\x0eEntityRelation\x12*\n" +
+	"\x11subject_entity_id\x18\x01 \x01(\tR\x0fsubjectEntityId\x12=\n" +
+	"\x04kind\x18\x02 \x01(\x0e2).historyquiz.entity.v1.EntityRelationKindR\x04kind\x12(\n" +
+	"\x10object_entity_id\x18\x03 \x01(\tR\x0eobjectEntityId\"\xa7\x01\n" +
+	"\x12EntityRelationView\x12=\n" +
+	"\x04kind\x18\x01 \x01(\x0e2).historyquiz.entity.v1.EntityRelationKindR\x04kind\x12\x1a\n" +
+	"\boutgoing\x18\x02 \x01(\bR\boutgoing\x126\n" +
+	"\x05other\x18\x03 \x01(\v2 .historyquiz.entity.v1.EntityRefR\x05other\"\x90\x01\n" +
+	"\x13CreateEntityRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x128\n" +
+	"\x05draft\x18\x02 \x01(\v2\".historyquiz.entity.v1.EntityDraftR\x05draft\"\x8e\x01\n" +
+	"\x14CreateEntityResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x125\n" +
+	"\x06entity\x18\x02 \x01(\v2\x1d.historyquiz.entity.v1.EntityR\x06entity\"\xad\x01\n" +
+	"\x13UpdateEntityRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x128\n" +
+	"\x05draft\x18\x03 \x01(\v2\".historyquiz.entity.v1.EntityDraftR\x05draft\"\x8e\x01\n" +
+	"\x14UpdateEntityResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x125\n" +
+	"\x06entity\x18\x02 \x01(\v2\x1d.historyquiz.entity.v1.EntityR\x06entity\"s\n" +
+	"\x13DeleteEntityRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\"W\n" +
+	"\x14DeleteEntityResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\"p\n" +
+	"\x10GetEntityRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\"\x8c\x02\n" +
+	"\x11GetEntityResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x125\n" +
+	"\x06entity\x18\x02 \x01(\v2\x1d.historyquiz.entity.v1.EntityR\x06entity\x12G\n" +
+	"\trelations\x18\x03 \x03(\v2).historyquiz.entity.v1.EntityRelationViewR\trelations\x126\n" +
+	"\x17playable_question_count\x18\x04 \x01(\x05R\x15playableQuestionCount\"\xef\x01\n" +
+	"\x13ListEntitiesRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12A\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2!.historyquiz.common.v1.PaginationR\n" +
+	"pagination\x125\n" +
+	"\x04kind\x18\x03 \x01(\x0e2!.historyquiz.entity.v1.EntityKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"name_query\x18\x04 \x01(\tR\tnameQuery\"\xd0\x01\n" +
+	"\x14ListEntitiesResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x129\n" +
+	"\bentities\x18\x02 \x03(\v2\x1d.historyquiz.entity.v1.EntityR\bentities\x12<\n" +
+	"\tpage_info\x18\x03 \x01(\v2\x1f.historyquiz.common.v1.PageInfoR\bpageInfo\"\x9e\x01\n" +
+	"\x18AddEntityRelationRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12A\n" +
+	"\brelation\x18\x02 \x01(\v2%.historyquiz.entity.v1.EntityRelationR\brelation\"\\\n" +
+	"\x19AddEntityRelationResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\"\xa1\x01\n" +
+	"\x1bRemoveEntityRelationRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12A\n" +
+	"\brelation\x18\x02 \x01(\v2%.historyquiz.entity.v1.EntityRelationR\brelation\"_\n" +
+	"\x1cRemoveEntityRelationResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\"\x9d\x01\n" +
+	"\x1aSetQuestionEntitiesRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12\x1d\n" +
+	"\n" +
+	"entity_ids\x18\x03 \x03(\tR\tentityIds\"\x9c\x01\n" +
+	"\x1bSetQuestionEntitiesResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12<\n" +
+	"\bentities\x18\x02 \x03(\v2 .historyquiz.entity.v1.EntityRefR\bentities\"\x7f\n" +
+	"\x1bListQuestionEntitiesRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\"\x9d\x01\n" +
+	"\x1cListQuestionEntitiesResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12<\n" +
+	"\bentities\x18\x02 \x03(\v2 .historyquiz.entity.v1.EntityRefR\bentities\"\xcc\x01\n" +
+	"\x15ImportEntitiesRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12?\n" +
+	"\x06format\x18\x02 \x01(\x0e2'.historyquiz.entity.v1.EntityFileFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xbe\x02\n" +
+	"\x16ImportEntitiesResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows\x12F\n" +
+	"\n" +
+	"row_errors\x18\x03 \x03(\v2'.historyquiz.question.v1.ImportRowErrorR\trowErrors\x12#\n" +
+	"\rcreated_count\x18\x04 \x01(\x05R\fcreatedCount\x12#\n" +
+	"\rupdated_count\x18\x05 \x01(\x05R\fupdatedCount\x122\n" +
+	"\x15relations_added_count\x18\x06 \x01(\x05R\x13relationsAddedCount*\x88\x01\n" +
+	"\n" +
+	"EntityKind\x12\x1b\n" +
+	"\x17ENTITY_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ENTITY_KIND_PERSON\x10\x01\x12\x15\n" +
+	"\x11ENTITY_KIND_EVENT\x10\x02\x12\x15\n" +
+	"\x11ENTITY_KIND_PLACE\x10\x03\x12\x17\n" +
+	"\x13ENTITY_KIND_DYNASTY\x10\x04*\xad\x01\n" +
+	"\x12EntityRelationKind\x12$\n" +
+	" ENTITY_RELATION_KIND_UNSPECIFIED\x10\x00\x12(\n" +
+	"$ENTITY_RELATION_KIND_PARTICIPATED_IN\x10\x01\x12#\n" +
+	"\x1fENTITY_RELATION_KIND_LOCATED_IN\x10\x02\x12\"\n" +
+	"\x1eENTITY_RELATION_KIND_MEMBER_OF\x10\x03*r\n" +
+	"\x10EntityFileFormat\x12\"\n" +
+	"\x1eENTITY_FILE_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ENTITY_FILE_FORMAT_CSV\x10\x01\x12\x1e\n" +
+	"\x1aENTITY_FILE_FORMAT_JSON_LD\x10\x022\xfa\b\n" +
+	"\rEntityService\x12g\n" +
+	"\fCreateEntity\x12*.historyquiz.entity.v1.CreateEntityRequest\x1a+.historyquiz.entity.v1.CreateEntityResponse\x12g\n" +
+	"\fUpdateEntity\x12*.historyquiz.entity.v1.UpdateEntityRequest\x1a+.historyquiz.entity.v1.UpdateEntityResponse\x12g\n" +
+	"\fDeleteEntity\x12*.historyquiz.entity.v1.DeleteEntityRequest\x1a+.historyquiz.entity.v1.DeleteEntityResponse\x12^\n" +
+	"\tGetEntity\x12'.historyquiz.entity.v1.GetEntityRequest\x1a(.historyquiz.entity.v1.GetEntityResponse\x12g\n" +
+	"\fListEntities\x12*.historyquiz.entity.v1.ListEntitiesRequest\x1a+.historyquiz.entity.v1.ListEntitiesResponse\x12v\n" +
+	"\x11AddEntityRelation\x12/.historyquiz.entity.v1.AddEntityRelationRequest\x1a0.historyquiz.entity.v1.AddEntityRelationResponse\x12\x7f\n" +
+	"\x14RemoveEntityRelation\x122.historyquiz.entity.v1.RemoveEntityRelationRequest\x1a3.historyquiz.entity.v1.RemoveEntityRelationResponse\x12|\n" +
+	"\x13SetQuestionEntities\x121.historyquiz.entity.v1.SetQuestionEntitiesRequest\x1a2.historyquiz.entity.v1.SetQuestionEntitiesResponse\x12\x7f\n" +
+	"\x14ListQuestionEntities\x122.historyquiz.entity.v1.ListQuestionEntitiesRequest\x1a3.historyquiz.entity.v1.ListQuestionEntitiesResponse\x12m\n" +
+	"\x0eImportEntities\x12,.historyquiz.entity.v1.ImportEntitiesRequest\x1a-.historyquiz.entity.v1.ImportEntitiesResponseB>Z<github.com/history-quiz/historyquiz/proto/entity/v1;entityv1b\x06proto3"
+
+var (
+	file_historyquiz_entity_v1_entity_service_proto_rawDescOnce sync.Once
+	file_historyquiz_entity_v1_entity_service_proto_rawDescData []byte
+)
+
+func file_historyquiz_entity_v1_entity_service_proto_rawDescGZIP() []byte {
+	file_historyquiz_entity_v1_entity_service_proto_rawDescOnce.Do(func() {
+		file_historyquiz_entity_v1_entity_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_historyquiz_entity_v1_entity_service_proto_rawDesc), len(file_historyquiz_entity_v1_entity_service_proto_rawDesc)))
+	})
+	return file_historyquiz_entity_v1_entity_service_proto_rawDescData
+}
+
+var file_historyquiz_entity_v1_entity_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_historyquiz_entity_v1_entity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_historyquiz_entity_v1_entity_service_proto_goTypes = []any{
+	(EntityKind)(0),                      // 0: historyquiz.entity.v1.EntityKind
+	(EntityRelationKind)(0),              // 1: historyquiz.entity.v1.EntityRelationKind
+	(EntityFileFormat)(0),                // 2: historyquiz.entity.v1.EntityFileFormat
+	(*Entity)(nil),                       // 3: historyquiz.entity.v1.Entity
+	(*EntityDraft)(nil),                  // 4: historyquiz.entity.v1.EntityDraft
+	(*EntityRef)(nil),                    // 5: historyquiz.entity.v1.EntityRef
+	(*EntityRelation)(nil),               // 6: historyquiz.entity.v1.EntityRelation
+	(*EntityRelationView)(nil),           // 7: historyquiz.entity.v1.EntityRelationView
+	(*CreateEntityRequest)(nil),          // 8: historyquiz.entity.v1.CreateEntityRequest
+	(*CreateEntityResponse)(nil),         // 9: historyquiz.entity.v1.CreateEntityResponse
+	(*UpdateEntityRequest)(nil),          // 10: historyquiz.entity.v1.UpdateEntityRequest
+	(*UpdateEntityResponse)(nil),         // 11: historyquiz.entity.v1.UpdateEntityResponse
+	(*DeleteEntityRequest)(nil),          // 12: historyquiz.entity.v1.DeleteEntityRequest
+	(*DeleteEntityResponse)(nil),         // 13: historyquiz.entity.v1.DeleteEntityResponse
+	(*GetEntityRequest)(nil),             // 14: historyquiz.entity.v1.GetEntityRequest
+	(*GetEntityResponse)(nil),            // 15: historyquiz.entity.v1.GetEntityResponse
+	(*ListEntitiesRequest)(nil),          // 16: historyquiz.entity.v1.ListEntitiesRequest
+	(*ListEntitiesResponse)(nil),         // 17: historyquiz.entity.v1.ListEntitiesResponse
+	(*AddEntityRelationRequest)(nil),     // 18: historyquiz.entity.v1.AddEntityRelationRequest
+	(*AddEntityRelationResponse)(nil),    // 19: historyquiz.entity.v1.AddEntityRelationResponse
+	(*RemoveEntityRelationRequest)(nil),  // 20: historyquiz.entity.v1.RemoveEntityRelationRequest
+	(*RemoveEntityRelationResponse)(nil), // 21: historyquiz.entity.v1.RemoveEntityRelationResponse
+	(*SetQuestionEntitiesRequest)(nil),   // 22: historyquiz.entity.v1.SetQuestionEntitiesRequest
+	(*SetQuestionEntitiesResponse)(nil),  // 23: historyquiz.entity.v1.SetQuestionEntitiesResponse
+	(*ListQuestionEntitiesRequest)(nil),  // 24: historyquiz.entity.v1.ListQuestionEntitiesRequest
+	(*ListQuestionEntitiesResponse)(nil), // 25: historyquiz.entity.v1.ListQuestionEntitiesResponse
+	(*ImportEntitiesRequest)(nil),        // 26: historyquiz.entity.v1.ImportEntitiesRequest
+	(*ImportEntitiesResponse)(nil),       // 27: historyquiz.entity.v1.ImportEntitiesResponse
+	(*v1.RequestContext)(nil),            // 28: historyquiz.common.v1.RequestContext
+	(*v1.Pagination)(nil),                // 29: historyquiz.common.v1.Pagination
+	(*v1.PageInfo)(nil),                  // 30: historyquiz.common.v1.PageInfo
+	(*v11.ImportRowError)(nil),           // 31: historyquiz.question.v1.ImportRowError
+}
+var file_historyquiz_entity_v1_entity_service_proto_depIdxs = []int32{
+	0,  // 0: historyquiz.entity.v1.Entity.kind:type_name -> historyquiz.entity.v1.EntityKind
+	0,  // 1: historyquiz.entity.v1.EntityDraft.kind:type_name -> historyquiz.entity.v1.EntityKind
+	0,  // 2: historyquiz.entity.v1.EntityRef.kind:type_name -> historyquiz.entity.v1.EntityKind
+	1,  // 3: historyquiz.entity.v1.EntityRelation.kind:type_name -> historyquiz.entity.v1.EntityRelationKind
+	1,  // 4: historyquiz.entity.v1.EntityRelationView.kind:type_name -> historyquiz.entity.v1.EntityRelationKind
+	5,  // 5: historyquiz.entity.v1.EntityRelationView.other:type_name -> historyquiz.entity.v1.EntityRef
+	28, // 6: historyquiz.entity.v1.CreateEntityRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	4,  // 7: historyquiz.entity.v1.CreateEntityRequest.draft:type_name -> historyquiz.entity.v1.EntityDraft
+	28, // 8: historyquiz.entity.v1.CreateEntityResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	3,  // 9: historyquiz.entity.v1.CreateEntityResponse.entity:type_name -> historyquiz.entity.v1.Entity
+	28, // 10: historyquiz.entity.v1.UpdateEntityRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	4,  // 11: historyquiz.entity.v1.UpdateEntityRequest.draft:type_name -> historyquiz.entity.v1.EntityDraft
+	28, // 12: historyquiz.entity.v1.UpdateEntityResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	3,  // 13: historyquiz.entity.v1.UpdateEntityResponse.entity:type_name -> historyquiz.entity.v1.Entity
+	28, // 14: historyquiz.entity.v1.DeleteEntityRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	28, // 15: historyquiz.entity.v1.DeleteEntityResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	28, // 16: historyquiz.entity.v1.GetEntityRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	28, // 17: historyquiz.entity.v1.GetEntityResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	3,  // 18: historyquiz.entity.v1.GetEntityResponse.entity:type_name -> historyquiz.entity.v1.Entity
+	7,  // 19: historyquiz.entity.v1.GetEntityResponse.relations:type_name -> historyquiz.entity.v1.EntityRelationView
+	28, // 20: historyquiz.entity.v1.ListEntitiesRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	29, // 21: historyquiz.entity.v1.ListEntitiesRequest.pagination:type_name -> historyquiz.common.v1.Pagination
+	0,  // 22: historyquiz.entity.v1.ListEntitiesRequest.kind:type_name -> historyquiz.entity.v1.EntityKind
+	28, // 23: historyquiz.entity.v1.ListEntitiesResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	3,  // 24: historyquiz.entity.v1.ListEntitiesResponse.entities:type_name -> historyquiz.entity.v1.Entity
+	30, // 25: historyquiz.entity.v1.ListEntitiesResponse.page_info:type_name -> historyquiz.common.v1.PageInfo
+	28, // 26: historyquiz.entity.v1.AddEntityRelationRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	6,  // 27: historyquiz.entity.v1.AddEntityRelationRequest.relation:type_name -> historyquiz.entity.v1.EntityRelation
+	28, // 28: historyquiz.entity.v1.AddEntityRelationResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	28, // 29: historyquiz.entity.v1.RemoveEntityRelationRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	6,  // 30: historyquiz.entity.v1.RemoveEntityRelationRequest.relation:type_name -> historyquiz.entity.v1.EntityRelation
+	28, // 31: historyquiz.entity.v1.RemoveEntityRelationResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	28, // 32: historyquiz.entity.v1.SetQuestionEntitiesRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	28, // 33: historyquiz.entity.v1.SetQuestionEntitiesResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	5,  // 34: historyquiz.entity.v1.SetQuestionEntitiesResponse.entities:type_name -> historyquiz.entity.v1.EntityRef
+	28, // 35: historyquiz.entity.v1.ListQuestionEntitiesRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	28, // 36: historyquiz.entity.v1.ListQuestionEntitiesResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	5,  // 37: historyquiz.entity.v1.ListQuestionEntitiesResponse.entities:type_name -> historyquiz.entity.v1.EntityRef
+	28, // 38: historyquiz.entity.v1.ImportEntitiesRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	2,  // 39: historyquiz.entity.v1.ImportEntitiesRequest.format:type_name -> historyquiz.entity.v1.EntityFileFormat
+	28, // 40: historyquiz.entity.v1.ImportEntitiesResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	31, // 41: historyquiz.entity.v1.ImportEntitiesResponse.row_errors:type_name -> historyquiz.question.v1.ImportRowError
+	8,  // 42: historyquiz.entity.v1.EntityService.CreateEntity:input_type -> historyquiz.entity.v1.CreateEntityRequest
+	10, // 43: historyquiz.entity.v1.EntityService.UpdateEntity:input_type -> historyquiz.entity.v1.UpdateEntityRequest
+	12, // 44: historyquiz.entity.v1.EntityService.DeleteEntity:input_type -> historyquiz.entity.v1.DeleteEntityRequest
+	14, // 45: historyquiz.entity.v1.EntityService.GetEntity:input_type -> historyquiz.entity.v1.GetEntityRequest
+	16, // 46: historyquiz.entity.v1.EntityService.ListEntities:input_type -> historyquiz.entity.v1.ListEntitiesRequest
+	18, // 47: historyquiz.entity.v1.EntityService.AddEntityRelation:input_type -> historyquiz.entity.v1.AddEntityRelationRequest
+	20, // 48: historyquiz.entity.v1.EntityService.RemoveEntityRelation:input_type -> historyquiz.entity.v1.RemoveEntityRelationRequest
+	22, // 49: historyquiz.entity.v1.EntityService.SetQuestionEntities:input_type -> historyquiz.entity.v1.SetQuestionEntitiesRequest
+	24, // 50: historyquiz.entity.v1.EntityService.ListQuestionEntities:input_type -> historyquiz.entity.v1.ListQuestionEntitiesRequest
+	26, // 51: historyquiz.entity.v1.EntityService.ImportEntities:input_type -> historyquiz.entity.v1.ImportEntitiesRequest
+	9,  // 52: historyquiz.entity.v1.EntityService.CreateEntity:output_type -> historyquiz.entity.v1.CreateEntityResponse
+	11, // 53: historyquiz.entity.v1.EntityService.UpdateEntity:output_type -> historyquiz.entity.v1.UpdateEntityResponse
+	13, // 54: historyquiz.entity.v1.EntityService.DeleteEntity:output_type -> historyquiz.entity.v1.DeleteEntityResponse
+	15, // 55: historyquiz.entity.v1.EntityService.GetEntity:output_type -> historyquiz.entity.v1.GetEntityResponse
+	17, // 56: historyquiz.entity.v1.EntityService.ListEntities:output_type -> historyquiz.entity.v1.ListEntitiesResponse
+	19, // 57: historyquiz.entity.v1.EntityService.AddEntityRelation:output_type -> historyquiz.entity.v1.AddEntityRelationResponse
+	21, // 58: historyquiz.entity.v1.EntityService.RemoveEntityRelation:output_type -> historyquiz.entity.v1.RemoveEntityRelationResponse
+	23, // 59: historyquiz.entity.v1.EntityService.SetQuestionEntities:output_type -> historyquiz.entity.v1.SetQuestionEntitiesResponse
+	25, // 60: historyquiz.entity.v1.EntityService.ListQuestionEntities:output_type -> historyquiz.entity.v1.ListQuestionEntitiesResponse
+	27, // 61: historyquiz.entity.v1.EntityService.ImportEntities:output_type -> historyquiz.entity.v1.ImportEntitiesResponse
+	52, // [52:62] is the sub-list for method output_type
+	42, // [42:52] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_historyquiz_entity_v1_entity_service_proto_init() }
+func file_historyquiz_entity_v1_entity_service_proto_init() {
+	if File_historyquiz_entity_v1_entity_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_historyquiz_entity_v1_entity_service_proto_rawDesc), len(file_historyquiz_entity_v1_entity_service_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_historyquiz_entity_v1_entity_service_proto_goTypes,
+		DependencyIndexes: file_historyquiz_entity_v1_entity_service_proto_depIdxs,
+		EnumInfos:         file_historyquiz_entity_v1_entity_service_proto_enumTypes,
+		MessageInfos:      file_historyquiz_entity_v1_entity_service_proto_msgTypes,
+	}.Build()
+	File_historyquiz_entity_v1_entity_service_proto = out.File
+	file_historyquiz_entity_v1_entity_service_proto_goTypes = nil
+	file_historyquiz_entity_v1_entity_service_proto_depIdxs = nil
+}
@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: historyquiz/entity/v1/entity_service.proto
+
+package entityv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EntityService_CreateEntity_FullMethodName         = "/historyquiz.entity.v1.EntityService/CreateEntity"
+	EntityService_UpdateEntity_FullMethodName         = "/historyquiz.entity.v1.EntityService/UpdateEntity"
+	EntityService_DeleteEntity_FullMethodName         = "/historyquiz.entity.v1.EntityService/DeleteEntity"
+	EntityService_GetEntity_FullMethodName            = "/historyquiz.entity.v1.EntityService/GetEntity"
+	EntityService_ListEntities_FullMethodName         = "/historyquiz.entity.v1.EntityService/ListEntities"
+	EntityService_AddEntityRelation_FullMethodName    = "/historyquiz.entity.v1.EntityService/AddEntityRelation"
+	EntityService_RemoveEntityRelation_FullMethodName = "/historyquiz.entity.v1.EntityService/RemoveEntityRelation"
+	EntityService_SetQuestionEntities_FullMethodName  = "/historyquiz.entity.v1.EntityService/SetQuestionEntities"
+	EntityService_ListQuestionEntities_FullMethodName = "/historyquiz.entity.v1.EntityService/ListQuestionEntities"
+	EntityService_ImportEntities_FullMethodName       = "/historyquiz.entity.v1.EntityService/ImportEntities"
+)
+
+// EntityServiceClient is the client API for EntityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// エンティティ（歴史上の人物/出来事/場所/王朝）と、その関係・問題への紐づけを扱うサービス。
+// エンティティに関する問題で遊ぶには、QuizService.GetQuestion の entity_id にエンティティの ID を指定する。
+type EntityServiceClient interface {
+	// エンティティを作成する（管理者のみ）。key が既にある場合は ALREADY_EXISTS。
+	CreateEntity(ctx context.Context, in *CreateEntityRequest, opts ...grpc.CallOption) (*CreateEntityResponse, error)
+	// エンティティを更新する（管理者のみ）。kind は変えられない（FAILED_PRECONDITION）。
+	UpdateEntity(ctx context.Context, in *UpdateEntityRequest, opts ...grpc.CallOption) (*UpdateEntityResponse, error)
+	// エンティティを削除する（管理者のみ）。関係と問題への紐づけも消える。
+	DeleteEntity(ctx context.Context, in *DeleteEntityRequest, opts ...grpc.CallOption) (*DeleteEntityResponse, error)
+	// エンティティを関係付きで取得する（未ログインでも呼べる）。
+	GetEntity(ctx context.Context, in *GetEntityRequest, opts ...grpc.CallOption) (*GetEntityResponse, error)
+	// エンティティを名前順に一覧する（未ログインでも呼べる）。
+	ListEntities(ctx context.Context, in *ListEntitiesRequest, opts ...grpc.CallOption) (*ListEntitiesResponse, error)
+	// 関係を追加する（管理者のみ）。種類の組み合わせは EntityRelationKind を参照。
+	AddEntityRelation(ctx context.Context, in *AddEntityRelationRequest, opts ...grpc.CallOption) (*AddEntityRelationResponse, error)
+	// 関係を削除する（管理者のみ）。
+	RemoveEntityRelation(ctx context.Context, in *RemoveEntityRelationRequest, opts ...grpc.CallOption) (*RemoveEntityRelationResponse, error)
+	// 問題に付けるエンティティを丸ごと置き換える（問題の所有者のみ。10 件まで）。
+	SetQuestionEntities(ctx context.Context, in *SetQuestionEntitiesRequest, opts ...grpc.CallOption) (*SetQuestionEntitiesResponse, error)
+	// 問題に付いたエンティティを取得する（問題の所有者のみ。解答者には SubmitAnswer の結果で返す）。
+	ListQuestionEntities(ctx context.Context, in *ListQuestionEntitiesRequest, opts ...grpc.CallOption) (*ListQuestionEntitiesResponse, error)
+	// CSV/JSON-LD のエンティティと関係を key で照合して作成/更新する（管理者のみ）。
+	// 1件でも不正があれば何も変更しない。既にある関係はそのまま（削除はしない）。
+	ImportEntities(ctx context.Context, in *ImportEntitiesRequest, opts ...grpc.CallOption) (*ImportEntitiesResponse, error)
+}
+
+type entityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEntityServiceClient(cc grpc.ClientConnInterface) EntityServiceClient {
+	return &entityServiceClient{cc}
+}
+
+func (c *entityServiceClient) CreateEntity(ctx context.Context, in *CreateEntityRequest, opts ...grpc.CallOption) (*CreateEntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEntityResponse)
+	err := c.cc.Invoke(ctx, EntityService_CreateEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) UpdateEntity(ctx context.Context, in *UpdateEntityRequest, opts ...grpc.CallOption) (*UpdateEntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEntityResponse)
+	err := c.cc.Invoke(ctx, EntityService_UpdateEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) DeleteEntity(ctx context.Context, in *DeleteEntityRequest, opts ...grpc.CallOption) (*DeleteEntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEntityResponse)
+	err := c.cc.Invoke(ctx, EntityService_DeleteEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) GetEntity(ctx context.Context, in *GetEntityRequest, opts ...grpc.CallOption) (*GetEntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEntityResponse)
+	err := c.cc.Invoke(ctx, EntityService_GetEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) ListEntities(ctx context.Context, in *ListEntitiesRequest, opts ...grpc.CallOption) (*ListEntitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntitiesResponse)
+	err := c.cc.Invoke(ctx, EntityService_ListEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) AddEntityRelation(ctx context.Context, in *AddEntityRelationRequest, opts ...grpc.CallOption) (*AddEntityRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddEntityRelationResponse)
+	err := c.cc.Invoke(ctx, EntityService_AddEntityRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) RemoveEntityRelation(ctx context.Context, in *RemoveEntityRelationRequest, opts ...grpc.CallOption) (*RemoveEntityRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveEntityRelationResponse)
+	err := c.cc.Invoke(ctx, EntityService_RemoveEntityRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) SetQuestionEntities(ctx context.Context, in *SetQuestionEntitiesRequest, opts ...grpc.CallOption) (*SetQuestionEntitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetQuestionEntitiesResponse)
+	err := c.cc.Invoke(ctx, EntityService_SetQuestionEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) ListQuestionEntities(ctx context.Context, in *ListQuestionEntitiesRequest, opts ...grpc.CallOption) (*ListQuestionEntitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuestionEntitiesResponse)
+	err := c.cc.Invoke(ctx, EntityService_ListQuestionEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) ImportEntities(ctx context.Context, in *ImportEntitiesRequest, opts ...grpc.CallOption) (*ImportEntitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportEntitiesResponse)
+	err := c.cc.Invoke(ctx, EntityService_ImportEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EntityServiceServer is the server API for EntityService service.
+// All implementations must embed UnimplementedEntityServiceServer
+// for forward compatibility.
+//
+// エンティティ（歴史上の人物/出来事/場所/王朝）と、その関係・問題への紐づけを扱うサービス。
+// エンティティに関する問題で遊ぶには、QuizService.GetQuestion の entity_id にエンティティの ID を指定する。
+type EntityServiceServer interface {
+	// エンティティを作成する（管理者のみ）。key が既にある場合は ALREADY_EXISTS。
+	CreateEntity(context.Context, *CreateEntityRequest) (*CreateEntityResponse, error)
+	// エンティティを更新する（管理者のみ）。kind は変えられない（FAILED_PRECONDITION）。
+	UpdateEntity(context.Context, *UpdateEntityRequest) (*UpdateEntityResponse, error)
+	// エンティティを削除する（管理者のみ）。関係と問題への紐づけも消える。
+	DeleteEntity(context.Context, *DeleteEntityRequest) (*DeleteEntityResponse, error)
+	// エンティティを関係付きで取得する（未ログインでも呼べる）。
+	GetEntity(context.Context, *GetEntityRequest) (*GetEntityResponse, error)
+	// エンティティを名前順に一覧する（未ログインでも呼べる）。
+	ListEntities(context.Context, *ListEntitiesRequest) (*ListEntitiesResponse, error)
+	// 関係を追加する（管理者のみ）。種類の組み合わせは EntityRelationKind を参照。
+	AddEntityRelation(context.Context, *AddEntityRelationRequest) (*AddEntityRelationResponse, error)
+	// 関係を削除する（管理者のみ）。
+	RemoveEntityRelation(context.Context, *RemoveEntityRelationRequest) (*RemoveEntityRelationResponse, error)
+	// 問題に付けるエンティティを丸ごと置き換える（問題の所有者のみ。10 件まで）。
+	SetQuestionEntities(context.Context, *SetQuestionEntitiesRequest) (*SetQuestionEntitiesResponse, error)
+	// 問題に付いたエンティティを取得する（問題の所有者のみ。解答者には SubmitAnswer の結果で返す）。
+	ListQuestionEntities(context.Context, *ListQuestionEntitiesRequest) (*ListQuestionEntitiesResponse, error)
+	// CSV/JSON-LD のエンティティと関係を key で照合して作成/更新する（管理者のみ）。
+	// 1件でも不正があれば何も変更しない。既にある関係はそのまま（削除はしない）。
+	ImportEntities(context.Context, *ImportEntitiesRequest) (*ImportEntitiesResponse, error)
+	mustEmbedUnimplementedEntityServiceServer()
+}
+
+// UnimplementedEntityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEntityServiceServer struct{}
+
+func (UnimplementedEntityServiceServer) CreateEntity(context.Context, *CreateEntityRequest) (*CreateEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntity not implemented")
+}
+func (UnimplementedEntityServiceServer) UpdateEntity(context.Context, *UpdateEntityRequest) (*UpdateEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntity not implemented")
+}
+func (UnimplementedEntityServiceServer) DeleteEntity(context.Context, *DeleteEntityRequest) (*DeleteEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntity not implemented")
+}
+func (UnimplementedEntityServiceServer) GetEntity(context.Context, *GetEntityRequest) (*GetEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntity not implemented")
+}
+func (UnimplementedEntityServiceServer) ListEntities(context.Context, *ListEntitiesRequest) (*ListEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntities not implemented")
+}
+func (UnimplementedEntityServiceServer) AddEntityRelation(context.Context, *AddEntityRelationRequest) (*AddEntityRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEntityRelation not implemented")
+}
+func (UnimplementedEntityServiceServer) RemoveEntityRelation(context.Context, *RemoveEntityRelationRequest) (*RemoveEntityRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEntityRelation not implemented")
+}
+func (UnimplementedEntityServiceServer) SetQuestionEntities(context.Context, *SetQuestionEntitiesRequest) (*SetQuestionEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuestionEntities not implemented")
+}
+func (UnimplementedEntityServiceServer) ListQuestionEntities(context.Context, *ListQuestionEntitiesRequest) (*ListQuestionEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuestionEntities not implemented")
+}
+func (UnimplementedEntityServiceServer) ImportEntities(context.Context, *ImportEntitiesRequest) (*ImportEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEntities not implemented")
+}
+func (UnimplementedEntityServiceServer) mustEmbedUnimplementedEntityServiceServer() {}
+func (UnimplementedEntityServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeEntityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EntityServiceServer will
+// result in compilation errors.
+type UnsafeEntityServiceServer interface {
+	mustEmbedUnimplementedEntityServiceServer()
+}
+
+func RegisterEntityServiceServer(s grpc.ServiceRegistrar, srv EntityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEntityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EntityService_ServiceDesc, srv)
+}
+
+func _EntityService_CreateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).CreateEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_CreateEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).CreateEntity(ctx, req.(*CreateEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_UpdateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).UpdateEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_UpdateEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).UpdateEntity(ctx, req.(*UpdateEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_DeleteEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).DeleteEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_DeleteEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).DeleteEntity(ctx, req.(*DeleteEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_GetEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).GetEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_GetEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).GetEntity(ctx, req.(*GetEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_ListEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).ListEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_ListEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).ListEntities(ctx, req.(*ListEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_AddEntityRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEntityRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).AddEntityRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_AddEntityRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).AddEntityRelation(ctx, req.(*AddEntityRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_RemoveEntityRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEntityRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).RemoveEntityRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_RemoveEntityRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).RemoveEntityRelation(ctx, req.(*RemoveEntityRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_SetQuestionEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuestionEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).SetQuestionEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_SetQuestionEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).SetQuestionEntities(ctx, req.(*SetQuestionEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_ListQuestionEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuestionEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).ListQuestionEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_ListQuestionEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).ListQuestionEntities(ctx, req.(*ListQuestionEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_ImportEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).ImportEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_ImportEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).ImportEntities(ctx, req.(*ImportEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EntityService_ServiceDesc is the grpc.ServiceDesc for EntityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EntityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "historyquiz.entity.v1.EntityService",
+	HandlerType: (*EntityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEntity",
+			Handler:    _EntityService_CreateEntity_Handler,
+		},
+		{
+			MethodName: "UpdateEntity",
+			Handler:    _EntityService_UpdateEntity_Handler,
+		},
+		{
+			MethodName: "DeleteEntity",
+			Handler:    _EntityService_DeleteEntity_Handler,
+		},
+		{
+			MethodName: "GetEntity",
+			Handler:    _EntityService_GetEntity_Handler,
+		},
+		{
+			MethodName: "ListEntities",
+			Handler:    _EntityService_ListEntities_Handler,
+		},
+		{
+			MethodName: "AddEntityRelation",
+			Handler:    _EntityService_AddEntityRelation_Handler,
+		},
+		{
+			MethodName: "RemoveEntityRelation",
+			Handler:    _EntityService_RemoveEntityRelation_Handler,
+		},
+		{
+			MethodName: "SetQuestionEntities",
+			Handler:    _EntityService_SetQuestionEntities_Handler,
+		},
+		{
+			MethodName: "ListQuestionEntities",
+			Handler:    _EntityService_ListQuestionEntities_Handler,
+		},
+		{
+			MethodName: "ImportEntities",
+			Handler:    _EntityService_ImportEntities_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "historyquiz/entity/v1/entity_service.proto",
+}
@@ -0,0 +1,1443 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: historyquiz/moderation/v1/moderation_service.proto
+
+package moderationv1
+
+import (
+	v1 "github.com/history-quiz/historyquiz/proto/common/v1"
+	v11 "github.com/history-quiz/historyquiz/proto/question/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReportReason int32
+
+const (
+	ReportReason_REPORT_REASON_UNSPECIFIED   ReportReason = 0
+	ReportReason_REPORT_REASON_WRONG_ANSWER  ReportReason = 1 // 正解が誤っている
+	ReportReason_REPORT_REASON_AMBIGUOUS     ReportReason = 2 // 正解が複数ありうる/問題文が曖昧
+	ReportReason_REPORT_REASON_TYPO          ReportReason = 3 // 誤字脱字
+	ReportReason_REPORT_REASON_INAPPROPRIATE ReportReason = 4 // 不適切な内容
+	ReportReason_REPORT_REASON_OTHER         ReportReason = 5
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "REPORT_REASON_UNSPECIFIED",
+		1: "REPORT_REASON_WRONG_ANSWER",
+		2: "REPORT_REASON_AMBIGUOUS",
+		3: "REPORT_REASON_TYPO",
+		4: "REPORT_REASON_INAPPROPRIATE",
+		5: "REPORT_REASON_OTHER",
+	}
+	ReportReason_value = map[string]int32{
+		"REPORT_REASON_UNSPECIFIED":   0,
+		"REPORT_REASON_WRONG_ANSWER":  1,
+		"REPORT_REASON_AMBIGUOUS":     2,
+		"REPORT_REASON_TYPO":          3,
+		"REPORT_REASON_INAPPROPRIATE": 4,
+		"REPORT_REASON_OTHER":         5,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_moderation_v1_moderation_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_historyquiz_moderation_v1_moderation_service_proto_enumTypes[0]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{0}
+}
+
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED ReportStatus = 0
+	ReportStatus_REPORT_STATUS_OPEN        ReportStatus = 1
+	ReportStatus_REPORT_STATUS_DISMISSED   ReportStatus = 2
+	ReportStatus_REPORT_STATUS_RESOLVED    ReportStatus = 3
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_OPEN",
+		2: "REPORT_STATUS_DISMISSED",
+		3: "REPORT_STATUS_RESOLVED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_STATUS_OPEN":        1,
+		"REPORT_STATUS_DISMISSED":   2,
+		"REPORT_STATUS_RESOLVED":    3,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_moderation_v1_moderation_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_historyquiz_moderation_v1_moderation_service_proto_enumTypes[1]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{1}
+}
+
+// 報告の解決方法。
+type ResolutionAction int32
+
+const (
+	ResolutionAction_RESOLUTION_ACTION_UNSPECIFIED ResolutionAction = 0
+	ResolutionAction_RESOLUTION_ACTION_DISMISS     ResolutionAction = 1 // 問題に誤りなし（自動非表示も解除する）
+	ResolutionAction_RESOLUTION_ACTION_HIDE        ResolutionAction = 2 // 問題を非表示にする
+	ResolutionAction_RESOLUTION_ACTION_EDIT        ResolutionAction = 3 // 問題を修正して再表示する
+)
+
+// Enum value maps for ResolutionAction.
+var (
+	ResolutionAction_name = map[int32]string{
+		0: "RESOLUTION_ACTION_UNSPECIFIED",
+		1: "RESOLUTION_ACTION_DISMISS",
+		2: "RESOLUTION_ACTION_HIDE",
+		3: "RESOLUTION_ACTION_EDIT",
+	}
+	ResolutionAction_value = map[string]int32{
+		"RESOLUTION_ACTION_UNSPECIFIED": 0,
+		"RESOLUTION_ACTION_DISMISS":     1,
+		"RESOLUTION_ACTION_HIDE":        2,
+		"RESOLUTION_ACTION_EDIT":        3,
+	}
+)
+
+func (x ResolutionAction) Enum() *ResolutionAction {
+	p := new(ResolutionAction)
+	*p = x
+	return p
+}
+
+func (x ResolutionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResolutionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_moderation_v1_moderation_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ResolutionAction) Type() protoreflect.EnumType {
+	return &file_historyquiz_moderation_v1_moderation_service_proto_enumTypes[2]
+}
+
+func (x ResolutionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResolutionAction.Descriptor instead.
+func (ResolutionAction) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{2}
+}
+
+type QuestionReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId     string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionPrompt string                 `protobuf:"bytes,3,opt,name=question_prompt,json=questionPrompt,proto3" json:"question_prompt,omitempty"`
+	ReporterUserId string                 `protobuf:"bytes,4,opt,name=reporter_user_id,json=reporterUserId,proto3" json:"reporter_user_id,omitempty"`
+	Reason         ReportReason           `protobuf:"varint,5,opt,name=reason,proto3,enum=historyquiz.moderation.v1.ReportReason" json:"reason,omitempty"`
+	Detail         string                 `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+	Status         ReportStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=historyquiz.moderation.v1.ReportStatus" json:"status,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QuestionReport) Reset() {
+	*x = QuestionReport{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionReport) ProtoMessage() {}
+
+func (x *QuestionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionReport.ProtoReflect.Descriptor instead.
+func (*QuestionReport) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuestionReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuestionReport) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *QuestionReport) GetQuestionPrompt() string {
+	if x != nil {
+		return x.QuestionPrompt
+	}
+	return ""
+}
+
+func (x *QuestionReport) GetReporterUserId() string {
+	if x != nil {
+		return x.ReporterUserId
+	}
+	return ""
+}
+
+func (x *QuestionReport) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *QuestionReport) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *QuestionReport) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *QuestionReport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ReportQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,3,opt,name=reason,proto3,enum=historyquiz.moderation.v1.ReportReason" json:"reason,omitempty"`
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"` // 任意の補足（最大1000文字）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportQuestionRequest) Reset() {
+	*x = ReportQuestionRequest{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportQuestionRequest) ProtoMessage() {}
+
+func (x *ReportQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportQuestionRequest.ProtoReflect.Descriptor instead.
+func (*ReportQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportQuestionRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ReportQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *ReportQuestionRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ReportQuestionRequest) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type ReportQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	ReportId      string                 `protobuf:"bytes,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportQuestionResponse) Reset() {
+	*x = ReportQuestionResponse{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportQuestionResponse) ProtoMessage() {}
+
+func (x *ReportQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportQuestionResponse.ProtoReflect.Descriptor instead.
+func (*ReportQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReportQuestionResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ReportQuestionResponse) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type ListOpenReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Pagination    *v1.Pagination         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenReportsRequest) Reset() {
+	*x = ListOpenReportsRequest{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenReportsRequest) ProtoMessage() {}
+
+func (x *ListOpenReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListOpenReportsRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOpenReportsRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListOpenReportsRequest) GetPagination() *v1.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListOpenReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Reports       []*QuestionReport      `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	PageInfo      *v1.PageInfo           `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenReportsResponse) Reset() {
+	*x = ListOpenReportsResponse{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenReportsResponse) ProtoMessage() {}
+
+func (x *ListOpenReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListOpenReportsResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOpenReportsResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListOpenReportsResponse) GetReports() []*QuestionReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ListOpenReportsResponse) GetPageInfo() *v1.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type GetReportedQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportedQuestionRequest) Reset() {
+	*x = GetReportedQuestionRequest{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportedQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportedQuestionRequest) ProtoMessage() {}
+
+func (x *GetReportedQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportedQuestionRequest.ProtoReflect.Descriptor instead.
+func (*GetReportedQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetReportedQuestionRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetReportedQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+type GetReportedQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Question      *v11.QuestionDetail    `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"` // 正解情報を含む
+	AuthorUserId  string                 `protobuf:"bytes,3,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
+	Hidden        bool                   `protobuf:"varint,4,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	OpenReports   []*QuestionReport      `protobuf:"bytes,5,rep,name=open_reports,json=openReports,proto3" json:"open_reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportedQuestionResponse) Reset() {
+	*x = GetReportedQuestionResponse{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportedQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportedQuestionResponse) ProtoMessage() {}
+
+func (x *GetReportedQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportedQuestionResponse.ProtoReflect.Descriptor instead.
+func (*GetReportedQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReportedQuestionResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetReportedQuestionResponse) GetQuestion() *v11.QuestionDetail {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *GetReportedQuestionResponse) GetAuthorUserId() string {
+	if x != nil {
+		return x.AuthorUserId
+	}
+	return ""
+}
+
+func (x *GetReportedQuestionResponse) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *GetReportedQuestionResponse) GetOpenReports() []*QuestionReport {
+	if x != nil {
+		return x.OpenReports
+	}
+	return nil
+}
+
+type ResolveReportRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Context  *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	ReportId string                 `protobuf:"bytes,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Action   ResolutionAction       `protobuf:"varint,3,opt,name=action,proto3,enum=historyquiz.moderation.v1.ResolutionAction" json:"action,omitempty"`
+	// action = EDIT の場合のみ使う修正内容。
+	Draft         *v11.QuestionDraft `protobuf:"bytes,4,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportRequest) Reset() {
+	*x = ResolveReportRequest{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportRequest) ProtoMessage() {}
+
+func (x *ResolveReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResolveReportRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ResolveReportRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *ResolveReportRequest) GetAction() ResolutionAction {
+	if x != nil {
+		return x.Action
+	}
+	return ResolutionAction_RESOLUTION_ACTION_UNSPECIFIED
+}
+
+func (x *ResolveReportRequest) GetDraft() *v11.QuestionDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type ResolveReportResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// 同じ問題に対する未対応の報告はまとめて解決する。
+	ResolvedCount int64               `protobuf:"varint,2,opt,name=resolved_count,json=resolvedCount,proto3" json:"resolved_count,omitempty"`
+	Question      *v11.QuestionDetail `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportResponse) Reset() {
+	*x = ResolveReportResponse{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportResponse) ProtoMessage() {}
+
+func (x *ResolveReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResolveReportResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ResolveReportResponse) GetResolvedCount() int64 {
+	if x != nil {
+		return x.ResolvedCount
+	}
+	return 0
+}
+
+func (x *ResolveReportResponse) GetQuestion() *v11.QuestionDetail {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type ListDuplicateClustersRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Context    *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Pagination *v1.Pagination         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// 同じまとまりとみなす類似度の下限（0.3..1.0）。未指定（0）の場合は 0.6。
+	MinSimilarity float64 `protobuf:"fixed64,3,opt,name=min_similarity,json=minSimilarity,proto3" json:"min_similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateClustersRequest) Reset() {
+	*x = ListDuplicateClustersRequest{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateClustersRequest) ProtoMessage() {}
+
+func (x *ListDuplicateClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateClustersRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListDuplicateClustersRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListDuplicateClustersRequest) GetPagination() *v1.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListDuplicateClustersRequest) GetMinSimilarity() float64 {
+	if x != nil {
+		return x.MinSimilarity
+	}
+	return 0
+}
+
+// 重複候補の問題。
+type DuplicateQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	AuthorUserId  string                 `protobuf:"bytes,2,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Status        v11.QuestionStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=historyquiz.question.v1.QuestionStatus" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateQuestion) Reset() {
+	*x = DuplicateQuestion{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateQuestion) ProtoMessage() {}
+
+func (x *DuplicateQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateQuestion.ProtoReflect.Descriptor instead.
+func (*DuplicateQuestion) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DuplicateQuestion) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *DuplicateQuestion) GetAuthorUserId() string {
+	if x != nil {
+		return x.AuthorUserId
+	}
+	return ""
+}
+
+func (x *DuplicateQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *DuplicateQuestion) GetStatus() v11.QuestionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v11.QuestionStatus(0)
+}
+
+func (x *DuplicateQuestion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 類似度が閾値以上の組を連結した問題のまとまり。
+// NOTE: A≒B かつ B≒C なら、A と C が閾値未満でも同じまとまりになる。
+type DuplicateCluster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Questions     []*DuplicateQuestion   `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"` // 古い順（先頭が元の問題である可能性が高い）
+	MaxSimilarity float64                `protobuf:"fixed64,2,opt,name=max_similarity,json=maxSimilarity,proto3" json:"max_similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateCluster) Reset() {
+	*x = DuplicateCluster{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCluster) ProtoMessage() {}
+
+func (x *DuplicateCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCluster.ProtoReflect.Descriptor instead.
+func (*DuplicateCluster) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DuplicateCluster) GetQuestions() []*DuplicateQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *DuplicateCluster) GetMaxSimilarity() float64 {
+	if x != nil {
+		return x.MaxSimilarity
+	}
+	return 0
+}
+
+type ListDuplicateClustersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Clusters      []*DuplicateCluster    `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	PageInfo      *v1.PageInfo           `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateClustersResponse) Reset() {
+	*x = ListDuplicateClustersResponse{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateClustersResponse) ProtoMessage() {}
+
+func (x *ListDuplicateClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateClustersResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListDuplicateClustersResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListDuplicateClustersResponse) GetClusters() []*DuplicateCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ListDuplicateClustersResponse) GetPageInfo() *v1.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type ListUncitedQuestionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// page_token には前のページの next_page_token を渡す。
+	Pagination    *v1.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUncitedQuestionsRequest) Reset() {
+	*x = ListUncitedQuestionsRequest{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUncitedQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUncitedQuestionsRequest) ProtoMessage() {}
+
+func (x *ListUncitedQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUncitedQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUncitedQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListUncitedQuestionsRequest) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListUncitedQuestionsRequest) GetPagination() *v1.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// 出典のない公開中の問題。
+type UncitedQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	AuthorUserId  string                 `protobuf:"bytes,2,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UncitedQuestion) Reset() {
+	*x = UncitedQuestion{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UncitedQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UncitedQuestion) ProtoMessage() {}
+
+func (x *UncitedQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UncitedQuestion.ProtoReflect.Descriptor instead.
+func (*UncitedQuestion) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UncitedQuestion) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *UncitedQuestion) GetAuthorUserId() string {
+	if x != nil {
+		return x.AuthorUserId
+	}
+	return ""
+}
+
+func (x *UncitedQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *UncitedQuestion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListUncitedQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Questions     []*UncitedQuestion     `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+	PageInfo      *v1.PageInfo           `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUncitedQuestionsResponse) Reset() {
+	*x = ListUncitedQuestionsResponse{}
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUncitedQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUncitedQuestionsResponse) ProtoMessage() {}
+
+func (x *ListUncitedQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_moderation_v1_moderation_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUncitedQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUncitedQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListUncitedQuestionsResponse) GetContext() *v1.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListUncitedQuestionsResponse) GetQuestions() []*UncitedQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ListUncitedQuestionsResponse) GetPageInfo() *v1.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+var File_historyquiz_moderation_v1_moderation_service_proto protoreflect.FileDescriptor
+
+const file_historyquiz_moderation_v1_moderation_service_proto_rawDesc = "" +
+	"\n" +
+	"2historyquiz/moderation/v1/moderation_service.proto\x12\x19historyquiz.moderation.v1\x1a\"historyquiz/common/v1/common.proto\x1a.historyquiz/question/v1/question_service.proto\"\xcd\x02\n" +
+	"\x0eQuestionReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12'\n" +
+	"\x0fquestion_prompt\x18\x03 \x01(\tR\x0equestionPrompt\x12(\n" +
+	"\x10reporter_user_id\x18\x04 \x01(\tR\x0ereporterUserId\x12?\n" +
+	"\x06reason\x18\x05 \x01(\x0e2'.historyquiz.moderation.v1.ReportReasonR\x06reason\x12\x16\n" +
+	"\x06detail\x18\x06 \x01(\tR\x06detail\x12?\n" +
+	"\x06status\x18\a \x01(\x0e2'.historyquiz.moderation.v1.ReportStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xd2\x01\n" +
+	"\x15ReportQuestionRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12?\n" +
+	"\x06reason\x18\x03 \x01(\x0e2'.historyquiz.moderation.v1.ReportReasonR\x06reason\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\"v\n" +
+	"\x16ReportQuestionResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\tR\breportId\"\x9c\x01\n" +
+	"\x16ListOpenReportsRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12A\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2!.historyquiz.common.v1.PaginationR\n" +
+	"pagination\"\xdd\x01\n" +
+	"\x17ListOpenReportsResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
+	"\areports\x18\x02 \x03(\v2).historyquiz.moderation.v1.QuestionReportR\areports\x12<\n" +
+	"\tpage_info\x18\x03 \x01(\v2\x1f.historyquiz.common.v1.PageInfoR\bpageInfo\"~\n" +
+	"\x1aGetReportedQuestionRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\"\xaf\x02\n" +
+	"\x1bGetReportedQuestionResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
+	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\x12$\n" +
+	"\x0eauthor_user_id\x18\x03 \x01(\tR\fauthorUserId\x12\x16\n" +
+	"\x06hidden\x18\x04 \x01(\bR\x06hidden\x12L\n" +
+	"\fopen_reports\x18\x05 \x03(\v2).historyquiz.moderation.v1.QuestionReportR\vopenReports\"\xf7\x01\n" +
+	"\x14ResolveReportRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\tR\breportId\x12C\n" +
+	"\x06action\x18\x03 \x01(\x0e2+.historyquiz.moderation.v1.ResolutionActionR\x06action\x12<\n" +
+	"\x05draft\x18\x04 \x01(\v2&.historyquiz.question.v1.QuestionDraftR\x05draft\"\xc4\x01\n" +
+	"\x15ResolveReportResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12%\n" +
+	"\x0eresolved_count\x18\x02 \x01(\x03R\rresolvedCount\x12C\n" +
+	"\bquestion\x18\x03 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\"\xc9\x01\n" +
+	"\x1cListDuplicateClustersRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12A\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2!.historyquiz.common.v1.PaginationR\n" +
+	"pagination\x12%\n" +
+	"\x0emin_similarity\x18\x03 \x01(\x01R\rminSimilarity\"\xd2\x01\n" +
+	"\x11DuplicateQuestion\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12$\n" +
+	"\x0eauthor_user_id\x18\x02 \x01(\tR\fauthorUserId\x12\x16\n" +
+	"\x06prompt\x18\x03 \x01(\tR\x06prompt\x12?\n" +
+	"\x06status\x18\x04 \x01(\x0e2'.historyquiz.question.v1.QuestionStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x85\x01\n" +
+	"\x10DuplicateCluster\x12J\n" +
+	"\tquestions\x18\x01 \x03(\v2,.historyquiz.moderation.v1.DuplicateQuestionR\tquestions\x12%\n" +
+	"\x0emax_similarity\x18\x02 \x01(\x01R\rmaxSimilarity\"\xe7\x01\n" +
+	"\x1dListDuplicateClustersResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12G\n" +
+	"\bclusters\x18\x02 \x03(\v2+.historyquiz.moderation.v1.DuplicateClusterR\bclusters\x12<\n" +
+	"\tpage_info\x18\x03 \x01(\v2\x1f.historyquiz.common.v1.PageInfoR\bpageInfo\"\xa1\x01\n" +
+	"\x1bListUncitedQuestionsRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12A\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2!.historyquiz.common.v1.PaginationR\n" +
+	"pagination\"\x8f\x01\n" +
+	"\x0fUncitedQuestion\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12$\n" +
+	"\x0eauthor_user_id\x18\x02 \x01(\tR\fauthorUserId\x12\x16\n" +
+	"\x06prompt\x18\x03 \x01(\tR\x06prompt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\xe7\x01\n" +
+	"\x1cListUncitedQuestionsResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12H\n" +
+	"\tquestions\x18\x02 \x03(\v2*.historyquiz.moderation.v1.UncitedQuestionR\tquestions\x12<\n" +
+	"\tpage_info\x18\x03 \x01(\v2\x1f.historyquiz.common.v1.PageInfoR\bpageInfo*\xbc\x01\n" +
+	"\fReportReason\x12\x1d\n" +
+	"\x19REPORT_REASON_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aREPORT_REASON_WRONG_ANSWER\x10\x01\x12\x1b\n" +
+	"\x17REPORT_REASON_AMBIGUOUS\x10\x02\x12\x16\n" +
+	"\x12REPORT_REASON_TYPO\x10\x03\x12\x1f\n" +
+	"\x1bREPORT_REASON_INAPPROPRIATE\x10\x04\x12\x17\n" +
+	"\x13REPORT_REASON_OTHER\x10\x05*~\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_STATUS_OPEN\x10\x01\x12\x1b\n" +
+	"\x17REPORT_STATUS_DISMISSED\x10\x02\x12\x1a\n" +
+	"\x16REPORT_STATUS_RESOLVED\x10\x03*\x8c\x01\n" +
+	"\x10ResolutionAction\x12!\n" +
+	"\x1dRESOLUTION_ACTION_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19RESOLUTION_ACTION_DISMISS\x10\x01\x12\x1a\n" +
+	"\x16RESOLUTION_ACTION_HIDE\x10\x02\x12\x1a\n" +
+	"\x16RESOLUTION_ACTION_EDIT\x10\x032\x96\x06\n" +
+	"\x11ModerationService\x12u\n" +
+	"\x0eReportQuestion\x120.historyquiz.moderation.v1.ReportQuestionRequest\x1a1.historyquiz.moderation.v1.ReportQuestionResponse\x12x\n" +
+	"\x0fListOpenReports\x121.historyquiz.moderation.v1.ListOpenReportsRequest\x1a2.historyquiz.moderation.v1.ListOpenReportsResponse\x12\x84\x01\n" +
+	"\x13GetReportedQuestion\x125.historyquiz.moderation.v1.GetReportedQuestionRequest\x1a6.historyquiz.moderation.v1.GetReportedQuestionResponse\x12r\n" +
+	"\rResolveReport\x12/.historyquiz.moderation.v1.ResolveReportRequest\x1a0.historyquiz.moderation.v1.ResolveReportResponse\x12\x8a\x01\n" +
+	"\x15ListDuplicateClusters\x127.historyquiz.moderation.v1.ListDuplicateClustersRequest\x1a8.historyquiz.moderation.v1.ListDuplicateClustersResponse\x12\x87\x01\n" +
+	"\x14ListUncitedQuestions\x126.historyquiz.moderation.v1.ListUncitedQuestionsRequest\x1a7.historyquiz.moderation.v1.ListUncitedQuestionsResponseBFZDgithub.com/history-quiz/historyquiz/proto/moderation/v1;moderationv1b\x06proto3"
+
+var (
+	file_historyquiz_moderation_v1_moderation_service_proto_rawDescOnce sync.Once
+	file_historyquiz_moderation_v1_moderation_service_proto_rawDescData []byte
+)
+
+func file_historyquiz_moderation_v1_moderation_service_proto_rawDescGZIP() []byte {
+	file_historyquiz_moderation_v1_moderation_service_proto_rawDescOnce.Do(func() {
+		file_historyquiz_moderation_v1_moderation_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_historyquiz_moderation_v1_moderation_service_proto_rawDesc), len(file_historyquiz_moderation_v1_moderation_service_proto_rawDesc)))
+	})
+	return file_historyquiz_moderation_v1_moderation_service_proto_rawDescData
+}
+
+var file_historyquiz_moderation_v1_moderation_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_historyquiz_moderation_v1_moderation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_historyquiz_moderation_v1_moderation_service_proto_goTypes = []any{
+	(ReportReason)(0),                     // 0: historyquiz.moderation.v1.ReportReason
+	(ReportStatus)(0),                     // 1: historyquiz.moderation.v1.ReportStatus
+	(ResolutionAction)(0),                 // 2: historyquiz.moderation.v1.ResolutionAction
+	(*QuestionReport)(nil),                // 3: historyquiz.moderation.v1.QuestionReport
+	(*ReportQuestionRequest)(nil),         // 4: historyquiz.moderation.v1.ReportQuestionRequest
+	(*ReportQuestionResponse)(nil),        // 5: historyquiz.moderation.v1.ReportQuestionResponse
+	(*ListOpenReportsRequest)(nil),        // 6: historyquiz.moderation.v1.ListOpenReportsRequest
+	(*ListOpenReportsResponse)(nil),       // 7: historyquiz.moderation.v1.ListOpenReportsResponse
+	(*GetReportedQuestionRequest)(nil),    // 8: historyquiz.moderation.v1.GetReportedQuestionRequest
+	(*GetReportedQuestionResponse)(nil),   // 9: historyquiz.moderation.v1.GetReportedQuestionResponse
+	(*ResolveReportRequest)(nil),          // 10: historyquiz.moderation.v1.ResolveReportRequest
+	(*ResolveReportResponse)(nil),         // 11: historyquiz.moderation.v1.ResolveReportResponse
+	(*ListDuplicateClustersRequest)(nil),  // 12: historyquiz.moderation.v1.ListDuplicateClustersRequest
+	(*DuplicateQuestion)(nil),             // 13: historyquiz.moderation.v1.DuplicateQuestion
+	(*DuplicateCluster)(nil),              // 14: historyquiz.moderation.v1.DuplicateCluster
+	(*ListDuplicateClustersResponse)(nil), // 15: historyquiz.moderation.v1.ListDuplicateClustersResponse
+	(*ListUncitedQuestionsRequest)(nil),   // 16: historyquiz.moderation.v1.ListUncitedQuestionsRequest
+	(*UncitedQuestion)(nil),               // 17: historyquiz.moderation.v1.UncitedQuestion
+	(*ListUncitedQuestionsResponse)(nil),  // 18: historyquiz.moderation.v1.ListUncitedQuestionsResponse
+	(*v1.RequestContext)(nil),             // 19: historyquiz.common.v1.RequestContext
+	(*v1.Pagination)(nil),                 // 20: historyquiz.common.v1.Pagination
+	(*v1.PageInfo)(nil),                   // 21: historyquiz.common.v1.PageInfo
+	(*v11.QuestionDetail)(nil),            // 22: historyquiz.question.v1.QuestionDetail
+	(*v11.QuestionDraft)(nil),             // 23: historyquiz.question.v1.QuestionDraft
+	(v11.QuestionStatus)(0),               // 24: historyquiz.question.v1.QuestionStatus
+}
+var file_historyquiz_moderation_v1_moderation_service_proto_depIdxs = []int32{
+	0,  // 0: historyquiz.moderation.v1.QuestionReport.reason:type_name -> historyquiz.moderation.v1.ReportReason
+	1,  // 1: historyquiz.moderation.v1.QuestionReport.status:type_name -> historyquiz.moderation.v1.ReportStatus
+	19, // 2: historyquiz.moderation.v1.ReportQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	0,  // 3: historyquiz.moderation.v1.ReportQuestionRequest.reason:type_name -> historyquiz.moderation.v1.ReportReason
+	19, // 4: historyquiz.moderation.v1.ReportQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	19, // 5: historyquiz.moderation.v1.ListOpenReportsRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	20, // 6: historyquiz.moderation.v1.ListOpenReportsRequest.pagination:type_name -> historyquiz.common.v1.Pagination
+	19, // 7: historyquiz.moderation.v1.ListOpenReportsResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	3,  // 8: historyquiz.moderation.v1.ListOpenReportsResponse.reports:type_name -> historyquiz.moderation.v1.QuestionReport
+	21, // 9: historyquiz.moderation.v1.ListOpenReportsResponse.page_info:type_name -> historyquiz.common.v1.PageInfo
+	19, // 10: historyquiz.moderation.v1.GetReportedQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	19, // 11: historyquiz.moderation.v1.GetReportedQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	22, // 12: historyquiz.moderation.v1.GetReportedQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
+	3,  // 13: historyquiz.moderation.v1.GetReportedQuestionResponse.open_reports:type_name -> historyquiz.moderation.v1.QuestionReport
+	19, // 14: historyquiz.moderation.v1.ResolveReportRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	2,  // 15: historyquiz.moderation.v1.ResolveReportRequest.action:type_name -> historyquiz.moderation.v1.ResolutionAction
+	23, // 16: historyquiz.moderation.v1.ResolveReportRequest.draft:type_name -> historyquiz.question.v1.QuestionDraft
+	19, // 17: historyquiz.moderation.v1.ResolveReportResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	22, // 18: historyquiz.moderation.v1.ResolveReportResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
+	19, // 19: historyquiz.moderation.v1.ListDuplicateClustersRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	20, // 20: historyquiz.moderation.v1.ListDuplicateClustersRequest.pagination:type_name -> historyquiz.common.v1.Pagination
+	24, // 21: historyquiz.moderation.v1.DuplicateQuestion.status:type_name -> historyquiz.question.v1.QuestionStatus
+	13, // 22: historyquiz.moderation.v1.DuplicateCluster.questions:type_name -> historyquiz.moderation.v1.DuplicateQuestion
+	19, // 23: historyquiz.moderation.v1.ListDuplicateClustersResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	14, // 24: historyquiz.moderation.v1.ListDuplicateClustersResponse.clusters:type_name -> historyquiz.moderation.v1.DuplicateCluster
+	21, // 25: historyquiz.moderation.v1.ListDuplicateClustersResponse.page_info:type_name -> historyquiz.common.v1.PageInfo
+	19, // 26: historyquiz.moderation.v1.ListUncitedQuestionsRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	20, // 27: historyquiz.moderation.v1.ListUncitedQuestionsRequest.pagination:type_name -> historyquiz.common.v1.Pagination
+	19, // 28: historyquiz.moderation.v1.ListUncitedQuestionsResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	17, // 29: historyquiz.moderation.v1.ListUncitedQuestionsResponse.questions:type_name -> historyquiz.moderation.v1.UncitedQuestion
+	21, // 30: historyquiz.moderation.v1.ListUncitedQuestionsResponse.page_info:type_name -> historyquiz.common.v1.PageInfo
+	4,  // 31: historyquiz.moderation.v1.ModerationService.ReportQuestion:input_type -> historyquiz.moderation.v1.ReportQuestionRequest
+	6,  // 32: historyquiz.moderation.v1.ModerationService.ListOpenReports:input_type -> historyquiz.moderation.v1.ListOpenReportsRequest
+	8,  // 33: historyquiz.moderation.v1.ModerationService.GetReportedQuestion:input_type -> historyquiz.moderation.v1.GetReportedQuestionRequest
+	10, // 34: historyquiz.moderation.v1.ModerationService.ResolveReport:input_type -> historyquiz.moderation.v1.ResolveReportRequest
+	12, // 35: historyquiz.moderation.v1.ModerationService.ListDuplicateClusters:input_type -> historyquiz.moderation.v1.ListDuplicateClustersRequest
+	16, // 36: historyquiz.moderation.v1.ModerationService.ListUncitedQuestions:input_type -> historyquiz.moderation.v1.ListUncitedQuestionsRequest
+	5,  // 37: historyquiz.moderation.v1.ModerationService.ReportQuestion:output_type -> historyquiz.moderation.v1.ReportQuestionResponse
+	7,  // 38: historyquiz.moderation.v1.ModerationService.ListOpenReports:output_type -> historyquiz.moderation.v1.ListOpenReportsResponse
+	9,  // 39: historyquiz.moderation.v1.ModerationService.GetReportedQuestion:output_type -> historyquiz.moderation.v1.GetReportedQuestionResponse
+	11, // 40: historyquiz.moderation.v1.ModerationService.ResolveReport:output_type -> historyquiz.moderation.v1.ResolveReportResponse
+	15, // 41: historyquiz.moderation.v1.ModerationService.ListDuplicateClusters:output_type -> historyquiz.moderation.v1.ListDuplicateClustersResponse
+	18, // 42: historyquiz.moderation.v1.ModerationService.ListUncitedQuestions:output_type -> historyquiz.moderation.v1.ListUncitedQuestionsResponse
+	37, // [37:43] is the sub-list for method output_type
+	31, // [31:37] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_historyquiz_moderation_v1_moderation_service_proto_init() }
+func file_historyquiz_moderation_v1_moderation_service_proto_init() {
+	if File_historyquiz_moderation_v1_moderation_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_historyquiz_moderation_v1_moderation_service_proto_rawDesc), len(file_historyquiz_moderation_v1_moderation_service_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_historyquiz_moderation_v1_moderation_service_proto_goTypes,
+		DependencyIndexes: file_historyquiz_moderation_v1_moderation_service_proto_depIdxs,
+		EnumInfos:         file_historyquiz_moderation_v1_moderation_service_proto_enumTypes,
+		MessageInfos:      file_historyquiz_moderation_v1_moderation_service_proto_msgTypes,
+	}.Build()
+	File_historyquiz_moderation_v1_moderation_service_proto = out.File
+	file_historyquiz_moderation_v1_moderation_service_proto_goTypes = nil
+	file_historyquiz_moderation_v1_moderation_service_proto_depIdxs = nil
+}
@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: historyquiz/moderation/v1/moderation_service.proto
+
+package moderationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ModerationService_ReportQuestion_FullMethodName        = "/historyquiz.moderation.v1.ModerationService/ReportQuestion"
+	ModerationService_ListOpenReports_FullMethodName       = "/historyquiz.moderation.v1.ModerationService/ListOpenReports"
+	ModerationService_GetReportedQuestion_FullMethodName   = "/historyquiz.moderation.v1.ModerationService/GetReportedQuestion"
+	ModerationService_ResolveReport_FullMethodName         = "/historyquiz.moderation.v1.ModerationService/ResolveReport"
+	ModerationService_ListDuplicateClusters_FullMethodName = "/historyquiz.moderation.v1.ModerationService/ListDuplicateClusters"
+	ModerationService_ListUncitedQuestions_FullMethodName  = "/historyquiz.moderation.v1.ModerationService/ListUncitedQuestions"
+)
+
+// ModerationServiceClient is the client API for ModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 問題の報告（プレイヤー）とモデレーション（管理者）を扱うサービス。
+type ModerationServiceClient interface {
+	// 問題の誤り等を報告する（ログイン必須）。
+	// 報告できるのは出題できる（公開中で非表示でない）問題だけ。それ以外は NOT_FOUND。
+	ReportQuestion(ctx context.Context, in *ReportQuestionRequest, opts ...grpc.CallOption) (*ReportQuestionResponse, error)
+	// 未対応の報告一覧を返す（管理者のみ）。
+	ListOpenReports(ctx context.Context, in *ListOpenReportsRequest, opts ...grpc.CallOption) (*ListOpenReportsResponse, error)
+	// 報告された問題を正解情報・報告一覧と合わせて返す（管理者のみ）。
+	GetReportedQuestion(ctx context.Context, in *GetReportedQuestionRequest, opts ...grpc.CallOption) (*GetReportedQuestionResponse, error)
+	// 報告を却下/非表示/修正のいずれかで解決する（管理者のみ）。
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error)
+	// 問題文がよく似た問題のまとまり（重複候補）を大きい順に返す（管理者のみ）。
+	ListDuplicateClusters(ctx context.Context, in *ListDuplicateClustersRequest, opts ...grpc.CallOption) (*ListDuplicateClustersResponse, error)
+	// 出典が1件もない公開中の問題を古い順に返す（管理者のみ）。
+	ListUncitedQuestions(ctx context.Context, in *ListUncitedQuestionsRequest, opts ...grpc.CallOption) (*ListUncitedQuestionsResponse, error)
+}
+
+type moderationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewModerationServiceClient(cc grpc.ClientConnInterface) ModerationServiceClient {
+	return &moderationServiceClient{cc}
+}
+
+func (c *moderationServiceClient) ReportQuestion(ctx context.Context, in *ReportQuestionRequest, opts ...grpc.CallOption) (*ReportQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportQuestionResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ReportQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ListOpenReports(ctx context.Context, in *ListOpenReportsRequest, opts ...grpc.CallOption) (*ListOpenReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOpenReportsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListOpenReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) GetReportedQuestion(ctx context.Context, in *GetReportedQuestionRequest, opts ...grpc.CallOption) (*GetReportedQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportedQuestionResponse)
+	err := c.cc.Invoke(ctx, ModerationService_GetReportedQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ResolveReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ListDuplicateClusters(ctx context.Context, in *ListDuplicateClustersRequest, opts ...grpc.CallOption) (*ListDuplicateClustersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuplicateClustersResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListDuplicateClusters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ListUncitedQuestions(ctx context.Context, in *ListUncitedQuestionsRequest, opts ...grpc.CallOption) (*ListUncitedQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUncitedQuestionsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListUncitedQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerationServiceServer is the server API for ModerationService service.
+// All implementations must embed UnimplementedModerationServiceServer
+// for forward compatibility.
+//
+// 問題の報告（プレイヤー）とモデレーション（管理者）を扱うサービス。
+type ModerationServiceServer interface {
+	// 問題の誤り等を報告する（ログイン必須）。
+	// 報告できるのは出題できる（公開中で非表示でない）問題だけ。それ以外は NOT_FOUND。
+	ReportQuestion(context.Context, *ReportQuestionRequest) (*ReportQuestionResponse, error)
+	// 未対応の報告一覧を返す（管理者のみ）。
+	ListOpenReports(context.Context, *ListOpenReportsRequest) (*ListOpenReportsResponse, error)
+	// 報告された問題を正解情報・報告一覧と合わせて返す（管理者のみ）。
+	GetReportedQuestion(context.Context, *GetReportedQuestionRequest) (*GetReportedQuestionResponse, error)
+	// 報告を却下/非表示/修正のいずれかで解決する（管理者のみ）。
+	ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error)
+	// 問題文がよく似た問題のまとまり（重複候補）を大きい順に返す（管理者のみ）。
+	ListDuplicateClusters(context.Context, *ListDuplicateClustersRequest) (*ListDuplicateClustersResponse, error)
+	// 出典が1件もない公開中の問題を古い順に返す（管理者のみ）。
+	ListUncitedQuestions(context.Context, *ListUncitedQuestionsRequest) (*ListUncitedQuestionsResponse, error)
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+// UnimplementedModerationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedModerationServiceServer struct{}
+
+func (UnimplementedModerationServiceServer) ReportQuestion(context.Context, *ReportQuestionRequest) (*ReportQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportQuestion not implemented")
+}
+func (UnimplementedModerationServiceServer) ListOpenReports(context.Context, *ListOpenReportsRequest) (*ListOpenReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOpenReports not implemented")
+}
+func (UnimplementedModerationServiceServer) GetReportedQuestion(context.Context, *GetReportedQuestionRequest) (*GetReportedQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportedQuestion not implemented")
+}
+func (UnimplementedModerationServiceServer) ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedModerationServiceServer) ListDuplicateClusters(context.Context, *ListDuplicateClustersRequest) (*ListDuplicateClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateClusters not implemented")
+}
+func (UnimplementedModerationServiceServer) ListUncitedQuestions(context.Context, *ListUncitedQuestionsRequest) (*ListUncitedQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUncitedQuestions not implemented")
+}
+func (UnimplementedModerationServiceServer) mustEmbedUnimplementedModerationServiceServer() {}
+func (UnimplementedModerationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ModerationServiceServer will
+// result in compilation errors.
+type UnsafeModerationServiceServer interface {
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+func RegisterModerationServiceServer(s grpc.ServiceRegistrar, srv ModerationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedModerationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ModerationService_ServiceDesc, srv)
+}
+
+func _ModerationService_ReportQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ReportQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ReportQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ReportQuestion(ctx, req.(*ReportQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ListOpenReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOpenReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListOpenReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListOpenReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListOpenReports(ctx, req.(*ListOpenReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_GetReportedQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportedQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).GetReportedQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_GetReportedQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).GetReportedQuestion(ctx, req.(*GetReportedQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ResolveReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ResolveReport(ctx, req.(*ResolveReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ListDuplicateClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListDuplicateClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListDuplicateClusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListDuplicateClusters(ctx, req.(*ListDuplicateClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ListUncitedQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUncitedQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListUncitedQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListUncitedQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListUncitedQuestions(ctx, req.(*ListUncitedQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ModerationService_ServiceDesc is the grpc.ServiceDesc for ModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "historyquiz.moderation.v1.ModerationService",
+	HandlerType: (*ModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportQuestion",
+			Handler:    _ModerationService_ReportQuestion_Handler,
+		},
+		{
+			MethodName: "ListOpenReports",
+			Handler:    _ModerationService_ListOpenReports_Handler,
+		},
+		{
+			MethodName: "GetReportedQuestion",
+			Handler:    _ModerationService_GetReportedQuestion_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _ModerationService_ResolveReport_Handler,
+		},
+		{
+			MethodName: "ListDuplicateClusters",
+			Handler:    _ModerationService_ListDuplicateClusters_Handler,
+		},
+		{
+			MethodName: "ListUncitedQuestions",
+			Handler:    _ModerationService_ListUncitedQuestions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "historyquiz/moderation/v1/moderation_service.proto",
+}
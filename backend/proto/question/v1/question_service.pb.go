@@ -7,7 +7,8 @@
 package questionv1
 
 import (
-	v1 "github.com/history-quiz/historyquiz/proto/common/v1"
+	v1 "github.com/history-quiz/historyquiz/proto/attachment/v1"
+	v11 "github.com/history-quiz/historyquiz/proto/common/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,13 +23,446 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 問題の公開状態。
+// draft → published → unlisted/archived の順に遷移し、出題候補になるのは published のみ。
+type QuestionStatus int32
+
+const (
+	QuestionStatus_QUESTION_STATUS_UNSPECIFIED QuestionStatus = 0
+	QuestionStatus_QUESTION_STATUS_DRAFT       QuestionStatus = 1
+	QuestionStatus_QUESTION_STATUS_PUBLISHED   QuestionStatus = 2
+	QuestionStatus_QUESTION_STATUS_UNLISTED    QuestionStatus = 3
+	QuestionStatus_QUESTION_STATUS_ARCHIVED    QuestionStatus = 4
+)
+
+// Enum value maps for QuestionStatus.
+var (
+	QuestionStatus_name = map[int32]string{
+		0: "QUESTION_STATUS_UNSPECIFIED",
+		1: "QUESTION_STATUS_DRAFT",
+		2: "QUESTION_STATUS_PUBLISHED",
+		3: "QUESTION_STATUS_UNLISTED",
+		4: "QUESTION_STATUS_ARCHIVED",
+	}
+	QuestionStatus_value = map[string]int32{
+		"QUESTION_STATUS_UNSPECIFIED": 0,
+		"QUESTION_STATUS_DRAFT":       1,
+		"QUESTION_STATUS_PUBLISHED":   2,
+		"QUESTION_STATUS_UNLISTED":    3,
+		"QUESTION_STATUS_ARCHIVED":    4,
+	}
+)
+
+func (x QuestionStatus) Enum() *QuestionStatus {
+	p := new(QuestionStatus)
+	*p = x
+	return p
+}
+
+func (x QuestionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_question_v1_question_service_proto_enumTypes[0].Descriptor()
+}
+
+func (QuestionStatus) Type() protoreflect.EnumType {
+	return &file_historyquiz_question_v1_question_service_proto_enumTypes[0]
+}
+
+func (x QuestionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionStatus.Descriptor instead.
+func (QuestionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{0}
+}
+
+// 問題の答え方の種類。
+type QuestionKind int32
+
+const (
+	QuestionKind_QUESTION_KIND_UNSPECIFIED QuestionKind = 0 // CHOICE として扱う
+	QuestionKind_QUESTION_KIND_CHOICE      QuestionKind = 1 // 4択（location があれば地図でも答えられる）
+	QuestionKind_QUESTION_KIND_LOCATION    QuestionKind = 2 // 地図でだけ答える（location 必須、choices/accepted_answers は指定できない）
+)
+
+// Enum value maps for QuestionKind.
+var (
+	QuestionKind_name = map[int32]string{
+		0: "QUESTION_KIND_UNSPECIFIED",
+		1: "QUESTION_KIND_CHOICE",
+		2: "QUESTION_KIND_LOCATION",
+	}
+	QuestionKind_value = map[string]int32{
+		"QUESTION_KIND_UNSPECIFIED": 0,
+		"QUESTION_KIND_CHOICE":      1,
+		"QUESTION_KIND_LOCATION":    2,
+	}
+)
+
+func (x QuestionKind) Enum() *QuestionKind {
+	p := new(QuestionKind)
+	*p = x
+	return p
+}
+
+func (x QuestionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_question_v1_question_service_proto_enumTypes[1].Descriptor()
+}
+
+func (QuestionKind) Type() protoreflect.EnumType {
+	return &file_historyquiz_question_v1_question_service_proto_enumTypes[1]
+}
+
+func (x QuestionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionKind.Descriptor instead.
+func (QuestionKind) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{1}
+}
+
+type CitationKind int32
+
+const (
+	CitationKind_CITATION_KIND_UNSPECIFIED    CitationKind = 0
+	CitationKind_CITATION_KIND_BOOK           CitationKind = 1 // 書籍
+	CitationKind_CITATION_KIND_WEB            CitationKind = 2 // Web ページ（url 必須）
+	CitationKind_CITATION_KIND_PRIMARY_SOURCE CitationKind = 3 // 史料（古文書・条約文・日記など）
+)
+
+// Enum value maps for CitationKind.
+var (
+	CitationKind_name = map[int32]string{
+		0: "CITATION_KIND_UNSPECIFIED",
+		1: "CITATION_KIND_BOOK",
+		2: "CITATION_KIND_WEB",
+		3: "CITATION_KIND_PRIMARY_SOURCE",
+	}
+	CitationKind_value = map[string]int32{
+		"CITATION_KIND_UNSPECIFIED":    0,
+		"CITATION_KIND_BOOK":           1,
+		"CITATION_KIND_WEB":            2,
+		"CITATION_KIND_PRIMARY_SOURCE": 3,
+	}
+)
+
+func (x CitationKind) Enum() *CitationKind {
+	p := new(CitationKind)
+	*p = x
+	return p
+}
+
+func (x CitationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CitationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_question_v1_question_service_proto_enumTypes[2].Descriptor()
+}
+
+func (CitationKind) Type() protoreflect.EnumType {
+	return &file_historyquiz_question_v1_question_service_proto_enumTypes[2]
+}
+
+func (x CitationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CitationKind.Descriptor instead.
+func (CitationKind) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{2}
+}
+
+// 自分の問題一覧の並び替えの基準。
+type QuestionSortKey int32
+
+const (
+	QuestionSortKey_QUESTION_SORT_KEY_UNSPECIFIED QuestionSortKey = 0 // 更新日時
+	QuestionSortKey_QUESTION_SORT_KEY_UPDATED_AT  QuestionSortKey = 1
+	QuestionSortKey_QUESTION_SORT_KEY_CREATED_AT  QuestionSortKey = 2
+	// 回答の無い問題は、昇順/降順のどちらでも最後に並ぶ。
+	QuestionSortKey_QUESTION_SORT_KEY_ACCURACY      QuestionSortKey = 3
+	QuestionSortKey_QUESTION_SORT_KEY_ATTEMPT_COUNT QuestionSortKey = 4
+)
+
+// Enum value maps for QuestionSortKey.
+var (
+	QuestionSortKey_name = map[int32]string{
+		0: "QUESTION_SORT_KEY_UNSPECIFIED",
+		1: "QUESTION_SORT_KEY_UPDATED_AT",
+		2: "QUESTION_SORT_KEY_CREATED_AT",
+		3: "QUESTION_SORT_KEY_ACCURACY",
+		4: "QUESTION_SORT_KEY_ATTEMPT_COUNT",
+	}
+	QuestionSortKey_value = map[string]int32{
+		"QUESTION_SORT_KEY_UNSPECIFIED":   0,
+		"QUESTION_SORT_KEY_UPDATED_AT":    1,
+		"QUESTION_SORT_KEY_CREATED_AT":    2,
+		"QUESTION_SORT_KEY_ACCURACY":      3,
+		"QUESTION_SORT_KEY_ATTEMPT_COUNT": 4,
+	}
+)
+
+func (x QuestionSortKey) Enum() *QuestionSortKey {
+	p := new(QuestionSortKey)
+	*p = x
+	return p
+}
+
+func (x QuestionSortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionSortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_question_v1_question_service_proto_enumTypes[3].Descriptor()
+}
+
+func (QuestionSortKey) Type() protoreflect.EnumType {
+	return &file_historyquiz_question_v1_question_service_proto_enumTypes[3]
+}
+
+func (x QuestionSortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionSortKey.Descriptor instead.
+func (QuestionSortKey) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{3}
+}
+
+// 解説の有無での絞り込み。
+type ExplanationFilter int32
+
+const (
+	ExplanationFilter_EXPLANATION_FILTER_UNSPECIFIED         ExplanationFilter = 0 // 絞り込まない
+	ExplanationFilter_EXPLANATION_FILTER_WITH_EXPLANATION    ExplanationFilter = 1
+	ExplanationFilter_EXPLANATION_FILTER_WITHOUT_EXPLANATION ExplanationFilter = 2
+)
+
+// Enum value maps for ExplanationFilter.
+var (
+	ExplanationFilter_name = map[int32]string{
+		0: "EXPLANATION_FILTER_UNSPECIFIED",
+		1: "EXPLANATION_FILTER_WITH_EXPLANATION",
+		2: "EXPLANATION_FILTER_WITHOUT_EXPLANATION",
+	}
+	ExplanationFilter_value = map[string]int32{
+		"EXPLANATION_FILTER_UNSPECIFIED":         0,
+		"EXPLANATION_FILTER_WITH_EXPLANATION":    1,
+		"EXPLANATION_FILTER_WITHOUT_EXPLANATION": 2,
+	}
+)
+
+func (x ExplanationFilter) Enum() *ExplanationFilter {
+	p := new(ExplanationFilter)
+	*p = x
+	return p
+}
+
+func (x ExplanationFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExplanationFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_question_v1_question_service_proto_enumTypes[4].Descriptor()
+}
+
+func (ExplanationFilter) Type() protoreflect.EnumType {
+	return &file_historyquiz_question_v1_question_service_proto_enumTypes[4]
+}
+
+func (x ExplanationFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExplanationFilter.Descriptor instead.
+func (ExplanationFilter) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{4}
+}
+
+// 一括取り込み/書き出しのファイル形式。
+type QuestionFileFormat int32
+
+const (
+	QuestionFileFormat_QUESTION_FILE_FORMAT_UNSPECIFIED QuestionFileFormat = 0
+	// ヘッダ行必須。列: prompt, choice_1..choice_4, correct_ordinal, explanation, accepted_answers/tags（"|" 区切り）
+	QuestionFileFormat_QUESTION_FILE_FORMAT_CSV QuestionFileFormat = 1
+	// QuestionDraft と同じキー（snake_case）を持つオブジェクトの配列。
+	QuestionFileFormat_QUESTION_FILE_FORMAT_JSON QuestionFileFormat = 2
+	// Anki に取り込めるタブ区切り（表面/裏面/タグ）。書き出し専用。
+	QuestionFileFormat_QUESTION_FILE_FORMAT_ANKI_TSV QuestionFileFormat = 3
+)
+
+// Enum value maps for QuestionFileFormat.
+var (
+	QuestionFileFormat_name = map[int32]string{
+		0: "QUESTION_FILE_FORMAT_UNSPECIFIED",
+		1: "QUESTION_FILE_FORMAT_CSV",
+		2: "QUESTION_FILE_FORMAT_JSON",
+		3: "QUESTION_FILE_FORMAT_ANKI_TSV",
+	}
+	QuestionFileFormat_value = map[string]int32{
+		"QUESTION_FILE_FORMAT_UNSPECIFIED": 0,
+		"QUESTION_FILE_FORMAT_CSV":         1,
+		"QUESTION_FILE_FORMAT_JSON":        2,
+		"QUESTION_FILE_FORMAT_ANKI_TSV":    3,
+	}
+)
+
+func (x QuestionFileFormat) Enum() *QuestionFileFormat {
+	p := new(QuestionFileFormat)
+	*p = x
+	return p
+}
+
+func (x QuestionFileFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionFileFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_question_v1_question_service_proto_enumTypes[5].Descriptor()
+}
+
+func (QuestionFileFormat) Type() protoreflect.EnumType {
+	return &file_historyquiz_question_v1_question_service_proto_enumTypes[5]
+}
+
+func (x QuestionFileFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionFileFormat.Descriptor instead.
+func (QuestionFileFormat) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{5}
+}
+
+// スニペットを作ったフィールド。
+type SearchField int32
+
+const (
+	SearchField_SEARCH_FIELD_UNSPECIFIED SearchField = 0
+	SearchField_SEARCH_FIELD_PROMPT      SearchField = 1
+	SearchField_SEARCH_FIELD_CHOICE      SearchField = 2
+	SearchField_SEARCH_FIELD_EXPLANATION SearchField = 3
+)
+
+// Enum value maps for SearchField.
+var (
+	SearchField_name = map[int32]string{
+		0: "SEARCH_FIELD_UNSPECIFIED",
+		1: "SEARCH_FIELD_PROMPT",
+		2: "SEARCH_FIELD_CHOICE",
+		3: "SEARCH_FIELD_EXPLANATION",
+	}
+	SearchField_value = map[string]int32{
+		"SEARCH_FIELD_UNSPECIFIED": 0,
+		"SEARCH_FIELD_PROMPT":      1,
+		"SEARCH_FIELD_CHOICE":      2,
+		"SEARCH_FIELD_EXPLANATION": 3,
+	}
+)
+
+func (x SearchField) Enum() *SearchField {
+	p := new(SearchField)
+	*p = x
+	return p
+}
+
+func (x SearchField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchField) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_question_v1_question_service_proto_enumTypes[6].Descriptor()
+}
+
+func (SearchField) Type() protoreflect.EnumType {
+	return &file_historyquiz_question_v1_question_service_proto_enumTypes[6]
+}
+
+func (x SearchField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchField.Descriptor instead.
+func (SearchField) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{6}
+}
+
+// 問題の質について自動で検出した注意点の種類。
+type QualityFlagKind int32
+
+const (
+	QualityFlagKind_QUALITY_FLAG_KIND_UNSPECIFIED QualityFlagKind = 0
+	// ほとんど選ばれない誤答の選択肢（選択肢として機能していない）。
+	QualityFlagKind_QUALITY_FLAG_KIND_UNPICKED_DISTRACTOR QualityFlagKind = 1
+	// よくできるプレイヤーの多くが間違える（正解の設定が誤っている可能性がある）。
+	QualityFlagKind_QUALITY_FLAG_KIND_STRONG_PLAYERS_MISS QualityFlagKind = 2
+)
+
+// Enum value maps for QualityFlagKind.
+var (
+	QualityFlagKind_name = map[int32]string{
+		0: "QUALITY_FLAG_KIND_UNSPECIFIED",
+		1: "QUALITY_FLAG_KIND_UNPICKED_DISTRACTOR",
+		2: "QUALITY_FLAG_KIND_STRONG_PLAYERS_MISS",
+	}
+	QualityFlagKind_value = map[string]int32{
+		"QUALITY_FLAG_KIND_UNSPECIFIED":         0,
+		"QUALITY_FLAG_KIND_UNPICKED_DISTRACTOR": 1,
+		"QUALITY_FLAG_KIND_STRONG_PLAYERS_MISS": 2,
+	}
+)
+
+func (x QualityFlagKind) Enum() *QualityFlagKind {
+	p := new(QualityFlagKind)
+	*p = x
+	return p
+}
+
+func (x QualityFlagKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QualityFlagKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_question_v1_question_service_proto_enumTypes[7].Descriptor()
+}
+
+func (QualityFlagKind) Type() protoreflect.EnumType {
+	return &file_historyquiz_question_v1_question_service_proto_enumTypes[7]
+}
+
+func (x QualityFlagKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QualityFlagKind.Descriptor instead.
+func (QualityFlagKind) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{7}
+}
+
 type QuestionSummary struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 文字列（言語間互換を優先）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prompt    string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	UpdatedAt string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 文字列（言語間互換を優先）
+	Status    QuestionStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=historyquiz.question.v1.QuestionStatus" json:"status,omitempty"`
+	// 以下は ListMyQuestions でのみ設定する。
+	CreatedAt       string  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	AttemptCount    int64   `protobuf:"varint,6,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	CorrectAttempts int64   `protobuf:"varint,7,opt,name=correct_attempts,json=correctAttempts,proto3" json:"correct_attempts,omitempty"`
+	Accuracy        float64 `protobuf:"fixed64,8,opt,name=accuracy,proto3" json:"accuracy,omitempty"` // 0.0..1.0（回答が無い場合は 0）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *QuestionSummary) Reset() {
@@ -82,6 +516,41 @@ func (x *QuestionSummary) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *QuestionSummary) GetStatus() QuestionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuestionStatus_QUESTION_STATUS_UNSPECIFIED
+}
+
+func (x *QuestionSummary) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *QuestionSummary) GetAttemptCount() int64 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *QuestionSummary) GetCorrectAttempts() int64 {
+	if x != nil {
+		return x.CorrectAttempts
+	}
+	return 0
+}
+
+func (x *QuestionSummary) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
 type QuestionDetail struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -89,7 +558,23 @@ type QuestionDetail struct {
 	Choices         []*Choice              `protobuf:"bytes,3,rep,name=choices,proto3" json:"choices,omitempty"`
 	CorrectChoiceId string                 `protobuf:"bytes,4,opt,name=correct_choice_id,json=correctChoiceId,proto3" json:"correct_choice_id,omitempty"`
 	Explanation     string                 `protobuf:"bytes,5,opt,name=explanation,proto3" json:"explanation,omitempty"`
-	UpdatedAt       string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	UpdatedAt       string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                   // RFC3339
+	AcceptedAnswers []string               `protobuf:"bytes,7,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"` // 記述式で正解として扱う別表記
+	Status          QuestionStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=historyquiz.question.v1.QuestionStatus" json:"status,omitempty"`
+	Hidden          bool                   `protobuf:"varint,9,opt,name=hidden,proto3" json:"hidden,omitempty"` // 報告によりモデレーションで非表示になっている
+	Tags            []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 楽観ロック用の版番号。更新のたびに 1 増える（UpdateQuestionRequest.expected_version に渡す）。
+	Version          int64                    `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	Attachments      []*v1.QuestionAttachment `protobuf:"bytes,12,rep,name=attachments,proto3" json:"attachments,omitempty"`                                     // 表示順
+	Citations        []*Citation              `protobuf:"bytes,13,rep,name=citations,proto3" json:"citations,omitempty"`                                         // 表示順
+	OriginQuestionId string                   `protobuf:"bytes,14,opt,name=origin_question_id,json=originQuestionId,proto3" json:"origin_question_id,omitempty"` // 複製（ForkQuestion）で作った場合の元の問題。それ以外は空
+	// prompt/explanation（書式付きの入力そのもの）の構文木。
+	PromptRich      *v11.RichText     `protobuf:"bytes,15,opt,name=prompt_rich,json=promptRich,proto3" json:"prompt_rich,omitempty"`
+	ExplanationRich *v11.RichText     `protobuf:"bytes,16,opt,name=explanation_rich,json=explanationRich,proto3" json:"explanation_rich,omitempty"`
+	Schedule        *QuestionSchedule `protobuf:"bytes,17,opt,name=schedule,proto3" json:"schedule,omitempty"`                                    // 公開/公開終了の予約（予約が無い場合は空文字）
+	Location        *QuestionLocation `protobuf:"bytes,18,opt,name=location,proto3" json:"location,omitempty"`                                    // 地図で答える場合の正解の地点（無い場合は未設定）
+	PassageId       string            `protobuf:"bytes,19,opt,name=passage_id,json=passageId,proto3" json:"passage_id,omitempty"`                 // 史料を共有する問題の場合の史料（それ以外は空）。本文の編集は UpdatePassage で行う
+	Kind            QuestionKind      `protobuf:"varint,20,opt,name=kind,proto3,enum=historyquiz.question.v1.QuestionKind" json:"kind,omitempty"` // LOCATION の場合は choices/correct_choice_id/accepted_answers が空
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -138,58 +623,3787 @@ func (x *QuestionDetail) GetPrompt() string {
 	return ""
 }
 
-func (x *QuestionDetail) GetChoices() []*Choice {
+func (x *QuestionDetail) GetChoices() []*Choice {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *QuestionDetail) GetCorrectChoiceId() string {
+	if x != nil {
+		return x.CorrectChoiceId
+	}
+	return ""
+}
+
+func (x *QuestionDetail) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *QuestionDetail) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *QuestionDetail) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+func (x *QuestionDetail) GetStatus() QuestionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuestionStatus_QUESTION_STATUS_UNSPECIFIED
+}
+
+func (x *QuestionDetail) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *QuestionDetail) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QuestionDetail) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *QuestionDetail) GetAttachments() []*v1.QuestionAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *QuestionDetail) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+func (x *QuestionDetail) GetOriginQuestionId() string {
+	if x != nil {
+		return x.OriginQuestionId
+	}
+	return ""
+}
+
+func (x *QuestionDetail) GetPromptRich() *v11.RichText {
+	if x != nil {
+		return x.PromptRich
+	}
+	return nil
+}
+
+func (x *QuestionDetail) GetExplanationRich() *v11.RichText {
+	if x != nil {
+		return x.ExplanationRich
+	}
+	return nil
+}
+
+func (x *QuestionDetail) GetSchedule() *QuestionSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *QuestionDetail) GetLocation() *QuestionLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *QuestionDetail) GetPassageId() string {
+	if x != nil {
+		return x.PassageId
+	}
+	return ""
+}
+
+func (x *QuestionDetail) GetKind() QuestionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return QuestionKind_QUESTION_KIND_UNSPECIFIED
+}
+
+// 公開/公開終了の予約（RFC3339。空文字は予約なし）。
+// サーバーの定期処理が、publish_at になったら下書き/限定公開の問題を公開し、unpublish_at になったら公開中の問題を限定公開に戻す。
+// NOTE: unpublish_at を過ぎた問題は、状態が変わる前でも出題候補から外れる。使った予約は空に戻る。
+type QuestionSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublishAt     string                 `protobuf:"bytes,1,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt   string                 `protobuf:"bytes,2,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionSchedule) Reset() {
+	*x = QuestionSchedule{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionSchedule) ProtoMessage() {}
+
+func (x *QuestionSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionSchedule.ProtoReflect.Descriptor instead.
+func (*QuestionSchedule) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QuestionSchedule) GetPublishAt() string {
+	if x != nil {
+		return x.PublishAt
+	}
+	return ""
+}
+
+func (x *QuestionSchedule) GetUnpublishAt() string {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return ""
+}
+
+type Choice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Ordinal       int32                  `protobuf:"varint,3,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Choice) Reset() {
+	*x = Choice{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Choice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Choice) ProtoMessage() {}
+
+func (x *Choice) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Choice.ProtoReflect.Descriptor instead.
+func (*Choice) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Choice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Choice) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Choice) GetOrdinal() int32 {
+	if x != nil {
+		return x.Ordinal
+	}
+	return 0
+}
+
+// 作問入力（作成/更新で共通）。
+// NOTE: choices は4件であることをバックエンドで検証する（kind が LOCATION の場合は0件で、location が必須）。
+// NOTE: prompt/explanation には書式（ルビ《》、**強調**、改行、[リンク](https://...)）を使える。書式の誤りは INVALID_ARGUMENT。
+type QuestionDraft struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Prompt         string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Choices        []string               `protobuf:"bytes,2,rep,name=choices,proto3" json:"choices,omitempty"`                                      // 期待: 4件
+	CorrectOrdinal int32                  `protobuf:"varint,3,opt,name=correct_ordinal,json=correctOrdinal,proto3" json:"correct_ordinal,omitempty"` // 期待: 0..3
+	Explanation    string                 `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// 記述式回答で正解として扱う別表記（例: "ヴァスコダガマ"）。
+	// NOTE: 正解の選択肢ラベルは自動で正解扱いになるため、ここには含めなくてよい。
+	AcceptedAnswers []string `protobuf:"bytes,5,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`
+	// 作者が付ける分類（最大10件、各30文字以内、"|" は使えない）。
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 問題に付ける画像/地図（最大4件、表示順）。AttachmentService でアップロードした自分の添付のみ指定できる。
+	Attachments []*AttachmentRef `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// 出典（最大10件、表示順）。回答後に解説と合わせて表示する。
+	Citations []*Citation `protobuf:"bytes,8,rep,name=citations,proto3" json:"citations,omitempty"`
+	// 地図で答える場合の正解の地点（任意）。指定すると、選択肢に加えて地図でも答えられる。
+	Location *QuestionLocation `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	// 問題の種類（未指定は CHOICE）。LOCATION の場合 correct_ordinal は使わない。
+	Kind          QuestionKind `protobuf:"varint,10,opt,name=kind,proto3,enum=historyquiz.question.v1.QuestionKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionDraft) Reset() {
+	*x = QuestionDraft{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionDraft) ProtoMessage() {}
+
+func (x *QuestionDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionDraft.ProtoReflect.Descriptor instead.
+func (*QuestionDraft) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QuestionDraft) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *QuestionDraft) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *QuestionDraft) GetCorrectOrdinal() int32 {
+	if x != nil {
+		return x.CorrectOrdinal
+	}
+	return 0
+}
+
+func (x *QuestionDraft) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *QuestionDraft) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+func (x *QuestionDraft) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QuestionDraft) GetAttachments() []*AttachmentRef {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *QuestionDraft) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+func (x *QuestionDraft) GetLocation() *QuestionLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *QuestionDraft) GetKind() QuestionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return QuestionKind_QUESTION_KIND_UNSPECIFIED
+}
+
+// 地図で答える問題の正解の地点。point から radius_km 以内を正解とし、それより遠い回答は距離に応じて部分点にする。
+type QuestionLocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Point         *v11.LatLng            `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,2,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"` // 許容半径（1..2000 km）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionLocation) Reset() {
+	*x = QuestionLocation{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionLocation) ProtoMessage() {}
+
+func (x *QuestionLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionLocation.ProtoReflect.Descriptor instead.
+func (*QuestionLocation) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuestionLocation) GetPoint() *v11.LatLng {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *QuestionLocation) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+type AttachmentRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	AltText       string                 `protobuf:"bytes,2,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"` // 任意、200文字以内
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentRef) Reset() {
+	*x = AttachmentRef{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentRef) ProtoMessage() {}
+
+func (x *AttachmentRef) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentRef.ProtoReflect.Descriptor instead.
+func (*AttachmentRef) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AttachmentRef) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *AttachmentRef) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+// 問題の出典。
+type Citation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          CitationKind           `protobuf:"varint,1,opt,name=kind,proto3,enum=historyquiz.question.v1.CitationKind" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`     // 必須、200文字以内
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`   // 任意、著者/編者/発行元（100文字以内）
+	Locator       string                 `protobuf:"bytes,4,opt,name=locator,proto3" json:"locator,omitempty"` // 任意、ページや巻・章（例: "p.123"、100文字以内）
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`         // http/https の絶対 URL（WEB では必須）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Citation) Reset() {
+	*x = Citation{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Citation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Citation) ProtoMessage() {}
+
+func (x *Citation) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Citation.ProtoReflect.Descriptor instead.
+func (*Citation) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Citation) GetKind() CitationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CitationKind_CITATION_KIND_UNSPECIFIED
+}
+
+func (x *Citation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Citation) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Citation) GetLocator() string {
+	if x != nil {
+		return x.Locator
+	}
+	return ""
+}
+
+func (x *Citation) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type CreateQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Draft         *QuestionDraft         `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuestionRequest) Reset() {
+	*x = CreateQuestionRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionRequest) ProtoMessage() {}
+
+func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateQuestionRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetDraft() *QuestionDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+// 作問時に見つかった、問題文がよく似た既存の問題（警告として返す）。
+// NOTE: 他人の問題は公開中のものだけを返す。
+type SimilarQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Status        QuestionStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=historyquiz.question.v1.QuestionStatus" json:"status,omitempty"`
+	Mine          bool                   `protobuf:"varint,4,opt,name=mine,proto3" json:"mine,omitempty"`              // 自分の問題かどうか
+	Similarity    float64                `protobuf:"fixed64,5,opt,name=similarity,proto3" json:"similarity,omitempty"` // 0..1（問題文の文字 bigram の Jaccard 係数）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarQuestion) Reset() {
+	*x = SimilarQuestion{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarQuestion) ProtoMessage() {}
+
+func (x *SimilarQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarQuestion.ProtoReflect.Descriptor instead.
+func (*SimilarQuestion) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SimilarQuestion) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *SimilarQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *SimilarQuestion) GetStatus() QuestionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuestionStatus_QUESTION_STATUS_UNSPECIFIED
+}
+
+func (x *SimilarQuestion) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+func (x *SimilarQuestion) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+// NOTE: ほぼ同じ問題（similarity >= 0.9）が既にある場合は ALREADY_EXISTS で作成しない。
+type CreateQuestionResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Context  *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Question *QuestionDetail        `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	// 問題文がよく似た既存の問題（類似度の高い順、最大5件）。作成自体は成功している。
+	SimilarQuestions []*SimilarQuestion `protobuf:"bytes,3,rep,name=similar_questions,json=similarQuestions,proto3" json:"similar_questions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateQuestionResponse) Reset() {
+	*x = CreateQuestionResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionResponse) ProtoMessage() {}
+
+func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateQuestionResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CreateQuestionResponse) GetQuestion() *QuestionDetail {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *CreateQuestionResponse) GetSimilarQuestions() []*SimilarQuestion {
+	if x != nil {
+		return x.SimilarQuestions
+	}
+	return nil
+}
+
+type UpdateQuestionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Context    *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Draft      *QuestionDraft         `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft,omitempty"`
+	// 編集を始めた時点の QuestionDetail.version（必須）。
+	// サーバ側の版と一致しない場合は ABORTED を返し、status の details に最新の QuestionDetail を載せる。
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// 未指定の場合は予約を変えない。指定した場合は置き換える（過去の日時は INVALID_ARGUMENT）。
+	// 公開中の問題への publish_at、アーカイブ済みの問題への予約は FAILED_PRECONDITION。
+	Schedule      *QuestionSchedule `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQuestionRequest) Reset() {
+	*x = UpdateQuestionRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuestionRequest) ProtoMessage() {}
+
+func (x *UpdateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateQuestionRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetDraft() *QuestionDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateQuestionRequest) GetSchedule() *QuestionSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UpdateQuestionResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Context  *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Question *QuestionDetail        `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	// 問題文がよく似た他の問題（類似度の高い順、最大5件）。更新では警告のみで拒否しない。
+	SimilarQuestions []*SimilarQuestion `protobuf:"bytes,3,rep,name=similar_questions,json=similarQuestions,proto3" json:"similar_questions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateQuestionResponse) Reset() {
+	*x = UpdateQuestionResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuestionResponse) ProtoMessage() {}
+
+func (x *UpdateQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuestionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateQuestionResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateQuestionResponse) GetQuestion() *QuestionDetail {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *UpdateQuestionResponse) GetSimilarQuestions() []*SimilarQuestion {
+	if x != nil {
+		return x.SimilarQuestions
+	}
+	return nil
+}
+
+type GetMyQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyQuestionRequest) Reset() {
+	*x = GetMyQuestionRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyQuestionRequest) ProtoMessage() {}
+
+func (x *GetMyQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyQuestionRequest.ProtoReflect.Descriptor instead.
+func (*GetMyQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMyQuestionRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetMyQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+type GetMyQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Question      *QuestionDetail        `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyQuestionResponse) Reset() {
+	*x = GetMyQuestionResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyQuestionResponse) ProtoMessage() {}
+
+func (x *GetMyQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyQuestionResponse.ProtoReflect.Descriptor instead.
+func (*GetMyQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMyQuestionResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetMyQuestionResponse) GetQuestion() *QuestionDetail {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type ListMyQuestionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// page_token には前のページの next_page_token を渡す。
+	// 並び替えの条件（sort/ascending）を変えた場合は先頭から読み直す（違う条件のトークンは INVALID_ARGUMENT）。
+	Pagination *v11.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// 以下の絞り込みは、指定したものすべてに一致する問題を返す。
+	Statuses    []QuestionStatus  `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=historyquiz.question.v1.QuestionStatus" json:"statuses,omitempty"` // 空の場合はすべての公開状態
+	Tag         string            `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	CreatedFrom string            `protobuf:"bytes,5,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` // RFC3339（この日時以降に作成した問題）
+	CreatedTo   string            `protobuf:"bytes,6,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       // RFC3339（この日時より前に作成した問題）
+	Explanation ExplanationFilter `protobuf:"varint,7,opt,name=explanation,proto3,enum=historyquiz.question.v1.ExplanationFilter" json:"explanation,omitempty"`
+	// 空白区切りの検索語。すべてを問題文/選択肢/解説のいずれかに含む問題に限る（SearchQuestions と同じ一致方法）。
+	Keyword       string          `protobuf:"bytes,8,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Sort          QuestionSortKey `protobuf:"varint,9,opt,name=sort,proto3,enum=historyquiz.question.v1.QuestionSortKey" json:"sort,omitempty"`
+	Ascending     bool            `protobuf:"varint,10,opt,name=ascending,proto3" json:"ascending,omitempty"` // 既定は降順（新しい順/高い順/多い順）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyQuestionsRequest) Reset() {
+	*x = ListMyQuestionsRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyQuestionsRequest) ProtoMessage() {}
+
+func (x *ListMyQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMyQuestionsRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListMyQuestionsRequest) GetPagination() *v11.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListMyQuestionsRequest) GetStatuses() []QuestionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListMyQuestionsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListMyQuestionsRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListMyQuestionsRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListMyQuestionsRequest) GetExplanation() ExplanationFilter {
+	if x != nil {
+		return x.Explanation
+	}
+	return ExplanationFilter_EXPLANATION_FILTER_UNSPECIFIED
+}
+
+func (x *ListMyQuestionsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListMyQuestionsRequest) GetSort() QuestionSortKey {
+	if x != nil {
+		return x.Sort
+	}
+	return QuestionSortKey_QUESTION_SORT_KEY_UNSPECIFIED
+}
+
+func (x *ListMyQuestionsRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+type ListMyQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Questions     []*QuestionSummary     `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+	PageInfo      *v11.PageInfo          `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyQuestionsResponse) Reset() {
+	*x = ListMyQuestionsResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyQuestionsResponse) ProtoMessage() {}
+
+func (x *ListMyQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMyQuestionsResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListMyQuestionsResponse) GetQuestions() []*QuestionSummary {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ListMyQuestionsResponse) GetPageInfo() *v11.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type DeleteQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionRequest) Reset() {
+	*x = DeleteQuestionRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteQuestionRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *DeleteQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+type DeleteQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionResponse) Reset() {
+	*x = DeleteQuestionResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionResponse) ProtoMessage() {}
+
+func (x *DeleteQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteQuestionResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type PublishQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishQuestionRequest) Reset() {
+	*x = PublishQuestionRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishQuestionRequest) ProtoMessage() {}
+
+func (x *PublishQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishQuestionRequest.ProtoReflect.Descriptor instead.
+func (*PublishQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PublishQuestionRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *PublishQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+type PublishQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Question      *QuestionDetail        `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishQuestionResponse) Reset() {
+	*x = PublishQuestionResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishQuestionResponse) ProtoMessage() {}
+
+func (x *PublishQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishQuestionResponse.ProtoReflect.Descriptor instead.
+func (*PublishQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PublishQuestionResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *PublishQuestionResponse) GetQuestion() *QuestionDetail {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type UnpublishQuestionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Context    *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// 遷移先（UNLISTED または ARCHIVED）。未指定の場合は UNLISTED として扱う。
+	TargetStatus  QuestionStatus `protobuf:"varint,3,opt,name=target_status,json=targetStatus,proto3,enum=historyquiz.question.v1.QuestionStatus" json:"target_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishQuestionRequest) Reset() {
+	*x = UnpublishQuestionRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishQuestionRequest) ProtoMessage() {}
+
+func (x *UnpublishQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishQuestionRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnpublishQuestionRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UnpublishQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *UnpublishQuestionRequest) GetTargetStatus() QuestionStatus {
+	if x != nil {
+		return x.TargetStatus
+	}
+	return QuestionStatus_QUESTION_STATUS_UNSPECIFIED
+}
+
+type UnpublishQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Question      *QuestionDetail        `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishQuestionResponse) Reset() {
+	*x = UnpublishQuestionResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishQuestionResponse) ProtoMessage() {}
+
+func (x *UnpublishQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishQuestionResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnpublishQuestionResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UnpublishQuestionResponse) GetQuestion() *QuestionDetail {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+// NOTE: 全行を1トランザクションで作成するため、1リクエストでファイル全体を送る（上限 1MiB / 500行）。
+type ImportQuestionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Format  QuestionFileFormat     `protobuf:"varint,2,opt,name=format,proto3,enum=historyquiz.question.v1.QuestionFileFormat" json:"format,omitempty"`
+	Content []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// true の場合は検証のみ行い、作成しない。
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportQuestionsRequest) Reset() {
+	*x = ImportQuestionsRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportQuestionsRequest) ProtoMessage() {}
+
+func (x *ImportQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ImportQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportQuestionsRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ImportQuestionsRequest) GetFormat() QuestionFileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return QuestionFileFormat_QUESTION_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportQuestionsRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportQuestionsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// 取り込みで不正だった行。
+type ImportRowError struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Row             int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // CSV は行番号（ヘッダ=1）、JSON は配列の 1 始まりの位置
+	FieldViolations []*v11.FieldViolation  `protobuf:"bytes,2,rep,name=field_violations,json=fieldViolations,proto3" json:"field_violations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetFieldViolations() []*v11.FieldViolation {
+	if x != nil {
+		return x.FieldViolations
+	}
+	return nil
+}
+
+type ImportQuestionsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Context   *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	TotalRows int32                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	// row_errors が空でない場合は何も作成していない。
+	RowErrors []*ImportRowError `protobuf:"bytes,3,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
+	// 作成した問題（dry_run または row_errors がある場合は空）。
+	Questions     []*QuestionSummary `protobuf:"bytes,4,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportQuestionsResponse) Reset() {
+	*x = ImportQuestionsResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportQuestionsResponse) ProtoMessage() {}
+
+func (x *ImportQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ImportQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportQuestionsResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ImportQuestionsResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportQuestionsResponse) GetRowErrors() []*ImportRowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+func (x *ImportQuestionsResponse) GetQuestions() []*QuestionSummary {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type ExportMyQuestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Format        QuestionFileFormat     `protobuf:"varint,2,opt,name=format,proto3,enum=historyquiz.question.v1.QuestionFileFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyQuestionsRequest) Reset() {
+	*x = ExportMyQuestionsRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyQuestionsRequest) ProtoMessage() {}
+
+func (x *ExportMyQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportMyQuestionsRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ExportMyQuestionsRequest) GetFormat() QuestionFileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return QuestionFileFormat_QUESTION_FILE_FORMAT_UNSPECIFIED
+}
+
+type ExportMyQuestionsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Chunk   []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// 最後のメッセージにだけ設定する（書き出した問題数）。
+	QuestionCount int32 `protobuf:"varint,3,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyQuestionsResponse) Reset() {
+	*x = ExportMyQuestionsResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyQuestionsResponse) ProtoMessage() {}
+
+func (x *ExportMyQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportMyQuestionsResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ExportMyQuestionsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ExportMyQuestionsResponse) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+// NOTE: 検索語は空白区切りで、すべてを含む問題に一致する（大文字小文字/全角半角/カタカナひらがなは区別しない）。
+type SearchQuestionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Query   string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// page_token には前回の next_page_token をそのまま渡す。
+	Pagination *v11.Pagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// 作者の絞り込み。管理者以外は省略（= 自分）か自分の userId のみ指定できる。
+	AuthorUserId string `protobuf:"bytes,4,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
+	Tag          string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	// 空の場合はすべての公開状態を対象にする。
+	Statuses      []QuestionStatus `protobuf:"varint,6,rep,packed,name=statuses,proto3,enum=historyquiz.question.v1.QuestionStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchQuestionsRequest) Reset() {
+	*x = SearchQuestionsRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQuestionsRequest) ProtoMessage() {}
+
+func (x *SearchQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchQuestionsRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SearchQuestionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchQuestionsRequest) GetPagination() *v11.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchQuestionsRequest) GetAuthorUserId() string {
+	if x != nil {
+		return x.AuthorUserId
+	}
+	return ""
+}
+
+func (x *SearchQuestionsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SearchQuestionsRequest) GetStatuses() []QuestionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// スニペットの一部分。highlighted は検索語に一致した部分（HTML ではなく区間で返す）。
+type SearchSnippetSegment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Highlighted   bool                   `protobuf:"varint,2,opt,name=highlighted,proto3" json:"highlighted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSnippetSegment) Reset() {
+	*x = SearchSnippetSegment{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSnippetSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSnippetSegment) ProtoMessage() {}
+
+func (x *SearchSnippetSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSnippetSegment.ProtoReflect.Descriptor instead.
+func (*SearchSnippetSegment) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SearchSnippetSegment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchSnippetSegment) GetHighlighted() bool {
+	if x != nil {
+		return x.Highlighted
+	}
+	return false
+}
+
+type SearchSnippet struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Field         SearchField             `protobuf:"varint,1,opt,name=field,proto3,enum=historyquiz.question.v1.SearchField" json:"field,omitempty"`
+	ChoiceOrdinal int32                   `protobuf:"varint,2,opt,name=choice_ordinal,json=choiceOrdinal,proto3" json:"choice_ordinal,omitempty"` // field = CHOICE の場合の選択肢の位置（0..3）
+	Segments      []*SearchSnippetSegment `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSnippet) Reset() {
+	*x = SearchSnippet{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSnippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSnippet) ProtoMessage() {}
+
+func (x *SearchSnippet) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSnippet.ProtoReflect.Descriptor instead.
+func (*SearchSnippet) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SearchSnippet) GetField() SearchField {
+	if x != nil {
+		return x.Field
+	}
+	return SearchField_SEARCH_FIELD_UNSPECIFIED
+}
+
+func (x *SearchSnippet) GetChoiceOrdinal() int32 {
+	if x != nil {
+		return x.ChoiceOrdinal
+	}
+	return 0
+}
+
+func (x *SearchSnippet) GetSegments() []*SearchSnippetSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type QuestionSearchHit struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Question     *QuestionSummary       `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	AuthorUserId string                 `protobuf:"bytes,2,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
+	Tags         []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 一致したフィールドの抜粋（問題文 → 選択肢 → 解説の順）。
+	Snippets []*SearchSnippet `protobuf:"bytes,4,rep,name=snippets,proto3" json:"snippets,omitempty"`
+	// 関連度（問題文 3 / 選択肢 2 / 解説 1 を検索語ごとに加算）。
+	Score         int32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionSearchHit) Reset() {
+	*x = QuestionSearchHit{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionSearchHit) ProtoMessage() {}
+
+func (x *QuestionSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionSearchHit.ProtoReflect.Descriptor instead.
+func (*QuestionSearchHit) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QuestionSearchHit) GetQuestion() *QuestionSummary {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *QuestionSearchHit) GetAuthorUserId() string {
+	if x != nil {
+		return x.AuthorUserId
+	}
+	return ""
+}
+
+func (x *QuestionSearchHit) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QuestionSearchHit) GetSnippets() []*SearchSnippet {
+	if x != nil {
+		return x.Snippets
+	}
+	return nil
+}
+
+func (x *QuestionSearchHit) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Hits          []*QuestionSearchHit   `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits,omitempty"` // 関連度の高い順
+	PageInfo      *v11.PageInfo          `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchQuestionsResponse) Reset() {
+	*x = SearchQuestionsResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQuestionsResponse) ProtoMessage() {}
+
+func (x *SearchQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchQuestionsResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SearchQuestionsResponse) GetHits() []*QuestionSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchQuestionsResponse) GetPageInfo() *v11.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+// 問題の翻訳。choices は原文の選択肢と同じ並び（ordinal 0..3）で指定する。
+type QuestionTranslation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Locale      string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // BCP 47（例: "en", "zh-Hant"）
+	Prompt      string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Choices     []string               `protobuf:"bytes,3,rep,name=choices,proto3" json:"choices,omitempty"`
+	Explanation string                 `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// 翻訳した時点の原文の版（QuestionDetail.version）。
+	SourceVersion int64 `protobuf:"varint,5,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"` // 出力専用
+	// 原文がこの翻訳より新しい場合 true。stale な翻訳は出題に使わない。
+	Stale         bool   `protobuf:"varint,6,opt,name=stale,proto3" json:"stale,omitempty"`                         // 出力専用
+	UpdatedAt     string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339（出力専用）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionTranslation) Reset() {
+	*x = QuestionTranslation{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionTranslation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionTranslation) ProtoMessage() {}
+
+func (x *QuestionTranslation) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionTranslation.ProtoReflect.Descriptor instead.
+func (*QuestionTranslation) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QuestionTranslation) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *QuestionTranslation) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *QuestionTranslation) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *QuestionTranslation) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *QuestionTranslation) GetSourceVersion() int64 {
+	if x != nil {
+		return x.SourceVersion
+	}
+	return 0
+}
+
+func (x *QuestionTranslation) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *QuestionTranslation) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type UpsertQuestionTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Translation   *QuestionTranslation   `protobuf:"bytes,3,opt,name=translation,proto3" json:"translation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertQuestionTranslationRequest) Reset() {
+	*x = UpsertQuestionTranslationRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertQuestionTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertQuestionTranslationRequest) ProtoMessage() {}
+
+func (x *UpsertQuestionTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertQuestionTranslationRequest.ProtoReflect.Descriptor instead.
+func (*UpsertQuestionTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpsertQuestionTranslationRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpsertQuestionTranslationRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *UpsertQuestionTranslationRequest) GetTranslation() *QuestionTranslation {
+	if x != nil {
+		return x.Translation
+	}
+	return nil
+}
+
+type UpsertQuestionTranslationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Translation   *QuestionTranslation   `protobuf:"bytes,2,opt,name=translation,proto3" json:"translation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertQuestionTranslationResponse) Reset() {
+	*x = UpsertQuestionTranslationResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertQuestionTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertQuestionTranslationResponse) ProtoMessage() {}
+
+func (x *UpsertQuestionTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertQuestionTranslationResponse.ProtoReflect.Descriptor instead.
+func (*UpsertQuestionTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpsertQuestionTranslationResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpsertQuestionTranslationResponse) GetTranslation() *QuestionTranslation {
+	if x != nil {
+		return x.Translation
+	}
+	return nil
+}
+
+type DeleteQuestionTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionTranslationRequest) Reset() {
+	*x = DeleteQuestionTranslationRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteQuestionTranslationRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *DeleteQuestionTranslationRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *DeleteQuestionTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type DeleteQuestionTranslationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionTranslationResponse) Reset() {
+	*x = DeleteQuestionTranslationResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionTranslationResponse) ProtoMessage() {}
+
+func (x *DeleteQuestionTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionTranslationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteQuestionTranslationResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ListQuestionTranslationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionTranslationsRequest) Reset() {
+	*x = ListQuestionTranslationsRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionTranslationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionTranslationsRequest) ProtoMessage() {}
+
+func (x *ListQuestionTranslationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuestionTranslationsRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListQuestionTranslationsRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListQuestionTranslationsRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+type ListQuestionTranslationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Translations  []*QuestionTranslation `protobuf:"bytes,2,rep,name=translations,proto3" json:"translations,omitempty"` // locale の昇順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionTranslationsResponse) Reset() {
+	*x = ListQuestionTranslationsResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionTranslationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionTranslationsResponse) ProtoMessage() {}
+
+func (x *ListQuestionTranslationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionTranslationsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuestionTranslationsResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListQuestionTranslationsResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListQuestionTranslationsResponse) GetTranslations() []*QuestionTranslation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+type GetQuestionStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuestionStatsRequest) Reset() {
+	*x = GetQuestionStatsRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionStatsRequest) ProtoMessage() {}
+
+func (x *GetQuestionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetQuestionStatsRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetQuestionStatsRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+type ChoiceStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChoiceId      string                 `protobuf:"bytes,1,opt,name=choice_id,json=choiceId,proto3" json:"choice_id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Ordinal       int32                  `protobuf:"varint,3,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	IsCorrect     bool                   `protobuf:"varint,4,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	Picks         int64                  `protobuf:"varint,5,opt,name=picks,proto3" json:"picks,omitempty"`
+	PickRate      float64                `protobuf:"fixed64,6,opt,name=pick_rate,json=pickRate,proto3" json:"pick_rate,omitempty"` // 選択式の回答に占める割合（0..1）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChoiceStats) Reset() {
+	*x = ChoiceStats{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoiceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoiceStats) ProtoMessage() {}
+
+func (x *ChoiceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoiceStats.ProtoReflect.Descriptor instead.
+func (*ChoiceStats) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ChoiceStats) GetChoiceId() string {
+	if x != nil {
+		return x.ChoiceId
+	}
+	return ""
+}
+
+func (x *ChoiceStats) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ChoiceStats) GetOrdinal() int32 {
+	if x != nil {
+		return x.Ordinal
+	}
+	return 0
+}
+
+func (x *ChoiceStats) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *ChoiceStats) GetPicks() int64 {
+	if x != nil {
+		return x.Picks
+	}
+	return 0
+}
+
+func (x *ChoiceStats) GetPickRate() float64 {
+	if x != nil {
+		return x.PickRate
+	}
+	return 0
+}
+
+// 週ごとの集計。
+type StatsBucket struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Start           string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // RFC3339（UTC の月曜 0 時）
+	Attempts        int64                  `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CorrectAttempts int64                  `protobuf:"varint,3,opt,name=correct_attempts,json=correctAttempts,proto3" json:"correct_attempts,omitempty"`
+	Accuracy        float64                `protobuf:"fixed64,4,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StatsBucket) Reset() {
+	*x = StatsBucket{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsBucket) ProtoMessage() {}
+
+func (x *StatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsBucket.ProtoReflect.Descriptor instead.
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StatsBucket) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *StatsBucket) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *StatsBucket) GetCorrectAttempts() int64 {
+	if x != nil {
+		return x.CorrectAttempts
+	}
+	return 0
+}
+
+func (x *StatsBucket) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+type QualityFlag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          QualityFlagKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=historyquiz.question.v1.QualityFlagKind" json:"kind,omitempty"`
+	ChoiceId      string                 `protobuf:"bytes,2,opt,name=choice_id,json=choiceId,proto3" json:"choice_id,omitempty"` // UNPICKED_DISTRACTOR の場合の対象の選択肢
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QualityFlag) Reset() {
+	*x = QualityFlag{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityFlag) ProtoMessage() {}
+
+func (x *QualityFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityFlag.ProtoReflect.Descriptor instead.
+func (*QualityFlag) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *QualityFlag) GetKind() QualityFlagKind {
+	if x != nil {
+		return x.Kind
+	}
+	return QualityFlagKind_QUALITY_FLAG_KIND_UNSPECIFIED
+}
+
+func (x *QualityFlag) GetChoiceId() string {
+	if x != nil {
+		return x.ChoiceId
+	}
+	return ""
+}
+
+type QuestionStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalAttempts   int64                  `protobuf:"varint,1,opt,name=total_attempts,json=totalAttempts,proto3" json:"total_attempts,omitempty"`
+	CorrectAttempts int64                  `protobuf:"varint,2,opt,name=correct_attempts,json=correctAttempts,proto3" json:"correct_attempts,omitempty"`
+	Accuracy        float64                `protobuf:"fixed64,3,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	TextAttempts    int64                  `protobuf:"varint,4,opt,name=text_attempts,json=textAttempts,proto3" json:"text_attempts,omitempty"` // 記述式の回答数（選択率の分母には含めない）
+	Choices         []*ChoiceStats         `protobuf:"bytes,5,rep,name=choices,proto3" json:"choices,omitempty"`                                // ordinal 順
+	Trend           []*StatsBucket         `protobuf:"bytes,6,rep,name=trend,proto3" json:"trend,omitempty"`                                    // 直近 12 週（古い順、回答の無い週も含む）
+	// よくできるプレイヤー（この問題以外に 30 回以上回答し、8 割以上正解）の回答。
+	StrongPlayerAttempts int64          `protobuf:"varint,7,opt,name=strong_player_attempts,json=strongPlayerAttempts,proto3" json:"strong_player_attempts,omitempty"`
+	StrongPlayerAccuracy float64        `protobuf:"fixed64,8,opt,name=strong_player_accuracy,json=strongPlayerAccuracy,proto3" json:"strong_player_accuracy,omitempty"`
+	Flags                []*QualityFlag `protobuf:"bytes,9,rep,name=flags,proto3" json:"flags,omitempty"`
+	ForkCount            int64          `protobuf:"varint,10,opt,name=fork_count,json=forkCount,proto3" json:"fork_count,omitempty"`                      // 他のユーザーによる複製の数（削除された複製は含めない）
+	LocationAttempts     int64          `protobuf:"varint,11,opt,name=location_attempts,json=locationAttempts,proto3" json:"location_attempts,omitempty"` // 地図での回答数（選択率の分母には含めない）
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QuestionStats) Reset() {
+	*x = QuestionStats{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionStats) ProtoMessage() {}
+
+func (x *QuestionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionStats.ProtoReflect.Descriptor instead.
+func (*QuestionStats) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *QuestionStats) GetTotalAttempts() int64 {
+	if x != nil {
+		return x.TotalAttempts
+	}
+	return 0
+}
+
+func (x *QuestionStats) GetCorrectAttempts() int64 {
+	if x != nil {
+		return x.CorrectAttempts
+	}
+	return 0
+}
+
+func (x *QuestionStats) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *QuestionStats) GetTextAttempts() int64 {
+	if x != nil {
+		return x.TextAttempts
+	}
+	return 0
+}
+
+func (x *QuestionStats) GetChoices() []*ChoiceStats {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *QuestionStats) GetTrend() []*StatsBucket {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+func (x *QuestionStats) GetStrongPlayerAttempts() int64 {
+	if x != nil {
+		return x.StrongPlayerAttempts
+	}
+	return 0
+}
+
+func (x *QuestionStats) GetStrongPlayerAccuracy() float64 {
+	if x != nil {
+		return x.StrongPlayerAccuracy
+	}
+	return 0
+}
+
+func (x *QuestionStats) GetFlags() []*QualityFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *QuestionStats) GetForkCount() int64 {
+	if x != nil {
+		return x.ForkCount
+	}
+	return 0
+}
+
+func (x *QuestionStats) GetLocationAttempts() int64 {
+	if x != nil {
+		return x.LocationAttempts
+	}
+	return 0
+}
+
+type GetQuestionStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Stats         *QuestionStats         `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuestionStatsResponse) Reset() {
+	*x = GetQuestionStatsResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionStatsResponse) ProtoMessage() {}
+
+func (x *GetQuestionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetQuestionStatsResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetQuestionStatsResponse) GetStats() *QuestionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ForkQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"` // 複製元（他のユーザーの公開中の問題）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForkQuestionRequest) Reset() {
+	*x = ForkQuestionRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkQuestionRequest) ProtoMessage() {}
+
+func (x *ForkQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkQuestionRequest.ProtoReflect.Descriptor instead.
+func (*ForkQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ForkQuestionRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ForkQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+type ForkQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Question      *QuestionDetail        `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"` // 作成された下書き
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForkQuestionResponse) Reset() {
+	*x = ForkQuestionResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkQuestionResponse) ProtoMessage() {}
+
+func (x *ForkQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkQuestionResponse.ProtoReflect.Descriptor instead.
+func (*ForkQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ForkQuestionResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ForkQuestionResponse) GetQuestion() *QuestionDetail {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+// 問題のテンプレート。{{列名}} をデータセットの各行の値で置き換える（列名は大文字小文字を区別しない）。
+type QuestionTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`                     // 必須。{{列名}} を1つ以上含める（例: "{{event}}が起きたのは何年？"）
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`                     // 正解の選択肢。必須。誤答も同じテンプレートで別の行から作る（例: "{{year}}年"）
+	Explanation   string                 `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`           // 任意
+	EraField      string                 `protobuf:"bytes,4,opt,name=era_field,json=eraField,proto3" json:"era_field,omitempty"` // 年（整数。紀元前は負の数）の列。誤答の選び方と選択肢の並び順（古い順）に使う
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionTemplate) Reset() {
+	*x = QuestionTemplate{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionTemplate) ProtoMessage() {}
+
+func (x *QuestionTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionTemplate.ProtoReflect.Descriptor instead.
+func (*QuestionTemplate) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *QuestionTemplate) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *QuestionTemplate) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *QuestionTemplate) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *QuestionTemplate) GetEraField() string {
+	if x != nil {
+		return x.EraField
+	}
+	return ""
+}
+
+func (x *QuestionTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// NOTE: 上限は ImportQuestions と同じ（1MiB / 500行）。
+type GenerateQuestionsFromTemplateRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Context  *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Template *QuestionTemplate      `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	// CSV（ヘッダ行必須、列名は自由）または JSON（値が文字列か数値のオブジェクトの配列）。
+	Format  QuestionFileFormat `protobuf:"varint,3,opt,name=format,proto3,enum=historyquiz.question.v1.QuestionFileFormat" json:"format,omitempty"`
+	Dataset []byte             `protobuf:"bytes,4,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// true の場合は生成した問題を返すだけで、作成しない。
+	DryRun        bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateQuestionsFromTemplateRequest) Reset() {
+	*x = GenerateQuestionsFromTemplateRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateQuestionsFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQuestionsFromTemplateRequest) ProtoMessage() {}
+
+func (x *GenerateQuestionsFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQuestionsFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GenerateQuestionsFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GenerateQuestionsFromTemplateRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GenerateQuestionsFromTemplateRequest) GetTemplate() *QuestionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *GenerateQuestionsFromTemplateRequest) GetFormat() QuestionFileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return QuestionFileFormat_QUESTION_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *GenerateQuestionsFromTemplateRequest) GetDataset() []byte {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *GenerateQuestionsFromTemplateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// テンプレートから生成した問題（作成前）。
+type TemplatePreview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // CSV は行番号（ヘッダ=1）、JSON は配列の 1 始まりの位置
+	Draft         *QuestionDraft         `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplatePreview) Reset() {
+	*x = TemplatePreview{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplatePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplatePreview) ProtoMessage() {}
+
+func (x *TemplatePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplatePreview.ProtoReflect.Descriptor instead.
+func (*TemplatePreview) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TemplatePreview) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *TemplatePreview) GetDraft() *QuestionDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type GenerateQuestionsFromTemplateResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Context   *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	TotalRows int32                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	// row_errors が空でない場合は何も作成していない。
+	RowErrors []*ImportRowError `protobuf:"bytes,3,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
+	// 生成した問題。row_errors がある場合は、不正でない行の分だけ入る。
+	Previews []*TemplatePreview `protobuf:"bytes,4,rep,name=previews,proto3" json:"previews,omitempty"`
+	// 作成した問題（dry_run または row_errors がある場合は空）。
+	Questions []*QuestionSummary `protobuf:"bytes,5,rep,name=questions,proto3" json:"questions,omitempty"`
+	// ほぼ同じ問題が既にあるため作成しなかった行。
+	Skipped       []*ImportRowError `protobuf:"bytes,6,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateQuestionsFromTemplateResponse) Reset() {
+	*x = GenerateQuestionsFromTemplateResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateQuestionsFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQuestionsFromTemplateResponse) ProtoMessage() {}
+
+func (x *GenerateQuestionsFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQuestionsFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GenerateQuestionsFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GenerateQuestionsFromTemplateResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GenerateQuestionsFromTemplateResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *GenerateQuestionsFromTemplateResponse) GetRowErrors() []*ImportRowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+func (x *GenerateQuestionsFromTemplateResponse) GetPreviews() []*TemplatePreview {
+	if x != nil {
+		return x.Previews
+	}
+	return nil
+}
+
+func (x *GenerateQuestionsFromTemplateResponse) GetQuestions() []*QuestionSummary {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *GenerateQuestionsFromTemplateResponse) GetSkipped() []*ImportRowError {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type SuggestDistractorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`                                    // 任意（2000 文字まで）。似た問題文の問題からも候補を集める
+	CorrectAnswer string                 `protobuf:"bytes,3,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"` // 必須（200 文字まで）
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`                                        // 任意（10 件まで）。時代や地域のタグを付けると同じ時代の候補が上位に来る
+	// 既に入力済みの選択肢（10 件まで）。正解とその表記揺れと合わせて候補から除く。
+	ExcludeChoices []string `protobuf:"bytes,5,rep,name=exclude_choices,json=excludeChoices,proto3" json:"exclude_choices,omitempty"`
+	// 編集中の問題（その問題の選択肢は候補にしない）。
+	ExcludeQuestionId string `protobuf:"bytes,6,opt,name=exclude_question_id,json=excludeQuestionId,proto3" json:"exclude_question_id,omitempty"`
+	// 0 の場合は 10。最大 20。
+	Limit         int32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestDistractorsRequest) Reset() {
+	*x = SuggestDistractorsRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestDistractorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestDistractorsRequest) ProtoMessage() {}
+
+func (x *SuggestDistractorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestDistractorsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestDistractorsRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SuggestDistractorsRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SuggestDistractorsRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *SuggestDistractorsRequest) GetCorrectAnswer() string {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return ""
+}
+
+func (x *SuggestDistractorsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SuggestDistractorsRequest) GetExcludeChoices() []string {
+	if x != nil {
+		return x.ExcludeChoices
+	}
+	return nil
+}
+
+func (x *SuggestDistractorsRequest) GetExcludeQuestionId() string {
+	if x != nil {
+		return x.ExcludeQuestionId
+	}
+	return ""
+}
+
+func (x *SuggestDistractorsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 誤答の候補。
+type DistractorSuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Label string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// 順位付けに使ったスコア（大きいほど上位。値そのものに意味は持たせない）。
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// 正解と同じ問題に選択肢として並んだ問題の数。
+	CoOccurrenceCount int32 `protobuf:"varint,3,opt,name=co_occurrence_count,json=coOccurrenceCount,proto3" json:"co_occurrence_count,omitempty"`
+	// タグが重なる問題に出てきた問題の数。
+	SameTagCount int32 `protobuf:"varint,4,opt,name=same_tag_count,json=sameTagCount,proto3" json:"same_tag_count,omitempty"`
+	// 他の問題で誤答として選ばれた回数。
+	PickCount int64 `protobuf:"varint,5,opt,name=pick_count,json=pickCount,proto3" json:"pick_count,omitempty"`
+	// 候補が出てきた関連問題の数。
+	QuestionCount int32 `protobuf:"varint,6,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistractorSuggestion) Reset() {
+	*x = DistractorSuggestion{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistractorSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistractorSuggestion) ProtoMessage() {}
+
+func (x *DistractorSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistractorSuggestion.ProtoReflect.Descriptor instead.
+func (*DistractorSuggestion) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DistractorSuggestion) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DistractorSuggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DistractorSuggestion) GetCoOccurrenceCount() int32 {
+	if x != nil {
+		return x.CoOccurrenceCount
+	}
+	return 0
+}
+
+func (x *DistractorSuggestion) GetSameTagCount() int32 {
+	if x != nil {
+		return x.SameTagCount
+	}
+	return 0
+}
+
+func (x *DistractorSuggestion) GetPickCount() int64 {
+	if x != nil {
+		return x.PickCount
+	}
+	return 0
+}
+
+func (x *DistractorSuggestion) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+type SuggestDistractorsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Context       *v11.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Suggestions   []*DistractorSuggestion `protobuf:"bytes,2,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestDistractorsResponse) Reset() {
+	*x = SuggestDistractorsResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestDistractorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestDistractorsResponse) ProtoMessage() {}
+
+func (x *SuggestDistractorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestDistractorsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestDistractorsResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SuggestDistractorsResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SuggestDistractorsResponse) GetSuggestions() []*DistractorSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// 複数の問題が共有する史料（一次史料の抜粋。例: 条約文の一節を読んで答える3問）。
+type Passage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"` // 書式付きの入力そのもの（問題文と同じ書式）
+	Source        *Citation              `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Attachment    *v1.QuestionAttachment `protobuf:"bytes,4,opt,name=attachment,proto3" json:"attachment,omitempty"`             // 史料の画像（無い場合は未設定）
+	BodyRich      *v11.RichText          `protobuf:"bytes,5,opt,name=body_rich,json=bodyRich,proto3" json:"body_rich,omitempty"` // body の構文木
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Passage) Reset() {
+	*x = Passage{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Passage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passage) ProtoMessage() {}
+
+func (x *Passage) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passage.ProtoReflect.Descriptor instead.
+func (*Passage) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Passage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Passage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Passage) GetSource() *Citation {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Passage) GetAttachment() *v1.QuestionAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *Passage) GetBodyRich() *v11.RichText {
+	if x != nil {
+		return x.BodyRich
+	}
+	return nil
+}
+
+// 史料と、それを共有する問題の入力（作成/更新で共通）。
+type PassageDraft struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Body          string                  `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`             // 必須、4000文字以内
+	Source        *Citation               `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`         // 必須
+	Attachment    *AttachmentRef          `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"` // 任意
+	Questions     []*PassageQuestionDraft `protobuf:"bytes,4,rep,name=questions,proto3" json:"questions,omitempty"`   // 出題順、1..10件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PassageDraft) Reset() {
+	*x = PassageDraft{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PassageDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassageDraft) ProtoMessage() {}
+
+func (x *PassageDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassageDraft.ProtoReflect.Descriptor instead.
+func (*PassageDraft) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PassageDraft) GetBody() string {
 	if x != nil {
-		return x.Choices
+		return x.Body
 	}
-	return nil
+	return ""
 }
 
-func (x *QuestionDetail) GetCorrectChoiceId() string {
+func (x *PassageDraft) GetSource() *Citation {
 	if x != nil {
-		return x.CorrectChoiceId
+		return x.Source
 	}
-	return ""
+	return nil
 }
 
-func (x *QuestionDetail) GetExplanation() string {
+func (x *PassageDraft) GetAttachment() *AttachmentRef {
 	if x != nil {
-		return x.Explanation
+		return x.Attachment
 	}
-	return ""
+	return nil
 }
 
-func (x *QuestionDetail) GetUpdatedAt() string {
+func (x *PassageDraft) GetQuestions() []*PassageQuestionDraft {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Questions
 	}
-	return ""
+	return nil
 }
 
-type Choice struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Ordinal       int32                  `protobuf:"varint,3,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+type PassageQuestionDraft struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 既存の子の問題（UpdatePassage のみ）。空の場合は新しく作る。
+	QuestionId    string         `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Draft         *QuestionDraft `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Choice) Reset() {
-	*x = Choice{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[2]
+func (x *PassageQuestionDraft) Reset() {
+	*x = PassageQuestionDraft{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Choice) String() string {
+func (x *PassageQuestionDraft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Choice) ProtoMessage() {}
+func (*PassageQuestionDraft) ProtoMessage() {}
 
-func (x *Choice) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[2]
+func (x *PassageQuestionDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,59 +4414,51 @@ func (x *Choice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Choice.ProtoReflect.Descriptor instead.
-func (*Choice) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *Choice) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use PassageQuestionDraft.ProtoReflect.Descriptor instead.
+func (*PassageQuestionDraft) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Choice) GetLabel() string {
+func (x *PassageQuestionDraft) GetQuestionId() string {
 	if x != nil {
-		return x.Label
+		return x.QuestionId
 	}
 	return ""
 }
 
-func (x *Choice) GetOrdinal() int32 {
+func (x *PassageQuestionDraft) GetDraft() *QuestionDraft {
 	if x != nil {
-		return x.Ordinal
+		return x.Draft
 	}
-	return 0
+	return nil
 }
 
-// 作問入力（作成/更新で共通）。
-// NOTE: choices は常に4件であることをバックエンドで検証する。
-type QuestionDraft struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Prompt         string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	Choices        []string               `protobuf:"bytes,2,rep,name=choices,proto3" json:"choices,omitempty"`                                      // 期待: 4件
-	CorrectOrdinal int32                  `protobuf:"varint,3,opt,name=correct_ordinal,json=correctOrdinal,proto3" json:"correct_ordinal,omitempty"` // 期待: 0..3
-	Explanation    string                 `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type PassageDetail struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Passage   *Passage               `protobuf:"bytes,1,opt,name=passage,proto3" json:"passage,omitempty"`
+	Questions []*QuestionDetail      `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"` // 出題順
+	// 楽観ロック用の版番号。UpdatePassage のたびに 1 増える（UpdatePassageRequest.expected_version に渡す）。
+	Version       int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuestionDraft) Reset() {
-	*x = QuestionDraft{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[3]
+func (x *PassageDetail) Reset() {
+	*x = PassageDetail{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuestionDraft) String() string {
+func (x *PassageDetail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuestionDraft) ProtoMessage() {}
+func (*PassageDetail) ProtoMessage() {}
 
-func (x *QuestionDraft) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[3]
+func (x *PassageDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,62 +4469,62 @@ func (x *QuestionDraft) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuestionDraft.ProtoReflect.Descriptor instead.
-func (*QuestionDraft) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PassageDetail.ProtoReflect.Descriptor instead.
+func (*PassageDetail) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *QuestionDraft) GetPrompt() string {
+func (x *PassageDetail) GetPassage() *Passage {
 	if x != nil {
-		return x.Prompt
+		return x.Passage
 	}
-	return ""
+	return nil
 }
 
-func (x *QuestionDraft) GetChoices() []string {
+func (x *PassageDetail) GetQuestions() []*QuestionDetail {
 	if x != nil {
-		return x.Choices
+		return x.Questions
 	}
 	return nil
 }
 
-func (x *QuestionDraft) GetCorrectOrdinal() int32 {
+func (x *PassageDetail) GetVersion() int64 {
 	if x != nil {
-		return x.CorrectOrdinal
+		return x.Version
 	}
 	return 0
 }
 
-func (x *QuestionDraft) GetExplanation() string {
+func (x *PassageDetail) GetUpdatedAt() string {
 	if x != nil {
-		return x.Explanation
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-type CreateQuestionRequest struct {
+type CreatePassageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Draft         *QuestionDraft         `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Draft         *PassageDraft          `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateQuestionRequest) Reset() {
-	*x = CreateQuestionRequest{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[4]
+func (x *CreatePassageRequest) Reset() {
+	*x = CreatePassageRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateQuestionRequest) String() string {
+func (x *CreatePassageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQuestionRequest) ProtoMessage() {}
+func (*CreatePassageRequest) ProtoMessage() {}
 
-func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[4]
+func (x *CreatePassageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,48 +4535,48 @@ func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
-func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreatePassageRequest.ProtoReflect.Descriptor instead.
+func (*CreatePassageRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CreateQuestionRequest) GetContext() *v1.RequestContext {
+func (x *CreatePassageRequest) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *CreateQuestionRequest) GetDraft() *QuestionDraft {
+func (x *CreatePassageRequest) GetDraft() *PassageDraft {
 	if x != nil {
 		return x.Draft
 	}
 	return nil
 }
 
-type CreateQuestionResponse struct {
+type CreatePassageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Question      *QuestionDetail        `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Passage       *PassageDetail         `protobuf:"bytes,2,opt,name=passage,proto3" json:"passage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateQuestionResponse) Reset() {
-	*x = CreateQuestionResponse{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[5]
+func (x *CreatePassageResponse) Reset() {
+	*x = CreatePassageResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateQuestionResponse) String() string {
+func (x *CreatePassageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQuestionResponse) ProtoMessage() {}
+func (*CreatePassageResponse) ProtoMessage() {}
 
-func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[5]
+func (x *CreatePassageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,49 +4587,52 @@ func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQuestionResponse.ProtoReflect.Descriptor instead.
-func (*CreateQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CreatePassageResponse.ProtoReflect.Descriptor instead.
+func (*CreatePassageResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *CreateQuestionResponse) GetContext() *v1.RequestContext {
+func (x *CreatePassageResponse) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *CreateQuestionResponse) GetQuestion() *QuestionDetail {
+func (x *CreatePassageResponse) GetPassage() *PassageDetail {
 	if x != nil {
-		return x.Question
+		return x.Passage
 	}
 	return nil
 }
 
-type UpdateQuestionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Draft         *QuestionDraft         `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdatePassageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Context   *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	PassageId string                 `protobuf:"bytes,2,opt,name=passage_id,json=passageId,proto3" json:"passage_id,omitempty"`
+	Draft     *PassageDraft          `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft,omitempty"`
+	// 編集を始めた時点の PassageDetail.version（必須）。
+	// サーバ側の版と一致しない場合は ABORTED を返し、status の details に最新の PassageDetail を載せる。
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpdateQuestionRequest) Reset() {
-	*x = UpdateQuestionRequest{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[6]
+func (x *UpdatePassageRequest) Reset() {
+	*x = UpdatePassageRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateQuestionRequest) String() string {
+func (x *UpdatePassageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateQuestionRequest) ProtoMessage() {}
+func (*UpdatePassageRequest) ProtoMessage() {}
 
-func (x *UpdateQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[6]
+func (x *UpdatePassageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,55 +4643,62 @@ func (x *UpdateQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateQuestionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdatePassageRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePassageRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *UpdateQuestionRequest) GetContext() *v1.RequestContext {
+func (x *UpdatePassageRequest) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *UpdateQuestionRequest) GetQuestionId() string {
+func (x *UpdatePassageRequest) GetPassageId() string {
 	if x != nil {
-		return x.QuestionId
+		return x.PassageId
 	}
 	return ""
 }
 
-func (x *UpdateQuestionRequest) GetDraft() *QuestionDraft {
+func (x *UpdatePassageRequest) GetDraft() *PassageDraft {
 	if x != nil {
 		return x.Draft
 	}
 	return nil
 }
 
-type UpdateQuestionResponse struct {
+func (x *UpdatePassageRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdatePassageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Question      *QuestionDetail        `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Passage       *PassageDetail         `protobuf:"bytes,2,opt,name=passage,proto3" json:"passage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateQuestionResponse) Reset() {
-	*x = UpdateQuestionResponse{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[7]
+func (x *UpdatePassageResponse) Reset() {
+	*x = UpdatePassageResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateQuestionResponse) String() string {
+func (x *UpdatePassageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateQuestionResponse) ProtoMessage() {}
+func (*UpdatePassageResponse) ProtoMessage() {}
 
-func (x *UpdateQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[7]
+func (x *UpdatePassageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,48 +4709,48 @@ func (x *UpdateQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateQuestionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdatePassageResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePassageResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UpdateQuestionResponse) GetContext() *v1.RequestContext {
+func (x *UpdatePassageResponse) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *UpdateQuestionResponse) GetQuestion() *QuestionDetail {
+func (x *UpdatePassageResponse) GetPassage() *PassageDetail {
 	if x != nil {
-		return x.Question
+		return x.Passage
 	}
 	return nil
 }
 
-type GetMyQuestionRequest struct {
+type GetMyPassageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	PassageId     string                 `protobuf:"bytes,2,opt,name=passage_id,json=passageId,proto3" json:"passage_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyQuestionRequest) Reset() {
-	*x = GetMyQuestionRequest{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[8]
+func (x *GetMyPassageRequest) Reset() {
+	*x = GetMyPassageRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyQuestionRequest) String() string {
+func (x *GetMyPassageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyQuestionRequest) ProtoMessage() {}
+func (*GetMyPassageRequest) ProtoMessage() {}
 
-func (x *GetMyQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[8]
+func (x *GetMyPassageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,48 +4761,48 @@ func (x *GetMyQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyQuestionRequest.ProtoReflect.Descriptor instead.
-func (*GetMyQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetMyPassageRequest.ProtoReflect.Descriptor instead.
+func (*GetMyPassageRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetMyQuestionRequest) GetContext() *v1.RequestContext {
+func (x *GetMyPassageRequest) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *GetMyQuestionRequest) GetQuestionId() string {
+func (x *GetMyPassageRequest) GetPassageId() string {
 	if x != nil {
-		return x.QuestionId
+		return x.PassageId
 	}
 	return ""
 }
 
-type GetMyQuestionResponse struct {
+type GetMyPassageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Question      *QuestionDetail        `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Passage       *PassageDetail         `protobuf:"bytes,2,opt,name=passage,proto3" json:"passage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyQuestionResponse) Reset() {
-	*x = GetMyQuestionResponse{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[9]
+func (x *GetMyPassageResponse) Reset() {
+	*x = GetMyPassageResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyQuestionResponse) String() string {
+func (x *GetMyPassageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyQuestionResponse) ProtoMessage() {}
+func (*GetMyPassageResponse) ProtoMessage() {}
 
-func (x *GetMyQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[9]
+func (x *GetMyPassageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,48 +4813,48 @@ func (x *GetMyQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyQuestionResponse.ProtoReflect.Descriptor instead.
-func (*GetMyQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetMyPassageResponse.ProtoReflect.Descriptor instead.
+func (*GetMyPassageResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetMyQuestionResponse) GetContext() *v1.RequestContext {
+func (x *GetMyPassageResponse) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *GetMyQuestionResponse) GetQuestion() *QuestionDetail {
+func (x *GetMyPassageResponse) GetPassage() *PassageDetail {
 	if x != nil {
-		return x.Question
+		return x.Passage
 	}
 	return nil
 }
 
-type ListMyQuestionsRequest struct {
+type DeletePassageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Pagination    *v1.Pagination         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	PassageId     string                 `protobuf:"bytes,2,opt,name=passage_id,json=passageId,proto3" json:"passage_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyQuestionsRequest) Reset() {
-	*x = ListMyQuestionsRequest{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[10]
+func (x *DeletePassageRequest) Reset() {
+	*x = DeletePassageRequest{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyQuestionsRequest) String() string {
+func (x *DeletePassageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyQuestionsRequest) ProtoMessage() {}
+func (*DeletePassageRequest) ProtoMessage() {}
 
-func (x *ListMyQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[10]
+func (x *DeletePassageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,49 +4865,47 @@ func (x *ListMyQuestionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyQuestionsRequest.ProtoReflect.Descriptor instead.
-func (*ListMyQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeletePassageRequest.ProtoReflect.Descriptor instead.
+func (*DeletePassageRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListMyQuestionsRequest) GetContext() *v1.RequestContext {
+func (x *DeletePassageRequest) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *ListMyQuestionsRequest) GetPagination() *v1.Pagination {
+func (x *DeletePassageRequest) GetPassageId() string {
 	if x != nil {
-		return x.Pagination
+		return x.PassageId
 	}
-	return nil
+	return ""
 }
 
-type ListMyQuestionsResponse struct {
+type DeletePassageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Questions     []*QuestionSummary     `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
-	PageInfo      *v1.PageInfo           `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyQuestionsResponse) Reset() {
-	*x = ListMyQuestionsResponse{}
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[11]
+func (x *DeletePassageResponse) Reset() {
+	*x = DeletePassageResponse{}
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyQuestionsResponse) String() string {
+func (x *DeletePassageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyQuestionsResponse) ProtoMessage() {}
+func (*DeletePassageResponse) ProtoMessage() {}
 
-func (x *ListMyQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[11]
+func (x *DeletePassageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_question_v1_question_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,42 +4916,34 @@ func (x *ListMyQuestionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyQuestionsResponse.ProtoReflect.Descriptor instead.
-func (*ListMyQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeletePassageResponse.ProtoReflect.Descriptor instead.
+func (*DeletePassageResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_question_v1_question_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListMyQuestionsResponse) GetContext() *v1.RequestContext {
+func (x *DeletePassageResponse) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *ListMyQuestionsResponse) GetQuestions() []*QuestionSummary {
-	if x != nil {
-		return x.Questions
-	}
-	return nil
-}
-
-func (x *ListMyQuestionsResponse) GetPageInfo() *v1.PageInfo {
-	if x != nil {
-		return x.PageInfo
-	}
-	return nil
-}
-
 var File_historyquiz_question_v1_question_service_proto protoreflect.FileDescriptor
 
 const file_historyquiz_question_v1_question_service_proto_rawDesc = "" +
 	"\n" +
-	".historyquiz/question/v1/question_service.proto\x12\x17historyquiz.question.v1\x1a\"historyquiz/common/v1/common.proto\"X\n" +
+	".historyquiz/question/v1/question_service.proto\x12\x17historyquiz.question.v1\x1a2historyquiz/attachment/v1/attachment_service.proto\x1a\"historyquiz/common/v1/common.proto\"\xa4\x02\n" +
 	"\x0fQuestionSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06prompt\x18\x02 \x01(\tR\x06prompt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x03 \x01(\tR\tupdatedAt\"\xe0\x01\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12?\n" +
+	"\x06status\x18\x04 \x01(\x0e2'.historyquiz.question.v1.QuestionStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12#\n" +
+	"\rattempt_count\x18\x06 \x01(\x03R\fattemptCount\x12)\n" +
+	"\x10correct_attempts\x18\a \x01(\x03R\x0fcorrectAttempts\x12\x1a\n" +
+	"\baccuracy\x18\b \x01(\x01R\baccuracy\"\xc8\a\n" +
 	"\x0eQuestionDetail\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06prompt\x18\x02 \x01(\tR\x06prompt\x129\n" +
@@ -745,51 +4951,410 @@ const file_historyquiz_question_v1_question_service_proto_rawDesc = "" +
 	"\x11correct_choice_id\x18\x04 \x01(\tR\x0fcorrectChoiceId\x12 \n" +
 	"\vexplanation\x18\x05 \x01(\tR\vexplanation\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"H\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12)\n" +
+	"\x10accepted_answers\x18\a \x03(\tR\x0facceptedAnswers\x12?\n" +
+	"\x06status\x18\b \x01(\x0e2'.historyquiz.question.v1.QuestionStatusR\x06status\x12\x16\n" +
+	"\x06hidden\x18\t \x01(\bR\x06hidden\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\x12O\n" +
+	"\vattachments\x18\f \x03(\v2-.historyquiz.attachment.v1.QuestionAttachmentR\vattachments\x12?\n" +
+	"\tcitations\x18\r \x03(\v2!.historyquiz.question.v1.CitationR\tcitations\x12,\n" +
+	"\x12origin_question_id\x18\x0e \x01(\tR\x10originQuestionId\x12@\n" +
+	"\vprompt_rich\x18\x0f \x01(\v2\x1f.historyquiz.common.v1.RichTextR\n" +
+	"promptRich\x12J\n" +
+	"\x10explanation_rich\x18\x10 \x01(\v2\x1f.historyquiz.common.v1.RichTextR\x0fexplanationRich\x12E\n" +
+	"\bschedule\x18\x11 \x01(\v2).historyquiz.question.v1.QuestionScheduleR\bschedule\x12E\n" +
+	"\blocation\x18\x12 \x01(\v2).historyquiz.question.v1.QuestionLocationR\blocation\x12\x1d\n" +
+	"\n" +
+	"passage_id\x18\x13 \x01(\tR\tpassageId\x129\n" +
+	"\x04kind\x18\x14 \x01(\x0e2%.historyquiz.question.v1.QuestionKindR\x04kind\"T\n" +
+	"\x10QuestionSchedule\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x01 \x01(\tR\tpublishAt\x12!\n" +
+	"\funpublish_at\x18\x02 \x01(\tR\vunpublishAt\"H\n" +
 	"\x06Choice\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
-	"\aordinal\x18\x03 \x01(\x05R\aordinal\"\x8c\x01\n" +
+	"\aordinal\x18\x03 \x01(\x05R\aordinal\"\xd8\x03\n" +
 	"\rQuestionDraft\x12\x16\n" +
 	"\x06prompt\x18\x01 \x01(\tR\x06prompt\x12\x18\n" +
 	"\achoices\x18\x02 \x03(\tR\achoices\x12'\n" +
 	"\x0fcorrect_ordinal\x18\x03 \x01(\x05R\x0ecorrectOrdinal\x12 \n" +
-	"\vexplanation\x18\x04 \x01(\tR\vexplanation\"\x96\x01\n" +
+	"\vexplanation\x18\x04 \x01(\tR\vexplanation\x12)\n" +
+	"\x10accepted_answers\x18\x05 \x03(\tR\x0facceptedAnswers\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12H\n" +
+	"\vattachments\x18\a \x03(\v2&.historyquiz.question.v1.AttachmentRefR\vattachments\x12?\n" +
+	"\tcitations\x18\b \x03(\v2!.historyquiz.question.v1.CitationR\tcitations\x12E\n" +
+	"\blocation\x18\t \x01(\v2).historyquiz.question.v1.QuestionLocationR\blocation\x129\n" +
+	"\x04kind\x18\n" +
+	" \x01(\x0e2%.historyquiz.question.v1.QuestionKindR\x04kind\"d\n" +
+	"\x10QuestionLocation\x123\n" +
+	"\x05point\x18\x01 \x01(\v2\x1d.historyquiz.common.v1.LatLngR\x05point\x12\x1b\n" +
+	"\tradius_km\x18\x02 \x01(\x01R\bradiusKm\"O\n" +
+	"\rAttachmentRef\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\x12\x19\n" +
+	"\balt_text\x18\x02 \x01(\tR\aaltText\"\x9f\x01\n" +
+	"\bCitation\x129\n" +
+	"\x04kind\x18\x01 \x01(\x0e2%.historyquiz.question.v1.CitationKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x18\n" +
+	"\alocator\x18\x04 \x01(\tR\alocator\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\"\x96\x01\n" +
 	"\x15CreateQuestionRequest\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12<\n" +
-	"\x05draft\x18\x02 \x01(\v2&.historyquiz.question.v1.QuestionDraftR\x05draft\"\x9e\x01\n" +
+	"\x05draft\x18\x02 \x01(\v2&.historyquiz.question.v1.QuestionDraftR\x05draft\"\xbf\x01\n" +
+	"\x0fSimilarQuestion\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12\x16\n" +
+	"\x06prompt\x18\x02 \x01(\tR\x06prompt\x12?\n" +
+	"\x06status\x18\x03 \x01(\x0e2'.historyquiz.question.v1.QuestionStatusR\x06status\x12\x12\n" +
+	"\x04mine\x18\x04 \x01(\bR\x04mine\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x05 \x01(\x01R\n" +
+	"similarity\"\xf5\x01\n" +
 	"\x16CreateQuestionResponse\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
-	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\"\xb7\x01\n" +
+	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\x12U\n" +
+	"\x11similar_questions\x18\x03 \x03(\v2(.historyquiz.question.v1.SimilarQuestionR\x10similarQuestions\"\xa9\x02\n" +
 	"\x15UpdateQuestionRequest\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
 	"\vquestion_id\x18\x02 \x01(\tR\n" +
 	"questionId\x12<\n" +
-	"\x05draft\x18\x03 \x01(\v2&.historyquiz.question.v1.QuestionDraftR\x05draft\"\x9e\x01\n" +
+	"\x05draft\x18\x03 \x01(\v2&.historyquiz.question.v1.QuestionDraftR\x05draft\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x12E\n" +
+	"\bschedule\x18\x05 \x01(\v2).historyquiz.question.v1.QuestionScheduleR\bschedule\"\xf5\x01\n" +
 	"\x16UpdateQuestionResponse\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
-	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\"x\n" +
+	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\x12U\n" +
+	"\x11similar_questions\x18\x03 \x03(\v2(.historyquiz.question.v1.SimilarQuestionR\x10similarQuestions\"x\n" +
 	"\x14GetMyQuestionRequest\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
 	"\vquestion_id\x18\x02 \x01(\tR\n" +
 	"questionId\"\x9d\x01\n" +
 	"\x15GetMyQuestionResponse\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
-	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\"\x9c\x01\n" +
+	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\"\xf9\x03\n" +
 	"\x16ListMyQuestionsRequest\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12A\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2!.historyquiz.common.v1.PaginationR\n" +
-	"pagination\"\xe0\x01\n" +
+	"pagination\x12C\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2'.historyquiz.question.v1.QuestionStatusR\bstatuses\x12\x10\n" +
+	"\x03tag\x18\x04 \x01(\tR\x03tag\x12!\n" +
+	"\fcreated_from\x18\x05 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x06 \x01(\tR\tcreatedTo\x12L\n" +
+	"\vexplanation\x18\a \x01(\x0e2*.historyquiz.question.v1.ExplanationFilterR\vexplanation\x12\x18\n" +
+	"\akeyword\x18\b \x01(\tR\akeyword\x12<\n" +
+	"\x04sort\x18\t \x01(\x0e2(.historyquiz.question.v1.QuestionSortKeyR\x04sort\x12\x1c\n" +
+	"\tascending\x18\n" +
+	" \x01(\bR\tascending\"\xe0\x01\n" +
 	"\x17ListMyQuestionsResponse\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12F\n" +
 	"\tquestions\x18\x02 \x03(\v2(.historyquiz.question.v1.QuestionSummaryR\tquestions\x12<\n" +
-	"\tpage_info\x18\x03 \x01(\v2\x1f.historyquiz.common.v1.PageInfoR\bpageInfo2\xdd\x03\n" +
+	"\tpage_info\x18\x03 \x01(\v2\x1f.historyquiz.common.v1.PageInfoR\bpageInfo\"y\n" +
+	"\x15DeleteQuestionRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\"Y\n" +
+	"\x16DeleteQuestionResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\"z\n" +
+	"\x16PublishQuestionRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\"\x9f\x01\n" +
+	"\x17PublishQuestionResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
+	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\"\xca\x01\n" +
+	"\x18UnpublishQuestionRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12L\n" +
+	"\rtarget_status\x18\x03 \x01(\x0e2'.historyquiz.question.v1.QuestionStatusR\ftargetStatus\"\xa1\x01\n" +
+	"\x19UnpublishQuestionResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
+	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\"\xd1\x01\n" +
+	"\x16ImportQuestionsRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
+	"\x06format\x18\x02 \x01(\x0e2+.historyquiz.question.v1.QuestionFileFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"t\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12P\n" +
+	"\x10field_violations\x18\x02 \x03(\v2%.historyquiz.common.v1.FieldViolationR\x0ffieldViolations\"\x89\x02\n" +
+	"\x17ImportQuestionsResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows\x12F\n" +
+	"\n" +
+	"row_errors\x18\x03 \x03(\v2'.historyquiz.question.v1.ImportRowErrorR\trowErrors\x12F\n" +
+	"\tquestions\x18\x04 \x03(\v2(.historyquiz.question.v1.QuestionSummaryR\tquestions\"\xa0\x01\n" +
+	"\x18ExportMyQuestionsRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
+	"\x06format\x18\x02 \x01(\x0e2+.historyquiz.question.v1.QuestionFileFormatR\x06format\"\x99\x01\n" +
+	"\x19ExportMyQuestionsResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\x12%\n" +
+	"\x0equestion_count\x18\x03 \x01(\x05R\rquestionCount\"\xaf\x02\n" +
+	"\x16SearchQuestionsRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12A\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2!.historyquiz.common.v1.PaginationR\n" +
+	"pagination\x12$\n" +
+	"\x0eauthor_user_id\x18\x04 \x01(\tR\fauthorUserId\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\x12C\n" +
+	"\bstatuses\x18\x06 \x03(\x0e2'.historyquiz.question.v1.QuestionStatusR\bstatuses\"L\n" +
+	"\x14SearchSnippetSegment\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12 \n" +
+	"\vhighlighted\x18\x02 \x01(\bR\vhighlighted\"\xbd\x01\n" +
+	"\rSearchSnippet\x12:\n" +
+	"\x05field\x18\x01 \x01(\x0e2$.historyquiz.question.v1.SearchFieldR\x05field\x12%\n" +
+	"\x0echoice_ordinal\x18\x02 \x01(\x05R\rchoiceOrdinal\x12I\n" +
+	"\bsegments\x18\x03 \x03(\v2-.historyquiz.question.v1.SearchSnippetSegmentR\bsegments\"\xed\x01\n" +
+	"\x11QuestionSearchHit\x12D\n" +
+	"\bquestion\x18\x01 \x01(\v2(.historyquiz.question.v1.QuestionSummaryR\bquestion\x12$\n" +
+	"\x0eauthor_user_id\x18\x02 \x01(\tR\fauthorUserId\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12B\n" +
+	"\bsnippets\x18\x04 \x03(\v2&.historyquiz.question.v1.SearchSnippetR\bsnippets\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\"\xd8\x01\n" +
+	"\x17SearchQuestionsResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12>\n" +
+	"\x04hits\x18\x02 \x03(\v2*.historyquiz.question.v1.QuestionSearchHitR\x04hits\x12<\n" +
+	"\tpage_info\x18\x03 \x01(\v2\x1f.historyquiz.common.v1.PageInfoR\bpageInfo\"\xdd\x01\n" +
+	"\x13QuestionTranslation\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x16\n" +
+	"\x06prompt\x18\x02 \x01(\tR\x06prompt\x12\x18\n" +
+	"\achoices\x18\x03 \x03(\tR\achoices\x12 \n" +
+	"\vexplanation\x18\x04 \x01(\tR\vexplanation\x12%\n" +
+	"\x0esource_version\x18\x05 \x01(\x03R\rsourceVersion\x12\x14\n" +
+	"\x05stale\x18\x06 \x01(\bR\x05stale\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xd4\x01\n" +
+	" UpsertQuestionTranslationRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12N\n" +
+	"\vtranslation\x18\x03 \x01(\v2,.historyquiz.question.v1.QuestionTranslationR\vtranslation\"\xb4\x01\n" +
+	"!UpsertQuestionTranslationResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12N\n" +
+	"\vtranslation\x18\x02 \x01(\v2,.historyquiz.question.v1.QuestionTranslationR\vtranslation\"\x9c\x01\n" +
+	" DeleteQuestionTranslationRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\"d\n" +
+	"!DeleteQuestionTranslationResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\"\x83\x01\n" +
+	"\x1fListQuestionTranslationsRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\"\xb5\x01\n" +
+	" ListQuestionTranslationsResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12P\n" +
+	"\ftranslations\x18\x02 \x03(\v2,.historyquiz.question.v1.QuestionTranslationR\ftranslations\"{\n" +
+	"\x17GetQuestionStatsRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\"\xac\x01\n" +
+	"\vChoiceStats\x12\x1b\n" +
+	"\tchoice_id\x18\x01 \x01(\tR\bchoiceId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
+	"\aordinal\x18\x03 \x01(\x05R\aordinal\x12\x1d\n" +
+	"\n" +
+	"is_correct\x18\x04 \x01(\bR\tisCorrect\x12\x14\n" +
+	"\x05picks\x18\x05 \x01(\x03R\x05picks\x12\x1b\n" +
+	"\tpick_rate\x18\x06 \x01(\x01R\bpickRate\"\x86\x01\n" +
+	"\vStatsBucket\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x1a\n" +
+	"\battempts\x18\x02 \x01(\x03R\battempts\x12)\n" +
+	"\x10correct_attempts\x18\x03 \x01(\x03R\x0fcorrectAttempts\x12\x1a\n" +
+	"\baccuracy\x18\x04 \x01(\x01R\baccuracy\"h\n" +
+	"\vQualityFlag\x12<\n" +
+	"\x04kind\x18\x01 \x01(\x0e2(.historyquiz.question.v1.QualityFlagKindR\x04kind\x12\x1b\n" +
+	"\tchoice_id\x18\x02 \x01(\tR\bchoiceId\"\x92\x04\n" +
+	"\rQuestionStats\x12%\n" +
+	"\x0etotal_attempts\x18\x01 \x01(\x03R\rtotalAttempts\x12)\n" +
+	"\x10correct_attempts\x18\x02 \x01(\x03R\x0fcorrectAttempts\x12\x1a\n" +
+	"\baccuracy\x18\x03 \x01(\x01R\baccuracy\x12#\n" +
+	"\rtext_attempts\x18\x04 \x01(\x03R\ftextAttempts\x12>\n" +
+	"\achoices\x18\x05 \x03(\v2$.historyquiz.question.v1.ChoiceStatsR\achoices\x12:\n" +
+	"\x05trend\x18\x06 \x03(\v2$.historyquiz.question.v1.StatsBucketR\x05trend\x124\n" +
+	"\x16strong_player_attempts\x18\a \x01(\x03R\x14strongPlayerAttempts\x124\n" +
+	"\x16strong_player_accuracy\x18\b \x01(\x01R\x14strongPlayerAccuracy\x12:\n" +
+	"\x05flags\x18\t \x03(\v2$.historyquiz.question.v1.QualityFlagR\x05flags\x12\x1d\n" +
+	"\n" +
+	"fork_count\x18\n" +
+	" \x01(\x03R\tforkCount\x12+\n" +
+	"\x11location_attempts\x18\v \x01(\x03R\x10locationAttempts\"\x99\x01\n" +
+	"\x18GetQuestionStatsResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12<\n" +
+	"\x05stats\x18\x02 \x01(\v2&.historyquiz.question.v1.QuestionStatsR\x05stats\"w\n" +
+	"\x13ForkQuestionRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\"\x9c\x01\n" +
+	"\x14ForkQuestionResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12C\n" +
+	"\bquestion\x18\x02 \x01(\v2'.historyquiz.question.v1.QuestionDetailR\bquestion\"\x95\x01\n" +
+	"\x10QuestionTemplate\x12\x16\n" +
+	"\x06prompt\x18\x01 \x01(\tR\x06prompt\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12 \n" +
+	"\vexplanation\x18\x03 \x01(\tR\vexplanation\x12\x1b\n" +
+	"\tera_field\x18\x04 \x01(\tR\beraField\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\"\xa6\x02\n" +
+	"$GenerateQuestionsFromTemplateRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12E\n" +
+	"\btemplate\x18\x02 \x01(\v2).historyquiz.question.v1.QuestionTemplateR\btemplate\x12C\n" +
+	"\x06format\x18\x03 \x01(\x0e2+.historyquiz.question.v1.QuestionFileFormatR\x06format\x12\x18\n" +
+	"\adataset\x18\x04 \x01(\fR\adataset\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"a\n" +
+	"\x0fTemplatePreview\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12<\n" +
+	"\x05draft\x18\x02 \x01(\v2&.historyquiz.question.v1.QuestionDraftR\x05draft\"\xa0\x03\n" +
+	"%GenerateQuestionsFromTemplateResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows\x12F\n" +
+	"\n" +
+	"row_errors\x18\x03 \x03(\v2'.historyquiz.question.v1.ImportRowErrorR\trowErrors\x12D\n" +
+	"\bpreviews\x18\x04 \x03(\v2(.historyquiz.question.v1.TemplatePreviewR\bpreviews\x12F\n" +
+	"\tquestions\x18\x05 \x03(\v2(.historyquiz.question.v1.QuestionSummaryR\tquestions\x12A\n" +
+	"\askipped\x18\x06 \x03(\v2'.historyquiz.question.v1.ImportRowErrorR\askipped\"\x9e\x02\n" +
+	"\x19SuggestDistractorsRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x16\n" +
+	"\x06prompt\x18\x02 \x01(\tR\x06prompt\x12%\n" +
+	"\x0ecorrect_answer\x18\x03 \x01(\tR\rcorrectAnswer\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12'\n" +
+	"\x0fexclude_choices\x18\x05 \x03(\tR\x0eexcludeChoices\x12.\n" +
+	"\x13exclude_question_id\x18\x06 \x01(\tR\x11excludeQuestionId\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\"\xde\x01\n" +
+	"\x14DistractorSuggestion\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12.\n" +
+	"\x13co_occurrence_count\x18\x03 \x01(\x05R\x11coOccurrenceCount\x12$\n" +
+	"\x0esame_tag_count\x18\x04 \x01(\x05R\fsameTagCount\x12\x1d\n" +
+	"\n" +
+	"pick_count\x18\x05 \x01(\x03R\tpickCount\x12%\n" +
+	"\x0equestion_count\x18\x06 \x01(\x05R\rquestionCount\"\xae\x01\n" +
+	"\x1aSuggestDistractorsResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12O\n" +
+	"\vsuggestions\x18\x02 \x03(\v2-.historyquiz.question.v1.DistractorSuggestionR\vsuggestions\"\xf5\x01\n" +
+	"\aPassage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x129\n" +
+	"\x06source\x18\x03 \x01(\v2!.historyquiz.question.v1.CitationR\x06source\x12M\n" +
+	"\n" +
+	"attachment\x18\x04 \x01(\v2-.historyquiz.attachment.v1.QuestionAttachmentR\n" +
+	"attachment\x12<\n" +
+	"\tbody_rich\x18\x05 \x01(\v2\x1f.historyquiz.common.v1.RichTextR\bbodyRich\"\xf2\x01\n" +
+	"\fPassageDraft\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\x129\n" +
+	"\x06source\x18\x02 \x01(\v2!.historyquiz.question.v1.CitationR\x06source\x12F\n" +
+	"\n" +
+	"attachment\x18\x03 \x01(\v2&.historyquiz.question.v1.AttachmentRefR\n" +
+	"attachment\x12K\n" +
+	"\tquestions\x18\x04 \x03(\v2-.historyquiz.question.v1.PassageQuestionDraftR\tquestions\"u\n" +
+	"\x14PassageQuestionDraft\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12<\n" +
+	"\x05draft\x18\x02 \x01(\v2&.historyquiz.question.v1.QuestionDraftR\x05draft\"\xcb\x01\n" +
+	"\rPassageDetail\x12:\n" +
+	"\apassage\x18\x01 \x01(\v2 .historyquiz.question.v1.PassageR\apassage\x12E\n" +
+	"\tquestions\x18\x02 \x03(\v2'.historyquiz.question.v1.QuestionDetailR\tquestions\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"\x94\x01\n" +
+	"\x14CreatePassageRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12;\n" +
+	"\x05draft\x18\x02 \x01(\v2%.historyquiz.question.v1.PassageDraftR\x05draft\"\x9a\x01\n" +
+	"\x15CreatePassageResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12@\n" +
+	"\apassage\x18\x02 \x01(\v2&.historyquiz.question.v1.PassageDetailR\apassage\"\xde\x01\n" +
+	"\x14UpdatePassageRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1d\n" +
+	"\n" +
+	"passage_id\x18\x02 \x01(\tR\tpassageId\x12;\n" +
+	"\x05draft\x18\x03 \x01(\v2%.historyquiz.question.v1.PassageDraftR\x05draft\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"\x9a\x01\n" +
+	"\x15UpdatePassageResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12@\n" +
+	"\apassage\x18\x02 \x01(\v2&.historyquiz.question.v1.PassageDetailR\apassage\"u\n" +
+	"\x13GetMyPassageRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1d\n" +
+	"\n" +
+	"passage_id\x18\x02 \x01(\tR\tpassageId\"\x99\x01\n" +
+	"\x14GetMyPassageResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12@\n" +
+	"\apassage\x18\x02 \x01(\v2&.historyquiz.question.v1.PassageDetailR\apassage\"v\n" +
+	"\x14DeletePassageRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1d\n" +
+	"\n" +
+	"passage_id\x18\x02 \x01(\tR\tpassageId\"X\n" +
+	"\x15DeletePassageResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext*\xa7\x01\n" +
+	"\x0eQuestionStatus\x12\x1f\n" +
+	"\x1bQUESTION_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15QUESTION_STATUS_DRAFT\x10\x01\x12\x1d\n" +
+	"\x19QUESTION_STATUS_PUBLISHED\x10\x02\x12\x1c\n" +
+	"\x18QUESTION_STATUS_UNLISTED\x10\x03\x12\x1c\n" +
+	"\x18QUESTION_STATUS_ARCHIVED\x10\x04*c\n" +
+	"\fQuestionKind\x12\x1d\n" +
+	"\x19QUESTION_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14QUESTION_KIND_CHOICE\x10\x01\x12\x1a\n" +
+	"\x16QUESTION_KIND_LOCATION\x10\x02*~\n" +
+	"\fCitationKind\x12\x1d\n" +
+	"\x19CITATION_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CITATION_KIND_BOOK\x10\x01\x12\x15\n" +
+	"\x11CITATION_KIND_WEB\x10\x02\x12 \n" +
+	"\x1cCITATION_KIND_PRIMARY_SOURCE\x10\x03*\xbd\x01\n" +
+	"\x0fQuestionSortKey\x12!\n" +
+	"\x1dQUESTION_SORT_KEY_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cQUESTION_SORT_KEY_UPDATED_AT\x10\x01\x12 \n" +
+	"\x1cQUESTION_SORT_KEY_CREATED_AT\x10\x02\x12\x1e\n" +
+	"\x1aQUESTION_SORT_KEY_ACCURACY\x10\x03\x12#\n" +
+	"\x1fQUESTION_SORT_KEY_ATTEMPT_COUNT\x10\x04*\x8c\x01\n" +
+	"\x11ExplanationFilter\x12\"\n" +
+	"\x1eEXPLANATION_FILTER_UNSPECIFIED\x10\x00\x12'\n" +
+	"#EXPLANATION_FILTER_WITH_EXPLANATION\x10\x01\x12*\n" +
+	"&EXPLANATION_FILTER_WITHOUT_EXPLANATION\x10\x02*\x9a\x01\n" +
+	"\x12QuestionFileFormat\x12$\n" +
+	" QUESTION_FILE_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18QUESTION_FILE_FORMAT_CSV\x10\x01\x12\x1d\n" +
+	"\x19QUESTION_FILE_FORMAT_JSON\x10\x02\x12!\n" +
+	"\x1dQUESTION_FILE_FORMAT_ANKI_TSV\x10\x03*{\n" +
+	"\vSearchField\x12\x1c\n" +
+	"\x18SEARCH_FIELD_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SEARCH_FIELD_PROMPT\x10\x01\x12\x17\n" +
+	"\x13SEARCH_FIELD_CHOICE\x10\x02\x12\x1c\n" +
+	"\x18SEARCH_FIELD_EXPLANATION\x10\x03*\x8a\x01\n" +
+	"\x0fQualityFlagKind\x12!\n" +
+	"\x1dQUALITY_FLAG_KIND_UNSPECIFIED\x10\x00\x12)\n" +
+	"%QUALITY_FLAG_KIND_UNPICKED_DISTRACTOR\x10\x01\x12)\n" +
+	"%QUALITY_FLAG_KIND_STRONG_PLAYERS_MISS\x10\x022\xab\x14\n" +
 	"\x0fQuestionService\x12q\n" +
 	"\x0eCreateQuestion\x12..historyquiz.question.v1.CreateQuestionRequest\x1a/.historyquiz.question.v1.CreateQuestionResponse\x12q\n" +
 	"\x0eUpdateQuestion\x12..historyquiz.question.v1.UpdateQuestionRequest\x1a/.historyquiz.question.v1.UpdateQuestionResponse\x12n\n" +
 	"\rGetMyQuestion\x12-.historyquiz.question.v1.GetMyQuestionRequest\x1a..historyquiz.question.v1.GetMyQuestionResponse\x12t\n" +
-	"\x0fListMyQuestions\x12/.historyquiz.question.v1.ListMyQuestionsRequest\x1a0.historyquiz.question.v1.ListMyQuestionsResponseBBZ@github.com/history-quiz/historyquiz/proto/question/v1;questionv1b\x06proto3"
+	"\x0fListMyQuestions\x12/.historyquiz.question.v1.ListMyQuestionsRequest\x1a0.historyquiz.question.v1.ListMyQuestionsResponse\x12q\n" +
+	"\x0eDeleteQuestion\x12..historyquiz.question.v1.DeleteQuestionRequest\x1a/.historyquiz.question.v1.DeleteQuestionResponse\x12t\n" +
+	"\x0fPublishQuestion\x12/.historyquiz.question.v1.PublishQuestionRequest\x1a0.historyquiz.question.v1.PublishQuestionResponse\x12z\n" +
+	"\x11UnpublishQuestion\x121.historyquiz.question.v1.UnpublishQuestionRequest\x1a2.historyquiz.question.v1.UnpublishQuestionResponse\x12t\n" +
+	"\x0fImportQuestions\x12/.historyquiz.question.v1.ImportQuestionsRequest\x1a0.historyquiz.question.v1.ImportQuestionsResponse\x12|\n" +
+	"\x11ExportMyQuestions\x121.historyquiz.question.v1.ExportMyQuestionsRequest\x1a2.historyquiz.question.v1.ExportMyQuestionsResponse0\x01\x12t\n" +
+	"\x0fSearchQuestions\x12/.historyquiz.question.v1.SearchQuestionsRequest\x1a0.historyquiz.question.v1.SearchQuestionsResponse\x12\x92\x01\n" +
+	"\x19UpsertQuestionTranslation\x129.historyquiz.question.v1.UpsertQuestionTranslationRequest\x1a:.historyquiz.question.v1.UpsertQuestionTranslationResponse\x12\x92\x01\n" +
+	"\x19DeleteQuestionTranslation\x129.historyquiz.question.v1.DeleteQuestionTranslationRequest\x1a:.historyquiz.question.v1.DeleteQuestionTranslationResponse\x12\x8f\x01\n" +
+	"\x18ListQuestionTranslations\x128.historyquiz.question.v1.ListQuestionTranslationsRequest\x1a9.historyquiz.question.v1.ListQuestionTranslationsResponse\x12w\n" +
+	"\x10GetQuestionStats\x120.historyquiz.question.v1.GetQuestionStatsRequest\x1a1.historyquiz.question.v1.GetQuestionStatsResponse\x12k\n" +
+	"\fForkQuestion\x12,.historyquiz.question.v1.ForkQuestionRequest\x1a-.historyquiz.question.v1.ForkQuestionResponse\x12\x9e\x01\n" +
+	"\x1dGenerateQuestionsFromTemplate\x12=.historyquiz.question.v1.GenerateQuestionsFromTemplateRequest\x1a>.historyquiz.question.v1.GenerateQuestionsFromTemplateResponse\x12}\n" +
+	"\x12SuggestDistractors\x122.historyquiz.question.v1.SuggestDistractorsRequest\x1a3.historyquiz.question.v1.SuggestDistractorsResponse\x12n\n" +
+	"\rCreatePassage\x12-.historyquiz.question.v1.CreatePassageRequest\x1a..historyquiz.question.v1.CreatePassageResponse\x12n\n" +
+	"\rUpdatePassage\x12-.historyquiz.question.v1.UpdatePassageRequest\x1a..historyquiz.question.v1.UpdatePassageResponse\x12k\n" +
+	"\fGetMyPassage\x12,.historyquiz.question.v1.GetMyPassageRequest\x1a-.historyquiz.question.v1.GetMyPassageResponse\x12n\n" +
+	"\rDeletePassage\x12-.historyquiz.question.v1.DeletePassageRequest\x1a..historyquiz.question.v1.DeletePassageResponseBBZ@github.com/history-quiz/historyquiz/proto/question/v1;questionv1b\x06proto3"
 
 var (
 	file_historyquiz_question_v1_question_service_proto_rawDescOnce sync.Once
@@ -803,55 +5368,260 @@ func file_historyquiz_question_v1_question_service_proto_rawDescGZIP() []byte {
 	return file_historyquiz_question_v1_question_service_proto_rawDescData
 }
 
-var file_historyquiz_question_v1_question_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_historyquiz_question_v1_question_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_historyquiz_question_v1_question_service_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_historyquiz_question_v1_question_service_proto_goTypes = []any{
-	(*QuestionSummary)(nil),         // 0: historyquiz.question.v1.QuestionSummary
-	(*QuestionDetail)(nil),          // 1: historyquiz.question.v1.QuestionDetail
-	(*Choice)(nil),                  // 2: historyquiz.question.v1.Choice
-	(*QuestionDraft)(nil),           // 3: historyquiz.question.v1.QuestionDraft
-	(*CreateQuestionRequest)(nil),   // 4: historyquiz.question.v1.CreateQuestionRequest
-	(*CreateQuestionResponse)(nil),  // 5: historyquiz.question.v1.CreateQuestionResponse
-	(*UpdateQuestionRequest)(nil),   // 6: historyquiz.question.v1.UpdateQuestionRequest
-	(*UpdateQuestionResponse)(nil),  // 7: historyquiz.question.v1.UpdateQuestionResponse
-	(*GetMyQuestionRequest)(nil),    // 8: historyquiz.question.v1.GetMyQuestionRequest
-	(*GetMyQuestionResponse)(nil),   // 9: historyquiz.question.v1.GetMyQuestionResponse
-	(*ListMyQuestionsRequest)(nil),  // 10: historyquiz.question.v1.ListMyQuestionsRequest
-	(*ListMyQuestionsResponse)(nil), // 11: historyquiz.question.v1.ListMyQuestionsResponse
-	(*v1.RequestContext)(nil),       // 12: historyquiz.common.v1.RequestContext
-	(*v1.Pagination)(nil),           // 13: historyquiz.common.v1.Pagination
-	(*v1.PageInfo)(nil),             // 14: historyquiz.common.v1.PageInfo
+	(QuestionStatus)(0),                           // 0: historyquiz.question.v1.QuestionStatus
+	(QuestionKind)(0),                             // 1: historyquiz.question.v1.QuestionKind
+	(CitationKind)(0),                             // 2: historyquiz.question.v1.CitationKind
+	(QuestionSortKey)(0),                          // 3: historyquiz.question.v1.QuestionSortKey
+	(ExplanationFilter)(0),                        // 4: historyquiz.question.v1.ExplanationFilter
+	(QuestionFileFormat)(0),                       // 5: historyquiz.question.v1.QuestionFileFormat
+	(SearchField)(0),                              // 6: historyquiz.question.v1.SearchField
+	(QualityFlagKind)(0),                          // 7: historyquiz.question.v1.QualityFlagKind
+	(*QuestionSummary)(nil),                       // 8: historyquiz.question.v1.QuestionSummary
+	(*QuestionDetail)(nil),                        // 9: historyquiz.question.v1.QuestionDetail
+	(*QuestionSchedule)(nil),                      // 10: historyquiz.question.v1.QuestionSchedule
+	(*Choice)(nil),                                // 11: historyquiz.question.v1.Choice
+	(*QuestionDraft)(nil),                         // 12: historyquiz.question.v1.QuestionDraft
+	(*QuestionLocation)(nil),                      // 13: historyquiz.question.v1.QuestionLocation
+	(*AttachmentRef)(nil),                         // 14: historyquiz.question.v1.AttachmentRef
+	(*Citation)(nil),                              // 15: historyquiz.question.v1.Citation
+	(*CreateQuestionRequest)(nil),                 // 16: historyquiz.question.v1.CreateQuestionRequest
+	(*SimilarQuestion)(nil),                       // 17: historyquiz.question.v1.SimilarQuestion
+	(*CreateQuestionResponse)(nil),                // 18: historyquiz.question.v1.CreateQuestionResponse
+	(*UpdateQuestionRequest)(nil),                 // 19: historyquiz.question.v1.UpdateQuestionRequest
+	(*UpdateQuestionResponse)(nil),                // 20: historyquiz.question.v1.UpdateQuestionResponse
+	(*GetMyQuestionRequest)(nil),                  // 21: historyquiz.question.v1.GetMyQuestionRequest
+	(*GetMyQuestionResponse)(nil),                 // 22: historyquiz.question.v1.GetMyQuestionResponse
+	(*ListMyQuestionsRequest)(nil),                // 23: historyquiz.question.v1.ListMyQuestionsRequest
+	(*ListMyQuestionsResponse)(nil),               // 24: historyquiz.question.v1.ListMyQuestionsResponse
+	(*DeleteQuestionRequest)(nil),                 // 25: historyquiz.question.v1.DeleteQuestionRequest
+	(*DeleteQuestionResponse)(nil),                // 26: historyquiz.question.v1.DeleteQuestionResponse
+	(*PublishQuestionRequest)(nil),                // 27: historyquiz.question.v1.PublishQuestionRequest
+	(*PublishQuestionResponse)(nil),               // 28: historyquiz.question.v1.PublishQuestionResponse
+	(*UnpublishQuestionRequest)(nil),              // 29: historyquiz.question.v1.UnpublishQuestionRequest
+	(*UnpublishQuestionResponse)(nil),             // 30: historyquiz.question.v1.UnpublishQuestionResponse
+	(*ImportQuestionsRequest)(nil),                // 31: historyquiz.question.v1.ImportQuestionsRequest
+	(*ImportRowError)(nil),                        // 32: historyquiz.question.v1.ImportRowError
+	(*ImportQuestionsResponse)(nil),               // 33: historyquiz.question.v1.ImportQuestionsResponse
+	(*ExportMyQuestionsRequest)(nil),              // 34: historyquiz.question.v1.ExportMyQuestionsRequest
+	(*ExportMyQuestionsResponse)(nil),             // 35: historyquiz.question.v1.ExportMyQuestionsResponse
+	(*SearchQuestionsRequest)(nil),                // 36: historyquiz.question.v1.SearchQuestionsRequest
+	(*SearchSnippetSegment)(nil),                  // 37: historyquiz.question.v1.SearchSnippetSegment
+	(*SearchSnippet)(nil),                         // 38: historyquiz.question.v1.SearchSnippet
+	(*QuestionSearchHit)(nil),                     // 39: historyquiz.question.v1.QuestionSearchHit
+	(*SearchQuestionsResponse)(nil),               // 40: historyquiz.question.v1.SearchQuestionsResponse
+	(*QuestionTranslation)(nil),                   // 41: historyquiz.question.v1.QuestionTranslation
+	(*UpsertQuestionTranslationRequest)(nil),      // 42: historyquiz.question.v1.UpsertQuestionTranslationRequest
+	(*UpsertQuestionTranslationResponse)(nil),     // 43: historyquiz.question.v1.UpsertQuestionTranslationResponse
+	(*DeleteQuestionTranslationRequest)(nil),      // 44: historyquiz.question.v1.DeleteQuestionTranslationRequest
+	(*DeleteQuestionTranslationResponse)(nil),     // 45: historyquiz.question.v1.DeleteQuestionTranslationResponse
+	(*ListQuestionTranslationsRequest)(nil),       // 46: historyquiz.question.v1.ListQuestionTranslationsRequest
+	(*ListQuestionTranslationsResponse)(nil),      // 47: historyquiz.question.v1.ListQuestionTranslationsResponse
+	(*GetQuestionStatsRequest)(nil),               // 48: historyquiz.question.v1.GetQuestionStatsRequest
+	(*ChoiceStats)(nil),                           // 49: historyquiz.question.v1.ChoiceStats
+	(*StatsBucket)(nil),                           // 50: historyquiz.question.v1.StatsBucket
+	(*QualityFlag)(nil),                           // 51: historyquiz.question.v1.QualityFlag
+	(*QuestionStats)(nil),                         // 52: historyquiz.question.v1.QuestionStats
+	(*GetQuestionStatsResponse)(nil),              // 53: historyquiz.question.v1.GetQuestionStatsResponse
+	(*ForkQuestionRequest)(nil),                   // 54: historyquiz.question.v1.ForkQuestionRequest
+	(*ForkQuestionResponse)(nil),                  // 55: historyquiz.question.v1.ForkQuestionResponse
+	(*QuestionTemplate)(nil),                      // 56: historyquiz.question.v1.QuestionTemplate
+	(*GenerateQuestionsFromTemplateRequest)(nil),  // 57: historyquiz.question.v1.GenerateQuestionsFromTemplateRequest
+	(*TemplatePreview)(nil),                       // 58: historyquiz.question.v1.TemplatePreview
+	(*GenerateQuestionsFromTemplateResponse)(nil), // 59: historyquiz.question.v1.GenerateQuestionsFromTemplateResponse
+	(*SuggestDistractorsRequest)(nil),             // 60: historyquiz.question.v1.SuggestDistractorsRequest
+	(*DistractorSuggestion)(nil),                  // 61: historyquiz.question.v1.DistractorSuggestion
+	(*SuggestDistractorsResponse)(nil),            // 62: historyquiz.question.v1.SuggestDistractorsResponse
+	(*Passage)(nil),                               // 63: historyquiz.question.v1.Passage
+	(*PassageDraft)(nil),                          // 64: historyquiz.question.v1.PassageDraft
+	(*PassageQuestionDraft)(nil),                  // 65: historyquiz.question.v1.PassageQuestionDraft
+	(*PassageDetail)(nil),                         // 66: historyquiz.question.v1.PassageDetail
+	(*CreatePassageRequest)(nil),                  // 67: historyquiz.question.v1.CreatePassageRequest
+	(*CreatePassageResponse)(nil),                 // 68: historyquiz.question.v1.CreatePassageResponse
+	(*UpdatePassageRequest)(nil),                  // 69: historyquiz.question.v1.UpdatePassageRequest
+	(*UpdatePassageResponse)(nil),                 // 70: historyquiz.question.v1.UpdatePassageResponse
+	(*GetMyPassageRequest)(nil),                   // 71: historyquiz.question.v1.GetMyPassageRequest
+	(*GetMyPassageResponse)(nil),                  // 72: historyquiz.question.v1.GetMyPassageResponse
+	(*DeletePassageRequest)(nil),                  // 73: historyquiz.question.v1.DeletePassageRequest
+	(*DeletePassageResponse)(nil),                 // 74: historyquiz.question.v1.DeletePassageResponse
+	(*v1.QuestionAttachment)(nil),                 // 75: historyquiz.attachment.v1.QuestionAttachment
+	(*v11.RichText)(nil),                          // 76: historyquiz.common.v1.RichText
+	(*v11.LatLng)(nil),                            // 77: historyquiz.common.v1.LatLng
+	(*v11.RequestContext)(nil),                    // 78: historyquiz.common.v1.RequestContext
+	(*v11.Pagination)(nil),                        // 79: historyquiz.common.v1.Pagination
+	(*v11.PageInfo)(nil),                          // 80: historyquiz.common.v1.PageInfo
+	(*v11.FieldViolation)(nil),                    // 81: historyquiz.common.v1.FieldViolation
 }
 var file_historyquiz_question_v1_question_service_proto_depIdxs = []int32{
-	2,  // 0: historyquiz.question.v1.QuestionDetail.choices:type_name -> historyquiz.question.v1.Choice
-	12, // 1: historyquiz.question.v1.CreateQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
-	3,  // 2: historyquiz.question.v1.CreateQuestionRequest.draft:type_name -> historyquiz.question.v1.QuestionDraft
-	12, // 3: historyquiz.question.v1.CreateQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
-	1,  // 4: historyquiz.question.v1.CreateQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
-	12, // 5: historyquiz.question.v1.UpdateQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
-	3,  // 6: historyquiz.question.v1.UpdateQuestionRequest.draft:type_name -> historyquiz.question.v1.QuestionDraft
-	12, // 7: historyquiz.question.v1.UpdateQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
-	1,  // 8: historyquiz.question.v1.UpdateQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
-	12, // 9: historyquiz.question.v1.GetMyQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
-	12, // 10: historyquiz.question.v1.GetMyQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
-	1,  // 11: historyquiz.question.v1.GetMyQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
-	12, // 12: historyquiz.question.v1.ListMyQuestionsRequest.context:type_name -> historyquiz.common.v1.RequestContext
-	13, // 13: historyquiz.question.v1.ListMyQuestionsRequest.pagination:type_name -> historyquiz.common.v1.Pagination
-	12, // 14: historyquiz.question.v1.ListMyQuestionsResponse.context:type_name -> historyquiz.common.v1.RequestContext
-	0,  // 15: historyquiz.question.v1.ListMyQuestionsResponse.questions:type_name -> historyquiz.question.v1.QuestionSummary
-	14, // 16: historyquiz.question.v1.ListMyQuestionsResponse.page_info:type_name -> historyquiz.common.v1.PageInfo
-	4,  // 17: historyquiz.question.v1.QuestionService.CreateQuestion:input_type -> historyquiz.question.v1.CreateQuestionRequest
-	6,  // 18: historyquiz.question.v1.QuestionService.UpdateQuestion:input_type -> historyquiz.question.v1.UpdateQuestionRequest
-	8,  // 19: historyquiz.question.v1.QuestionService.GetMyQuestion:input_type -> historyquiz.question.v1.GetMyQuestionRequest
-	10, // 20: historyquiz.question.v1.QuestionService.ListMyQuestions:input_type -> historyquiz.question.v1.ListMyQuestionsRequest
-	5,  // 21: historyquiz.question.v1.QuestionService.CreateQuestion:output_type -> historyquiz.question.v1.CreateQuestionResponse
-	7,  // 22: historyquiz.question.v1.QuestionService.UpdateQuestion:output_type -> historyquiz.question.v1.UpdateQuestionResponse
-	9,  // 23: historyquiz.question.v1.QuestionService.GetMyQuestion:output_type -> historyquiz.question.v1.GetMyQuestionResponse
-	11, // 24: historyquiz.question.v1.QuestionService.ListMyQuestions:output_type -> historyquiz.question.v1.ListMyQuestionsResponse
-	21, // [21:25] is the sub-list for method output_type
-	17, // [17:21] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	0,   // 0: historyquiz.question.v1.QuestionSummary.status:type_name -> historyquiz.question.v1.QuestionStatus
+	11,  // 1: historyquiz.question.v1.QuestionDetail.choices:type_name -> historyquiz.question.v1.Choice
+	0,   // 2: historyquiz.question.v1.QuestionDetail.status:type_name -> historyquiz.question.v1.QuestionStatus
+	75,  // 3: historyquiz.question.v1.QuestionDetail.attachments:type_name -> historyquiz.attachment.v1.QuestionAttachment
+	15,  // 4: historyquiz.question.v1.QuestionDetail.citations:type_name -> historyquiz.question.v1.Citation
+	76,  // 5: historyquiz.question.v1.QuestionDetail.prompt_rich:type_name -> historyquiz.common.v1.RichText
+	76,  // 6: historyquiz.question.v1.QuestionDetail.explanation_rich:type_name -> historyquiz.common.v1.RichText
+	10,  // 7: historyquiz.question.v1.QuestionDetail.schedule:type_name -> historyquiz.question.v1.QuestionSchedule
+	13,  // 8: historyquiz.question.v1.QuestionDetail.location:type_name -> historyquiz.question.v1.QuestionLocation
+	1,   // 9: historyquiz.question.v1.QuestionDetail.kind:type_name -> historyquiz.question.v1.QuestionKind
+	14,  // 10: historyquiz.question.v1.QuestionDraft.attachments:type_name -> historyquiz.question.v1.AttachmentRef
+	15,  // 11: historyquiz.question.v1.QuestionDraft.citations:type_name -> historyquiz.question.v1.Citation
+	13,  // 12: historyquiz.question.v1.QuestionDraft.location:type_name -> historyquiz.question.v1.QuestionLocation
+	1,   // 13: historyquiz.question.v1.QuestionDraft.kind:type_name -> historyquiz.question.v1.QuestionKind
+	77,  // 14: historyquiz.question.v1.QuestionLocation.point:type_name -> historyquiz.common.v1.LatLng
+	2,   // 15: historyquiz.question.v1.Citation.kind:type_name -> historyquiz.question.v1.CitationKind
+	78,  // 16: historyquiz.question.v1.CreateQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	12,  // 17: historyquiz.question.v1.CreateQuestionRequest.draft:type_name -> historyquiz.question.v1.QuestionDraft
+	0,   // 18: historyquiz.question.v1.SimilarQuestion.status:type_name -> historyquiz.question.v1.QuestionStatus
+	78,  // 19: historyquiz.question.v1.CreateQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	9,   // 20: historyquiz.question.v1.CreateQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
+	17,  // 21: historyquiz.question.v1.CreateQuestionResponse.similar_questions:type_name -> historyquiz.question.v1.SimilarQuestion
+	78,  // 22: historyquiz.question.v1.UpdateQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	12,  // 23: historyquiz.question.v1.UpdateQuestionRequest.draft:type_name -> historyquiz.question.v1.QuestionDraft
+	10,  // 24: historyquiz.question.v1.UpdateQuestionRequest.schedule:type_name -> historyquiz.question.v1.QuestionSchedule
+	78,  // 25: historyquiz.question.v1.UpdateQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	9,   // 26: historyquiz.question.v1.UpdateQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
+	17,  // 27: historyquiz.question.v1.UpdateQuestionResponse.similar_questions:type_name -> historyquiz.question.v1.SimilarQuestion
+	78,  // 28: historyquiz.question.v1.GetMyQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 29: historyquiz.question.v1.GetMyQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	9,   // 30: historyquiz.question.v1.GetMyQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
+	78,  // 31: historyquiz.question.v1.ListMyQuestionsRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	79,  // 32: historyquiz.question.v1.ListMyQuestionsRequest.pagination:type_name -> historyquiz.common.v1.Pagination
+	0,   // 33: historyquiz.question.v1.ListMyQuestionsRequest.statuses:type_name -> historyquiz.question.v1.QuestionStatus
+	4,   // 34: historyquiz.question.v1.ListMyQuestionsRequest.explanation:type_name -> historyquiz.question.v1.ExplanationFilter
+	3,   // 35: historyquiz.question.v1.ListMyQuestionsRequest.sort:type_name -> historyquiz.question.v1.QuestionSortKey
+	78,  // 36: historyquiz.question.v1.ListMyQuestionsResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	8,   // 37: historyquiz.question.v1.ListMyQuestionsResponse.questions:type_name -> historyquiz.question.v1.QuestionSummary
+	80,  // 38: historyquiz.question.v1.ListMyQuestionsResponse.page_info:type_name -> historyquiz.common.v1.PageInfo
+	78,  // 39: historyquiz.question.v1.DeleteQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 40: historyquiz.question.v1.DeleteQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 41: historyquiz.question.v1.PublishQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 42: historyquiz.question.v1.PublishQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	9,   // 43: historyquiz.question.v1.PublishQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
+	78,  // 44: historyquiz.question.v1.UnpublishQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	0,   // 45: historyquiz.question.v1.UnpublishQuestionRequest.target_status:type_name -> historyquiz.question.v1.QuestionStatus
+	78,  // 46: historyquiz.question.v1.UnpublishQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	9,   // 47: historyquiz.question.v1.UnpublishQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
+	78,  // 48: historyquiz.question.v1.ImportQuestionsRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	5,   // 49: historyquiz.question.v1.ImportQuestionsRequest.format:type_name -> historyquiz.question.v1.QuestionFileFormat
+	81,  // 50: historyquiz.question.v1.ImportRowError.field_violations:type_name -> historyquiz.common.v1.FieldViolation
+	78,  // 51: historyquiz.question.v1.ImportQuestionsResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	32,  // 52: historyquiz.question.v1.ImportQuestionsResponse.row_errors:type_name -> historyquiz.question.v1.ImportRowError
+	8,   // 53: historyquiz.question.v1.ImportQuestionsResponse.questions:type_name -> historyquiz.question.v1.QuestionSummary
+	78,  // 54: historyquiz.question.v1.ExportMyQuestionsRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	5,   // 55: historyquiz.question.v1.ExportMyQuestionsRequest.format:type_name -> historyquiz.question.v1.QuestionFileFormat
+	78,  // 56: historyquiz.question.v1.ExportMyQuestionsResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 57: historyquiz.question.v1.SearchQuestionsRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	79,  // 58: historyquiz.question.v1.SearchQuestionsRequest.pagination:type_name -> historyquiz.common.v1.Pagination
+	0,   // 59: historyquiz.question.v1.SearchQuestionsRequest.statuses:type_name -> historyquiz.question.v1.QuestionStatus
+	6,   // 60: historyquiz.question.v1.SearchSnippet.field:type_name -> historyquiz.question.v1.SearchField
+	37,  // 61: historyquiz.question.v1.SearchSnippet.segments:type_name -> historyquiz.question.v1.SearchSnippetSegment
+	8,   // 62: historyquiz.question.v1.QuestionSearchHit.question:type_name -> historyquiz.question.v1.QuestionSummary
+	38,  // 63: historyquiz.question.v1.QuestionSearchHit.snippets:type_name -> historyquiz.question.v1.SearchSnippet
+	78,  // 64: historyquiz.question.v1.SearchQuestionsResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	39,  // 65: historyquiz.question.v1.SearchQuestionsResponse.hits:type_name -> historyquiz.question.v1.QuestionSearchHit
+	80,  // 66: historyquiz.question.v1.SearchQuestionsResponse.page_info:type_name -> historyquiz.common.v1.PageInfo
+	78,  // 67: historyquiz.question.v1.UpsertQuestionTranslationRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	41,  // 68: historyquiz.question.v1.UpsertQuestionTranslationRequest.translation:type_name -> historyquiz.question.v1.QuestionTranslation
+	78,  // 69: historyquiz.question.v1.UpsertQuestionTranslationResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	41,  // 70: historyquiz.question.v1.UpsertQuestionTranslationResponse.translation:type_name -> historyquiz.question.v1.QuestionTranslation
+	78,  // 71: historyquiz.question.v1.DeleteQuestionTranslationRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 72: historyquiz.question.v1.DeleteQuestionTranslationResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 73: historyquiz.question.v1.ListQuestionTranslationsRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 74: historyquiz.question.v1.ListQuestionTranslationsResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	41,  // 75: historyquiz.question.v1.ListQuestionTranslationsResponse.translations:type_name -> historyquiz.question.v1.QuestionTranslation
+	78,  // 76: historyquiz.question.v1.GetQuestionStatsRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	7,   // 77: historyquiz.question.v1.QualityFlag.kind:type_name -> historyquiz.question.v1.QualityFlagKind
+	49,  // 78: historyquiz.question.v1.QuestionStats.choices:type_name -> historyquiz.question.v1.ChoiceStats
+	50,  // 79: historyquiz.question.v1.QuestionStats.trend:type_name -> historyquiz.question.v1.StatsBucket
+	51,  // 80: historyquiz.question.v1.QuestionStats.flags:type_name -> historyquiz.question.v1.QualityFlag
+	78,  // 81: historyquiz.question.v1.GetQuestionStatsResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	52,  // 82: historyquiz.question.v1.GetQuestionStatsResponse.stats:type_name -> historyquiz.question.v1.QuestionStats
+	78,  // 83: historyquiz.question.v1.ForkQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 84: historyquiz.question.v1.ForkQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	9,   // 85: historyquiz.question.v1.ForkQuestionResponse.question:type_name -> historyquiz.question.v1.QuestionDetail
+	78,  // 86: historyquiz.question.v1.GenerateQuestionsFromTemplateRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	56,  // 87: historyquiz.question.v1.GenerateQuestionsFromTemplateRequest.template:type_name -> historyquiz.question.v1.QuestionTemplate
+	5,   // 88: historyquiz.question.v1.GenerateQuestionsFromTemplateRequest.format:type_name -> historyquiz.question.v1.QuestionFileFormat
+	12,  // 89: historyquiz.question.v1.TemplatePreview.draft:type_name -> historyquiz.question.v1.QuestionDraft
+	78,  // 90: historyquiz.question.v1.GenerateQuestionsFromTemplateResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	32,  // 91: historyquiz.question.v1.GenerateQuestionsFromTemplateResponse.row_errors:type_name -> historyquiz.question.v1.ImportRowError
+	58,  // 92: historyquiz.question.v1.GenerateQuestionsFromTemplateResponse.previews:type_name -> historyquiz.question.v1.TemplatePreview
+	8,   // 93: historyquiz.question.v1.GenerateQuestionsFromTemplateResponse.questions:type_name -> historyquiz.question.v1.QuestionSummary
+	32,  // 94: historyquiz.question.v1.GenerateQuestionsFromTemplateResponse.skipped:type_name -> historyquiz.question.v1.ImportRowError
+	78,  // 95: historyquiz.question.v1.SuggestDistractorsRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 96: historyquiz.question.v1.SuggestDistractorsResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	61,  // 97: historyquiz.question.v1.SuggestDistractorsResponse.suggestions:type_name -> historyquiz.question.v1.DistractorSuggestion
+	15,  // 98: historyquiz.question.v1.Passage.source:type_name -> historyquiz.question.v1.Citation
+	75,  // 99: historyquiz.question.v1.Passage.attachment:type_name -> historyquiz.attachment.v1.QuestionAttachment
+	76,  // 100: historyquiz.question.v1.Passage.body_rich:type_name -> historyquiz.common.v1.RichText
+	15,  // 101: historyquiz.question.v1.PassageDraft.source:type_name -> historyquiz.question.v1.Citation
+	14,  // 102: historyquiz.question.v1.PassageDraft.attachment:type_name -> historyquiz.question.v1.AttachmentRef
+	65,  // 103: historyquiz.question.v1.PassageDraft.questions:type_name -> historyquiz.question.v1.PassageQuestionDraft
+	12,  // 104: historyquiz.question.v1.PassageQuestionDraft.draft:type_name -> historyquiz.question.v1.QuestionDraft
+	63,  // 105: historyquiz.question.v1.PassageDetail.passage:type_name -> historyquiz.question.v1.Passage
+	9,   // 106: historyquiz.question.v1.PassageDetail.questions:type_name -> historyquiz.question.v1.QuestionDetail
+	78,  // 107: historyquiz.question.v1.CreatePassageRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	64,  // 108: historyquiz.question.v1.CreatePassageRequest.draft:type_name -> historyquiz.question.v1.PassageDraft
+	78,  // 109: historyquiz.question.v1.CreatePassageResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	66,  // 110: historyquiz.question.v1.CreatePassageResponse.passage:type_name -> historyquiz.question.v1.PassageDetail
+	78,  // 111: historyquiz.question.v1.UpdatePassageRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	64,  // 112: historyquiz.question.v1.UpdatePassageRequest.draft:type_name -> historyquiz.question.v1.PassageDraft
+	78,  // 113: historyquiz.question.v1.UpdatePassageResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	66,  // 114: historyquiz.question.v1.UpdatePassageResponse.passage:type_name -> historyquiz.question.v1.PassageDetail
+	78,  // 115: historyquiz.question.v1.GetMyPassageRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 116: historyquiz.question.v1.GetMyPassageResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	66,  // 117: historyquiz.question.v1.GetMyPassageResponse.passage:type_name -> historyquiz.question.v1.PassageDetail
+	78,  // 118: historyquiz.question.v1.DeletePassageRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	78,  // 119: historyquiz.question.v1.DeletePassageResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	16,  // 120: historyquiz.question.v1.QuestionService.CreateQuestion:input_type -> historyquiz.question.v1.CreateQuestionRequest
+	19,  // 121: historyquiz.question.v1.QuestionService.UpdateQuestion:input_type -> historyquiz.question.v1.UpdateQuestionRequest
+	21,  // 122: historyquiz.question.v1.QuestionService.GetMyQuestion:input_type -> historyquiz.question.v1.GetMyQuestionRequest
+	23,  // 123: historyquiz.question.v1.QuestionService.ListMyQuestions:input_type -> historyquiz.question.v1.ListMyQuestionsRequest
+	25,  // 124: historyquiz.question.v1.QuestionService.DeleteQuestion:input_type -> historyquiz.question.v1.DeleteQuestionRequest
+	27,  // 125: historyquiz.question.v1.QuestionService.PublishQuestion:input_type -> historyquiz.question.v1.PublishQuestionRequest
+	29,  // 126: historyquiz.question.v1.QuestionService.UnpublishQuestion:input_type -> historyquiz.question.v1.UnpublishQuestionRequest
+	31,  // 127: historyquiz.question.v1.QuestionService.ImportQuestions:input_type -> historyquiz.question.v1.ImportQuestionsRequest
+	34,  // 128: historyquiz.question.v1.QuestionService.ExportMyQuestions:input_type -> historyquiz.question.v1.ExportMyQuestionsRequest
+	36,  // 129: historyquiz.question.v1.QuestionService.SearchQuestions:input_type -> historyquiz.question.v1.SearchQuestionsRequest
+	42,  // 130: historyquiz.question.v1.QuestionService.UpsertQuestionTranslation:input_type -> historyquiz.question.v1.UpsertQuestionTranslationRequest
+	44,  // 131: historyquiz.question.v1.QuestionService.DeleteQuestionTranslation:input_type -> historyquiz.question.v1.DeleteQuestionTranslationRequest
+	46,  // 132: historyquiz.question.v1.QuestionService.ListQuestionTranslations:input_type -> historyquiz.question.v1.ListQuestionTranslationsRequest
+	48,  // 133: historyquiz.question.v1.QuestionService.GetQuestionStats:input_type -> historyquiz.question.v1.GetQuestionStatsRequest
+	54,  // 134: historyquiz.question.v1.QuestionService.ForkQuestion:input_type -> historyquiz.question.v1.ForkQuestionRequest
+	57,  // 135: historyquiz.question.v1.QuestionService.GenerateQuestionsFromTemplate:input_type -> historyquiz.question.v1.GenerateQuestionsFromTemplateRequest
+	60,  // 136: historyquiz.question.v1.QuestionService.SuggestDistractors:input_type -> historyquiz.question.v1.SuggestDistractorsRequest
+	67,  // 137: historyquiz.question.v1.QuestionService.CreatePassage:input_type -> historyquiz.question.v1.CreatePassageRequest
+	69,  // 138: historyquiz.question.v1.QuestionService.UpdatePassage:input_type -> historyquiz.question.v1.UpdatePassageRequest
+	71,  // 139: historyquiz.question.v1.QuestionService.GetMyPassage:input_type -> historyquiz.question.v1.GetMyPassageRequest
+	73,  // 140: historyquiz.question.v1.QuestionService.DeletePassage:input_type -> historyquiz.question.v1.DeletePassageRequest
+	18,  // 141: historyquiz.question.v1.QuestionService.CreateQuestion:output_type -> historyquiz.question.v1.CreateQuestionResponse
+	20,  // 142: historyquiz.question.v1.QuestionService.UpdateQuestion:output_type -> historyquiz.question.v1.UpdateQuestionResponse
+	22,  // 143: historyquiz.question.v1.QuestionService.GetMyQuestion:output_type -> historyquiz.question.v1.GetMyQuestionResponse
+	24,  // 144: historyquiz.question.v1.QuestionService.ListMyQuestions:output_type -> historyquiz.question.v1.ListMyQuestionsResponse
+	26,  // 145: historyquiz.question.v1.QuestionService.DeleteQuestion:output_type -> historyquiz.question.v1.DeleteQuestionResponse
+	28,  // 146: historyquiz.question.v1.QuestionService.PublishQuestion:output_type -> historyquiz.question.v1.PublishQuestionResponse
+	30,  // 147: historyquiz.question.v1.QuestionService.UnpublishQuestion:output_type -> historyquiz.question.v1.UnpublishQuestionResponse
+	33,  // 148: historyquiz.question.v1.QuestionService.ImportQuestions:output_type -> historyquiz.question.v1.ImportQuestionsResponse
+	35,  // 149: historyquiz.question.v1.QuestionService.ExportMyQuestions:output_type -> historyquiz.question.v1.ExportMyQuestionsResponse
+	40,  // 150: historyquiz.question.v1.QuestionService.SearchQuestions:output_type -> historyquiz.question.v1.SearchQuestionsResponse
+	43,  // 151: historyquiz.question.v1.QuestionService.UpsertQuestionTranslation:output_type -> historyquiz.question.v1.UpsertQuestionTranslationResponse
+	45,  // 152: historyquiz.question.v1.QuestionService.DeleteQuestionTranslation:output_type -> historyquiz.question.v1.DeleteQuestionTranslationResponse
+	47,  // 153: historyquiz.question.v1.QuestionService.ListQuestionTranslations:output_type -> historyquiz.question.v1.ListQuestionTranslationsResponse
+	53,  // 154: historyquiz.question.v1.QuestionService.GetQuestionStats:output_type -> historyquiz.question.v1.GetQuestionStatsResponse
+	55,  // 155: historyquiz.question.v1.QuestionService.ForkQuestion:output_type -> historyquiz.question.v1.ForkQuestionResponse
+	59,  // 156: historyquiz.question.v1.QuestionService.GenerateQuestionsFromTemplate:output_type -> historyquiz.question.v1.GenerateQuestionsFromTemplateResponse
+	62,  // 157: historyquiz.question.v1.QuestionService.SuggestDistractors:output_type -> historyquiz.question.v1.SuggestDistractorsResponse
+	68,  // 158: historyquiz.question.v1.QuestionService.CreatePassage:output_type -> historyquiz.question.v1.CreatePassageResponse
+	70,  // 159: historyquiz.question.v1.QuestionService.UpdatePassage:output_type -> historyquiz.question.v1.UpdatePassageResponse
+	72,  // 160: historyquiz.question.v1.QuestionService.GetMyPassage:output_type -> historyquiz.question.v1.GetMyPassageResponse
+	74,  // 161: historyquiz.question.v1.QuestionService.DeletePassage:output_type -> historyquiz.question.v1.DeletePassageResponse
+	141, // [141:162] is the sub-list for method output_type
+	120, // [120:141] is the sub-list for method input_type
+	120, // [120:120] is the sub-list for extension type_name
+	120, // [120:120] is the sub-list for extension extendee
+	0,   // [0:120] is the sub-list for field type_name
 }
 
 func init() { file_historyquiz_question_v1_question_service_proto_init() }
@@ -864,13 +5634,14 @@ func file_historyquiz_question_v1_question_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_historyquiz_question_v1_question_service_proto_rawDesc), len(file_historyquiz_question_v1_question_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      8,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_historyquiz_question_v1_question_service_proto_goTypes,
 		DependencyIndexes: file_historyquiz_question_v1_question_service_proto_depIdxs,
+		EnumInfos:         file_historyquiz_question_v1_question_service_proto_enumTypes,
 		MessageInfos:      file_historyquiz_question_v1_question_service_proto_msgTypes,
 	}.Build()
 	File_historyquiz_question_v1_question_service_proto = out.File
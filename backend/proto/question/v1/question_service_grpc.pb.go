@@ -19,10 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	QuestionService_CreateQuestion_FullMethodName  = "/historyquiz.question.v1.QuestionService/CreateQuestion"
-	QuestionService_UpdateQuestion_FullMethodName  = "/historyquiz.question.v1.QuestionService/UpdateQuestion"
-	QuestionService_GetMyQuestion_FullMethodName   = "/historyquiz.question.v1.QuestionService/GetMyQuestion"
-	QuestionService_ListMyQuestions_FullMethodName = "/historyquiz.question.v1.QuestionService/ListMyQuestions"
+	QuestionService_CreateQuestion_FullMethodName                = "/historyquiz.question.v1.QuestionService/CreateQuestion"
+	QuestionService_UpdateQuestion_FullMethodName                = "/historyquiz.question.v1.QuestionService/UpdateQuestion"
+	QuestionService_GetMyQuestion_FullMethodName                 = "/historyquiz.question.v1.QuestionService/GetMyQuestion"
+	QuestionService_ListMyQuestions_FullMethodName               = "/historyquiz.question.v1.QuestionService/ListMyQuestions"
+	QuestionService_DeleteQuestion_FullMethodName                = "/historyquiz.question.v1.QuestionService/DeleteQuestion"
+	QuestionService_PublishQuestion_FullMethodName               = "/historyquiz.question.v1.QuestionService/PublishQuestion"
+	QuestionService_UnpublishQuestion_FullMethodName             = "/historyquiz.question.v1.QuestionService/UnpublishQuestion"
+	QuestionService_ImportQuestions_FullMethodName               = "/historyquiz.question.v1.QuestionService/ImportQuestions"
+	QuestionService_ExportMyQuestions_FullMethodName             = "/historyquiz.question.v1.QuestionService/ExportMyQuestions"
+	QuestionService_SearchQuestions_FullMethodName               = "/historyquiz.question.v1.QuestionService/SearchQuestions"
+	QuestionService_UpsertQuestionTranslation_FullMethodName     = "/historyquiz.question.v1.QuestionService/UpsertQuestionTranslation"
+	QuestionService_DeleteQuestionTranslation_FullMethodName     = "/historyquiz.question.v1.QuestionService/DeleteQuestionTranslation"
+	QuestionService_ListQuestionTranslations_FullMethodName      = "/historyquiz.question.v1.QuestionService/ListQuestionTranslations"
+	QuestionService_GetQuestionStats_FullMethodName              = "/historyquiz.question.v1.QuestionService/GetQuestionStats"
+	QuestionService_ForkQuestion_FullMethodName                  = "/historyquiz.question.v1.QuestionService/ForkQuestion"
+	QuestionService_GenerateQuestionsFromTemplate_FullMethodName = "/historyquiz.question.v1.QuestionService/GenerateQuestionsFromTemplate"
+	QuestionService_SuggestDistractors_FullMethodName            = "/historyquiz.question.v1.QuestionService/SuggestDistractors"
+	QuestionService_CreatePassage_FullMethodName                 = "/historyquiz.question.v1.QuestionService/CreatePassage"
+	QuestionService_UpdatePassage_FullMethodName                 = "/historyquiz.question.v1.QuestionService/UpdatePassage"
+	QuestionService_GetMyPassage_FullMethodName                  = "/historyquiz.question.v1.QuestionService/GetMyPassage"
+	QuestionService_DeletePassage_FullMethodName                 = "/historyquiz.question.v1.QuestionService/DeletePassage"
 )
 
 // QuestionServiceClient is the client API for QuestionService service.
@@ -35,6 +52,47 @@ type QuestionServiceClient interface {
 	UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*UpdateQuestionResponse, error)
 	GetMyQuestion(ctx context.Context, in *GetMyQuestionRequest, opts ...grpc.CallOption) (*GetMyQuestionResponse, error)
 	ListMyQuestions(ctx context.Context, in *ListMyQuestionsRequest, opts ...grpc.CallOption) (*ListMyQuestionsResponse, error)
+	// 自分の問題を論理削除する。その問題だけが使っていた添付も削除扱いになる。
+	DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*DeleteQuestionResponse, error)
+	// 問題を公開し、クイズの出題候補に含める（所有者のみ）。
+	PublishQuestion(ctx context.Context, in *PublishQuestionRequest, opts ...grpc.CallOption) (*PublishQuestionResponse, error)
+	// 公開中の問題を限定公開/アーカイブに戻し、出題候補から外す（所有者のみ）。
+	UnpublishQuestion(ctx context.Context, in *UnpublishQuestionRequest, opts ...grpc.CallOption) (*UnpublishQuestionResponse, error)
+	// CSV/JSON から問題を一括作成する。1行でも不正があれば何も作成しない（全件 or 0件）。
+	// ほぼ同じ問題（similarity >= 0.9）が既にある行も、CreateQuestion と同じく不正な行（draft.prompt）として返す。
+	ImportQuestions(ctx context.Context, in *ImportQuestionsRequest, opts ...grpc.CallOption) (*ImportQuestionsResponse, error)
+	// 自分の問題をすべて書き出す。ファイルの内容を chunk に分けて順に返す（連結すると1ファイルになる）。
+	ExportMyQuestions(ctx context.Context, in *ExportMyQuestionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportMyQuestionsResponse], error)
+	// 問題文/選択肢/解説を全文検索する（作者は自分の問題のみ、管理者は全体）。
+	SearchQuestions(ctx context.Context, in *SearchQuestionsRequest, opts ...grpc.CallOption) (*SearchQuestionsResponse, error)
+	// 問題の翻訳（原文 "ja" 以外の言語）を作成/更新する（所有者のみ）。原文の現在の版に対する翻訳として保存する。
+	UpsertQuestionTranslation(ctx context.Context, in *UpsertQuestionTranslationRequest, opts ...grpc.CallOption) (*UpsertQuestionTranslationResponse, error)
+	// 問題の翻訳を削除する（所有者のみ）。
+	DeleteQuestionTranslation(ctx context.Context, in *DeleteQuestionTranslationRequest, opts ...grpc.CallOption) (*DeleteQuestionTranslationResponse, error)
+	// 問題の翻訳を一覧する（所有者のみ）。原文の更新後に直されていない翻訳は stale = true になる。
+	ListQuestionTranslations(ctx context.Context, in *ListQuestionTranslationsRequest, opts ...grpc.CallOption) (*ListQuestionTranslationsResponse, error)
+	// 自分の問題の回答統計（回答数、正答率、選択肢ごとの選択率、週ごとの推移、質の注意点）を返す（所有者のみ）。
+	GetQuestionStats(ctx context.Context, in *GetQuestionStatsRequest, opts ...grpc.CallOption) (*GetQuestionStatsResponse, error)
+	// 他のユーザーの公開中の問題を、自分の下書きとして複製する（選択肢/正解/別表記/解説/タグ/出典。添付は複製しない）。
+	// 複製は元の問題を origin_question_id で参照し続ける（元の問題が削除されても複製はそのまま使える）。
+	ForkQuestion(ctx context.Context, in *ForkQuestionRequest, opts ...grpc.CallOption) (*ForkQuestionResponse, error)
+	// テンプレートとデータセット（出来事/人物/年の CSV/JSON）から問題を生成し、1問ずつ作成する。
+	// 誤答は同じデータセットの年が近い行から選ぶ。1行でも不正があれば何も作成しない。ほぼ同じ問題が既にある行は作成しない（skipped）。
+	GenerateQuestionsFromTemplate(ctx context.Context, in *GenerateQuestionsFromTemplateRequest, opts ...grpc.CallOption) (*GenerateQuestionsFromTemplateResponse, error)
+	// 正解（と問題文/タグ）から、既存の問題の選択肢を誤答の候補として提案する（要ログイン）。
+	// 正解と同じ問題に並んだ選択肢、同じタグ（時代/地域）の問題の選択肢、似た問題文の選択肢を、
+	// 関連の強さと他の問題で誤答として選ばれた割合で順位付けする。外部のサービスは使わない。
+	SuggestDistractors(ctx context.Context, in *SuggestDistractorsRequest, opts ...grpc.CallOption) (*SuggestDistractorsResponse, error)
+	// 史料（一次史料の抜粋）と、それを共有する問題（最大10問）をまとめて作成する（要ログイン）。
+	// 史料と子の問題は1トランザクションで作成し、1問でも不正があれば何も作成しない。子の問題は下書きとして作られる。
+	CreatePassage(ctx context.Context, in *CreatePassageRequest, opts ...grpc.CallOption) (*CreatePassageResponse, error)
+	// 史料と子の問題をまとめて更新する（所有者のみ、1トランザクション）。
+	// 子の問題は draft.questions の並びで置き換える（question_id が空の問題は作成し、並びから外れた問題は削除する）。
+	UpdatePassage(ctx context.Context, in *UpdatePassageRequest, opts ...grpc.CallOption) (*UpdatePassageResponse, error)
+	// 自分の史料を子の問題付きで返す（他人の史料は NOT_FOUND）。
+	GetMyPassage(ctx context.Context, in *GetMyPassageRequest, opts ...grpc.CallOption) (*GetMyPassageResponse, error)
+	// 史料と子の問題をまとめて削除する（所有者のみ）。回答履歴は残る。
+	DeletePassage(ctx context.Context, in *DeletePassageRequest, opts ...grpc.CallOption) (*DeletePassageResponse, error)
 }
 
 type questionServiceClient struct {
@@ -85,6 +143,185 @@ func (c *questionServiceClient) ListMyQuestions(ctx context.Context, in *ListMyQ
 	return out, nil
 }
 
+func (c *questionServiceClient) DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*DeleteQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuestionResponse)
+	err := c.cc.Invoke(ctx, QuestionService_DeleteQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) PublishQuestion(ctx context.Context, in *PublishQuestionRequest, opts ...grpc.CallOption) (*PublishQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishQuestionResponse)
+	err := c.cc.Invoke(ctx, QuestionService_PublishQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) UnpublishQuestion(ctx context.Context, in *UnpublishQuestionRequest, opts ...grpc.CallOption) (*UnpublishQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpublishQuestionResponse)
+	err := c.cc.Invoke(ctx, QuestionService_UnpublishQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) ImportQuestions(ctx context.Context, in *ImportQuestionsRequest, opts ...grpc.CallOption) (*ImportQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportQuestionsResponse)
+	err := c.cc.Invoke(ctx, QuestionService_ImportQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) ExportMyQuestions(ctx context.Context, in *ExportMyQuestionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportMyQuestionsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuestionService_ServiceDesc.Streams[0], QuestionService_ExportMyQuestions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportMyQuestionsRequest, ExportMyQuestionsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuestionService_ExportMyQuestionsClient = grpc.ServerStreamingClient[ExportMyQuestionsResponse]
+
+func (c *questionServiceClient) SearchQuestions(ctx context.Context, in *SearchQuestionsRequest, opts ...grpc.CallOption) (*SearchQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchQuestionsResponse)
+	err := c.cc.Invoke(ctx, QuestionService_SearchQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) UpsertQuestionTranslation(ctx context.Context, in *UpsertQuestionTranslationRequest, opts ...grpc.CallOption) (*UpsertQuestionTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertQuestionTranslationResponse)
+	err := c.cc.Invoke(ctx, QuestionService_UpsertQuestionTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) DeleteQuestionTranslation(ctx context.Context, in *DeleteQuestionTranslationRequest, opts ...grpc.CallOption) (*DeleteQuestionTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuestionTranslationResponse)
+	err := c.cc.Invoke(ctx, QuestionService_DeleteQuestionTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) ListQuestionTranslations(ctx context.Context, in *ListQuestionTranslationsRequest, opts ...grpc.CallOption) (*ListQuestionTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuestionTranslationsResponse)
+	err := c.cc.Invoke(ctx, QuestionService_ListQuestionTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) GetQuestionStats(ctx context.Context, in *GetQuestionStatsRequest, opts ...grpc.CallOption) (*GetQuestionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestionStatsResponse)
+	err := c.cc.Invoke(ctx, QuestionService_GetQuestionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) ForkQuestion(ctx context.Context, in *ForkQuestionRequest, opts ...grpc.CallOption) (*ForkQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForkQuestionResponse)
+	err := c.cc.Invoke(ctx, QuestionService_ForkQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) GenerateQuestionsFromTemplate(ctx context.Context, in *GenerateQuestionsFromTemplateRequest, opts ...grpc.CallOption) (*GenerateQuestionsFromTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateQuestionsFromTemplateResponse)
+	err := c.cc.Invoke(ctx, QuestionService_GenerateQuestionsFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) SuggestDistractors(ctx context.Context, in *SuggestDistractorsRequest, opts ...grpc.CallOption) (*SuggestDistractorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestDistractorsResponse)
+	err := c.cc.Invoke(ctx, QuestionService_SuggestDistractors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) CreatePassage(ctx context.Context, in *CreatePassageRequest, opts ...grpc.CallOption) (*CreatePassageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePassageResponse)
+	err := c.cc.Invoke(ctx, QuestionService_CreatePassage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) UpdatePassage(ctx context.Context, in *UpdatePassageRequest, opts ...grpc.CallOption) (*UpdatePassageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePassageResponse)
+	err := c.cc.Invoke(ctx, QuestionService_UpdatePassage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) GetMyPassage(ctx context.Context, in *GetMyPassageRequest, opts ...grpc.CallOption) (*GetMyPassageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyPassageResponse)
+	err := c.cc.Invoke(ctx, QuestionService_GetMyPassage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) DeletePassage(ctx context.Context, in *DeletePassageRequest, opts ...grpc.CallOption) (*DeletePassageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePassageResponse)
+	err := c.cc.Invoke(ctx, QuestionService_DeletePassage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuestionServiceServer is the server API for QuestionService service.
 // All implementations must embed UnimplementedQuestionServiceServer
 // for forward compatibility.
@@ -95,6 +332,47 @@ type QuestionServiceServer interface {
 	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error)
 	GetMyQuestion(context.Context, *GetMyQuestionRequest) (*GetMyQuestionResponse, error)
 	ListMyQuestions(context.Context, *ListMyQuestionsRequest) (*ListMyQuestionsResponse, error)
+	// 自分の問題を論理削除する。その問題だけが使っていた添付も削除扱いになる。
+	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error)
+	// 問題を公開し、クイズの出題候補に含める（所有者のみ）。
+	PublishQuestion(context.Context, *PublishQuestionRequest) (*PublishQuestionResponse, error)
+	// 公開中の問題を限定公開/アーカイブに戻し、出題候補から外す（所有者のみ）。
+	UnpublishQuestion(context.Context, *UnpublishQuestionRequest) (*UnpublishQuestionResponse, error)
+	// CSV/JSON から問題を一括作成する。1行でも不正があれば何も作成しない（全件 or 0件）。
+	// ほぼ同じ問題（similarity >= 0.9）が既にある行も、CreateQuestion と同じく不正な行（draft.prompt）として返す。
+	ImportQuestions(context.Context, *ImportQuestionsRequest) (*ImportQuestionsResponse, error)
+	// 自分の問題をすべて書き出す。ファイルの内容を chunk に分けて順に返す（連結すると1ファイルになる）。
+	ExportMyQuestions(*ExportMyQuestionsRequest, grpc.ServerStreamingServer[ExportMyQuestionsResponse]) error
+	// 問題文/選択肢/解説を全文検索する（作者は自分の問題のみ、管理者は全体）。
+	SearchQuestions(context.Context, *SearchQuestionsRequest) (*SearchQuestionsResponse, error)
+	// 問題の翻訳（原文 "ja" 以外の言語）を作成/更新する（所有者のみ）。原文の現在の版に対する翻訳として保存する。
+	UpsertQuestionTranslation(context.Context, *UpsertQuestionTranslationRequest) (*UpsertQuestionTranslationResponse, error)
+	// 問題の翻訳を削除する（所有者のみ）。
+	DeleteQuestionTranslation(context.Context, *DeleteQuestionTranslationRequest) (*DeleteQuestionTranslationResponse, error)
+	// 問題の翻訳を一覧する（所有者のみ）。原文の更新後に直されていない翻訳は stale = true になる。
+	ListQuestionTranslations(context.Context, *ListQuestionTranslationsRequest) (*ListQuestionTranslationsResponse, error)
+	// 自分の問題の回答統計（回答数、正答率、選択肢ごとの選択率、週ごとの推移、質の注意点）を返す（所有者のみ）。
+	GetQuestionStats(context.Context, *GetQuestionStatsRequest) (*GetQuestionStatsResponse, error)
+	// 他のユーザーの公開中の問題を、自分の下書きとして複製する（選択肢/正解/別表記/解説/タグ/出典。添付は複製しない）。
+	// 複製は元の問題を origin_question_id で参照し続ける（元の問題が削除されても複製はそのまま使える）。
+	ForkQuestion(context.Context, *ForkQuestionRequest) (*ForkQuestionResponse, error)
+	// テンプレートとデータセット（出来事/人物/年の CSV/JSON）から問題を生成し、1問ずつ作成する。
+	// 誤答は同じデータセットの年が近い行から選ぶ。1行でも不正があれば何も作成しない。ほぼ同じ問題が既にある行は作成しない（skipped）。
+	GenerateQuestionsFromTemplate(context.Context, *GenerateQuestionsFromTemplateRequest) (*GenerateQuestionsFromTemplateResponse, error)
+	// 正解（と問題文/タグ）から、既存の問題の選択肢を誤答の候補として提案する（要ログイン）。
+	// 正解と同じ問題に並んだ選択肢、同じタグ（時代/地域）の問題の選択肢、似た問題文の選択肢を、
+	// 関連の強さと他の問題で誤答として選ばれた割合で順位付けする。外部のサービスは使わない。
+	SuggestDistractors(context.Context, *SuggestDistractorsRequest) (*SuggestDistractorsResponse, error)
+	// 史料（一次史料の抜粋）と、それを共有する問題（最大10問）をまとめて作成する（要ログイン）。
+	// 史料と子の問題は1トランザクションで作成し、1問でも不正があれば何も作成しない。子の問題は下書きとして作られる。
+	CreatePassage(context.Context, *CreatePassageRequest) (*CreatePassageResponse, error)
+	// 史料と子の問題をまとめて更新する（所有者のみ、1トランザクション）。
+	// 子の問題は draft.questions の並びで置き換える（question_id が空の問題は作成し、並びから外れた問題は削除する）。
+	UpdatePassage(context.Context, *UpdatePassageRequest) (*UpdatePassageResponse, error)
+	// 自分の史料を子の問題付きで返す（他人の史料は NOT_FOUND）。
+	GetMyPassage(context.Context, *GetMyPassageRequest) (*GetMyPassageResponse, error)
+	// 史料と子の問題をまとめて削除する（所有者のみ）。回答履歴は残る。
+	DeletePassage(context.Context, *DeletePassageRequest) (*DeletePassageResponse, error)
 	mustEmbedUnimplementedQuestionServiceServer()
 }
 
@@ -117,6 +395,57 @@ func (UnimplementedQuestionServiceServer) GetMyQuestion(context.Context, *GetMyQ
 func (UnimplementedQuestionServiceServer) ListMyQuestions(context.Context, *ListMyQuestionsRequest) (*ListMyQuestionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMyQuestions not implemented")
 }
+func (UnimplementedQuestionServiceServer) DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (UnimplementedQuestionServiceServer) PublishQuestion(context.Context, *PublishQuestionRequest) (*PublishQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishQuestion not implemented")
+}
+func (UnimplementedQuestionServiceServer) UnpublishQuestion(context.Context, *UnpublishQuestionRequest) (*UnpublishQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishQuestion not implemented")
+}
+func (UnimplementedQuestionServiceServer) ImportQuestions(context.Context, *ImportQuestionsRequest) (*ImportQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportQuestions not implemented")
+}
+func (UnimplementedQuestionServiceServer) ExportMyQuestions(*ExportMyQuestionsRequest, grpc.ServerStreamingServer[ExportMyQuestionsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMyQuestions not implemented")
+}
+func (UnimplementedQuestionServiceServer) SearchQuestions(context.Context, *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchQuestions not implemented")
+}
+func (UnimplementedQuestionServiceServer) UpsertQuestionTranslation(context.Context, *UpsertQuestionTranslationRequest) (*UpsertQuestionTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertQuestionTranslation not implemented")
+}
+func (UnimplementedQuestionServiceServer) DeleteQuestionTranslation(context.Context, *DeleteQuestionTranslationRequest) (*DeleteQuestionTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestionTranslation not implemented")
+}
+func (UnimplementedQuestionServiceServer) ListQuestionTranslations(context.Context, *ListQuestionTranslationsRequest) (*ListQuestionTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuestionTranslations not implemented")
+}
+func (UnimplementedQuestionServiceServer) GetQuestionStats(context.Context, *GetQuestionStatsRequest) (*GetQuestionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestionStats not implemented")
+}
+func (UnimplementedQuestionServiceServer) ForkQuestion(context.Context, *ForkQuestionRequest) (*ForkQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkQuestion not implemented")
+}
+func (UnimplementedQuestionServiceServer) GenerateQuestionsFromTemplate(context.Context, *GenerateQuestionsFromTemplateRequest) (*GenerateQuestionsFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateQuestionsFromTemplate not implemented")
+}
+func (UnimplementedQuestionServiceServer) SuggestDistractors(context.Context, *SuggestDistractorsRequest) (*SuggestDistractorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestDistractors not implemented")
+}
+func (UnimplementedQuestionServiceServer) CreatePassage(context.Context, *CreatePassageRequest) (*CreatePassageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePassage not implemented")
+}
+func (UnimplementedQuestionServiceServer) UpdatePassage(context.Context, *UpdatePassageRequest) (*UpdatePassageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassage not implemented")
+}
+func (UnimplementedQuestionServiceServer) GetMyPassage(context.Context, *GetMyPassageRequest) (*GetMyPassageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyPassage not implemented")
+}
+func (UnimplementedQuestionServiceServer) DeletePassage(context.Context, *DeletePassageRequest) (*DeletePassageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePassage not implemented")
+}
 func (UnimplementedQuestionServiceServer) mustEmbedUnimplementedQuestionServiceServer() {}
 func (UnimplementedQuestionServiceServer) testEmbeddedByValue()                         {}
 
@@ -210,6 +539,305 @@ func _QuestionService_ListMyQuestions_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuestionService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_DeleteQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_PublishQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).PublishQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_PublishQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).PublishQuestion(ctx, req.(*PublishQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_UnpublishQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).UnpublishQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_UnpublishQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).UnpublishQuestion(ctx, req.(*UnpublishQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_ImportQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).ImportQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_ImportQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).ImportQuestions(ctx, req.(*ImportQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_ExportMyQuestions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMyQuestionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuestionServiceServer).ExportMyQuestions(m, &grpc.GenericServerStream[ExportMyQuestionsRequest, ExportMyQuestionsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuestionService_ExportMyQuestionsServer = grpc.ServerStreamingServer[ExportMyQuestionsResponse]
+
+func _QuestionService_SearchQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).SearchQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_SearchQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).SearchQuestions(ctx, req.(*SearchQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_UpsertQuestionTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertQuestionTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).UpsertQuestionTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_UpsertQuestionTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).UpsertQuestionTranslation(ctx, req.(*UpsertQuestionTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_DeleteQuestionTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).DeleteQuestionTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_DeleteQuestionTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).DeleteQuestionTranslation(ctx, req.(*DeleteQuestionTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_ListQuestionTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuestionTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).ListQuestionTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_ListQuestionTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).ListQuestionTranslations(ctx, req.(*ListQuestionTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_GetQuestionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).GetQuestionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_GetQuestionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).GetQuestionStats(ctx, req.(*GetQuestionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_ForkQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).ForkQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_ForkQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).ForkQuestion(ctx, req.(*ForkQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_GenerateQuestionsFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateQuestionsFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).GenerateQuestionsFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_GenerateQuestionsFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).GenerateQuestionsFromTemplate(ctx, req.(*GenerateQuestionsFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_SuggestDistractors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestDistractorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).SuggestDistractors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_SuggestDistractors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).SuggestDistractors(ctx, req.(*SuggestDistractorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_CreatePassage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePassageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).CreatePassage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_CreatePassage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).CreatePassage(ctx, req.(*CreatePassageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_UpdatePassage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePassageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).UpdatePassage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_UpdatePassage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).UpdatePassage(ctx, req.(*UpdatePassageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_GetMyPassage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyPassageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).GetMyPassage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_GetMyPassage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).GetMyPassage(ctx, req.(*GetMyPassageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_DeletePassage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePassageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).DeletePassage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_DeletePassage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).DeletePassage(ctx, req.(*DeletePassageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuestionService_ServiceDesc is the grpc.ServiceDesc for QuestionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,7 +861,77 @@ var QuestionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMyQuestions",
 			Handler:    _QuestionService_ListMyQuestions_Handler,
 		},
+		{
+			MethodName: "DeleteQuestion",
+			Handler:    _QuestionService_DeleteQuestion_Handler,
+		},
+		{
+			MethodName: "PublishQuestion",
+			Handler:    _QuestionService_PublishQuestion_Handler,
+		},
+		{
+			MethodName: "UnpublishQuestion",
+			Handler:    _QuestionService_UnpublishQuestion_Handler,
+		},
+		{
+			MethodName: "ImportQuestions",
+			Handler:    _QuestionService_ImportQuestions_Handler,
+		},
+		{
+			MethodName: "SearchQuestions",
+			Handler:    _QuestionService_SearchQuestions_Handler,
+		},
+		{
+			MethodName: "UpsertQuestionTranslation",
+			Handler:    _QuestionService_UpsertQuestionTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteQuestionTranslation",
+			Handler:    _QuestionService_DeleteQuestionTranslation_Handler,
+		},
+		{
+			MethodName: "ListQuestionTranslations",
+			Handler:    _QuestionService_ListQuestionTranslations_Handler,
+		},
+		{
+			MethodName: "GetQuestionStats",
+			Handler:    _QuestionService_GetQuestionStats_Handler,
+		},
+		{
+			MethodName: "ForkQuestion",
+			Handler:    _QuestionService_ForkQuestion_Handler,
+		},
+		{
+			MethodName: "GenerateQuestionsFromTemplate",
+			Handler:    _QuestionService_GenerateQuestionsFromTemplate_Handler,
+		},
+		{
+			MethodName: "SuggestDistractors",
+			Handler:    _QuestionService_SuggestDistractors_Handler,
+		},
+		{
+			MethodName: "CreatePassage",
+			Handler:    _QuestionService_CreatePassage_Handler,
+		},
+		{
+			MethodName: "UpdatePassage",
+			Handler:    _QuestionService_UpdatePassage_Handler,
+		},
+		{
+			MethodName: "GetMyPassage",
+			Handler:    _QuestionService_GetMyPassage_Handler,
+		},
+		{
+			MethodName: "DeletePassage",
+			Handler:    _QuestionService_DeletePassage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMyQuestions",
+			Handler:       _QuestionService_ExportMyQuestions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "historyquiz/question/v1/question_service.proto",
 }
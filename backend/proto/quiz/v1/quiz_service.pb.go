@@ -7,7 +7,10 @@
 package quizv1
 
 import (
-	v1 "github.com/history-quiz/historyquiz/proto/common/v1"
+	v1 "github.com/history-quiz/historyquiz/proto/attachment/v1"
+	v11 "github.com/history-quiz/historyquiz/proto/common/v1"
+	v13 "github.com/history-quiz/historyquiz/proto/entity/v1"
+	v12 "github.com/history-quiz/historyquiz/proto/question/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,6 +25,118 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 地図モードの距離の帯（許容半径の倍数）。
+type LocationBand int32
+
+const (
+	LocationBand_LOCATION_BAND_UNSPECIFIED LocationBand = 0
+	LocationBand_LOCATION_BAND_WITHIN      LocationBand = 1 // 許容半径以内（正解、100 点）
+	LocationBand_LOCATION_BAND_NEAR        LocationBand = 2 // 2 倍以内（70 点）
+	LocationBand_LOCATION_BAND_REGION      LocationBand = 3 // 5 倍以内（40 点）
+	LocationBand_LOCATION_BAND_FAR         LocationBand = 4 // 10 倍以内（10 点）
+	LocationBand_LOCATION_BAND_MISS        LocationBand = 5 // それより遠い（0 点）
+)
+
+// Enum value maps for LocationBand.
+var (
+	LocationBand_name = map[int32]string{
+		0: "LOCATION_BAND_UNSPECIFIED",
+		1: "LOCATION_BAND_WITHIN",
+		2: "LOCATION_BAND_NEAR",
+		3: "LOCATION_BAND_REGION",
+		4: "LOCATION_BAND_FAR",
+		5: "LOCATION_BAND_MISS",
+	}
+	LocationBand_value = map[string]int32{
+		"LOCATION_BAND_UNSPECIFIED": 0,
+		"LOCATION_BAND_WITHIN":      1,
+		"LOCATION_BAND_NEAR":        2,
+		"LOCATION_BAND_REGION":      3,
+		"LOCATION_BAND_FAR":         4,
+		"LOCATION_BAND_MISS":        5,
+	}
+)
+
+func (x LocationBand) Enum() *LocationBand {
+	p := new(LocationBand)
+	*p = x
+	return p
+}
+
+func (x LocationBand) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocationBand) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_quiz_v1_quiz_service_proto_enumTypes[0].Descriptor()
+}
+
+func (LocationBand) Type() protoreflect.EnumType {
+	return &file_historyquiz_quiz_v1_quiz_service_proto_enumTypes[0]
+}
+
+func (x LocationBand) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocationBand.Descriptor instead.
+func (LocationBand) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{0}
+}
+
+// 「どちらが先か」の難しさ（最も早い出来事と2番目の出来事の年の差。誤差を除いた近い端どうしで測る）。
+type TimelineDifficulty int32
+
+const (
+	TimelineDifficulty_TIMELINE_DIFFICULTY_UNSPECIFIED TimelineDifficulty = 0 // 指定なし（前後が確実な組なら何でもよい）
+	TimelineDifficulty_TIMELINE_DIFFICULTY_EASY        TimelineDifficulty = 1 // 150 年を超えて離れている
+	TimelineDifficulty_TIMELINE_DIFFICULTY_NORMAL      TimelineDifficulty = 2 // 26..150 年
+	TimelineDifficulty_TIMELINE_DIFFICULTY_HARD        TimelineDifficulty = 3 // 1..25 年
+)
+
+// Enum value maps for TimelineDifficulty.
+var (
+	TimelineDifficulty_name = map[int32]string{
+		0: "TIMELINE_DIFFICULTY_UNSPECIFIED",
+		1: "TIMELINE_DIFFICULTY_EASY",
+		2: "TIMELINE_DIFFICULTY_NORMAL",
+		3: "TIMELINE_DIFFICULTY_HARD",
+	}
+	TimelineDifficulty_value = map[string]int32{
+		"TIMELINE_DIFFICULTY_UNSPECIFIED": 0,
+		"TIMELINE_DIFFICULTY_EASY":        1,
+		"TIMELINE_DIFFICULTY_NORMAL":      2,
+		"TIMELINE_DIFFICULTY_HARD":        3,
+	}
+)
+
+func (x TimelineDifficulty) Enum() *TimelineDifficulty {
+	p := new(TimelineDifficulty)
+	*p = x
+	return p
+}
+
+func (x TimelineDifficulty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineDifficulty) Descriptor() protoreflect.EnumDescriptor {
+	return file_historyquiz_quiz_v1_quiz_service_proto_enumTypes[1].Descriptor()
+}
+
+func (TimelineDifficulty) Type() protoreflect.EnumType {
+	return &file_historyquiz_quiz_v1_quiz_service_proto_enumTypes[1]
+}
+
+func (x TimelineDifficulty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineDifficulty.Descriptor instead.
+func (TimelineDifficulty) EnumDescriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{1}
+}
+
 type Choice struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -83,11 +198,25 @@ func (x *Choice) GetOrdinal() int32 {
 }
 
 type Question struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	Choices       []*Choice              `protobuf:"bytes,3,rep,name=choices,proto3" json:"choices,omitempty"`
-	Explanation   string                 `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"` // 将来の拡張用（初期は空でもよい）
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prompt      string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Choices     []*Choice              `protobuf:"bytes,3,rep,name=choices,proto3" json:"choices,omitempty"`
+	Explanation string                 `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"` // 将来の拡張用（初期は空でもよい）
+	// 問題に付いた画像/地図（表示順）。本体は AttachmentService.GetAttachmentContent で取得する。
+	Attachments []*v1.QuestionAttachment `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// prompt/choices/explanation の言語（BCP 47）。メタデータ accept-language に合う翻訳が無ければ原文の "ja"。
+	Locale string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	// prompt/explanation（書式付き）の構文木。描画にはこちらを使う。
+	PromptRich      *v11.RichText `protobuf:"bytes,7,opt,name=prompt_rich,json=promptRich,proto3" json:"prompt_rich,omitempty"`
+	ExplanationRich *v11.RichText `protobuf:"bytes,8,opt,name=explanation_rich,json=explanationRich,proto3" json:"explanation_rich,omitempty"`
+	// 地図でも答えられる（正解の地点がある）こと。地点そのものは回答後にだけ返す。
+	AcceptsLocation bool `protobuf:"varint,9,opt,name=accepts_location,json=acceptsLocation,proto3" json:"accepts_location,omitempty"`
+	// 史料を共有する問題の場合の史料と、その中での位置（それ以外は未設定）。
+	// NOTE: previous_question_id に史料の問題を渡すと、同じ史料の次の問題を続けて出題する（最後の問題の次は通常の抽選に戻る）。
+	Passage *QuizPassage `protobuf:"bytes,10,opt,name=passage,proto3" json:"passage,omitempty"`
+	// 問題の種類。LOCATION の場合は choices が空で、SubmitAnswer の location でだけ答えられる（選択肢/記述式の回答は FAILED_PRECONDITION）。
+	Kind          v12.QuestionKind `protobuf:"varint,11,opt,name=kind,proto3,enum=historyquiz.question.v1.QuestionKind" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -150,18 +279,134 @@ func (x *Question) GetExplanation() string {
 	return ""
 }
 
+func (x *Question) GetAttachments() []*v1.QuestionAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Question) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Question) GetPromptRich() *v11.RichText {
+	if x != nil {
+		return x.PromptRich
+	}
+	return nil
+}
+
+func (x *Question) GetExplanationRich() *v11.RichText {
+	if x != nil {
+		return x.ExplanationRich
+	}
+	return nil
+}
+
+func (x *Question) GetAcceptsLocation() bool {
+	if x != nil {
+		return x.AcceptsLocation
+	}
+	return false
+}
+
+func (x *Question) GetPassage() *QuizPassage {
+	if x != nil {
+		return x.Passage
+	}
+	return nil
+}
+
+func (x *Question) GetKind() v12.QuestionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return v12.QuestionKind(0)
+}
+
+// 出題中の問題が共有する史料。
+type QuizPassage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passage       *v12.Passage           `protobuf:"bytes,1,opt,name=passage,proto3" json:"passage,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"` // 史料の中での位置（1始まり）
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`       // 史料の中で今出題できる問題の数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizPassage) Reset() {
+	*x = QuizPassage{}
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizPassage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizPassage) ProtoMessage() {}
+
+func (x *QuizPassage) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizPassage.ProtoReflect.Descriptor instead.
+func (*QuizPassage) Descriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QuizPassage) GetPassage() *v12.Passage {
+	if x != nil {
+		return x.Passage
+	}
+	return nil
+}
+
+func (x *QuizPassage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *QuizPassage) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type GetQuestionRequest struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
-	Context *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Context *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	// 連続出題で直前の問題を避けたい場合に使う（将来拡張）。
 	PreviousQuestionId string `protobuf:"bytes,2,opt,name=previous_question_id,json=previousQuestionId,proto3" json:"previous_question_id,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// 指定した場合はデッキの問題だけを並び順に出題する（previous_question_id の次の問題）。
+	// デッキに出題できる問題が無い場合は FAILED_PRECONDITION。
+	DeckId string `protobuf:"bytes,3,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	// 指定した場合はエンティティ（人物/出来事/場所/王朝）が付いた問題から出題する（deck_id と同時には指定できない）。
+	// 出題できる問題が無い場合は FAILED_PRECONDITION。
+	EntityId      string `protobuf:"bytes,4,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetQuestionRequest) Reset() {
 	*x = GetQuestionRequest{}
-	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[2]
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +418,7 @@ func (x *GetQuestionRequest) String() string {
 func (*GetQuestionRequest) ProtoMessage() {}
 
 func (x *GetQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[2]
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,10 +431,10 @@ func (x *GetQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQuestionRequest.ProtoReflect.Descriptor instead.
 func (*GetQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{2}
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetQuestionRequest) GetContext() *v1.RequestContext {
+func (x *GetQuestionRequest) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
@@ -203,9 +448,23 @@ func (x *GetQuestionRequest) GetPreviousQuestionId() string {
 	return ""
 }
 
+func (x *GetQuestionRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *GetQuestionRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
 type GetQuestionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Context       *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	Question      *Question              `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -213,7 +472,7 @@ type GetQuestionResponse struct {
 
 func (x *GetQuestionResponse) Reset() {
 	*x = GetQuestionResponse{}
-	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[3]
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -225,7 +484,7 @@ func (x *GetQuestionResponse) String() string {
 func (*GetQuestionResponse) ProtoMessage() {}
 
 func (x *GetQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[3]
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,10 +497,10 @@ func (x *GetQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQuestionResponse.ProtoReflect.Descriptor instead.
 func (*GetQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{3}
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetQuestionResponse) GetContext() *v1.RequestContext {
+func (x *GetQuestionResponse) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
@@ -257,16 +516,20 @@ func (x *GetQuestionResponse) GetQuestion() *Question {
 
 type SubmitAnswerRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Context          *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Context          *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	QuestionId       string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
 	SelectedChoiceId string                 `protobuf:"bytes,3,opt,name=selected_choice_id,json=selectedChoiceId,proto3" json:"selected_choice_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// 記述式モードの入力文字列。selected_choice_id が空の場合のみ使う。
+	AnswerText string `protobuf:"bytes,4,opt,name=answer_text,json=answerText,proto3" json:"answer_text,omitempty"`
+	// 地図モードで選んだ地点。selected_choice_id と answer_text が空の場合のみ使う（Question.accepts_location の問題だけ）。
+	Location      *v11.LatLng `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitAnswerRequest) Reset() {
 	*x = SubmitAnswerRequest{}
-	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[4]
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +541,7 @@ func (x *SubmitAnswerRequest) String() string {
 func (*SubmitAnswerRequest) ProtoMessage() {}
 
 func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[4]
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,10 +554,10 @@ func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitAnswerRequest.ProtoReflect.Descriptor instead.
 func (*SubmitAnswerRequest) Descriptor() ([]byte, []int) {
-	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{4}
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SubmitAnswerRequest) GetContext() *v1.RequestContext {
+func (x *SubmitAnswerRequest) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
@@ -315,19 +578,41 @@ func (x *SubmitAnswerRequest) GetSelectedChoiceId() string {
 	return ""
 }
 
+func (x *SubmitAnswerRequest) GetAnswerText() string {
+	if x != nil {
+		return x.AnswerText
+	}
+	return ""
+}
+
+func (x *SubmitAnswerRequest) GetLocation() *v11.LatLng {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type SubmitAnswerResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Context         *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Context         *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	IsCorrect       bool                   `protobuf:"varint,2,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
-	CorrectChoiceId string                 `protobuf:"bytes,3,opt,name=correct_choice_id,json=correctChoiceId,proto3" json:"correct_choice_id,omitempty"`
+	CorrectChoiceId string                 `protobuf:"bytes,3,opt,name=correct_choice_id,json=correctChoiceId,proto3" json:"correct_choice_id,omitempty"` // 地図でだけ答える問題（Question.kind が LOCATION）では空
 	AttemptId       string                 `protobuf:"bytes,4,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// 記述式モードで一致した正解/別表記（不一致または選択式の場合は空）。
+	MatchedAnswer string `protobuf:"bytes,5,opt,name=matched_answer,json=matchedAnswer,proto3" json:"matched_answer,omitempty"`
+	// 問題の出典（表示順）。答えの手がかりになりうるため、出題時ではなく回答後にだけ返す。
+	Citations []*v12.Citation `protobuf:"bytes,6,rep,name=citations,proto3" json:"citations,omitempty"`
+	// 問題に付いたエンティティ（名前順）。出典と同じく回答後にだけ返す。
+	Entities []*v13.EntityRef `protobuf:"bytes,7,rep,name=entities,proto3" json:"entities,omitempty"`
+	// 地図モードの採点結果（それ以外のモードでは未設定）。
+	LocationResult *LocationResult `protobuf:"bytes,8,opt,name=location_result,json=locationResult,proto3" json:"location_result,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SubmitAnswerResponse) Reset() {
 	*x = SubmitAnswerResponse{}
-	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[5]
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -339,7 +624,7 @@ func (x *SubmitAnswerResponse) String() string {
 func (*SubmitAnswerResponse) ProtoMessage() {}
 
 func (x *SubmitAnswerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[5]
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,10 +637,10 @@ func (x *SubmitAnswerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitAnswerResponse.ProtoReflect.Descriptor instead.
 func (*SubmitAnswerResponse) Descriptor() ([]byte, []int) {
-	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{5}
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SubmitAnswerResponse) GetContext() *v1.RequestContext {
+func (x *SubmitAnswerResponse) GetContext() *v11.RequestContext {
 	if x != nil {
 		return x.Context
 	}
@@ -383,41 +668,608 @@ func (x *SubmitAnswerResponse) GetAttemptId() string {
 	return ""
 }
 
+func (x *SubmitAnswerResponse) GetMatchedAnswer() string {
+	if x != nil {
+		return x.MatchedAnswer
+	}
+	return ""
+}
+
+func (x *SubmitAnswerResponse) GetCitations() []*v12.Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+func (x *SubmitAnswerResponse) GetEntities() []*v13.EntityRef {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *SubmitAnswerResponse) GetLocationResult() *LocationResult {
+	if x != nil {
+		return x.LocationResult
+	}
+	return nil
+}
+
+type LocationResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DistanceKm      float64                `protobuf:"fixed64,1,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"` // 選んだ地点から正解の地点までの大円距離
+	Band            LocationBand           `protobuf:"varint,2,opt,name=band,proto3,enum=historyquiz.quiz.v1.LocationBand" json:"band,omitempty"`
+	Score           int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"` // 0..100
+	CorrectLocation *v12.QuestionLocation  `protobuf:"bytes,4,opt,name=correct_location,json=correctLocation,proto3" json:"correct_location,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LocationResult) Reset() {
+	*x = LocationResult{}
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationResult) ProtoMessage() {}
+
+func (x *LocationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationResult.ProtoReflect.Descriptor instead.
+func (*LocationResult) Descriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LocationResult) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *LocationResult) GetBand() LocationBand {
+	if x != nil {
+		return x.Band
+	}
+	return LocationBand_LOCATION_BAND_UNSPECIFIED
+}
+
+func (x *LocationResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LocationResult) GetCorrectLocation() *v12.QuestionLocation {
+	if x != nil {
+		return x.CorrectLocation
+	}
+	return nil
+}
+
+// 出題する出来事（年は含まない）。
+type TimelineEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEvent) Reset() {
+	*x = TimelineEvent{}
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEvent) ProtoMessage() {}
+
+func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TimelineEvent) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 回答後に返す出来事と年。
+type DatedTimelineEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EntityId string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 年（紀元前は負の数）。
+	Year int32 `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	// 年の誤差（±年）。0 は正確な年、それ以外は「頃」。
+	YearMargin    int32 `protobuf:"varint,4,opt,name=year_margin,json=yearMargin,proto3" json:"year_margin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatedTimelineEvent) Reset() {
+	*x = DatedTimelineEvent{}
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatedTimelineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatedTimelineEvent) ProtoMessage() {}
+
+func (x *DatedTimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatedTimelineEvent.ProtoReflect.Descriptor instead.
+func (*DatedTimelineEvent) Descriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DatedTimelineEvent) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *DatedTimelineEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatedTimelineEvent) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *DatedTimelineEvent) GetYearMargin() int32 {
+	if x != nil {
+		return x.YearMargin
+	}
+	return 0
+}
+
+type GetTimelineDuelRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Context    *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Difficulty TimelineDifficulty     `protobuf:"varint,2,opt,name=difficulty,proto3,enum=historyquiz.quiz.v1.TimelineDifficulty" json:"difficulty,omitempty"`
+	// 出来事の数（2..4）。0 の場合は 2。
+	EventCount int32 `protobuf:"varint,3,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// 連続出題で直前の出来事を避けたい場合に使う（20 件まで）。避けると組が作れない場合は避けない。
+	PreviousEventIds []string `protobuf:"bytes,4,rep,name=previous_event_ids,json=previousEventIds,proto3" json:"previous_event_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetTimelineDuelRequest) Reset() {
+	*x = GetTimelineDuelRequest{}
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineDuelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineDuelRequest) ProtoMessage() {}
+
+func (x *GetTimelineDuelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineDuelRequest.ProtoReflect.Descriptor instead.
+func (*GetTimelineDuelRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTimelineDuelRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetTimelineDuelRequest) GetDifficulty() TimelineDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return TimelineDifficulty_TIMELINE_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *GetTimelineDuelRequest) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *GetTimelineDuelRequest) GetPreviousEventIds() []string {
+	if x != nil {
+		return x.PreviousEventIds
+	}
+	return nil
+}
+
+type GetTimelineDuelResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// 表示順（年の順ではない）。
+	Events        []*TimelineEvent   `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	Difficulty    TimelineDifficulty `protobuf:"varint,3,opt,name=difficulty,proto3,enum=historyquiz.quiz.v1.TimelineDifficulty" json:"difficulty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineDuelResponse) Reset() {
+	*x = GetTimelineDuelResponse{}
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineDuelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineDuelResponse) ProtoMessage() {}
+
+func (x *GetTimelineDuelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineDuelResponse.ProtoReflect.Descriptor instead.
+func (*GetTimelineDuelResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTimelineDuelResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetTimelineDuelResponse) GetEvents() []*TimelineEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetTimelineDuelResponse) GetDifficulty() TimelineDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return TimelineDifficulty_TIMELINE_DIFFICULTY_UNSPECIFIED
+}
+
+type SubmitTimelineAnswerRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// GetTimelineDuel で返した出来事の ID をそのまま送る。
+	EventIds []string `protobuf:"bytes,2,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	// 最も早いと選んだ出来事。
+	ChosenEventId string `protobuf:"bytes,3,opt,name=chosen_event_id,json=chosenEventId,proto3" json:"chosen_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTimelineAnswerRequest) Reset() {
+	*x = SubmitTimelineAnswerRequest{}
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTimelineAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTimelineAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitTimelineAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTimelineAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTimelineAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubmitTimelineAnswerRequest) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SubmitTimelineAnswerRequest) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+func (x *SubmitTimelineAnswerRequest) GetChosenEventId() string {
+	if x != nil {
+		return x.ChosenEventId
+	}
+	return ""
+}
+
+type SubmitTimelineAnswerResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Context         *v11.RequestContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	IsCorrect       bool                   `protobuf:"varint,2,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	EarliestEventId string                 `protobuf:"bytes,3,opt,name=earliest_event_id,json=earliestEventId,proto3" json:"earliest_event_id,omitempty"`
+	// 出来事を年の古い順に並べたもの。
+	Events []*DatedTimelineEvent `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// 最も早い出来事と2番目の出来事の年の差（誤差を考えない）。
+	YearGap       int32 `protobuf:"varint,5,opt,name=year_gap,json=yearGap,proto3" json:"year_gap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTimelineAnswerResponse) Reset() {
+	*x = SubmitTimelineAnswerResponse{}
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTimelineAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTimelineAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitTimelineAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_historyquiz_quiz_v1_quiz_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTimelineAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitTimelineAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SubmitTimelineAnswerResponse) GetContext() *v11.RequestContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SubmitTimelineAnswerResponse) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *SubmitTimelineAnswerResponse) GetEarliestEventId() string {
+	if x != nil {
+		return x.EarliestEventId
+	}
+	return ""
+}
+
+func (x *SubmitTimelineAnswerResponse) GetEvents() []*DatedTimelineEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *SubmitTimelineAnswerResponse) GetYearGap() int32 {
+	if x != nil {
+		return x.YearGap
+	}
+	return 0
+}
+
 var File_historyquiz_quiz_v1_quiz_service_proto protoreflect.FileDescriptor
 
 const file_historyquiz_quiz_v1_quiz_service_proto_rawDesc = "" +
 	"\n" +
-	"&historyquiz/quiz/v1/quiz_service.proto\x12\x13historyquiz.quiz.v1\x1a\"historyquiz/common/v1/common.proto\"H\n" +
+	"&historyquiz/quiz/v1/quiz_service.proto\x12\x13historyquiz.quiz.v1\x1a2historyquiz/attachment/v1/attachment_service.proto\x1a\"historyquiz/common/v1/common.proto\x1a*historyquiz/entity/v1/entity_service.proto\x1a.historyquiz/question/v1/question_service.proto\"H\n" +
 	"\x06Choice\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
-	"\aordinal\x18\x03 \x01(\x05R\aordinal\"\x8b\x01\n" +
+	"\aordinal\x18\x03 \x01(\x05R\aordinal\"\xa4\x04\n" +
 	"\bQuestion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06prompt\x18\x02 \x01(\tR\x06prompt\x125\n" +
 	"\achoices\x18\x03 \x03(\v2\x1b.historyquiz.quiz.v1.ChoiceR\achoices\x12 \n" +
-	"\vexplanation\x18\x04 \x01(\tR\vexplanation\"\x87\x01\n" +
+	"\vexplanation\x18\x04 \x01(\tR\vexplanation\x12O\n" +
+	"\vattachments\x18\x05 \x03(\v2-.historyquiz.attachment.v1.QuestionAttachmentR\vattachments\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\x12@\n" +
+	"\vprompt_rich\x18\a \x01(\v2\x1f.historyquiz.common.v1.RichTextR\n" +
+	"promptRich\x12J\n" +
+	"\x10explanation_rich\x18\b \x01(\v2\x1f.historyquiz.common.v1.RichTextR\x0fexplanationRich\x12)\n" +
+	"\x10accepts_location\x18\t \x01(\bR\x0facceptsLocation\x12:\n" +
+	"\apassage\x18\n" +
+	" \x01(\v2 .historyquiz.quiz.v1.QuizPassageR\apassage\x129\n" +
+	"\x04kind\x18\v \x01(\x0e2%.historyquiz.question.v1.QuestionKindR\x04kind\"{\n" +
+	"\vQuizPassage\x12:\n" +
+	"\apassage\x18\x01 \x01(\v2 .historyquiz.question.v1.PassageR\apassage\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"\xbd\x01\n" +
 	"\x12GetQuestionRequest\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x120\n" +
-	"\x14previous_question_id\x18\x02 \x01(\tR\x12previousQuestionId\"\x91\x01\n" +
+	"\x14previous_question_id\x18\x02 \x01(\tR\x12previousQuestionId\x12\x17\n" +
+	"\adeck_id\x18\x03 \x01(\tR\x06deckId\x12\x1b\n" +
+	"\tentity_id\x18\x04 \x01(\tR\bentityId\"\x91\x01\n" +
 	"\x13GetQuestionResponse\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x129\n" +
-	"\bquestion\x18\x02 \x01(\v2\x1d.historyquiz.quiz.v1.QuestionR\bquestion\"\xa5\x01\n" +
+	"\bquestion\x18\x02 \x01(\v2\x1d.historyquiz.quiz.v1.QuestionR\bquestion\"\x81\x02\n" +
 	"\x13SubmitAnswerRequest\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1f\n" +
 	"\vquestion_id\x18\x02 \x01(\tR\n" +
 	"questionId\x12,\n" +
-	"\x12selected_choice_id\x18\x03 \x01(\tR\x10selectedChoiceId\"\xc1\x01\n" +
+	"\x12selected_choice_id\x18\x03 \x01(\tR\x10selectedChoiceId\x12\x1f\n" +
+	"\vanswer_text\x18\x04 \x01(\tR\n" +
+	"answerText\x129\n" +
+	"\blocation\x18\x05 \x01(\v2\x1d.historyquiz.common.v1.LatLngR\blocation\"\xb5\x03\n" +
 	"\x14SubmitAnswerResponse\x12?\n" +
 	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1d\n" +
 	"\n" +
 	"is_correct\x18\x02 \x01(\bR\tisCorrect\x12*\n" +
 	"\x11correct_choice_id\x18\x03 \x01(\tR\x0fcorrectChoiceId\x12\x1d\n" +
 	"\n" +
-	"attempt_id\x18\x04 \x01(\tR\tattemptId2\xd4\x01\n" +
+	"attempt_id\x18\x04 \x01(\tR\tattemptId\x12%\n" +
+	"\x0ematched_answer\x18\x05 \x01(\tR\rmatchedAnswer\x12?\n" +
+	"\tcitations\x18\x06 \x03(\v2!.historyquiz.question.v1.CitationR\tcitations\x12<\n" +
+	"\bentities\x18\a \x03(\v2 .historyquiz.entity.v1.EntityRefR\bentities\x12L\n" +
+	"\x0flocation_result\x18\b \x01(\v2#.historyquiz.quiz.v1.LocationResultR\x0elocationResult\"\xd4\x01\n" +
+	"\x0eLocationResult\x12\x1f\n" +
+	"\vdistance_km\x18\x01 \x01(\x01R\n" +
+	"distanceKm\x125\n" +
+	"\x04band\x18\x02 \x01(\x0e2!.historyquiz.quiz.v1.LocationBandR\x04band\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x12T\n" +
+	"\x10correct_location\x18\x04 \x01(\v2).historyquiz.question.v1.QuestionLocationR\x0fcorrectLocation\"@\n" +
+	"\rTimelineEvent\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"z\n" +
+	"\x12DatedTimelineEvent\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12\x1f\n" +
+	"\vyear_margin\x18\x04 \x01(\x05R\n" +
+	"yearMargin\"\xf1\x01\n" +
+	"\x16GetTimelineDuelRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12G\n" +
+	"\n" +
+	"difficulty\x18\x02 \x01(\x0e2'.historyquiz.quiz.v1.TimelineDifficultyR\n" +
+	"difficulty\x12\x1f\n" +
+	"\vevent_count\x18\x03 \x01(\x05R\n" +
+	"eventCount\x12,\n" +
+	"\x12previous_event_ids\x18\x04 \x03(\tR\x10previousEventIds\"\xdf\x01\n" +
+	"\x17GetTimelineDuelResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12:\n" +
+	"\x06events\x18\x02 \x03(\v2\".historyquiz.quiz.v1.TimelineEventR\x06events\x12G\n" +
+	"\n" +
+	"difficulty\x18\x03 \x01(\x0e2'.historyquiz.quiz.v1.TimelineDifficultyR\n" +
+	"difficulty\"\xa3\x01\n" +
+	"\x1bSubmitTimelineAnswerRequest\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1b\n" +
+	"\tevent_ids\x18\x02 \x03(\tR\beventIds\x12&\n" +
+	"\x0fchosen_event_id\x18\x03 \x01(\tR\rchosenEventId\"\x86\x02\n" +
+	"\x1cSubmitTimelineAnswerResponse\x12?\n" +
+	"\acontext\x18\x01 \x01(\v2%.historyquiz.common.v1.RequestContextR\acontext\x12\x1d\n" +
+	"\n" +
+	"is_correct\x18\x02 \x01(\bR\tisCorrect\x12*\n" +
+	"\x11earliest_event_id\x18\x03 \x01(\tR\x0fearliestEventId\x12?\n" +
+	"\x06events\x18\x04 \x03(\v2'.historyquiz.quiz.v1.DatedTimelineEventR\x06events\x12\x19\n" +
+	"\byear_gap\x18\x05 \x01(\x05R\ayearGap*\xa8\x01\n" +
+	"\fLocationBand\x12\x1d\n" +
+	"\x19LOCATION_BAND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14LOCATION_BAND_WITHIN\x10\x01\x12\x16\n" +
+	"\x12LOCATION_BAND_NEAR\x10\x02\x12\x18\n" +
+	"\x14LOCATION_BAND_REGION\x10\x03\x12\x15\n" +
+	"\x11LOCATION_BAND_FAR\x10\x04\x12\x16\n" +
+	"\x12LOCATION_BAND_MISS\x10\x05*\x95\x01\n" +
+	"\x12TimelineDifficulty\x12#\n" +
+	"\x1fTIMELINE_DIFFICULTY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TIMELINE_DIFFICULTY_EASY\x10\x01\x12\x1e\n" +
+	"\x1aTIMELINE_DIFFICULTY_NORMAL\x10\x02\x12\x1c\n" +
+	"\x18TIMELINE_DIFFICULTY_HARD\x10\x032\xbf\x03\n" +
 	"\vQuizService\x12`\n" +
 	"\vGetQuestion\x12'.historyquiz.quiz.v1.GetQuestionRequest\x1a(.historyquiz.quiz.v1.GetQuestionResponse\x12c\n" +
-	"\fSubmitAnswer\x12(.historyquiz.quiz.v1.SubmitAnswerRequest\x1a).historyquiz.quiz.v1.SubmitAnswerResponseB:Z8github.com/history-quiz/historyquiz/proto/quiz/v1;quizv1b\x06proto3"
+	"\fSubmitAnswer\x12(.historyquiz.quiz.v1.SubmitAnswerRequest\x1a).historyquiz.quiz.v1.SubmitAnswerResponse\x12l\n" +
+	"\x0fGetTimelineDuel\x12+.historyquiz.quiz.v1.GetTimelineDuelRequest\x1a,.historyquiz.quiz.v1.GetTimelineDuelResponse\x12{\n" +
+	"\x14SubmitTimelineAnswer\x120.historyquiz.quiz.v1.SubmitTimelineAnswerRequest\x1a1.historyquiz.quiz.v1.SubmitTimelineAnswerResponseB:Z8github.com/history-quiz/historyquiz/proto/quiz/v1;quizv1b\x06proto3"
 
 var (
 	file_historyquiz_quiz_v1_quiz_service_proto_rawDescOnce sync.Once
@@ -431,32 +1283,75 @@ func file_historyquiz_quiz_v1_quiz_service_proto_rawDescGZIP() []byte {
 	return file_historyquiz_quiz_v1_quiz_service_proto_rawDescData
 }
 
-var file_historyquiz_quiz_v1_quiz_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_historyquiz_quiz_v1_quiz_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_historyquiz_quiz_v1_quiz_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_historyquiz_quiz_v1_quiz_service_proto_goTypes = []any{
-	(*Choice)(nil),               // 0: historyquiz.quiz.v1.Choice
-	(*Question)(nil),             // 1: historyquiz.quiz.v1.Question
-	(*GetQuestionRequest)(nil),   // 2: historyquiz.quiz.v1.GetQuestionRequest
-	(*GetQuestionResponse)(nil),  // 3: historyquiz.quiz.v1.GetQuestionResponse
-	(*SubmitAnswerRequest)(nil),  // 4: historyquiz.quiz.v1.SubmitAnswerRequest
-	(*SubmitAnswerResponse)(nil), // 5: historyquiz.quiz.v1.SubmitAnswerResponse
-	(*v1.RequestContext)(nil),    // 6: historyquiz.common.v1.RequestContext
+	(LocationBand)(0),                    // 0: historyquiz.quiz.v1.LocationBand
+	(TimelineDifficulty)(0),              // 1: historyquiz.quiz.v1.TimelineDifficulty
+	(*Choice)(nil),                       // 2: historyquiz.quiz.v1.Choice
+	(*Question)(nil),                     // 3: historyquiz.quiz.v1.Question
+	(*QuizPassage)(nil),                  // 4: historyquiz.quiz.v1.QuizPassage
+	(*GetQuestionRequest)(nil),           // 5: historyquiz.quiz.v1.GetQuestionRequest
+	(*GetQuestionResponse)(nil),          // 6: historyquiz.quiz.v1.GetQuestionResponse
+	(*SubmitAnswerRequest)(nil),          // 7: historyquiz.quiz.v1.SubmitAnswerRequest
+	(*SubmitAnswerResponse)(nil),         // 8: historyquiz.quiz.v1.SubmitAnswerResponse
+	(*LocationResult)(nil),               // 9: historyquiz.quiz.v1.LocationResult
+	(*TimelineEvent)(nil),                // 10: historyquiz.quiz.v1.TimelineEvent
+	(*DatedTimelineEvent)(nil),           // 11: historyquiz.quiz.v1.DatedTimelineEvent
+	(*GetTimelineDuelRequest)(nil),       // 12: historyquiz.quiz.v1.GetTimelineDuelRequest
+	(*GetTimelineDuelResponse)(nil),      // 13: historyquiz.quiz.v1.GetTimelineDuelResponse
+	(*SubmitTimelineAnswerRequest)(nil),  // 14: historyquiz.quiz.v1.SubmitTimelineAnswerRequest
+	(*SubmitTimelineAnswerResponse)(nil), // 15: historyquiz.quiz.v1.SubmitTimelineAnswerResponse
+	(*v1.QuestionAttachment)(nil),        // 16: historyquiz.attachment.v1.QuestionAttachment
+	(*v11.RichText)(nil),                 // 17: historyquiz.common.v1.RichText
+	(v12.QuestionKind)(0),                // 18: historyquiz.question.v1.QuestionKind
+	(*v12.Passage)(nil),                  // 19: historyquiz.question.v1.Passage
+	(*v11.RequestContext)(nil),           // 20: historyquiz.common.v1.RequestContext
+	(*v11.LatLng)(nil),                   // 21: historyquiz.common.v1.LatLng
+	(*v12.Citation)(nil),                 // 22: historyquiz.question.v1.Citation
+	(*v13.EntityRef)(nil),                // 23: historyquiz.entity.v1.EntityRef
+	(*v12.QuestionLocation)(nil),         // 24: historyquiz.question.v1.QuestionLocation
 }
 var file_historyquiz_quiz_v1_quiz_service_proto_depIdxs = []int32{
-	0, // 0: historyquiz.quiz.v1.Question.choices:type_name -> historyquiz.quiz.v1.Choice
-	6, // 1: historyquiz.quiz.v1.GetQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
-	6, // 2: historyquiz.quiz.v1.GetQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
-	1, // 3: historyquiz.quiz.v1.GetQuestionResponse.question:type_name -> historyquiz.quiz.v1.Question
-	6, // 4: historyquiz.quiz.v1.SubmitAnswerRequest.context:type_name -> historyquiz.common.v1.RequestContext
-	6, // 5: historyquiz.quiz.v1.SubmitAnswerResponse.context:type_name -> historyquiz.common.v1.RequestContext
-	2, // 6: historyquiz.quiz.v1.QuizService.GetQuestion:input_type -> historyquiz.quiz.v1.GetQuestionRequest
-	4, // 7: historyquiz.quiz.v1.QuizService.SubmitAnswer:input_type -> historyquiz.quiz.v1.SubmitAnswerRequest
-	3, // 8: historyquiz.quiz.v1.QuizService.GetQuestion:output_type -> historyquiz.quiz.v1.GetQuestionResponse
-	5, // 9: historyquiz.quiz.v1.QuizService.SubmitAnswer:output_type -> historyquiz.quiz.v1.SubmitAnswerResponse
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	2,  // 0: historyquiz.quiz.v1.Question.choices:type_name -> historyquiz.quiz.v1.Choice
+	16, // 1: historyquiz.quiz.v1.Question.attachments:type_name -> historyquiz.attachment.v1.QuestionAttachment
+	17, // 2: historyquiz.quiz.v1.Question.prompt_rich:type_name -> historyquiz.common.v1.RichText
+	17, // 3: historyquiz.quiz.v1.Question.explanation_rich:type_name -> historyquiz.common.v1.RichText
+	4,  // 4: historyquiz.quiz.v1.Question.passage:type_name -> historyquiz.quiz.v1.QuizPassage
+	18, // 5: historyquiz.quiz.v1.Question.kind:type_name -> historyquiz.question.v1.QuestionKind
+	19, // 6: historyquiz.quiz.v1.QuizPassage.passage:type_name -> historyquiz.question.v1.Passage
+	20, // 7: historyquiz.quiz.v1.GetQuestionRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	20, // 8: historyquiz.quiz.v1.GetQuestionResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	3,  // 9: historyquiz.quiz.v1.GetQuestionResponse.question:type_name -> historyquiz.quiz.v1.Question
+	20, // 10: historyquiz.quiz.v1.SubmitAnswerRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	21, // 11: historyquiz.quiz.v1.SubmitAnswerRequest.location:type_name -> historyquiz.common.v1.LatLng
+	20, // 12: historyquiz.quiz.v1.SubmitAnswerResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	22, // 13: historyquiz.quiz.v1.SubmitAnswerResponse.citations:type_name -> historyquiz.question.v1.Citation
+	23, // 14: historyquiz.quiz.v1.SubmitAnswerResponse.entities:type_name -> historyquiz.entity.v1.EntityRef
+	9,  // 15: historyquiz.quiz.v1.SubmitAnswerResponse.location_result:type_name -> historyquiz.quiz.v1.LocationResult
+	0,  // 16: historyquiz.quiz.v1.LocationResult.band:type_name -> historyquiz.quiz.v1.LocationBand
+	24, // 17: historyquiz.quiz.v1.LocationResult.correct_location:type_name -> historyquiz.question.v1.QuestionLocation
+	20, // 18: historyquiz.quiz.v1.GetTimelineDuelRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	1,  // 19: historyquiz.quiz.v1.GetTimelineDuelRequest.difficulty:type_name -> historyquiz.quiz.v1.TimelineDifficulty
+	20, // 20: historyquiz.quiz.v1.GetTimelineDuelResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	10, // 21: historyquiz.quiz.v1.GetTimelineDuelResponse.events:type_name -> historyquiz.quiz.v1.TimelineEvent
+	1,  // 22: historyquiz.quiz.v1.GetTimelineDuelResponse.difficulty:type_name -> historyquiz.quiz.v1.TimelineDifficulty
+	20, // 23: historyquiz.quiz.v1.SubmitTimelineAnswerRequest.context:type_name -> historyquiz.common.v1.RequestContext
+	20, // 24: historyquiz.quiz.v1.SubmitTimelineAnswerResponse.context:type_name -> historyquiz.common.v1.RequestContext
+	11, // 25: historyquiz.quiz.v1.SubmitTimelineAnswerResponse.events:type_name -> historyquiz.quiz.v1.DatedTimelineEvent
+	5,  // 26: historyquiz.quiz.v1.QuizService.GetQuestion:input_type -> historyquiz.quiz.v1.GetQuestionRequest
+	7,  // 27: historyquiz.quiz.v1.QuizService.SubmitAnswer:input_type -> historyquiz.quiz.v1.SubmitAnswerRequest
+	12, // 28: historyquiz.quiz.v1.QuizService.GetTimelineDuel:input_type -> historyquiz.quiz.v1.GetTimelineDuelRequest
+	14, // 29: historyquiz.quiz.v1.QuizService.SubmitTimelineAnswer:input_type -> historyquiz.quiz.v1.SubmitTimelineAnswerRequest
+	6,  // 30: historyquiz.quiz.v1.QuizService.GetQuestion:output_type -> historyquiz.quiz.v1.GetQuestionResponse
+	8,  // 31: historyquiz.quiz.v1.QuizService.SubmitAnswer:output_type -> historyquiz.quiz.v1.SubmitAnswerResponse
+	13, // 32: historyquiz.quiz.v1.QuizService.GetTimelineDuel:output_type -> historyquiz.quiz.v1.GetTimelineDuelResponse
+	15, // 33: historyquiz.quiz.v1.QuizService.SubmitTimelineAnswer:output_type -> historyquiz.quiz.v1.SubmitTimelineAnswerResponse
+	30, // [30:34] is the sub-list for method output_type
+	26, // [26:30] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_historyquiz_quiz_v1_quiz_service_proto_init() }
@@ -469,13 +1364,14 @@ func file_historyquiz_quiz_v1_quiz_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_historyquiz_quiz_v1_quiz_service_proto_rawDesc), len(file_historyquiz_quiz_v1_quiz_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_historyquiz_quiz_v1_quiz_service_proto_goTypes,
 		DependencyIndexes: file_historyquiz_quiz_v1_quiz_service_proto_depIdxs,
+		EnumInfos:         file_historyquiz_quiz_v1_quiz_service_proto_enumTypes,
 		MessageInfos:      file_historyquiz_quiz_v1_quiz_service_proto_msgTypes,
 	}.Build()
 	File_historyquiz_quiz_v1_quiz_service_proto = out.File
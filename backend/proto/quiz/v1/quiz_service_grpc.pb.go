@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	QuizService_GetQuestion_FullMethodName  = "/historyquiz.quiz.v1.QuizService/GetQuestion"
-	QuizService_SubmitAnswer_FullMethodName = "/historyquiz.quiz.v1.QuizService/SubmitAnswer"
+	QuizService_GetQuestion_FullMethodName          = "/historyquiz.quiz.v1.QuizService/GetQuestion"
+	QuizService_SubmitAnswer_FullMethodName         = "/historyquiz.quiz.v1.QuizService/SubmitAnswer"
+	QuizService_GetTimelineDuel_FullMethodName      = "/historyquiz.quiz.v1.QuizService/GetTimelineDuel"
+	QuizService_SubmitTimelineAnswer_FullMethodName = "/historyquiz.quiz.v1.QuizService/SubmitTimelineAnswer"
 )
 
 // QuizServiceClient is the client API for QuizService service.
@@ -33,6 +35,12 @@ type QuizServiceClient interface {
 	GetQuestion(ctx context.Context, in *GetQuestionRequest, opts ...grpc.CallOption) (*GetQuestionResponse, error)
 	// 回答を送信し、正誤判定と結果を返す。
 	SubmitAnswer(ctx context.Context, in *SubmitAnswerRequest, opts ...grpc.CallOption) (*SubmitAnswerResponse, error)
+	// 「どちらが先か」の出題。年が分かる出来事（EntityService の出来事）を2〜4件返す。年は回答後にだけ返す。
+	// 最も早い出来事が確実に決まる組（「頃」の年は誤差の範囲が重ならない組）だけを選ぶ。
+	// 組が作れない場合は FAILED_PRECONDITION。
+	GetTimelineDuel(ctx context.Context, in *GetTimelineDuelRequest, opts ...grpc.CallOption) (*GetTimelineDuelResponse, error)
+	// 「どちらが先か」の回答を判定し、出来事の年を返す（回答は記録しない）。
+	SubmitTimelineAnswer(ctx context.Context, in *SubmitTimelineAnswerRequest, opts ...grpc.CallOption) (*SubmitTimelineAnswerResponse, error)
 }
 
 type quizServiceClient struct {
@@ -63,6 +71,26 @@ func (c *quizServiceClient) SubmitAnswer(ctx context.Context, in *SubmitAnswerRe
 	return out, nil
 }
 
+func (c *quizServiceClient) GetTimelineDuel(ctx context.Context, in *GetTimelineDuelRequest, opts ...grpc.CallOption) (*GetTimelineDuelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTimelineDuelResponse)
+	err := c.cc.Invoke(ctx, QuizService_GetTimelineDuel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) SubmitTimelineAnswer(ctx context.Context, in *SubmitTimelineAnswerRequest, opts ...grpc.CallOption) (*SubmitTimelineAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitTimelineAnswerResponse)
+	err := c.cc.Invoke(ctx, QuizService_SubmitTimelineAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuizServiceServer is the server API for QuizService service.
 // All implementations must embed UnimplementedQuizServiceServer
 // for forward compatibility.
@@ -73,6 +101,12 @@ type QuizServiceServer interface {
 	GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error)
 	// 回答を送信し、正誤判定と結果を返す。
 	SubmitAnswer(context.Context, *SubmitAnswerRequest) (*SubmitAnswerResponse, error)
+	// 「どちらが先か」の出題。年が分かる出来事（EntityService の出来事）を2〜4件返す。年は回答後にだけ返す。
+	// 最も早い出来事が確実に決まる組（「頃」の年は誤差の範囲が重ならない組）だけを選ぶ。
+	// 組が作れない場合は FAILED_PRECONDITION。
+	GetTimelineDuel(context.Context, *GetTimelineDuelRequest) (*GetTimelineDuelResponse, error)
+	// 「どちらが先か」の回答を判定し、出来事の年を返す（回答は記録しない）。
+	SubmitTimelineAnswer(context.Context, *SubmitTimelineAnswerRequest) (*SubmitTimelineAnswerResponse, error)
 	mustEmbedUnimplementedQuizServiceServer()
 }
 
@@ -89,6 +123,12 @@ func (UnimplementedQuizServiceServer) GetQuestion(context.Context, *GetQuestionR
 func (UnimplementedQuizServiceServer) SubmitAnswer(context.Context, *SubmitAnswerRequest) (*SubmitAnswerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnswer not implemented")
 }
+func (UnimplementedQuizServiceServer) GetTimelineDuel(context.Context, *GetTimelineDuelRequest) (*GetTimelineDuelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimelineDuel not implemented")
+}
+func (UnimplementedQuizServiceServer) SubmitTimelineAnswer(context.Context, *SubmitTimelineAnswerRequest) (*SubmitTimelineAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTimelineAnswer not implemented")
+}
 func (UnimplementedQuizServiceServer) mustEmbedUnimplementedQuizServiceServer() {}
 func (UnimplementedQuizServiceServer) testEmbeddedByValue()                     {}
 
@@ -146,6 +186,42 @@ func _QuizService_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuizService_GetTimelineDuel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimelineDuelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetTimelineDuel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_GetTimelineDuel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetTimelineDuel(ctx, req.(*GetTimelineDuelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_SubmitTimelineAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTimelineAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).SubmitTimelineAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_SubmitTimelineAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).SubmitTimelineAnswer(ctx, req.(*SubmitTimelineAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuizService_ServiceDesc is the grpc.ServiceDesc for QuizService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +237,14 @@ var QuizService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitAnswer",
 			Handler:    _QuizService_SubmitAnswer_Handler,
 		},
+		{
+			MethodName: "GetTimelineDuel",
+			Handler:    _QuizService_GetTimelineDuel_Handler,
+		},
+		{
+			MethodName: "SubmitTimelineAnswer",
+			Handler:    _QuizService_SubmitTimelineAnswer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "historyquiz/quiz/v1/quiz_service.proto",
@@ -30,6 +30,7 @@ type Attempt struct {
 	SelectedChoiceId string                 `protobuf:"bytes,4,opt,name=selected_choice_id,json=selectedChoiceId,proto3" json:"selected_choice_id,omitempty"`
 	IsCorrect        bool                   `protobuf:"varint,5,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
 	AnsweredAt       string                 `protobuf:"bytes,6,opt,name=answered_at,json=answeredAt,proto3" json:"answered_at,omitempty"` // RFC3339
+	AnswerText       string                 `protobuf:"bytes,7,opt,name=answer_text,json=answerText,proto3" json:"answer_text,omitempty"` // 記述式で回答した場合の入力文字列
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -106,6 +107,13 @@ func (x *Attempt) GetAnsweredAt() string {
 	return ""
 }
 
+func (x *Attempt) GetAnswerText() string {
+	if x != nil {
+		return x.AnswerText
+	}
+	return ""
+}
+
 type Stats struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TotalAttempts   int64                  `protobuf:"varint,1,opt,name=total_attempts,json=totalAttempts,proto3" json:"total_attempts,omitempty"`
@@ -167,9 +175,10 @@ func (x *Stats) GetAccuracy() float64 {
 }
 
 type ListMyAttemptsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Pagination    *v1.Pagination         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *v1.RequestContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// 回答の新しい順に返す。page_token には前のページの next_page_token を渡す（不正な場合は INVALID_ARGUMENT）。
+	Pagination    *v1.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -378,7 +387,7 @@ var File_historyquiz_user_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_historyquiz_user_v1_user_service_proto_rawDesc = "" +
 	"\n" +
-	"&historyquiz/user/v1/user_service.proto\x12\x13historyquiz.user.v1\x1a\"historyquiz/common/v1/common.proto\"\xd1\x01\n" +
+	"&historyquiz/user/v1/user_service.proto\x12\x13historyquiz.user.v1\x1a\"historyquiz/common/v1/common.proto\"\xf2\x01\n" +
 	"\aAttempt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vquestion_id\x18\x02 \x01(\tR\n" +
@@ -388,7 +397,9 @@ const file_historyquiz_user_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"is_correct\x18\x05 \x01(\bR\tisCorrect\x12\x1f\n" +
 	"\vanswered_at\x18\x06 \x01(\tR\n" +
-	"answeredAt\"u\n" +
+	"answeredAt\x12\x1f\n" +
+	"\vanswer_text\x18\a \x01(\tR\n" +
+	"answerText\"u\n" +
 	"\x05Stats\x12%\n" +
 	"\x0etotal_attempts\x18\x01 \x01(\x03R\rtotalAttempts\x12)\n" +
 	"\x10correct_attempts\x18\x02 \x01(\x03R\x0fcorrectAttempts\x12\x1a\n" +
@@ -0,0 +1,57 @@
+// 書式付きテキスト（問題文/解説）の構文木を描画するコンポーネント。
+// NOTE: 文字列は React の子要素として渡すだけで、HTML として解釈しない（dangerouslySetInnerHTML は使わない）。
+
+import type { ReactNode } from "react";
+
+import type { RichText as RichTextValue, RichTextNode } from "../grpc/question.server";
+
+// isSafeHref はリンク先が http/https の場合だけ true を返す（バックエンドでも検証済みだが、描画側でも確認する）。
+function isSafeHref(url: string | undefined): url is string {
+  if (!url) {
+    return false;
+  }
+  try {
+    const parsed = new URL(url);
+    return parsed.protocol === "http:" || parsed.protocol === "https:";
+  } catch {
+    return false;
+  }
+}
+
+function renderNodes(nodes: RichTextNode[] | undefined): ReactNode[] {
+  return (nodes ?? []).map((node, index) => {
+    switch (node.kind) {
+      case "RICH_TEXT_NODE_KIND_RUBY":
+        return (
+          <ruby key={index}>
+            {node.text}
+            <rp>（</rp>
+            <rt>{node.reading}</rt>
+            <rp>）</rp>
+          </ruby>
+        );
+      case "RICH_TEXT_NODE_KIND_EMPHASIS":
+        return <strong key={index}>{renderNodes(node.children)}</strong>;
+      case "RICH_TEXT_NODE_KIND_LINE_BREAK":
+        return <br key={index} />;
+      case "RICH_TEXT_NODE_KIND_LINK":
+        return isSafeHref(node.url) ? (
+          <a key={index} href={node.url} rel="noopener noreferrer nofollow" target="_blank">
+            {renderNodes(node.children)}
+          </a>
+        ) : (
+          <span key={index}>{renderNodes(node.children)}</span>
+        );
+      default:
+        return <span key={index}>{node.text}</span>;
+    }
+  });
+}
+
+// RichText は構文木があればそれを描画し、無ければ（古いバックエンドなど）書式の文字列をそのまま表示する。
+export function RichText(props: { value?: RichTextValue; fallback: string }) {
+  if (!props.value?.nodes?.length) {
+    return <>{props.fallback}</>;
+  }
+  return <>{renderNodes(props.value.nodes)}</>;
+}
@@ -28,6 +28,7 @@ const DEFAULT_BACKEND_GRPC_TIMEOUT_MS = 3_000;
 
 const METADATA_KEY_USER_ID = "x-user-id";
 const METADATA_KEY_REQUEST_ID = "x-request-id";
+const METADATA_KEY_ACCEPT_LANGUAGE = "accept-language";
 
 const GRPC_STATUS_NAME_BY_NUMBER: Record<number, string> = {
   [grpcStatus.OK]: "OK",
@@ -64,6 +65,8 @@ export type RequestWithContext = {
 };
 
 export type GrpcCallContext = {
+  // ブラウザの Accept-Language をそのまま渡す（翻訳がある問題はその言語で返る）。
+  acceptLanguage?: string;
   requestId?: string;
   timeoutMs?: number;
   userId?: string;
@@ -88,6 +91,7 @@ export class GrpcCallError extends Error {
 }
 
 export type GrpcMetadata = {
+  acceptLanguage?: string;
   requestId: string;
   userId?: string;
 };
@@ -109,6 +113,10 @@ type QuestionRawClient = {
   getMyQuestion: UnaryMethod<unknown, unknown>;
   listMyQuestions: UnaryMethod<unknown, unknown>;
   updateQuestion: UnaryMethod<unknown, unknown>;
+  createPassage: UnaryMethod<unknown, unknown>;
+  updatePassage: UnaryMethod<unknown, unknown>;
+  getMyPassage: UnaryMethod<unknown, unknown>;
+  deletePassage: UnaryMethod<unknown, unknown>;
 };
 
 type UserRawClient = {
@@ -119,6 +127,8 @@ type UserRawClient = {
 const QUIZ_RPC_METHOD_NAMES: Record<QuizMethod, string> = {
   getQuestion: "/historyquiz.quiz.v1.QuizService/GetQuestion",
   submitAnswer: "/historyquiz.quiz.v1.QuizService/SubmitAnswer",
+  getTimelineDuel: "/historyquiz.quiz.v1.QuizService/GetTimelineDuel",
+  submitTimelineAnswer: "/historyquiz.quiz.v1.QuizService/SubmitTimelineAnswer",
 };
 
 const QUESTION_RPC_METHOD_NAMES: Record<QuestionMethod, string> = {
@@ -126,6 +136,10 @@ const QUESTION_RPC_METHOD_NAMES: Record<QuestionMethod, string> = {
   getMyQuestion: "/historyquiz.question.v1.QuestionService/GetMyQuestion",
   listMyQuestions: "/historyquiz.question.v1.QuestionService/ListMyQuestions",
   updateQuestion: "/historyquiz.question.v1.QuestionService/UpdateQuestion",
+  createPassage: "/historyquiz.question.v1.QuestionService/CreatePassage",
+  updatePassage: "/historyquiz.question.v1.QuestionService/UpdatePassage",
+  getMyPassage: "/historyquiz.question.v1.QuestionService/GetMyPassage",
+  deletePassage: "/historyquiz.question.v1.QuestionService/DeletePassage",
 };
 
 const USER_RPC_METHOD_NAMES: Record<UserMethod, string> = {
@@ -284,9 +298,10 @@ export function createRequestId(): string {
   return randomBytes(16).toString("hex");
 }
 
-// buildGrpcMetadata は gRPC metadata に入れる userId/requestId を構築する。
-export function buildGrpcMetadata(params: { requestId: string; userId?: string }): GrpcMetadata {
+// buildGrpcMetadata は gRPC metadata に入れる userId/requestId/acceptLanguage を構築する。
+export function buildGrpcMetadata(params: { acceptLanguage?: string; requestId: string; userId?: string }): GrpcMetadata {
   return {
+    acceptLanguage: params.acceptLanguage,
     requestId: params.requestId,
     userId: params.userId,
   };
@@ -300,12 +315,21 @@ function createMetadataObject(metadataValues: GrpcMetadata): Metadata {
     metadata.set(METADATA_KEY_USER_ID, metadataValues.userId);
   }
   metadata.set(METADATA_KEY_REQUEST_ID, metadataValues.requestId);
+  if (metadataValues.acceptLanguage && metadataValues.acceptLanguage.length > 0) {
+    metadata.set(METADATA_KEY_ACCEPT_LANGUAGE, metadataValues.acceptLanguage);
+  }
   return metadata;
 }
 
 // normalizeCallContext は callContext の必須値とデフォルト値を確定する。
-function normalizeCallContext(callContext: GrpcCallContext): { requestId: string; timeoutMs: number; userId: string } {
+function normalizeCallContext(callContext: GrpcCallContext): {
+  acceptLanguage: string;
+  requestId: string;
+  timeoutMs: number;
+  userId: string;
+} {
   return {
+    acceptLanguage: callContext.acceptLanguage?.trim() ?? "",
     requestId: callContext.requestId ?? createRequestId(),
     timeoutMs: callContext.timeoutMs ?? resolveGrpcTimeoutMs(),
     userId: callContext.userId?.trim() ?? "",
@@ -335,6 +359,7 @@ function invokeUnary<TRequest extends RequestWithContext, TResponse>(params: {
   const normalizedCallContext = normalizeCallContext(params.callContext);
   const requestWithContext = withRequestContext(params.request, normalizedCallContext.requestId);
   const grpcMetadataValues = buildGrpcMetadata({
+    acceptLanguage: normalizedCallContext.acceptLanguage,
     requestId: normalizedCallContext.requestId,
     userId: normalizedCallContext.userId,
   });
@@ -385,8 +410,9 @@ function toGrpcCodeName(code: number | undefined): string {
   return GRPC_STATUS_NAME_BY_NUMBER[code] ?? "UNKNOWN";
 }
 
-type QuizMethod = "getQuestion" | "submitAnswer";
-type QuestionMethod = "createQuestion" | "updateQuestion" | "getMyQuestion" | "listMyQuestions";
+type QuizMethod = "getQuestion" | "submitAnswer" | "getTimelineDuel" | "submitTimelineAnswer";
+type QuestionMethod = "createQuestion" | "updateQuestion" | "getMyQuestion" | "listMyQuestions" | "getQuestionStats" | "forkQuestion"
+  | "createPassage" | "updatePassage" | "getMyPassage" | "deletePassage";
 type UserMethod = "listMyAttempts" | "getMyStats";
 
 // callQuizService は QuizService の unary RPC を共通設定付きで呼び出す。
@@ -18,10 +18,97 @@ export type QuestionChoice = {
   ordinal: number;
 };
 
+export type QuestionStatus =
+  | "QUESTION_STATUS_UNSPECIFIED"
+  | "QUESTION_STATUS_DRAFT"
+  | "QUESTION_STATUS_PUBLISHED"
+  | "QUESTION_STATUS_UNLISTED"
+  | "QUESTION_STATUS_ARCHIVED";
+
+// 問題の答え方の種類（UNSPECIFIED は CHOICE として扱われる）。
+// LOCATION は地図でだけ答える問題（選択肢/別表記を持たず、location が必須）。
+export type QuestionKind = "QUESTION_KIND_UNSPECIFIED" | "QUESTION_KIND_CHOICE" | "QUESTION_KIND_LOCATION";
+
 export type QuestionSummary = {
   id: string;
   prompt: string;
   updatedAt: string;
+  status?: QuestionStatus;
+  // 以下は listMyQuestions でのみ設定される。
+  createdAt?: string;
+  // int64 のため proto-loader の longs: String で文字列になる。
+  attemptCount?: string;
+  correctAttempts?: string;
+  accuracy?: number;
+};
+
+export type QuestionSortKey =
+  | "QUESTION_SORT_KEY_UNSPECIFIED"
+  | "QUESTION_SORT_KEY_UPDATED_AT"
+  | "QUESTION_SORT_KEY_CREATED_AT"
+  | "QUESTION_SORT_KEY_ACCURACY"
+  | "QUESTION_SORT_KEY_ATTEMPT_COUNT";
+
+export type ExplanationFilter =
+  | "EXPLANATION_FILTER_UNSPECIFIED"
+  | "EXPLANATION_FILTER_WITH_EXPLANATION"
+  | "EXPLANATION_FILTER_WITHOUT_EXPLANATION";
+
+export type AttachmentKind = "ATTACHMENT_KIND_UNSPECIFIED" | "ATTACHMENT_KIND_IMAGE" | "ATTACHMENT_KIND_MAP";
+
+export type QuestionAttachment = {
+  attachmentId: string;
+  kind: AttachmentKind;
+  contentType: string;
+  // int64 のため proto-loader の longs: String で文字列になる。
+  sizeBytes: string;
+  altText: string;
+};
+
+export type AttachmentRef = {
+  attachmentId: string;
+  altText?: string;
+};
+
+export type CitationKind =
+  | "CITATION_KIND_UNSPECIFIED"
+  | "CITATION_KIND_BOOK"
+  | "CITATION_KIND_WEB"
+  | "CITATION_KIND_PRIMARY_SOURCE";
+
+export type Citation = {
+  kind: CitationKind;
+  title: string;
+  author?: string;
+  locator?: string;
+  url?: string;
+};
+
+export type RichTextNodeKind =
+  | "RICH_TEXT_NODE_KIND_UNSPECIFIED"
+  | "RICH_TEXT_NODE_KIND_TEXT"
+  | "RICH_TEXT_NODE_KIND_RUBY"
+  | "RICH_TEXT_NODE_KIND_EMPHASIS"
+  | "RICH_TEXT_NODE_KIND_LINE_BREAK"
+  | "RICH_TEXT_NODE_KIND_LINK";
+
+// 書式付きテキスト（問題文/解説）の構文木。text は HTML として解釈せず、そのまま文字として描画する。
+export type RichTextNode = {
+  kind: RichTextNodeKind;
+  text?: string;
+  reading?: string;
+  url?: string;
+  children?: RichTextNode[];
+};
+
+export type RichText = {
+  nodes?: RichTextNode[];
+};
+
+// 公開/公開終了の予約（RFC3339。空文字は予約なし）。
+export type QuestionSchedule = {
+  publishAt?: string;
+  unpublishAt?: string;
 };
 
 export type QuestionDetail = {
@@ -31,6 +118,21 @@ export type QuestionDetail = {
   correctChoiceId: string;
   explanation: string;
   updatedAt: string;
+  // 楽観ロック用の版番号（int64 のため proto-loader の longs: String で文字列になる）。
+  version: string;
+  attachments?: QuestionAttachment[];
+  citations?: Citation[];
+  // 複製（forkQuestion）で作った場合の元の問題。それ以外は空文字。
+  originQuestionId?: string;
+  // prompt/explanation（書式付き）の構文木。
+  promptRich?: RichText;
+  explanationRich?: RichText;
+  schedule?: QuestionSchedule;
+  location?: QuestionLocation;
+  // 史料を共有する問題の場合の史料。それ以外は空文字。
+  passageId?: string;
+  // LOCATION の場合は choices/correctChoiceId が空。
+  kind?: QuestionKind;
 };
 
 export type QuestionDraft = {
@@ -38,6 +140,24 @@ export type QuestionDraft = {
   choices: string[];
   correctOrdinal: number;
   explanation?: string;
+  attachments?: AttachmentRef[];
+  citations?: Citation[];
+  // 地図で答える場合の正解の地点（任意。kind が LOCATION の場合は必須）。
+  location?: QuestionLocation;
+  // 未指定は CHOICE。LOCATION の場合は choices を空にする（correctOrdinal は使われない）。
+  kind?: QuestionKind;
+};
+
+// 地球上の地点（度）。緯度は -90..90、経度は -180..180。
+export type LatLng = {
+  latitude: number;
+  longitude: number;
+};
+
+// 地図で答える問題の正解の地点と許容半径（1..2000 km）。
+export type QuestionLocation = {
+  point?: LatLng;
+  radiusKm: number;
 };
 
 export type CreateQuestionRequest = RequestWithContext & {
@@ -52,6 +172,10 @@ export type CreateQuestionResponse = {
 export type UpdateQuestionRequest = RequestWithContext & {
   questionId: string;
   draft: QuestionDraft;
+  // 編集を始めた時点の QuestionDetail.version。不一致の場合は ABORTED になる。
+  expectedVersion: string;
+  // 未指定の場合は予約を変えない。指定した場合は置き換える（空文字は予約の取り消し）。
+  schedule?: QuestionSchedule;
 };
 
 export type UpdateQuestionResponse = {
@@ -70,6 +194,16 @@ export type GetMyQuestionResponse = {
 
 export type ListMyQuestionsRequest = RequestWithContext & {
   pagination?: Pagination;
+  statuses?: QuestionStatus[];
+  tag?: string;
+  // RFC3339（createdFrom 以上 createdTo 未満）。
+  createdFrom?: string;
+  createdTo?: string;
+  explanation?: ExplanationFilter;
+  keyword?: string;
+  // 並び替えの条件を変えた場合は pageToken を付けずに先頭から読み直す。
+  sort?: QuestionSortKey;
+  ascending?: boolean;
 };
 
 export type ListMyQuestionsResponse = {
@@ -78,6 +212,134 @@ export type ListMyQuestionsResponse = {
   questions: QuestionSummary[];
 };
 
+export type GetQuestionStatsRequest = RequestWithContext & {
+  questionId: string;
+};
+
+// int64 は proto-loader の設定（longs: String）により文字列で届く。
+export type ChoiceStats = {
+  choiceId: string;
+  isCorrect: boolean;
+  label: string;
+  ordinal: number;
+  pickRate: number;
+  picks: string;
+};
+
+export type StatsBucket = {
+  accuracy: number;
+  attempts: string;
+  correctAttempts: string;
+  start: string;
+};
+
+export type QualityFlagKind =
+  | "QUALITY_FLAG_KIND_UNSPECIFIED"
+  | "QUALITY_FLAG_KIND_UNPICKED_DISTRACTOR"
+  | "QUALITY_FLAG_KIND_STRONG_PLAYERS_MISS";
+
+export type QualityFlag = {
+  choiceId: string;
+  kind: QualityFlagKind;
+};
+
+export type QuestionStats = {
+  accuracy: number;
+  choices: ChoiceStats[];
+  correctAttempts: string;
+  flags: QualityFlag[];
+  strongPlayerAccuracy: number;
+  strongPlayerAttempts: string;
+  textAttempts: string;
+  totalAttempts: string;
+  trend: StatsBucket[];
+  // 他のユーザーによる複製の数。
+  forkCount: string;
+};
+
+export type GetQuestionStatsResponse = {
+  context?: RequestContext;
+  stats?: QuestionStats;
+};
+
+export type ForkQuestionRequest = RequestWithContext & {
+  questionId: string;
+};
+
+export type ForkQuestionResponse = {
+  context?: RequestContext;
+  question?: QuestionDetail;
+};
+
+// 複数の問題が共有する史料の抜粋。
+export type Passage = {
+  id: string;
+  body: string;
+  source?: Citation;
+  attachment?: QuestionAttachment;
+  bodyRich?: RichText;
+};
+
+// 史料を共有する問題の1件。更新で questionId が空の問題は新しく作り、並びから外れた既存の問題は削除する。
+export type PassageQuestionDraft = {
+  questionId?: string;
+  draft: QuestionDraft;
+};
+
+export type PassageDraft = {
+  body: string;
+  source: Citation;
+  attachment?: AttachmentRef;
+  // 出題順に並べた子の問題（1..10件）。
+  questions: PassageQuestionDraft[];
+};
+
+export type PassageDetail = {
+  passage?: Passage;
+  questions: QuestionDetail[];
+  // 楽観ロック用の版番号（int64 のため proto-loader の longs: String で文字列になる）。
+  version: string;
+  updatedAt: string;
+};
+
+export type CreatePassageRequest = RequestWithContext & {
+  draft: PassageDraft;
+};
+
+export type CreatePassageResponse = {
+  context?: RequestContext;
+  passage?: PassageDetail;
+};
+
+export type UpdatePassageRequest = RequestWithContext & {
+  passageId: string;
+  draft: PassageDraft;
+  // 編集を始めた時点の PassageDetail.version。不一致の場合は ABORTED になる。
+  expectedVersion: string;
+};
+
+export type UpdatePassageResponse = {
+  context?: RequestContext;
+  passage?: PassageDetail;
+};
+
+export type GetMyPassageRequest = RequestWithContext & {
+  passageId: string;
+};
+
+export type GetMyPassageResponse = {
+  context?: RequestContext;
+  passage?: PassageDetail;
+};
+
+export type DeletePassageRequest = RequestWithContext & {
+  passageId: string;
+};
+
+export type DeletePassageResponse = {
+  context?: RequestContext;
+};
+
 // createQuestion は QuestionService/CreateQuestion を呼び出す。
 export function createQuestion(params: {
   callContext: GrpcCallContext;
@@ -125,3 +387,75 @@ export function listMyQuestions(params: {
     request: params.request,
   });
 }
+
+// getQuestionStats は QuestionService/GetQuestionStats を呼び出す（所有者のみ）。
+export function getQuestionStats(params: {
+  callContext: GrpcCallContext;
+  request: GetQuestionStatsRequest;
+}): Promise<GrpcCallResult<GetQuestionStatsResponse>> {
+  return callQuestionService<GetQuestionStatsRequest, GetQuestionStatsResponse>({
+    callContext: params.callContext,
+    method: "getQuestionStats",
+    request: params.request,
+  });
+}
+
+// forkQuestion は QuestionService/ForkQuestion を呼び出す（他のユーザーの公開中の問題を自分の下書きとして複製する）。
+export function forkQuestion(params: {
+  callContext: GrpcCallContext;
+  request: ForkQuestionRequest;
+}): Promise<GrpcCallResult<ForkQuestionResponse>> {
+  return callQuestionService<ForkQuestionRequest, ForkQuestionResponse>({
+    callContext: params.callContext,
+    method: "forkQuestion",
+    request: params.request,
+  });
+}
+
+// createPassage は QuestionService/CreatePassage を呼び出す（史料と子の問題をまとめて作成する）。
+export function createPassage(params: {
+  callContext: GrpcCallContext;
+  request: CreatePassageRequest;
+}): Promise<GrpcCallResult<CreatePassageResponse>> {
+  return callQuestionService<CreatePassageRequest, CreatePassageResponse>({
+    callContext: params.callContext,
+    method: "createPassage",
+    request: params.request,
+  });
+}
+
+// updatePassage は QuestionService/UpdatePassage を呼び出す（史料と子の問題をまとめて更新する）。
+export function updatePassage(params: {
+  callContext: GrpcCallContext;
+  request: UpdatePassageRequest;
+}): Promise<GrpcCallResult<UpdatePassageResponse>> {
+  return callQuestionService<UpdatePassageRequest, UpdatePassageResponse>({
+    callContext: params.callContext,
+    method: "updatePassage",
+    request: params.request,
+  });
+}
+
+// getMyPassage は QuestionService/GetMyPassage を呼び出す。
+export function getMyPassage(params: {
+  callContext: GrpcCallContext;
+  request: GetMyPassageRequest;
+}): Promise<GrpcCallResult<GetMyPassageResponse>> {
+  return callQuestionService<GetMyPassageRequest, GetMyPassageResponse>({
+    callContext: params.callContext,
+    method: "getMyPassage",
+    request: params.request,
+  });
+}
+
+// deletePassage は QuestionService/DeletePassage を呼び出す（史料と子の問題をまとめて削除する）。
+export function deletePassage(params: {
+  callContext: GrpcCallContext;
+  request: DeletePassageRequest;
+}): Promise<GrpcCallResult<DeletePassageResponse>> {
+  return callQuestionService<DeletePassageRequest, DeletePassageResponse>({
+    callContext: params.callContext,
+    method: "deletePassage",
+    request: params.request,
+  });
+}
@@ -2,6 +2,7 @@
 
 import type { GrpcCallContext, GrpcCallResult, RequestContext, RequestWithContext } from "./client.server";
 import { callQuizService } from "./client.server";
+import type { Citation, LatLng, Passage, QuestionKind, QuestionLocation, RichText } from "./question.server";
 
 export type QuizChoice = {
   id: string;
@@ -14,9 +15,45 @@ export type QuizQuestion = {
   prompt: string;
   choices: QuizChoice[];
   explanation: string;
+  // prompt/choices/explanation の言語（翻訳が無ければ原文の "ja"）。
+  locale?: string;
+  // prompt/explanation（書式付き）の構文木。描画にはこちらを使う。
+  promptRich?: RichText;
+  explanationRich?: RichText;
+  // 地図でも答えられる（正解の地点がある）問題。地点そのものは回答後にだけ返る。
+  acceptsLocation?: boolean;
+  // 史料を共有する問題の場合の史料と、その中での位置（1始まり）。
+  passage?: QuizPassage;
+  // LOCATION の場合は choices が空で、地図（location）でだけ答えられる。
+  kind?: QuestionKind;
+};
+
+// position/total は今出題できる子の問題の中での位置と数。
+export type QuizPassage = {
+  passage?: Passage;
+  position: number;
+  total: number;
+};
+
+export type EntityKind =
+  | "ENTITY_KIND_UNSPECIFIED"
+  | "ENTITY_KIND_PERSON"
+  | "ENTITY_KIND_EVENT"
+  | "ENTITY_KIND_PLACE"
+  | "ENTITY_KIND_DYNASTY";
+
+// 問題に付いたエンティティ（人物/出来事/場所/王朝）の要約。
+export type EntityRef = {
+  id: string;
+  kind: EntityKind;
+  name: string;
 };
 
 export type GetQuestionRequest = RequestWithContext & {
+  // 指定した場合はデッキの問題だけを並び順に出題する。
+  deckId?: string;
+  // 指定した場合はエンティティが付いた問題から出題する（deckId とは同時に指定しない）。
+  entityId?: string;
   previousQuestionId?: string;
 };
 
@@ -28,6 +65,26 @@ export type GetQuestionResponse = {
 export type SubmitAnswerRequest = RequestWithContext & {
   questionId: string;
   selectedChoiceId: string;
+  // 地図モードで選んだ地点（selectedChoiceId が空の場合のみ使う）。
+  location?: LatLng;
+};
+
+export type LocationBand =
+  | "LOCATION_BAND_UNSPECIFIED"
+  | "LOCATION_BAND_WITHIN"
+  | "LOCATION_BAND_NEAR"
+  | "LOCATION_BAND_REGION"
+  | "LOCATION_BAND_FAR"
+  | "LOCATION_BAND_MISS";
+
+// 地図モードの採点結果。
+export type LocationResult = {
+  // 選んだ地点から正解の地点までの大円距離（km）。
+  distanceKm: number;
+  band: LocationBand;
+  // 0..100（許容半径以内なら 100）。
+  score: number;
+  correctLocation?: QuestionLocation;
 };
 
 export type SubmitAnswerResponse = {
@@ -35,6 +92,12 @@ export type SubmitAnswerResponse = {
   context?: RequestContext;
   correctChoiceId: string;
   isCorrect: boolean;
+  // 回答後にだけ返る出典（表示順）。
+  citations?: Citation[];
+  // 回答後にだけ返る、問題に付いたエンティティ（名前順）。
+  entities?: EntityRef[];
+  // 地図モードで回答した場合の採点結果。
+  locationResult?: LocationResult;
 };
 
 // getQuestion は QuizService/GetQuestion を呼び出す。
@@ -60,3 +123,72 @@ export function submitAnswer(params: {
     request: params.request,
   });
 }
+
+export type TimelineDifficulty =
+  | "TIMELINE_DIFFICULTY_UNSPECIFIED"
+  | "TIMELINE_DIFFICULTY_EASY"
+  | "TIMELINE_DIFFICULTY_NORMAL"
+  | "TIMELINE_DIFFICULTY_HARD";
+
+// 「どちらが先か」で出題する出来事（年は回答後にだけ返る）。
+export type TimelineEvent = {
+  entityId: string;
+  name: string;
+};
+
+// 回答後に返る出来事と年（紀元前は負の数。yearMargin が 0 でなければ「頃」）。
+export type DatedTimelineEvent = TimelineEvent & {
+  year: number;
+  yearMargin: number;
+};
+
+export type GetTimelineDuelRequest = RequestWithContext & {
+  difficulty?: TimelineDifficulty;
+  // 2..4（省略時は 2）。
+  eventCount?: number;
+  previousEventIds?: string[];
+};
+
+export type GetTimelineDuelResponse = {
+  context?: RequestContext;
+  events: TimelineEvent[];
+  difficulty: TimelineDifficulty;
+};
+
+export type SubmitTimelineAnswerRequest = RequestWithContext & {
+  eventIds: string[];
+  chosenEventId: string;
+};
+
+export type SubmitTimelineAnswerResponse = {
+  context?: RequestContext;
+  isCorrect: boolean;
+  earliestEventId: string;
+  // 年の古い順。
+  events: DatedTimelineEvent[];
+  yearGap: number;
+};
+
+// getTimelineDuel は QuizService/GetTimelineDuel を呼び出す（「どちらが先か」の出題）。
+export function getTimelineDuel(params: {
+  callContext: GrpcCallContext;
+  request: GetTimelineDuelRequest;
+}): Promise<GrpcCallResult<GetTimelineDuelResponse>> {
+  return callQuizService<GetTimelineDuelRequest, GetTimelineDuelResponse>({
+    callContext: params.callContext,
+    method: "getTimelineDuel",
+    request: params.request,
+  });
+}
+
+// submitTimelineAnswer は QuizService/SubmitTimelineAnswer を呼び出す（回答は記録されない）。
+export function submitTimelineAnswer(params: {
+  callContext: GrpcCallContext;
+  request: SubmitTimelineAnswerRequest;
+}): Promise<GrpcCallResult<SubmitTimelineAnswerResponse>> {
+  return callQuizService<SubmitTimelineAnswerRequest, SubmitTimelineAnswerResponse>({
+    callContext: params.callContext,
+    method: "submitTimelineAnswer",
+    request: params.request,
+  });
+}
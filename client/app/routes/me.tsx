@@ -2,16 +2,29 @@
 
 import type { LoaderFunctionArgs } from "@remix-run/node";
 import { json } from "@remix-run/node";
-import { Link, isRouteErrorResponse, useLoaderData, useRouteError } from "@remix-run/react";
+import { Form, Link, isRouteErrorResponse, useLoaderData, useRouteError } from "@remix-run/react";
 
 import { createRequestId } from "../grpc/client.server";
-import { listMyQuestions } from "../grpc/question.server";
+import { listMyQuestions, type ListMyQuestionsRequest } from "../grpc/question.server";
 import { getMyStats, listMyAttempts, type Stats } from "../grpc/user.server";
 import { requireAuthenticatedUser } from "../services/auth.server";
 import { throwGrpcErrorResponse } from "../services/grpc-error.server";
 
 const LIST_PAGE_SIZE = 20;
 
+// 各一覧の続き（page_token）を受け取るクエリ文字列の名前。
+const ATTEMPTS_PAGE_TOKEN_PARAM = "attemptsPageToken";
+const QUESTIONS_PAGE_TOKEN_PARAM = "questionsPageToken";
+const QUESTIONS_SORT_PARAM = "questionsSort";
+
+// 自作問題一覧の並び替えの選択肢（クエリ文字列の値 → 表示名と ListMyQuestions の条件）。
+const QUESTIONS_SORT_OPTIONS: Record<string, { label: string; request: Pick<ListMyQuestionsRequest, "sort" | "ascending"> }> = {
+  updated: { label: "更新の新しい順", request: {} },
+  created: { label: "作成の新しい順", request: { sort: "QUESTION_SORT_KEY_CREATED_AT" } },
+  accuracy: { label: "正答率の低い順", request: { sort: "QUESTION_SORT_KEY_ACCURACY", ascending: true } },
+  attempts: { label: "回答数の多い順", request: { sort: "QUESTION_SORT_KEY_ATTEMPT_COUNT" } },
+};
+
 type LoaderData = {
   attempts: {
     id: string;
@@ -21,11 +34,16 @@ type LoaderData = {
     selectedChoiceId: string;
     answeredAt: string;
   }[];
+  attemptsNextHref: string | null;
   questions: {
     id: string;
     prompt: string;
     updatedAt: string;
+    attemptCount?: string;
+    accuracy?: number;
   }[];
+  questionsNextHref: string | null;
+  questionsSort: string;
   requestId: string;
   stats: {
     accuracy: number;
@@ -72,17 +90,39 @@ function toDisplayDateTime(value: string): string {
   }).format(parsed);
 }
 
+// toPagination は page_token がある場合だけ pagination に含める（先頭ページは page_token 無し）。
+function toPagination(pageToken: string | null) {
+  return pageToken ? { pageSize: LIST_PAGE_SIZE, pageToken } : { pageSize: LIST_PAGE_SIZE };
+}
+
+// toQuestionsSort はクエリ文字列の並び替えを既知の値にそろえる（未知の値は既定の更新順）。
+function toQuestionsSort(value: string | null): string {
+  return value && value in QUESTIONS_SORT_OPTIONS ? value : "updated";
+}
+
+// toNextPageHref は一覧の次ページの URL を返す（もう一方の一覧の位置は維持する）。次が無い場合は null。
+function toNextPageHref(url: URL, param: string, nextPageToken: string | undefined): string | null {
+  if (!nextPageToken) {
+    return null;
+  }
+  const params = new URLSearchParams(url.searchParams);
+  params.set(param, nextPageToken);
+  return `/me?${params.toString()}`;
+}
+
 // loader はマイページの各セクションに必要なデータを gRPC から取得する。
 export async function loader({ request }: LoaderFunctionArgs) {
   const user = await requireAuthenticatedUser(request);
   const requestId = createRequestId();
+  const url = new URL(request.url);
+  const questionsSort = toQuestionsSort(url.searchParams.get(QUESTIONS_SORT_PARAM));
 
   try {
     const [attemptsResult, statsResult, questionsResult] = await Promise.all([
       listMyAttempts({
         callContext: { requestId, userId: user.userId },
         request: {
-          pagination: { pageSize: LIST_PAGE_SIZE },
+          pagination: toPagination(url.searchParams.get(ATTEMPTS_PAGE_TOKEN_PARAM)),
         },
       }),
       getMyStats({
@@ -92,7 +132,8 @@ export async function loader({ request }: LoaderFunctionArgs) {
       listMyQuestions({
         callContext: { requestId, userId: user.userId },
         request: {
-          pagination: { pageSize: LIST_PAGE_SIZE },
+          pagination: toPagination(url.searchParams.get(QUESTIONS_PAGE_TOKEN_PARAM)),
+          ...QUESTIONS_SORT_OPTIONS[questionsSort].request,
         },
       }),
     ]);
@@ -100,7 +141,18 @@ export async function loader({ request }: LoaderFunctionArgs) {
     return json<LoaderData>(
       {
         attempts: attemptsResult.response.attempts,
+        attemptsNextHref: toNextPageHref(
+          url,
+          ATTEMPTS_PAGE_TOKEN_PARAM,
+          attemptsResult.response.pageInfo?.nextPageToken,
+        ),
         questions: questionsResult.response.questions,
+        questionsNextHref: toNextPageHref(
+          url,
+          QUESTIONS_PAGE_TOKEN_PARAM,
+          questionsResult.response.pageInfo?.nextPageToken,
+        ),
+        questionsSort,
         requestId,
         stats: toSafeStats(statsResult.response.stats),
         userId: user.userId,
@@ -163,8 +215,27 @@ export default function MeRoute() {
       ) : (
         <p className="muted">まだ解答履歴がありません。クイズに挑戦するとここに表示されます。</p>
       )}
+      {data.attemptsNextHref ? (
+        <p>
+          <Link to={data.attemptsNextHref}>さらに古い解答履歴へ</Link>
+        </p>
+      ) : null}
 
       <h2>自作問題一覧</h2>
+      {/* 並び替えを変えると、一覧の位置（questionsPageToken）は付けずに先頭から表示し直す。 */}
+      <Form method="get">
+        <label>
+          並び替え:{" "}
+          <select name={QUESTIONS_SORT_PARAM} defaultValue={data.questionsSort}>
+            {Object.entries(QUESTIONS_SORT_OPTIONS).map(([value, option]) => (
+              <option key={value} value={value}>
+                {option.label}
+              </option>
+            ))}
+          </select>
+        </label>{" "}
+        <button type="submit">表示</button>
+      </Form>
       {hasQuestions ? (
         <ul>
           {data.questions.map((question) => (
@@ -172,6 +243,13 @@ export default function MeRoute() {
               <Link to={`/questions/${question.id}/edit`}>{question.prompt || "（問題文なし）"}</Link>
               <p className="muted">
                 更新日時: <time dateTime={question.updatedAt}>{toDisplayDateTime(question.updatedAt)}</time>
+                {question.attemptCount !== undefined ? (
+                  <>
+                    {" "}
+                    / 回答数: {question.attemptCount}
+                    {question.attemptCount !== "0" ? ` / 正答率: ${toAccuracyLabel(question.accuracy ?? 0)}` : null}
+                  </>
+                ) : null}
               </p>
             </li>
           ))}
@@ -179,6 +257,11 @@ export default function MeRoute() {
       ) : (
         <p className="muted">まだ作成した問題がありません。問題作成から追加できます。</p>
       )}
+      {data.questionsNextHref ? (
+        <p>
+          <Link to={data.questionsNextHref}>さらに古い問題へ</Link>
+        </p>
+      ) : null}
 
       <p className="muted">
         requestId: <code>{data.requestId}</code>
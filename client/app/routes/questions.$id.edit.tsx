@@ -17,7 +17,7 @@ import {
 } from "@remix-run/react";
 
 import { createRequestId } from "../grpc/client.server";
-import type { QuestionDetail } from "../grpc/question.server";
+import type { QuestionDetail, QuestionSchedule } from "../grpc/question.server";
 import { getMyQuestion, updateQuestion } from "../grpc/question.server";
 import {
   QUESTION_CHOICES_COUNT,
@@ -35,7 +35,9 @@ type LoaderData = {
   initialValues: CreateQuestionFormValue;
   questionId: string;
   requestId: string;
+  schedule: QuestionSchedule;
   userId: string;
+  version: string;
 };
 
 type ActionData = {
@@ -49,6 +51,7 @@ type ActionData = {
   submissionResult?: SubmissionResult<string[]>;
 };
 const QUESTION_EDIT_ACTION_MAX_BODY_BYTES = 32 * 1024;
+const EXPECTED_VERSION_FIELD_NAME = "expectedVersion";
 
 // resolveRequiredQuestionId は URL パラメータの問題IDを検証し、空値を拒否する。
 function resolveRequiredQuestionId(id: string | undefined): string {
@@ -107,7 +110,9 @@ export async function loader({ params, request }: LoaderFunctionArgs) {
         initialValues: toInitialFormValues(question),
         questionId: question.id,
         requestId,
+        schedule: question.schedule ?? {},
         userId: user.userId,
+        version: question.version,
       },
       {
         headers: setCookie
@@ -141,6 +146,9 @@ export async function action({ params, request }: ActionFunctionArgs) {
     );
   }
 
+  // 混同しやすい点: 編集開始時点の版を送り、別タブ等で先に保存された内容を上書きしないようにする。
+  const expectedVersion = String(formData.get(EXPECTED_VERSION_FIELD_NAME) ?? "").trim();
+
   const submission = parseWithZod(formData, { schema: createQuestionFormSchema });
   if (submission.status !== "success") {
     return json<ActionData>(
@@ -165,6 +173,7 @@ export async function action({ params, request }: ActionFunctionArgs) {
           correctOrdinal: submission.value.correctOrdinal,
           explanation: submission.value.explanation,
         },
+        expectedVersion,
       },
     });
     const updatedQuestion = result.response.question;
@@ -248,8 +257,22 @@ export default function QuestionsEditRoute() {
         ログイン中ユーザー: <code>{data.userId}</code>
       </p>
 
+      {data.schedule.publishAt || data.schedule.unpublishAt ? (
+        <p className="muted">
+          予約: {data.schedule.publishAt ? <>公開 <code>{data.schedule.publishAt}</code> </> : null}
+          {data.schedule.unpublishAt ? <>公開終了 <code>{data.schedule.unpublishAt}</code></> : null}
+          （保存しても予約は変わりません）
+        </p>
+      ) : null}
+
+      <p className="muted">
+        問題文と解説では、ルビ（<code>北条時宗《ほうじょうときむね》</code>）、強調（<code>**強調**</code>）、リンク（
+        <code>[表示する文字](https://...)</code>）が使えます。解説では改行もそのまま表示されます。
+      </p>
+
       <Form method="post" {...getFormProps(form)}>
         <input type="hidden" name={CSRF_TOKEN_FIELD_NAME} value={data.csrfToken} />
+        <input type="hidden" name={EXPECTED_VERSION_FIELD_NAME} value={data.version} />
         <label style={{ display: "block" }}>
           問題文
           <input {...getInputProps(fields.prompt, { type: "text" })} style={{ display: "block", marginTop: 6, width: "100%" }} />
@@ -160,6 +160,11 @@ export default function QuestionsNewRoute() {
         問題文・選択肢4件・正解を入力して保存します（作成者本人のデータとして保存されます）。
       </p>
 
+      <p className="muted">
+        問題文と解説では、ルビ（<code>北条時宗《ほうじょうときむね》</code>）、強調（<code>**強調**</code>）、リンク（
+        <code>[表示する文字](https://...)</code>）が使えます。解説では改行もそのまま表示されます。
+      </p>
+
       <Form method="post" {...getFormProps(form)}>
         <input type="hidden" name={CSRF_TOKEN_FIELD_NAME} value={data.csrfToken} />
         <label style={{ display: "block" }}>
@@ -14,7 +14,10 @@ import {
   useRouteError,
 } from "@remix-run/react";
 
+import { RichText } from "../components/rich-text";
+import type { Citation } from "../grpc/question.server";
 import type { QuizQuestion } from "../grpc/quiz.server";
+import type { EntityRef } from "../grpc/quiz.server";
 import { getQuestion, submitAnswer } from "../grpc/quiz.server";
 import { quizAnswerFormSchema, resolveQuizChoiceFieldError } from "../schemas/quiz";
 import { CSRF_TOKEN_FIELD_NAME, issueCsrfToken, verifyCsrfToken } from "../services/csrf.server";
@@ -24,6 +27,10 @@ import { getUser } from "../services/session.server";
 
 type LoaderData = {
   csrfToken: string;
+  // デッキで遊んでいる場合のデッキ ID（次の問題もデッキから出す）。
+  deckId?: string;
+  // エンティティ（人物/出来事/場所/王朝）で絞って遊んでいる場合のエンティティ ID。
+  entityId?: string;
   question: QuizQuestion;
   requestId: string;
 };
@@ -35,7 +42,9 @@ type ActionData =
       requestId: string;
       result: {
         attemptId: string;
+        citations: Citation[];
         correctChoiceId: string;
+        entities: EntityRef[];
         isCorrect: boolean;
         questionId: string;
         selectedChoiceId: string;
@@ -66,12 +75,14 @@ export async function loader({ request }: LoaderFunctionArgs) {
   const user = await getUser(request);
   const url = new URL(request.url);
   const previousQuestionId = toOptionalTrimmedString(url.searchParams.get("previousQuestionId"));
+  const deckId = toOptionalTrimmedString(url.searchParams.get("deckId"));
+  const entityId = toOptionalTrimmedString(url.searchParams.get("entityId"));
   const { csrfToken, setCookie } = await issueCsrfToken(request);
 
   try {
     const result = await getQuestion({
-      callContext: { userId: user?.userId },
-      request: { previousQuestionId },
+      callContext: { acceptLanguage: request.headers.get("accept-language") ?? undefined, userId: user?.userId },
+      request: { deckId, entityId, previousQuestionId },
     });
     const question = result.response.question;
     if (!question || question.id.length === 0) {
@@ -81,6 +92,8 @@ export async function loader({ request }: LoaderFunctionArgs) {
     return json<LoaderData>(
       {
         csrfToken,
+        deckId,
+        entityId,
         question,
         requestId: result.requestId,
       },
@@ -143,7 +156,9 @@ export async function action({ request }: ActionFunctionArgs) {
         requestId: result.requestId,
         result: {
           attemptId: result.response.attemptId,
+          citations: result.response.citations ?? [],
           correctChoiceId: result.response.correctChoiceId,
+          entities: result.response.entities ?? [],
           isCorrect: result.response.isCorrect,
           questionId,
           selectedChoiceId,
@@ -195,12 +210,14 @@ export default function QuizRoute() {
   return (
     <section className="card">
       <h1>クイズ</h1>
-      <p className="muted">{data.question.prompt}</p>
+      <p className="muted" lang={data.question.locale}>
+        <RichText value={data.question.promptRich} fallback={data.question.prompt} />
+      </p>
 
       <Form method="post">
         <input type="hidden" name={CSRF_TOKEN_FIELD_NAME} value={data.csrfToken} />
         <input type="hidden" name="questionId" value={data.question.id} />
-        <fieldset disabled={isSubmitting || answered}>
+        <fieldset disabled={isSubmitting || answered} lang={data.question.locale}>
           <legend className="muted">回答</legend>
           {data.question.choices.map((choice) => {
             const isCorrectChoice = answered && choice.id === correctChoiceId;
@@ -254,11 +271,48 @@ export default function QuizRoute() {
           ) : null}
           {data.question.explanation.length > 0 ? (
             <p className="muted">
-              解説: <span>{data.question.explanation}</span>
+              解説:{" "}
+              <span>
+                <RichText value={data.question.explanationRich} fallback={data.question.explanation} />
+              </span>
+            </p>
+          ) : null}
+          {actionData.result.citations.length > 0 ? (
+            <div className="muted">
+              出典:
+              <ul>
+                {actionData.result.citations.map((citation, index) => (
+                  <li key={index}>
+                    {citation.url ? (
+                      <a href={citation.url} rel="noopener noreferrer nofollow" target="_blank">
+                        {citation.title}
+                      </a>
+                    ) : (
+                      citation.title
+                    )}
+                    {[citation.author, citation.locator].filter(Boolean).length > 0
+                      ? `（${[citation.author, citation.locator].filter(Boolean).join("、")}）`
+                      : null}
+                  </li>
+                ))}
+              </ul>
+            </div>
+          ) : null}
+          {actionData.result.entities.length > 0 ? (
+            <p className="muted">
+              関連:{" "}
+              {actionData.result.entities.map((entity, index) => (
+                <span key={entity.id}>
+                  {index > 0 ? "、" : null}
+                  <a href={`/quiz?entityId=${encodeURIComponent(entity.id)}`}>{entity.name}</a>
+                </span>
+              ))}
             </p>
           ) : null}
           <Form method="get">
             <input type="hidden" name="previousQuestionId" value={data.question.id} />
+            {data.deckId ? <input type="hidden" name="deckId" value={data.deckId} /> : null}
+            {data.entityId ? <input type="hidden" name="entityId" value={data.entityId} /> : null}
             <button type="submit" style={{ marginTop: 8 }}>
               次の問題へ
             </button>
@@ -62,6 +62,7 @@ type StoredQuestion = {
   id: string;
   prompt: string;
   updatedAt: string;
+  version: string;
 };
 
 // toQuestionDetailResponse はモック用の保存データを gRPC 応答形式へ変換する。
@@ -77,6 +78,7 @@ function toQuestionDetailResponse(question: StoredQuestion) {
     correctChoiceId: `${question.id}-choice-${question.correctOrdinal}`,
     explanation: question.explanation,
     updatedAt: question.updatedAt,
+    version: question.version,
   };
 }
 
@@ -148,7 +150,7 @@ describe("integration: 作問→一覧→編集", () => {
     verifyCsrfTokenMock.mockResolvedValue({ requestId: "req-csrf-test" });
     createRequestIdMock.mockImplementation(() => `req-${requestSequence++}`);
 
-    createQuestionMock.mockImplementation(async (params: { request: { draft: Omit<StoredQuestion, "id" | "updatedAt"> } }) => {
+    createQuestionMock.mockImplementation(async (params: { request: { draft: Omit<StoredQuestion, "id" | "updatedAt" | "version"> } }) => {
       const id = `q-${questionSequence++}`;
       const created: StoredQuestion = {
         id,
@@ -157,6 +159,7 @@ describe("integration: 作問→一覧→編集", () => {
         correctOrdinal: params.request.draft.correctOrdinal,
         explanation: params.request.draft.explanation ?? "",
         updatedAt: "2026-02-08T00:00:00.000Z",
+        version: "1",
       };
       questionStore.set(id, created);
 
@@ -186,7 +189,7 @@ describe("integration: 作問→一覧→編集", () => {
     });
 
     updateQuestionMock.mockImplementation(
-      async (params: { request: { draft: Omit<StoredQuestion, "id" | "updatedAt">; questionId: string } }) => {
+      async (params: { request: { draft: Omit<StoredQuestion, "id" | "updatedAt" | "version">; questionId: string } }) => {
         const current = questionStore.get(params.request.questionId);
         if (!current) {
           return {
@@ -204,6 +207,7 @@ describe("integration: 作問→一覧→編集", () => {
           correctOrdinal: params.request.draft.correctOrdinal,
           explanation: params.request.draft.explanation ?? "",
           updatedAt: "2026-02-08T00:10:00.000Z",
+          version: String(Number(current.version) + 1),
         };
         questionStore.set(current.id, updated);
 
@@ -295,10 +299,11 @@ describe("integration: 作問→一覧→編集", () => {
       createLoaderArgs(`http://localhost/questions/${createdQuestionId}/edit`, { id: createdQuestionId }),
     );
     expect(editLoadResponse.status).toBe(200);
-    const editLoadBody = await toJson<{ initialValues: { prompt: string; correctOrdinal: number } }>(
+    const editLoadBody = await toJson<{ initialValues: { prompt: string; correctOrdinal: number }; version: string }>(
       editLoadResponse,
     );
     expect(editLoadBody.initialValues.prompt).toBe("日本の首都はどこですか？");
+    expect(editLoadBody.version).toBe("1");
     expect(editLoadBody.initialValues.correctOrdinal).toBe(0);
     expect(getMyQuestionMock).toHaveBeenCalledWith({
       callContext: expect.objectContaining({
@@ -317,6 +322,7 @@ describe("integration: 作問→一覧→編集", () => {
         ["choices", "札幌"],
         ["correctOrdinal", "0"],
         ["explanation", "更新後の解説です。"],
+        ["expectedVersion", editLoadBody.version],
       ]),
     );
     expect(editActionResponse.status).toBe(200);
@@ -341,6 +347,7 @@ describe("integration: 作問→一覧→編集", () => {
           correctOrdinal: 0,
           explanation: "更新後の解説です。",
         },
+        expectedVersion: "1",
       },
     });
 
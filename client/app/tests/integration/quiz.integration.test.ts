@@ -120,9 +120,12 @@ describe("integration: /quiz", () => {
 
     const answerBody = await toJson<{
       ok: boolean;
-      result: { isCorrect: boolean; questionId: string; selectedChoiceId: string };
+      result: { citations: unknown[]; entities: unknown[]; isCorrect: boolean; questionId: string; selectedChoiceId: string };
     }>(answerResponse);
     expect(answerBody.ok).toBe(true);
+    // 出典が無い問題でも、画面側で分岐しなくてよいよう空配列にそろえる。
+    expect(answerBody.result.citations).toEqual([]);
+    expect(answerBody.result.entities).toEqual([]);
     expect(answerBody.result.isCorrect).toBe(false);
     expect(answerBody.result.questionId).toBe("q-1");
     expect(answerBody.result.selectedChoiceId).toBe("c-2");